@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -23,7 +24,7 @@ var (
 	logMetricsIntervalFlag = flag.Uint64("log-metrics-interval", 23,
 		"log metrics every specified seconds. 0 means disable logging")
 	httpFlag = flag.String("http", "",
-		"start http server at specified address")
+		"start http server at specified address, it serves pprof and the prometheus metrics on /metrics")
 )
 
 func startCPUProfile() func() {
@@ -168,6 +169,7 @@ func handleDebugFlags() {
 	}
 
 	if *httpFlag != "" {
+		http.Handle("/metrics", metric.Handler())
 		go func() {
 			if err := http.ListenAndServe(*httpFlag, nil); err != nil {
 				panic(err)
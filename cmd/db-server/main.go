@@ -40,8 +40,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 	"github.com/matrixorigin/matrixone/pkg/sql/handler"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	aoeDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
@@ -155,7 +157,8 @@ type aoeHandler struct {
 }
 
 type tpeHandler struct {
-	aoe *aoeHandler
+	aoe  *aoeHandler // nil for the pebble kv, which runs without the cube
+	pbkv *cPebble.Storage
 }
 
 type taeHandler struct {
@@ -202,6 +205,7 @@ func initAoe(configFilePath string) *aoeHandler {
 
 	//put the node info to the computation
 	compile.InitAddress(addr)
+	compile2.InitAddress(addr)
 
 	//aoe: catalog
 	c = catalog.NewCatalog(a)
@@ -252,9 +256,8 @@ func closeAoe(aoe *aoeHandler) {
 }
 
 func initTpe(configFilePath string, args []string) *tpeHandler {
-	aoe := initAoe(configFilePath)
+	var aoe *aoeHandler
 	tpeConf := &tpeEngine.TpeConfig{}
-	tpeConf.PBKV = nil
 	tpeConf.KVLimit = uint64(config.GlobalSystemVariables.GetTpeKVLimit())
 	tpeConf.ParallelReader = config.GlobalSystemVariables.GetTpeParallelReader()
 	tpeConf.MultiNode = config.GlobalSystemVariables.GetTpeMultiNode()
@@ -265,10 +268,21 @@ func initTpe(configFilePath string, args []string) *tpeHandler {
 	tpeConf.ValueLayoutSerializerType = config.GlobalSystemVariables.GetTpeValueLayoutSerializer()
 	configKvTyp := strings.ToLower(config.GlobalSystemVariables.GetTpeKVType())
 	if configKvTyp == "memorykv" {
+		aoe = initAoe(configFilePath)
 		tpeConf.KvType = tuplecodec.KV_MEMORY
 	} else if configKvTyp == "cubekv" {
+		aoe = initAoe(configFilePath)
 		tpeConf.KvType = tuplecodec.KV_CUBE
 		tpeConf.Cube = aoe.cube
+	} else if configKvTyp == "pebblekv" {
+		//the pebble kv is local to the node, the cube is not needed
+		targetDir := config.GlobalSystemVariables.GetStorePath()
+		if err := recreateDir(targetDir); err != nil {
+			logutil.Infof("Recreate dir error:%v\n", err)
+			os.Exit(RecreateDirExit)
+		}
+		tpeConf.KvType = tuplecodec.KV_PEBBLE
+		tpeConf.PBKV = getTpeKVStorage(targetDir)
 	} else {
 		logutil.Infof("there is no such kvType %s \n", configKvTyp)
 		os.Exit(CreateTpeExit)
@@ -305,11 +319,27 @@ func initTpe(configFilePath string, args []string) *tpeHandler {
 		logutil.Errorf("%s", err)
 	}
 
-	return &tpeHandler{aoe: aoe}
+	return &tpeHandler{aoe: aoe, pbkv: tpeConf.PBKV}
 }
 
 func closeTpe(tpe *tpeHandler) {
-	closeAoe(tpe.aoe)
+	if tpe.pbkv != nil {
+		tpe.pbkv.Close()
+	}
+	if tpe.aoe != nil {
+		closeAoe(tpe.aoe)
+	}
+}
+
+func getTpeKVStorage(targetDir string) *cPebble.Storage {
+	kvs, err := cPebble.NewStorage(targetDir+"/tpe/pebble", nil, &pebble.Options{
+		FS: vfs.NewPebbleFS(vfs.Default),
+	})
+	if err != nil {
+		logutil.Infof("create tpe kv storage error, %v\n", err)
+		os.Exit(CreateTpeExit)
+	}
+	return kvs
 }
 
 func initTae() *taeHandler {
@@ -402,6 +432,7 @@ func main() {
 	logutil.Infof("Shutdown The Server With Ctrl+C | Ctrl+\\.")
 
 	config.HostMmu = host.New(config.GlobalSystemVariables.GetHostMmuLimitation())
+	metric.RegisterHostMmu(config.HostMmu)
 
 	Host := config.GlobalSystemVariables.GetHost()
 	NodeId := config.GlobalSystemVariables.GetNodeID()
@@ -434,13 +465,18 @@ func main() {
 		fmt.Println("Initialize the TAE engine Done")
 	} else if engineName == "tpe" {
 		tpe = initTpe(configFilePath, args)
-		port = tpe.aoe.port
+		if tpe.aoe != nil {
+			port = tpe.aoe.port
+		}
 	} else {
 		logutil.Errorf("undefined engine %s", engineName)
 		os.Exit(LoadConfigExit)
 	}
 
-	srv, err := rpcserver.New(fmt.Sprintf("%s:%d", Host, port+100), 1<<30, logutil.GetGlobalLogger())
+	portOffset := config.GlobalSystemVariables.GetPortOffsetOfRpcServer()
+	compile.InitRemotePortOffset(portOffset)
+	compile2.InitRemotePortOffset(portOffset)
+	srv, err := rpcserver.New(fmt.Sprintf("%s:%d", Host, port+portOffset), 1<<30, logutil.GetGlobalLogger())
 	if err != nil {
 		logutil.Infof("Create rpcserver failed, %v", err)
 		os.Exit(CreateRPCExit)
@@ -450,6 +486,7 @@ func main() {
 	proc := process.New(mheap.New(gm))
 	hp := handler.New(config.StorageEngine, proc)
 	srv.Register(hp.Process)
+	compile2.InitRemoteCmd(uint64(srv.Register(hp.ProcessPipeline)))
 
 	go func() {
 		if err := srv.Run(); err != nil {
@@ -0,0 +1,46 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/fsck"
+)
+
+func main() {
+	truncate := flag.Bool("truncate", false, "truncate the log files at their first invalid entry")
+	flag.Usage = func() {
+		fmt.Printf("usage: %s [-truncate] dataDirectory\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	report, err := fsck.Check(flag.Arg(0), *truncate)
+	if err != nil {
+		fmt.Printf("check %s failed. error:%v\n", flag.Arg(0), err)
+		os.Exit(2)
+	}
+	fmt.Print(report.String())
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
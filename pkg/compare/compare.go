@@ -25,6 +25,7 @@ import (
 	aint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/int32s"
 	aint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/int64s"
 	aint8s "github.com/matrixorigin/matrixone/pkg/compare/asc/int8s"
+	atimes "github.com/matrixorigin/matrixone/pkg/compare/asc/times"
 	auint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint16s"
 	auint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint32s"
 	auint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint64s"
@@ -40,6 +41,7 @@ import (
 	dint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/int32s"
 	dint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/int64s"
 	dint8s "github.com/matrixorigin/matrixone/pkg/compare/desc/int8s"
+	dtimes "github.com/matrixorigin/matrixone/pkg/compare/desc/times"
 	duint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint16s"
 	duint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint32s"
 	duint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint64s"
@@ -100,7 +102,8 @@ func New(typ types.T, desc bool) Compare {
 			return dfloat64s.New()
 		}
 		return afloat64s.New()
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar,
+		types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		if desc {
 			return dvarchar.New()
 		}
@@ -110,6 +113,11 @@ func New(typ types.T, desc bool) Compare {
 			return ddates.New()
 		}
 		return adates.New()
+	case types.T_time:
+		if desc {
+			return dtimes.New()
+		}
+		return atimes.New()
 	case types.T_datetime:
 		if desc {
 			return ddatetimes.New()
@@ -15,12 +15,33 @@
 package compress
 
 import (
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
 	"github.com/pierrec/lz4"
 )
 
+// ZstdLevel is the compression level of zstd
+const ZstdLevel = zstd.DefaultCompression
+
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+// CompressBound returns the size of the buffer large enough to hold the
+// compressed data of size bytes
+func CompressBound(size int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(size)
+	case Zstd:
+		return zstd.CompressBound(size)
+	case Snappy:
+		return snappy.MaxEncodedLen(size)
+	}
+	return size
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
@@ -31,6 +52,10 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.CompressLevel(dst, src, ZstdLevel)
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
@@ -43,6 +68,10 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.Decompress(dst, src)
+	case Snappy:
+		return snappy.Decode(dst, src)
 	}
 	return nil, nil
 }
@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"testing"
@@ -41,3 +42,31 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestAlgorithms(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 10)
+	}
+	raw := encoding.EncodeInt64Slice(xs)
+	for name, typ := range Algorithms {
+		if typ == None {
+			continue
+		}
+		buf := make([]byte, CompressBound(len(raw), typ))
+		buf, err := Compress(raw, buf, typ)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(buf) >= len(raw) {
+			t.Fatalf("%s: %d bytes are compressed to %d bytes", name, len(raw), len(buf))
+		}
+		data := make([]byte, len(raw))
+		if data, err = Decompress(buf, data, typ); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("%s: decompressed data mismatch", name)
+		}
+	}
+}
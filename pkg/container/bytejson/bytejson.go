@@ -0,0 +1,424 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+var (
+	Null  = ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
+	True  = ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralTrue}}
+	False = ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralFalse}}
+)
+
+// ParseFromString parses a json text to be a ByteJson
+func ParseFromString(s string) (ByteJson, error) {
+	return ParseFromByteSlice([]byte(s))
+}
+
+// ParseFromByteSlice parses a json text to be a ByteJson
+func ParseFromByteSlice(s []byte) (ByteJson, error) {
+	dec := json.NewDecoder(bytes.NewReader(s))
+	dec.UseNumber()
+	var in any
+	if err := dec.Decode(&in); err != nil {
+		return ByteJson{}, ErrInvalidJson
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return ByteJson{}, ErrInvalidJson
+	}
+	return CreateByteJson(in)
+}
+
+// IsValid returns true if s is a valid json text
+func IsValid(s []byte) bool {
+	return json.Valid(s)
+}
+
+// CreateByteJson builds a ByteJson from a go value, the supported types are
+// nil, bool, int64, uint64, float64, json.Number, string, []any,
+// map[string]any and ByteJson
+func CreateByteJson(in any) (ByteJson, error) {
+	tp, data, err := appendValue(nil, in)
+	if err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: tp, Data: data}, nil
+}
+
+// String creates a json string value
+func String(s string) ByteJson {
+	return ByteJson{Type: TpCodeString, Data: appendString(nil, s)}
+}
+
+// Unmarshal reads a ByteJson from the storage format, without copy
+func Unmarshal(buf []byte) (ByteJson, error) {
+	if len(buf) == 0 {
+		return ByteJson{}, ErrInvalidJson
+	}
+	return ByteJson{Type: TpCode(buf[0]), Data: buf[1:]}, nil
+}
+
+// Marshal returns the storage format of bj: | type | data |
+func (bj ByteJson) Marshal() []byte {
+	buf := make([]byte, 0, len(bj.Data)+1)
+	buf = append(buf, byte(bj.Type))
+	return append(buf, bj.Data...)
+}
+
+func appendValue(buf []byte, in any) (TpCode, []byte, error) {
+	switch v := in.(type) {
+	case nil:
+		return TpCodeLiteral, append(buf, LiteralNull), nil
+	case bool:
+		if v {
+			return TpCodeLiteral, append(buf, LiteralTrue), nil
+		}
+		return TpCodeLiteral, append(buf, LiteralFalse), nil
+	case int64:
+		return TpCodeInt64, appendUint64(buf, uint64(v)), nil
+	case uint64:
+		return TpCodeUint64, appendUint64(buf, v), nil
+	case float64:
+		return TpCodeFloat64, appendUint64(buf, math.Float64bits(v)), nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return TpCodeInt64, appendUint64(buf, uint64(i)), nil
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return TpCodeUint64, appendUint64(buf, u), nil
+		}
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return 0, nil, ErrInvalidJson
+		}
+		return TpCodeFloat64, appendUint64(buf, math.Float64bits(f)), nil
+	case string:
+		return TpCodeString, appendString(buf, v), nil
+	case ByteJson:
+		return v.Type, append(buf, v.Data...), nil
+	case []any:
+		buf, err := appendArray(buf, v)
+		return TpCodeArray, buf, err
+	case map[string]any:
+		buf, err := appendObject(buf, v)
+		return TpCodeObject, buf, err
+	}
+	return 0, nil, ErrUnsupportedType
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [numberSize]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendString(buf []byte, s string) []byte {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], uint64(len(s)))
+	buf = append(buf, b[:n]...)
+	return append(buf, s...)
+}
+
+func appendContainer(buf []byte, keys []string, vals []any) ([]byte, error) {
+	start := len(buf)
+	cnt := len(vals)
+	entryOff := headerSize + len(keys)*keyEntrySize
+	dataOff := entryOff + cnt*valEntrySize
+	for _, k := range keys {
+		if len(k) > maxKeyLen {
+			return nil, ErrKeyTooLong
+		}
+		dataOff += len(k)
+	}
+	buf = append(buf, make([]byte, dataOff)...)
+	binary.LittleEndian.PutUint32(buf[start:], uint32(cnt))
+	keyOff := entryOff + cnt*valEntrySize
+	for i, k := range keys {
+		entry := buf[start+headerSize+i*keyEntrySize:]
+		binary.LittleEndian.PutUint32(entry, uint32(keyOff))
+		binary.LittleEndian.PutUint16(entry[4:], uint16(len(k)))
+		copy(buf[start+keyOff:], k)
+		keyOff += len(k)
+	}
+	for i, v := range vals {
+		var tp TpCode
+		var err error
+		valOff := len(buf) - start
+		if tp, buf, err = appendValue(buf, v); err != nil {
+			return nil, err
+		}
+		entry := buf[start+entryOff+i*valEntrySize:]
+		entry[0] = byte(tp)
+		if tp == TpCodeLiteral {
+			// inline the literal into the entry
+			entry[1] = buf[len(buf)-1]
+			buf = buf[:len(buf)-1]
+			continue
+		}
+		binary.LittleEndian.PutUint32(entry[1:], uint32(valOff))
+	}
+	binary.LittleEndian.PutUint32(buf[start+4:], uint32(len(buf)-start))
+	return buf, nil
+}
+
+func appendArray(buf []byte, vals []any) ([]byte, error) {
+	return appendContainer(buf, nil, vals)
+}
+
+func appendObject(buf []byte, m map[string]any) ([]byte, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return compareKey(keys[i], keys[j]) < 0 })
+	vals := make([]any, len(keys))
+	for i, k := range keys {
+		vals[i] = m[k]
+	}
+	return appendContainer(buf, keys, vals)
+}
+
+// compareKey orders the object keys as MySQL does, shorter keys first
+func compareKey(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// GetElemCnt returns the element count of an object or an array
+func (bj ByteJson) GetElemCnt() int {
+	return int(binary.LittleEndian.Uint32(bj.Data))
+}
+
+func (bj ByteJson) getValEntry(off int) ByteJson {
+	tp := TpCode(bj.Data[off])
+	if tp == TpCodeLiteral {
+		return ByteJson{Type: tp, Data: bj.Data[off+1 : off+2]}
+	}
+	valOff := int(binary.LittleEndian.Uint32(bj.Data[off+1:]))
+	switch tp {
+	case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+		return ByteJson{Type: tp, Data: bj.Data[valOff : valOff+numberSize]}
+	case TpCodeString:
+		l, n := binary.Uvarint(bj.Data[valOff:])
+		return ByteJson{Type: tp, Data: bj.Data[valOff : valOff+n+int(l)]}
+	}
+	size := int(binary.LittleEndian.Uint32(bj.Data[valOff+4:]))
+	return ByteJson{Type: tp, Data: bj.Data[valOff : valOff+size]}
+}
+
+// GetArrayElem returns the i-th element of an array
+func (bj ByteJson) GetArrayElem(i int) ByteJson {
+	return bj.getValEntry(headerSize + i*valEntrySize)
+}
+
+// GetObjectKey returns the i-th key of an object
+func (bj ByteJson) GetObjectKey(i int) []byte {
+	entry := bj.Data[headerSize+i*keyEntrySize:]
+	off := binary.LittleEndian.Uint32(entry)
+	l := binary.LittleEndian.Uint16(entry[4:])
+	return bj.Data[off : off+uint32(l)]
+}
+
+// GetObjectVal returns the i-th value of an object
+func (bj ByteJson) GetObjectVal(i int) ByteJson {
+	cnt := bj.GetElemCnt()
+	return bj.getValEntry(headerSize + cnt*keyEntrySize + i*valEntrySize)
+}
+
+// GetObjectValByKey looks up a key with binary search
+func (bj ByteJson) GetObjectValByKey(key string) (ByteJson, bool) {
+	cnt := bj.GetElemCnt()
+	i := sort.Search(cnt, func(i int) bool {
+		return compareKey(string(bj.GetObjectKey(i)), key) >= 0
+	})
+	if i < cnt && string(bj.GetObjectKey(i)) == key {
+		return bj.GetObjectVal(i), true
+	}
+	return ByteJson{}, false
+}
+
+func (bj ByteJson) GetInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetUint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.Data)
+}
+
+func (bj ByteJson) GetFloat64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetString() []byte {
+	l, n := binary.Uvarint(bj.Data)
+	return bj.Data[n : n+int(l)]
+}
+
+func (bj ByteJson) IsNull() bool {
+	return bj.Type == TpCodeLiteral && bj.Data[0] == LiteralNull
+}
+
+// ToGo decodes bj to be a go value, see CreateByteJson
+func (bj ByteJson) ToGo() any {
+	switch bj.Type {
+	case TpCodeLiteral:
+		switch bj.Data[0] {
+		case LiteralTrue:
+			return true
+		case LiteralFalse:
+			return false
+		}
+		return nil
+	case TpCodeInt64:
+		return bj.GetInt64()
+	case TpCodeUint64:
+		return bj.GetUint64()
+	case TpCodeFloat64:
+		return bj.GetFloat64()
+	case TpCodeString:
+		return string(bj.GetString())
+	case TpCodeArray:
+		vals := make([]any, bj.GetElemCnt())
+		for i := range vals {
+			vals[i] = bj.GetArrayElem(i).ToGo()
+		}
+		return vals
+	case TpCodeObject:
+		cnt := bj.GetElemCnt()
+		m := make(map[string]any, cnt)
+		for i := 0; i < cnt; i++ {
+			m[string(bj.GetObjectKey(i))] = bj.GetObjectVal(i).ToGo()
+		}
+		return m
+	}
+	return nil
+}
+
+func (bj ByteJson) String() string {
+	return string(bj.appendTo(nil))
+}
+
+// Unquote returns the raw string of a json string, and the json text of others
+func (bj ByteJson) Unquote() string {
+	if bj.Type == TpCodeString {
+		return string(bj.GetString())
+	}
+	return bj.String()
+}
+
+func (bj ByteJson) appendTo(buf []byte) []byte {
+	switch bj.Type {
+	case TpCodeLiteral:
+		switch bj.Data[0] {
+		case LiteralTrue:
+			return append(buf, "true"...)
+		case LiteralFalse:
+			return append(buf, "false"...)
+		}
+		return append(buf, "null"...)
+	case TpCodeInt64:
+		return strconv.AppendInt(buf, bj.GetInt64(), 10)
+	case TpCodeUint64:
+		return strconv.AppendUint(buf, bj.GetUint64(), 10)
+	case TpCodeFloat64:
+		return appendFloat(buf, bj.GetFloat64())
+	case TpCodeString:
+		return appendQuoted(buf, bj.GetString())
+	case TpCodeArray:
+		buf = append(buf, '[')
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.GetArrayElem(i).appendTo(buf)
+		}
+		return append(buf, ']')
+	case TpCodeObject:
+		buf = append(buf, '{')
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendQuoted(buf, bj.GetObjectKey(i))
+			buf = append(buf, ": "...)
+			buf = bj.GetObjectVal(i).appendTo(buf)
+		}
+		return append(buf, '}')
+	}
+	return buf
+}
+
+func appendFloat(buf []byte, f float64) []byte {
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	return strconv.AppendFloat(buf, f, format, -1, 64)
+}
+
+const hexDigits = "0123456789abcdef"
+
+func appendQuoted(buf []byte, s []byte) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			default:
+				if c < 0x20 {
+					buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+				} else {
+					buf = append(buf, c)
+				}
+			}
+			i++
+			continue
+		}
+		_, size := utf8.DecodeRune(s[i:])
+		buf = append(buf, s[i:i+size]...)
+		i += size
+	}
+	return append(buf, '"')
+}
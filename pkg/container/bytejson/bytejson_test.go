@@ -0,0 +1,168 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	kases := []struct {
+		s    string
+		want string
+	}{
+		{`1`, `1`},
+		{` -1 `, `-1`},
+		{`18446744073709551615`, `18446744073709551615`},
+		{`1.5`, `1.5`},
+		{`"a\"b\n"`, `"a\"b\n"`},
+		{`true`, `true`},
+		{`null`, `null`},
+		{`[]`, `[]`},
+		{`{}`, `{}`},
+		{`[1, "a", null, [false]]`, `[1, "a", null, [false]]`},
+		{`{"bb": 1, "a": {"c": [1, 2]}, "ab": null}`, `{"a": {"c": [1, 2]}, "ab": null, "bb": 1}`},
+	}
+	for _, k := range kases {
+		bj, err := ParseFromString(k.s)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, bj.String())
+
+		got, err := Unmarshal(bj.Marshal())
+		require.NoError(t, err)
+		require.Equal(t, k.want, got.String())
+	}
+
+	for _, s := range []string{``, `{`, `[1,]`, `1 2`, `{"a"}`} {
+		_, err := ParseFromString(s)
+		require.Error(t, err, s)
+		require.False(t, IsValid([]byte(s)))
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for _, s := range []string{`$`, `$.a`, `$."a b"[1]`, `$.*`, `$[*].a`, `$**.b`, `$[last]`} {
+		_, err := ParsePath(s)
+		require.NoError(t, err, s)
+	}
+	for _, s := range []string{``, `a`, `$.`, `$[`, `$[a]`, `$**`, `$.a b`} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func mustPaths(t *testing.T, ss ...string) []*Path {
+	paths := make([]*Path, len(ss))
+	for i, s := range ss {
+		p, err := ParsePath(s)
+		require.NoError(t, err)
+		paths[i] = p
+	}
+	return paths
+}
+
+func TestExtract(t *testing.T) {
+	bj, err := ParseFromString(`{"a": [1, {"b": 2}, "x"], "c": {"b": 3}}`)
+	require.NoError(t, err)
+	kases := []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{`$`}, `{"a": [1, {"b": 2}, "x"], "c": {"b": 3}}`},
+		{[]string{`$.a[0]`}, `1`},
+		{[]string{`$.a[last]`}, `"x"`},
+		{[]string{`$.c`}, `{"b": 3}`},
+		{[]string{`$.c[0].b`}, `3`},
+		{[]string{`$.a[1].b`, `$.c.b`}, `[2, 3]`},
+		{[]string{`$.*.b`}, `[3]`},
+		{[]string{`$**.b`}, `[2, 3]`},
+		{[]string{`$.a[*]`}, `[1, {"b": 2}, "x"]`},
+	}
+	for _, k := range kases {
+		res, ok := bj.Extract(mustPaths(t, k.paths...))
+		require.True(t, ok, k.paths)
+		require.Equal(t, k.want, res.String(), k.paths)
+	}
+	_, ok := bj.Extract(mustPaths(t, `$.d`))
+	require.False(t, ok)
+
+	res, _ := bj.Extract(mustPaths(t, `$.a[2]`))
+	require.Equal(t, "x", res.Unquote())
+}
+
+func TestLengthKeys(t *testing.T) {
+	bj, err := ParseFromString(`{"a": [1, 2], "b": 1}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), bj.Length())
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+
+	arr, _ := bj.Extract(mustPaths(t, `$.a`))
+	require.Equal(t, int64(2), arr.Length())
+	_, ok = arr.Keys()
+	require.False(t, ok)
+	require.Equal(t, int64(1), String("x").Length())
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target, candidate string
+		want              bool
+	}{
+		{`1`, `1`, true},
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`[1, 2, [3]]`, `2`, true},
+		{`[1, 2, [3]]`, `[1, 3]`, true},
+		{`[1, 2]`, `[1, 4]`, false},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1}`, `{"a": 1, "b": 2}`, false},
+		{`{"a": 1}`, `1`, false},
+	}
+	for _, k := range kases {
+		target, err := ParseFromString(k.target)
+		require.NoError(t, err)
+		candidate, err := ParseFromString(k.candidate)
+		require.NoError(t, err)
+		require.Equal(t, k.want, target.Contains(candidate), "%s %s", k.target, k.candidate)
+	}
+}
+
+func TestSet(t *testing.T) {
+	bj, err := ParseFromString(`{"a": 1, "b": [2, 3]}`)
+	require.NoError(t, err)
+	kases := []struct {
+		path, val, want string
+	}{
+		{`$.a`, `10`, `{"a": 10, "b": [2, 3]}`},
+		{`$.c`, `[true]`, `{"a": 1, "b": [2, 3], "c": [true]}`},
+		{`$.b[5]`, `4`, `{"a": 1, "b": [2, 3, 4]}`},
+		{`$.b[0]`, `"x"`, `{"a": 1, "b": ["x", 3]}`},
+		{`$.a[1]`, `2`, `{"a": [1, 2], "b": [2, 3]}`},
+		{`$.d.e`, `1`, `{"a": 1, "b": [2, 3]}`},
+	}
+	for _, k := range kases {
+		val, err := ParseFromString(k.val)
+		require.NoError(t, err)
+		res, err := bj.Set(mustPaths(t, k.path), []ByteJson{val})
+		require.NoError(t, err)
+		require.Equal(t, k.want, res.String(), k.path)
+	}
+	_, err = bj.Set(mustPaths(t, `$.*`), []ByteJson{Null})
+	require.Error(t, err)
+}
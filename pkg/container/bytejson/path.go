@@ -0,0 +1,166 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"strconv"
+	"strings"
+)
+
+type pathLegType byte
+
+const (
+	pathLegKey            pathLegType = iota // .key or ."key"
+	pathLegIndex                             // [n] or [last]
+	pathLegKeyWildcard                       // .*
+	pathLegIndexWildcard                     // [*]
+	pathLegDoubleWildcard                    // **
+)
+
+// lastIndex is the index of [last]
+const lastIndex = -1
+
+type pathLeg struct {
+	typ   pathLegType
+	key   string
+	index int
+}
+
+// Path is a parsed MySQL json path expression, such as $.a[0].b
+type Path struct {
+	legs        []pathLeg
+	hasWildcard bool
+}
+
+// ParsePath parses a json path expression, the supported syntax is
+// $, .key, ."key", .*, [n], [last], [*] and **
+func ParsePath(s string) (*Path, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, ErrInvalidPath
+	}
+	p := &Path{}
+	i := 1
+	for {
+		for i < len(s) && s[i] == ' ' {
+			i++
+		}
+		if i == len(s) {
+			break
+		}
+		switch {
+		case s[i] == '.':
+			i++
+			for i < len(s) && s[i] == ' ' {
+				i++
+			}
+			if i == len(s) {
+				return nil, ErrInvalidPath
+			}
+			switch s[i] {
+			case '*':
+				p.legs = append(p.legs, pathLeg{typ: pathLegKeyWildcard})
+				p.hasWildcard = true
+				i++
+			case '"':
+				j := i + 1
+				for ; j < len(s) && s[j] != '"'; j++ {
+					if s[j] == '\\' {
+						j++
+					}
+				}
+				if j >= len(s) {
+					return nil, ErrInvalidPath
+				}
+				key, err := strconv.Unquote(s[i : j+1])
+				if err != nil {
+					return nil, ErrInvalidPath
+				}
+				p.legs = append(p.legs, pathLeg{typ: pathLegKey, key: key})
+				i = j + 1
+			default:
+				j := i
+				for j < len(s) && s[j] != '.' && s[j] != '[' && s[j] != ' ' && s[j] != '*' {
+					j++
+				}
+				if j == i {
+					return nil, ErrInvalidPath
+				}
+				p.legs = append(p.legs, pathLeg{typ: pathLegKey, key: s[i:j]})
+				i = j
+			}
+		case s[i] == '[':
+			j := strings.IndexByte(s[i:], ']')
+			if j < 0 {
+				return nil, ErrInvalidPath
+			}
+			idx := strings.TrimSpace(s[i+1 : i+j])
+			switch idx {
+			case "*":
+				p.legs = append(p.legs, pathLeg{typ: pathLegIndexWildcard})
+				p.hasWildcard = true
+			case "last":
+				p.legs = append(p.legs, pathLeg{typ: pathLegIndex, index: lastIndex})
+			default:
+				n, err := strconv.ParseUint(idx, 10, 31)
+				if err != nil {
+					return nil, ErrInvalidPath
+				}
+				p.legs = append(p.legs, pathLeg{typ: pathLegIndex, index: int(n)})
+			}
+			i += j + 1
+		case strings.HasPrefix(s[i:], "**"):
+			p.legs = append(p.legs, pathLeg{typ: pathLegDoubleWildcard})
+			p.hasWildcard = true
+			i += 2
+		default:
+			return nil, ErrInvalidPath
+		}
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == pathLegDoubleWildcard {
+		return nil, ErrInvalidPath
+	}
+	return p, nil
+}
+
+// HasWildcard returns true if the path contains *, [*] or **
+func (p *Path) HasWildcard() bool {
+	return p.hasWildcard
+}
+
+func (p *Path) String() string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for _, leg := range p.legs {
+		switch leg.typ {
+		case pathLegKey:
+			b.WriteByte('.')
+			b.WriteString(strconv.Quote(leg.key))
+		case pathLegIndex:
+			if leg.index == lastIndex {
+				b.WriteString("[last]")
+			} else {
+				b.WriteString("[" + strconv.Itoa(leg.index) + "]")
+			}
+		case pathLegKeyWildcard:
+			b.WriteString(".*")
+		case pathLegIndexWildcard:
+			b.WriteString("[*]")
+		case pathLegDoubleWildcard:
+			b.WriteString("**")
+		}
+	}
+	return b.String()
+}
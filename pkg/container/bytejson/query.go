@@ -0,0 +1,242 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+)
+
+// Extract returns the values matched by the paths, false if nothing matches.
+// As JSON_EXTRACT, the matches are wrapped into an array unless there is
+// only one path without wildcard.
+func (bj ByteJson) Extract(paths []*Path) (ByteJson, bool) {
+	var matches []ByteJson
+	for _, p := range paths {
+		matches = bj.query(p.legs, matches)
+	}
+	if len(matches) == 0 {
+		return ByteJson{}, false
+	}
+	if len(paths) == 1 && !paths[0].hasWildcard {
+		return matches[0], true
+	}
+	vals := make([]any, len(matches))
+	for i, m := range matches {
+		vals[i] = m
+	}
+	res, err := CreateByteJson(vals)
+	if err != nil {
+		return ByteJson{}, false
+	}
+	return res, true
+}
+
+func (bj ByteJson) query(legs []pathLeg, matches []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(matches, bj)
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case pathLegKey:
+		if bj.Type == TpCodeObject {
+			if v, ok := bj.GetObjectValByKey(leg.key); ok {
+				matches = v.query(rest, matches)
+			}
+		}
+	case pathLegKeyWildcard:
+		if bj.Type == TpCodeObject {
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				matches = bj.GetObjectVal(i).query(rest, matches)
+			}
+		}
+	case pathLegIndex:
+		if bj.Type == TpCodeArray {
+			cnt := bj.GetElemCnt()
+			idx := leg.index
+			if idx == lastIndex {
+				idx = cnt - 1
+			}
+			if idx >= 0 && idx < cnt {
+				matches = bj.GetArrayElem(idx).query(rest, matches)
+			}
+		} else if leg.index == 0 || leg.index == lastIndex {
+			// a scalar or an object is treated as an array of one element
+			matches = bj.query(rest, matches)
+		}
+	case pathLegIndexWildcard:
+		if bj.Type == TpCodeArray {
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				matches = bj.GetArrayElem(i).query(rest, matches)
+			}
+		}
+	case pathLegDoubleWildcard:
+		matches = bj.query(rest, matches)
+		switch bj.Type {
+		case TpCodeArray:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				matches = bj.GetArrayElem(i).query(legs, matches)
+			}
+		case TpCodeObject:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				matches = bj.GetObjectVal(i).query(legs, matches)
+			}
+		}
+	}
+	return matches
+}
+
+// Length returns the length of bj as JSON_LENGTH,
+// the element count of containers and 1 for scalars
+func (bj ByteJson) Length() int64 {
+	switch bj.Type {
+	case TpCodeArray, TpCodeObject:
+		return int64(bj.GetElemCnt())
+	}
+	return 1
+}
+
+// Keys returns the keys of an object as a json array, false for the others
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return ByteJson{}, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]any, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = string(bj.GetObjectKey(i))
+	}
+	res, err := CreateByteJson(keys)
+	if err != nil {
+		return ByteJson{}, false
+	}
+	return res, true
+}
+
+// Contains returns true if candidate is contained in bj, as JSON_CONTAINS
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+			v, ok := bj.GetObjectValByKey(string(candidate.GetObjectKey(i)))
+			if !ok || !v.Contains(candidate.GetObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+				if !bj.Contains(candidate.GetArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if bj.GetArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return bj.scalarEqual(candidate)
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func (bj ByteJson) scalarEqual(other ByteJson) bool {
+	if bj.isNumber() && other.isNumber() {
+		switch {
+		case bj.Type == other.Type:
+			return bytes.Equal(bj.Data, other.Data)
+		case bj.Type == TpCodeInt64 && other.Type == TpCodeUint64:
+			return bj.GetInt64() >= 0 && uint64(bj.GetInt64()) == other.GetUint64()
+		case bj.Type == TpCodeUint64 && other.Type == TpCodeInt64:
+			return other.scalarEqual(bj)
+		}
+		return bj.toFloat64() == other.toFloat64()
+	}
+	return bj.Type == other.Type && bytes.Equal(bj.Data, other.Data)
+}
+
+func (bj ByteJson) toFloat64() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
+// Set replaces or inserts the values at the paths, as JSON_SET.
+// The paths must not contain wildcards.
+func (bj ByteJson) Set(paths []*Path, vals []ByteJson) (ByteJson, error) {
+	doc := bj.ToGo()
+	for i, p := range paths {
+		if p.hasWildcard {
+			return ByteJson{}, ErrWildcardPath
+		}
+		doc = set(doc, p.legs, vals[i].ToGo())
+	}
+	return CreateByteJson(doc)
+}
+
+func set(doc any, legs []pathLeg, val any) any {
+	if len(legs) == 0 {
+		return val
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case pathLegKey:
+		m, ok := doc.(map[string]any)
+		if !ok {
+			return doc
+		}
+		if v, ok := m[leg.key]; ok {
+			m[leg.key] = set(v, rest, val)
+		} else if len(rest) == 0 {
+			m[leg.key] = val
+		}
+	case pathLegIndex:
+		arr, ok := doc.([]any)
+		if !ok {
+			if leg.index == 0 || leg.index == lastIndex {
+				return set(doc, rest, val)
+			}
+			if len(rest) > 0 {
+				return doc
+			}
+			// autowrap the scalar into an array, then append
+			return []any{doc, val}
+		}
+		idx := leg.index
+		if idx == lastIndex {
+			idx = len(arr) - 1
+		}
+		if idx >= 0 && idx < len(arr) {
+			arr[idx] = set(arr[idx], rest, val)
+		} else if len(rest) == 0 {
+			arr = append(arr, val)
+		}
+		return arr
+	}
+	return doc
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// TpCode is the type of a binary json value
+type TpCode byte
+
+const (
+	TpCodeObject  TpCode = 0x01
+	TpCodeArray   TpCode = 0x03
+	TpCodeLiteral TpCode = 0x04
+	TpCodeInt64   TpCode = 0x09
+	TpCodeUint64  TpCode = 0x0a
+	TpCodeFloat64 TpCode = 0x0b
+	TpCodeString  TpCode = 0x0c
+)
+
+const (
+	LiteralNull  byte = 0x00
+	LiteralTrue  byte = 0x01
+	LiteralFalse byte = 0x02
+)
+
+const (
+	// headerSize is the size of the element count and the total size
+	// at the beginning of an object or an array
+	headerSize = 8
+	// keyEntrySize is the size of key offset(4) and key length(2)
+	keyEntrySize = 6
+	// valEntrySize is the size of value type(1) and value offset(4),
+	// literals are inlined into the offset
+	valEntrySize = 5
+	numberSize   = 8
+	maxKeyLen    = 0xffff
+)
+
+// ByteJson is the binary representation of a json value.
+// The layout of the containers is
+// object: | elem count | size | key entries | value entries | keys | values |
+// array:  | elem count | size | value entries | values |
+// all offsets in the entries are relative to the beginning of Data, so an
+// element can be read without decoding the whole document.
+type ByteJson struct {
+	Type TpCode
+	Data []byte
+}
+
+var (
+	ErrInvalidJson     = errors.New(errno.DataException, "Invalid JSON text")
+	ErrInvalidPath     = errors.New(errno.DataException, "Invalid JSON path expression")
+	ErrWildcardPath    = errors.New(errno.DataException, "In this situation, path expressions may not contain the * and ** tokens")
+	ErrKeyTooLong      = errors.New(errno.DataException, "JSON object key is too long")
+	ErrUnsupportedType = errors.New(errno.DatatypeMismatch, "unsupported value type for JSON")
+)
@@ -0,0 +1,233 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewDistinct(r ring.Ring, typ types.Type) *DistinctRing {
+	return &DistinctRing{
+		R:   r,
+		Typ: typ,
+		Vs:  vector.New(typ),
+	}
+}
+
+// NewDistinctWithData returns a ring holding the values of a decoded ring,
+// the set of seen values is rebuilt from them.
+func NewDistinctWithData(r ring.Ring, vs *vector.Vector, rows [][]int64, m *mheap.Mheap) *DistinctRing {
+	dr := &DistinctRing{
+		R:    r,
+		Typ:  vs.Typ,
+		Vs:   vs,
+		Rows: rows,
+		m:    m,
+	}
+	dr.rehash()
+	return dr
+}
+
+func (r *DistinctRing) String() string {
+	return fmt.Sprintf("distinct(%s)", r.R.String())
+}
+
+func (r *DistinctRing) Free(m *mheap.Mheap) {
+	r.R.Free(m)
+	if r.Vs != nil {
+		vector.Free(r.Vs, m)
+		r.Vs = nil
+	}
+	r.Rows = nil
+	r.mp = nil
+}
+
+func (r *DistinctRing) Count() int {
+	return len(r.Rows)
+}
+
+func (r *DistinctRing) Size() int {
+	if r.Vs == nil {
+		return r.R.Size()
+	}
+	return r.R.Size() + cap(r.Vs.Data)
+}
+
+func (r *DistinctRing) Dup() ring.Ring {
+	return NewDistinct(r.R.Dup(), r.Typ)
+}
+
+func (r *DistinctRing) Type() types.Type {
+	return r.R.Type()
+}
+
+func (r *DistinctRing) SetLength(n int) {
+	r.R.SetLength(n)
+	r.Rows = r.Rows[:n]
+	r.rehash()
+}
+
+func (r *DistinctRing) Shrink(sels []int64) {
+	r.R.Shrink(sels)
+	for i, sel := range sels {
+		r.Rows[i] = r.Rows[sel]
+	}
+	r.Rows = r.Rows[:len(sels)]
+	r.rehash()
+}
+
+func (r *DistinctRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *DistinctRing) Grow(m *mheap.Mheap) error {
+	r.m = m
+	if err := r.R.Grow(m); err != nil {
+		return err
+	}
+	r.Rows = append(r.Rows, nil)
+	return nil
+}
+
+func (r *DistinctRing) Grows(size int, m *mheap.Mheap) error {
+	r.m = m
+	if err := r.R.Grows(size, m); err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		r.Rows = append(r.Rows, nil)
+	}
+	return nil
+}
+
+func (r *DistinctRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if z > 0 {
+		r.fill(i, sel, vec)
+	}
+}
+
+func (r *DistinctRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		if zs[int64(i)+start] > 0 {
+			r.fill(int64(vps[i]-1), int64(i)+start, vec)
+		}
+	}
+}
+
+func (r *DistinctRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		if z > 0 {
+			r.fill(i, int64(j), vec)
+		}
+	}
+}
+
+// Add fills the x-th group with the values of the y-th group of a, the
+// partial results of the wrapped rings cannot be merged as they may have
+// counted the same value twice.
+func (r *DistinctRing) Add(a interface{}, x, y int64) {
+	ar := a.(*DistinctRing)
+	for _, sel := range ar.Rows[y] {
+		r.fill(x, sel, ar.Vs)
+	}
+}
+
+func (r *DistinctRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+// Mul is the same as Add, a value is counted once whatever its multiplicity.
+func (r *DistinctRing) Mul(a interface{}, x, y, _ int64) {
+	r.Add(a, x, y)
+}
+
+// Eval evaluates the wrapped ring with the number of distinct values of each
+// group instead of the number of rows.
+func (r *DistinctRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		if r.Vs != nil {
+			vector.Free(r.Vs, r.m)
+			r.Vs = nil
+		}
+		r.Rows = nil
+		r.mp = nil
+	}()
+	zs := make([]int64, len(r.Rows))
+	for i, rows := range r.Rows {
+		zs[i] = int64(len(rows))
+	}
+	return r.R.Eval(zs)
+}
+
+// fill fills the i-th group with the sel-th row of vec if the value is new
+// to the group, the null values are skipped.
+func (r *DistinctRing) fill(i, sel int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	if !r.insert(i, sel, vec) {
+		return
+	}
+	if err := vector.UnionOne(r.Vs, vec, sel, r.m); err != nil {
+		panic(err)
+	}
+	row := int64(vector.Length(r.Vs) - 1)
+	r.Rows[i] = append(r.Rows[i], row)
+	r.R.Fill(i, row, 1, r.Vs)
+}
+
+// insert adds (i, value) to the set of seen values and reports whether it
+// was not seen before.
+func (r *DistinctRing) insert(i, sel int64, vec *vector.Vector) bool {
+	if r.mp == nil {
+		r.mp = &hashtable.StringHashMap{}
+		r.mp.Init()
+		r.keys = make([][]byte, 1)
+		r.states = make([][3]uint64, 1)
+		r.values = make([]uint64, 1)
+	}
+	key := append(r.keys[0][:0], encoding.EncodeInt64(i)...)
+	key = ring.AppendValue(key, vec, sel)
+	if l := len(key); l < 16 {
+		key = append(key, hashtable.StrKeyPadding[l:]...)
+	}
+	r.keys[0] = key
+	r.mp.InsertStringBatch(r.states, r.keys, r.values)
+	if r.values[0] > r.cnt {
+		r.cnt++
+		return true
+	}
+	return false
+}
+
+func (r *DistinctRing) rehash() {
+	r.mp = nil
+	r.cnt = 0
+	for i, rows := range r.Rows {
+		for _, sel := range rows {
+			r.insert(int64(i), sel, r.Vs)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistinct(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, []int64{1, 2, 2, 3, 3, 0}))
+	nulls.Add(vec.Nsp, 5)
+	zs := []int64{1, 1, 1, 1, 1, 1}
+
+	r := NewDistinct(count.NewCount(typ), typ)
+	require.NoError(t, r.Grows(2, m))
+	r.BatchFill(0, make([]uint8, 6), []uint64{1, 1, 1, 2, 2, 2}, zs, vec)
+	require.Equal(t, [][]int64{{0, 1}, {2}}, r.Rows)
+
+	r2 := r.Dup().(*DistinctRing)
+	require.NoError(t, r2.Grow(m))
+	r2.BulkFill(0, zs, vec)
+	r.Add(r2, 0, 0)
+	r.Add(r2, 1, 0)
+	require.Equal(t, []int64{3, 3}, r.Eval(nil).Col)
+
+	a := NewDistinct(avg.NewAvg(typ), typ)
+	require.NoError(t, a.Grows(2, m))
+	a.Fill(0, 0, 1, vec)
+	a.Fill(0, 1, 2, vec)
+	a.Fill(0, 2, 1, vec)
+	a.Fill(0, 4, 0, vec)
+	a.Fill(1, 5, 1, vec)
+	res := a.Eval([]int64{4, 1})
+	require.Equal(t, 1.5, res.Col.([]float64)[0])
+	require.True(t, nulls.Contains(res.Nsp, 1))
+}
+
+func TestDistinctStr(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.Type{Oid: types.T_varchar, Size: 24}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, [][]byte{[]byte("a"), []byte("a\x00"), []byte("a"), []byte("")}))
+
+	r := NewDistinct(count.NewCount(typ), typ)
+	require.NoError(t, r.Grow(m))
+	r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+	require.Equal(t, 3, len(r.Rows[0]))
+	require.NoError(t, r.Grow(m))
+	r.Fill(1, 2, 1, vec)
+
+	// the set is rebuilt when the groups are shrunk
+	r.Shrink([]int64{1})
+	r.Fill(0, 0, 1, vec)
+	r.Fill(0, 1, 1, vec)
+	require.Equal(t, 1, r.Count())
+	require.Equal(t, []int64{2}, r.Eval(nil).Col)
+}
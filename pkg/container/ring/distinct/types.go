@@ -0,0 +1,42 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// DistinctRing wraps a ring and fills it only with the values which are
+// new to a group, so the wrapped ring computes aggregate(DISTINCT x).
+type DistinctRing struct {
+	R   ring.Ring
+	Typ types.Type
+	// Vs holds the distinct values of all the groups
+	Vs *vector.Vector
+	// Rows[i] are the rows of Vs which belong to the i-th group
+	Rows [][]int64
+
+	m *mheap.Mheap
+	// mp is the set of (group, value) pairs that have been seen
+	mp     *hashtable.StringHashMap
+	cnt    uint64
+	keys   [][]byte
+	states [][3]uint64
+	values []uint64
+}
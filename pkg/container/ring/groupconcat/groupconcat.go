@@ -0,0 +1,358 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewGroupConcat(typ types.Type, sep string, desc []bool) *GroupConcatRing {
+	return &GroupConcatRing{
+		Typ:  typ,
+		Sep:  sep,
+		Desc: desc,
+	}
+}
+
+func (r *GroupConcatRing) String() string {
+	return fmt.Sprintf("group_concat(separator '%s')", r.Sep)
+}
+
+func (r *GroupConcatRing) Free(_ *mheap.Mheap) {
+	r.Release()
+	r.Vs = nil
+	r.Ks = nil
+	r.ovs = nil
+}
+
+func (r *GroupConcatRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *GroupConcatRing) Size() int {
+	size := 0
+	for i := range r.Vs {
+		size += groupSize(r.Vs[i], r.Ks[i])
+	}
+	return size
+}
+
+func (r *GroupConcatRing) Dup() ring.Ring {
+	return NewGroupConcat(r.Typ, r.Sep, r.Desc)
+}
+
+func (r *GroupConcatRing) Type() types.Type {
+	return types.T_varchar.ToType()
+}
+
+func (r *GroupConcatRing) SetOrderVecs(vecs []*vector.Vector) {
+	r.ovs = vecs
+}
+
+func (r *GroupConcatRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ks = r.Ks[:n]
+}
+
+func (r *GroupConcatRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ks[i] = r.Ks[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Ks = r.Ks[:len(sels)]
+}
+
+func (r *GroupConcatRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *GroupConcatRing) Grow(m *mheap.Mheap) error {
+	r.SetMheap(m)
+	r.Vs = append(r.Vs, nil)
+	r.Ks = append(r.Ks, nil)
+	return nil
+}
+
+func (r *GroupConcatRing) Grows(size int, m *mheap.Mheap) error {
+	r.SetMheap(m)
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+		r.Ks = append(r.Ks, nil)
+	}
+	return nil
+}
+
+func (r *GroupConcatRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	r.fill(i, sel, z, vec)
+}
+
+func (r *GroupConcatRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *GroupConcatRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *GroupConcatRing) Add(a interface{}, x, y int64) {
+	ar := a.(*GroupConcatRing)
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	r.Ks[x] = append(r.Ks[x], ar.Ks[y]...)
+	r.Alloc(int64(groupSize(ar.Vs[y], ar.Ks[y])))
+}
+
+func (r *GroupConcatRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *GroupConcatRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*GroupConcatRing)
+	for k := int64(0); k < z; k++ {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+		r.Ks[x] = append(r.Ks[x], ar.Ks[y]...)
+	}
+	r.Alloc(int64(groupSize(ar.Vs[y], ar.Ks[y])) * z)
+}
+
+// Eval sorts the values of each group by their order keys and joins them
+// with the separator, the result of a group without any value is null.
+func (r *GroupConcatRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Release()
+		r.Vs = nil
+		r.Ks = nil
+		r.ovs = nil
+	}()
+	nsp := new(nulls.Nulls)
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(r.Vs)),
+		Lengths: make([]uint32, len(r.Vs)),
+	}
+	for i, vs := range r.Vs {
+		if len(vs) == 0 {
+			nulls.Add(nsp, uint64(i))
+			col.Offsets[i] = uint32(len(col.Data))
+			continue
+		}
+		sort.Stable(&group{vs: vs, ks: r.Ks[i]})
+		col.Offsets[i] = uint32(len(col.Data))
+		for j, v := range vs {
+			if j > 0 {
+				col.Data = append(col.Data, r.Sep...)
+			}
+			col.Data = append(col.Data, v...)
+		}
+		col.Lengths[i] = uint32(len(col.Data)) - col.Offsets[i]
+	}
+	return &vector.Vector{
+		Nsp: nsp,
+		Col: col,
+		Or:  false,
+		Typ: r.Type(),
+	}
+}
+
+func (r *GroupConcatRing) fill(i, sel, z int64, vec *vector.Vector) {
+	if z == 0 || nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	v := format(vec, sel)
+	k := r.key(sel)
+	r.Alloc(int64(len(v)+len(k)) * z)
+	for ; z > 0; z-- {
+		r.Vs[i] = append(r.Vs[i], v)
+		r.Ks[i] = append(r.Ks[i], k)
+	}
+}
+
+// key returns the order key of the sel-th row, the keys of two rows compare
+// in the same order as the rows are sorted.
+func (r *GroupConcatRing) key(sel int64) []byte {
+	if len(r.ovs) == 0 {
+		return nil
+	}
+	var k []byte
+	for i, vec := range r.ovs {
+		start := len(k)
+		if nulls.Contains(vec.Nsp, uint64(sel)) {
+			k = append(k, 0)
+		} else {
+			k = append(k, 1)
+			k = appendKey(k, vec, sel)
+		}
+		if i < len(r.Desc) && r.Desc[i] {
+			for j := start; j < len(k); j++ {
+				k[j] = ^k[j]
+			}
+		}
+	}
+	return k
+}
+
+// groupSize returns the memory size of the values and the order keys of a group.
+func groupSize(vs, ks [][]byte) int {
+	size := 0
+	for i := range vs {
+		size += len(vs[i]) + len(ks[i])
+	}
+	return size
+}
+
+type group struct {
+	vs [][]byte
+	ks [][]byte
+}
+
+func (g *group) Len() int { return len(g.vs) }
+
+func (g *group) Less(i, j int) bool { return bytes.Compare(g.ks[i], g.ks[j]) < 0 }
+
+func (g *group) Swap(i, j int) {
+	g.vs[i], g.vs[j] = g.vs[j], g.vs[i]
+	g.ks[i], g.ks[j] = g.ks[j], g.ks[i]
+}
+
+// format returns the string form of the sel-th value of the vector.
+func format(vec *vector.Vector, sel int64) []byte {
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		return append([]byte{}, vs.Get(sel)...)
+	case []bool:
+		if vs[sel] {
+			return []byte("1")
+		}
+		return []byte("0")
+	case []int8:
+		return strconv.AppendInt(nil, int64(vs[sel]), 10)
+	case []int16:
+		return strconv.AppendInt(nil, int64(vs[sel]), 10)
+	case []int32:
+		return strconv.AppendInt(nil, int64(vs[sel]), 10)
+	case []int64:
+		return strconv.AppendInt(nil, vs[sel], 10)
+	case []uint8:
+		return strconv.AppendUint(nil, uint64(vs[sel]), 10)
+	case []uint16:
+		return strconv.AppendUint(nil, uint64(vs[sel]), 10)
+	case []uint32:
+		return strconv.AppendUint(nil, uint64(vs[sel]), 10)
+	case []uint64:
+		return strconv.AppendUint(nil, vs[sel], 10)
+	case []float32:
+		return strconv.AppendFloat(nil, float64(vs[sel]), 'g', -1, 32)
+	case []float64:
+		return strconv.AppendFloat(nil, vs[sel], 'g', -1, 64)
+	case []types.Date:
+		return []byte(vs[sel].String())
+	case []types.Datetime:
+		return []byte(vs[sel].String())
+	case []types.Timestamp:
+		return []byte(vs[sel].String())
+	case []types.Decimal64:
+		return vs[sel].Decimal64ToString(vec.Typ.Scale)
+	case []types.Decimal128:
+		return vs[sel].Decimal128ToString(vec.Typ.Scale)
+	}
+	panic(fmt.Sprintf("unexpected type %s for group_concat", vec.Typ))
+}
+
+// appendKey appends the memcomparable form of the sel-th value of the
+// vector to k.
+func appendKey(k []byte, vec *vector.Vector, sel int64) []byte {
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		for _, c := range vs.Get(sel) {
+			if c == 0 {
+				k = append(k, 0, 0xff)
+			} else {
+				k = append(k, c)
+			}
+		}
+		return append(k, 0, 1)
+	case []bool:
+		if vs[sel] {
+			return append(k, 1)
+		}
+		return append(k, 0)
+	case []int8:
+		return appendInt(k, int64(vs[sel]))
+	case []int16:
+		return appendInt(k, int64(vs[sel]))
+	case []int32:
+		return appendInt(k, int64(vs[sel]))
+	case []int64:
+		return appendInt(k, vs[sel])
+	case []uint8:
+		return appendUint(k, uint64(vs[sel]))
+	case []uint16:
+		return appendUint(k, uint64(vs[sel]))
+	case []uint32:
+		return appendUint(k, uint64(vs[sel]))
+	case []uint64:
+		return appendUint(k, vs[sel])
+	case []float32:
+		return appendFloat(k, float64(vs[sel]))
+	case []float64:
+		return appendFloat(k, vs[sel])
+	case []types.Date:
+		return appendInt(k, int64(vs[sel]))
+	case []types.Datetime:
+		return appendInt(k, int64(vs[sel]))
+	case []types.Timestamp:
+		return appendInt(k, int64(vs[sel]))
+	case []types.Decimal64:
+		return appendInt(k, int64(vs[sel]))
+	case []types.Decimal128:
+		k = appendInt(k, vs[sel].Hi)
+		return appendUint(k, uint64(vs[sel].Lo))
+	}
+	panic(fmt.Sprintf("unexpected type %s for group_concat", vec.Typ))
+}
+
+func appendInt(k []byte, v int64) []byte {
+	return appendUint(k, uint64(v)^(1<<63))
+}
+
+func appendUint(k []byte, v uint64) []byte {
+	return append(k, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendFloat(k []byte, v float64) []byte {
+	u := math.Float64bits(v)
+	if v < 0 {
+		u = ^u
+	} else {
+		u |= 1 << 63
+	}
+	return appendUint(k, u)
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupConcat(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(vec, [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")}))
+	nulls.Add(vec.Nsp, 3)
+	ovec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(ovec, []int64{2, -1, 3, 0, 1}))
+	zs := []int64{1, 1, 2, 1, 1}
+
+	r := NewGroupConcat(vec.Typ, ";", []bool{true})
+	r.SetOrderVecs([]*vector.Vector{ovec})
+	require.NoError(t, r.Grows(3, m))
+	r.BatchFill(0, make([]uint8, 4), []uint64{1, 1, 1, 3}, zs, vec)
+
+	r2 := r.Dup().(*GroupConcatRing)
+	r2.SetOrderVecs([]*vector.Vector{ovec})
+	require.NoError(t, r2.Grow(m))
+	r2.Fill(0, 4, 1, vec)
+	r.Add(r2, 0, 0)
+
+	res := r.Eval(nil)
+	col := res.Col.(*types.Bytes)
+	require.Equal(t, "c;c;a;e;b", string(col.Get(0)))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+}
+
+func TestGroupConcatNumber(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	require.NoError(t, vector.Append(vec, []float64{1.5, -2, 0.25}))
+	ovec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(ovec, [][]byte{[]byte("b"), []byte("a\x00"), []byte("a")}))
+
+	r := NewGroupConcat(vec.Typ, ",", nil)
+	r.SetOrderVecs([]*vector.Vector{ovec})
+	require.NoError(t, r.Grow(m))
+	r.BulkFill(0, []int64{1, 1, 1}, vec)
+	require.Equal(t, "0.25,-2,1.5", string(r.Eval(nil).Col.(*types.Bytes).Get(0)))
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// GroupConcatRing concatenates the values of each group, the values are
+// sorted by their order keys before being concatenated.
+type GroupConcatRing struct {
+	// Typ is the type of the concatenated values
+	Typ types.Type
+	// Sep is the separator between two values
+	Sep string
+	// Desc[i] is true if the i-th order by expression is descending
+	Desc []bool
+	// Vs[i] are the values of the i-th group
+	Vs [][][]byte
+	// Ks[i] are the order keys of the values of the i-th group
+	Ks [][][]byte
+
+	ring.Memory
+
+	ovs []*vector.Vector
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ring
+
+import "github.com/matrixorigin/matrixone/pkg/vm/mheap"
+
+// Err returns the first error met by the accounted rings.
+func Err(rs []Ring) error {
+	for _, r := range rs {
+		if ar, ok := r.(AccountedRing); ok {
+			if err := ar.Err(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SetMheap sets the mheap the memory is accounted on, the first one is kept.
+func (m *Memory) SetMheap(mp *mheap.Mheap) {
+	if m.Mp == nil {
+		m.Mp = mp
+	}
+}
+
+// Alloc accounts size bytes more memory, the error is kept and returned
+// by Err because the rings are filled without returning an error.
+func (m *Memory) Alloc(size int64) {
+	if m.Mp == nil || m.err != nil || size <= 0 {
+		return
+	}
+	if m.err = m.Mp.Gm.Alloc(size); m.err == nil {
+		m.size += size
+	}
+}
+
+// Release gives back all the accounted memory.
+func (m *Memory) Release() {
+	if m.Mp != nil && m.size > 0 {
+		m.Mp.Gm.Free(m.size)
+	}
+	m.size = 0
+}
+
+func (m *Memory) Err() error {
+	return m.err
+}
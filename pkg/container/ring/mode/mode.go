@@ -0,0 +1,227 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewMode(typ types.Type) *ModeRing {
+	return &ModeRing{Typ: typ}
+}
+
+func (r *ModeRing) String() string {
+	return "mode"
+}
+
+func (r *ModeRing) Free(_ *mheap.Mheap) {
+	r.Release()
+	r.Vs = nil
+}
+
+func (r *ModeRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *ModeRing) Size() int {
+	size := 0
+	for _, mp := range r.Vs {
+		for k := range mp {
+			size += len(k) + 8
+		}
+	}
+	return size
+}
+
+func (r *ModeRing) Dup() ring.Ring {
+	return NewMode(r.Typ)
+}
+
+func (r *ModeRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *ModeRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *ModeRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *ModeRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *ModeRing) Grow(m *mheap.Mheap) error {
+	r.SetMheap(m)
+	r.Vs = append(r.Vs, make(map[string]int64))
+	return nil
+}
+
+func (r *ModeRing) Grows(size int, m *mheap.Mheap) error {
+	r.SetMheap(m)
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, make(map[string]int64))
+	}
+	return nil
+}
+
+func (r *ModeRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	r.fill(i, sel, z, vec)
+}
+
+func (r *ModeRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *ModeRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *ModeRing) Add(a interface{}, x, y int64) {
+	ar := a.(*ModeRing)
+	for k, n := range ar.Vs[y] {
+		r.add(x, k, n)
+	}
+}
+
+func (r *ModeRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *ModeRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*ModeRing)
+	for k, n := range ar.Vs[y] {
+		r.add(x, k, n*z)
+	}
+}
+
+// Eval returns the most frequent value of each group, ties are broken by
+// the smaller encoded value so the result does not depend on the order of
+// the rows. The result of a group without any value is null.
+func (r *ModeRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Release()
+		r.Vs = nil
+	}()
+	nsp := new(nulls.Nulls)
+	ks := make([]string, len(r.Vs))
+	for i, mp := range r.Vs {
+		var cnt int64
+		for k, n := range mp {
+			if n > cnt || (n == cnt && k < ks[i]) {
+				ks[i], cnt = k, n
+			}
+		}
+		if cnt == 0 {
+			nulls.Add(nsp, uint64(i))
+		}
+	}
+	vec := vector.New(r.Typ)
+	vec.Nsp = nsp
+	if r.Typ.Oid == types.T_char || r.Typ.Oid == types.T_varchar {
+		col := vec.Col.(*types.Bytes)
+		for _, k := range ks {
+			if len(k) > 0 {
+				k = k[4:]
+			}
+			col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+			col.Lengths = append(col.Lengths, uint32(len(k)))
+			col.Data = append(col.Data, k...)
+		}
+		vec.Data = col.Data
+		return vec
+	}
+	for _, k := range ks {
+		if len(k) == 0 {
+			vec.Data = append(vec.Data, make([]byte, r.Typ.Size)...)
+		} else {
+			vec.Data = append(vec.Data, k...)
+		}
+	}
+	vec.Col = decode(r.Typ, vec.Data)
+	return vec
+}
+
+func (r *ModeRing) fill(i, sel, z int64, vec *vector.Vector) {
+	if z == 0 || nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	r.add(i, string(ring.AppendValue(nil, vec, sel)), z)
+}
+
+// add adds n to the frequency of the value k of the i-th group, the memory
+// of a value is accounted when it is met for the first time.
+func (r *ModeRing) add(i int64, k string, n int64) {
+	if _, ok := r.Vs[i][k]; !ok {
+		r.Alloc(int64(len(k)) + 8)
+	}
+	r.Vs[i][k] += n
+}
+
+func decode(typ types.Type, data []byte) interface{} {
+	switch typ.Oid {
+	case types.T_bool:
+		return encoding.DecodeBoolSlice(data)
+	case types.T_int8:
+		return encoding.DecodeInt8Slice(data)
+	case types.T_int16:
+		return encoding.DecodeInt16Slice(data)
+	case types.T_int32:
+		return encoding.DecodeInt32Slice(data)
+	case types.T_int64:
+		return encoding.DecodeInt64Slice(data)
+	case types.T_uint8:
+		return encoding.DecodeUint8Slice(data)
+	case types.T_uint16:
+		return encoding.DecodeUint16Slice(data)
+	case types.T_uint32:
+		return encoding.DecodeUint32Slice(data)
+	case types.T_uint64:
+		return encoding.DecodeUint64Slice(data)
+	case types.T_float32:
+		return encoding.DecodeFloat32Slice(data)
+	case types.T_float64:
+		return encoding.DecodeFloat64Slice(data)
+	case types.T_date:
+		return encoding.DecodeDateSlice(data)
+	case types.T_datetime:
+		return encoding.DecodeDatetimeSlice(data)
+	case types.T_timestamp:
+		return encoding.DecodeTimestampSlice(data)
+	case types.T_decimal64:
+		return encoding.DecodeDecimal64Slice(data)
+	case types.T_decimal128:
+		return encoding.DecodeDecimal128Slice(data)
+	}
+	panic(fmt.Sprintf("unexpected type %s for mode", typ))
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMode(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, []int64{1, 2, 2, 3, 3, 0}))
+	nulls.Add(vec.Nsp, 5)
+
+	r := NewMode(typ)
+	require.NoError(t, r.Grows(3, m))
+	r.BatchFill(0, make([]uint8, 6), []uint64{1, 1, 1, 2, 2, 3}, []int64{3, 1, 1, 1, 1, 1}, vec)
+
+	r2 := r.Dup().(*ModeRing)
+	require.NoError(t, r2.Grow(m))
+	r2.Fill(0, 2, 2, vec)
+	r.Add(r2, 1, 0)
+
+	res := r.Eval(nil)
+	require.Equal(t, []int64{1, 2, 0}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 2))
+}
+
+func TestModeStr(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.Type{Oid: types.T_varchar, Size: 24}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, [][]byte{[]byte("b"), []byte("a"), []byte("b"), []byte("")}))
+
+	r := NewMode(typ)
+	require.NoError(t, r.Grows(2, m))
+	r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+	r.Fill(1, 3, 1, vec)
+	col := r.Eval(nil).Col.(*types.Bytes)
+	require.Equal(t, "b", string(col.Get(0)))
+	require.Equal(t, "", string(col.Get(1)))
+}
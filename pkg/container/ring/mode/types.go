@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// ModeRing computes the most frequent value of each group.
+type ModeRing struct {
+	// Typ is the type of the values
+	Typ types.Type
+	// Vs[i] maps the encoded values of the i-th group to their frequencies,
+	// the values are encoded by ring.AppendValue
+	Vs []map[string]int64
+
+	ring.Memory
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewPercentile(typ types.Type, p float64) *PercentileRing {
+	return &PercentileRing{Typ: typ, P: p}
+}
+
+func (r *PercentileRing) String() string {
+	return fmt.Sprintf("percentile_cont(%v)", r.P)
+}
+
+func (r *PercentileRing) Free(_ *mheap.Mheap) {
+	r.Release()
+	r.Vs = nil
+}
+
+func (r *PercentileRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *PercentileRing) Size() int {
+	size := 0
+	for _, vs := range r.Vs {
+		size += cap(vs) * 8
+	}
+	return size
+}
+
+func (r *PercentileRing) Dup() ring.Ring {
+	return NewPercentile(r.Typ, r.P)
+}
+
+func (r *PercentileRing) Type() types.Type {
+	return types.T_float64.ToType()
+}
+
+func (r *PercentileRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *PercentileRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *PercentileRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *PercentileRing) Grow(m *mheap.Mheap) error {
+	r.SetMheap(m)
+	r.Vs = append(r.Vs, nil)
+	return nil
+}
+
+func (r *PercentileRing) Grows(size int, m *mheap.Mheap) error {
+	r.SetMheap(m)
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+	}
+	return nil
+}
+
+func (r *PercentileRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	r.fill(i, sel, z, vec)
+}
+
+func (r *PercentileRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *PercentileRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *PercentileRing) Add(a interface{}, x, y int64) {
+	ar := a.(*PercentileRing)
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	r.Alloc(int64(len(ar.Vs[y])) * 8)
+}
+
+func (r *PercentileRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *PercentileRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*PercentileRing)
+	for k := int64(0); k < z; k++ {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	}
+	r.Alloc(int64(len(ar.Vs[y])) * 8 * z)
+}
+
+// Eval sorts the values of each group and interpolates linearly between the
+// two values around the percentile, the result of a group without any value
+// is null.
+func (r *PercentileRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Release()
+		r.Vs = nil
+	}()
+	nsp := new(nulls.Nulls)
+	rs := make([]float64, len(r.Vs))
+	for i, vs := range r.Vs {
+		if len(vs) == 0 {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		sort.Float64s(vs)
+		pos := r.P * float64(len(vs)-1)
+		lo, hi := int(math.Floor(pos)), int(math.Ceil(pos))
+		rs[i] = vs[lo] + (pos-float64(lo))*(vs[hi]-vs[lo])
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: encoding.EncodeFloat64Slice(rs),
+		Col:  rs,
+		Or:   false,
+		Typ:  r.Type(),
+	}
+}
+
+func (r *PercentileRing) fill(i, sel, z int64, vec *vector.Vector) {
+	if z == 0 || nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	var v float64
+	switch vs := vec.Col.(type) {
+	case []int8:
+		v = float64(vs[sel])
+	case []int16:
+		v = float64(vs[sel])
+	case []int32:
+		v = float64(vs[sel])
+	case []int64:
+		v = float64(vs[sel])
+	case []uint8:
+		v = float64(vs[sel])
+	case []uint16:
+		v = float64(vs[sel])
+	case []uint32:
+		v = float64(vs[sel])
+	case []uint64:
+		v = float64(vs[sel])
+	case []float32:
+		v = float64(vs[sel])
+	case []float64:
+		v = vs[sel]
+	}
+	r.Alloc(z * 8)
+	for ; z > 0; z-- {
+		r.Vs[i] = append(r.Vs[i], v)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	require.NoError(t, vector.Append(vec, []int32{4, 1, 3, 2, 10}))
+	nulls.Add(vec.Nsp, 4)
+	zs := []int64{1, 1, 1, 1, 1}
+
+	r := NewPercentile(vec.Typ, 0.5)
+	require.NoError(t, r.Grows(2, m))
+	r.BatchFill(0, make([]uint8, 5), []uint64{1, 1, 1, 1, 2}, zs, vec)
+
+	r2 := r.Dup().(*PercentileRing)
+	require.NoError(t, r2.Grow(m))
+	r2.Fill(0, 0, 2, vec)
+	r.Add(r2, 0, 0)
+
+	res := r.Eval(nil)
+	require.Equal(t, 3.5, res.Col.([]float64)[0])
+	require.True(t, nulls.Contains(res.Nsp, 1))
+
+	p := NewPercentile(vec.Typ, 0.9)
+	require.NoError(t, p.Grow(m))
+	p.BulkFill(0, zs[:4], vec)
+	require.InDelta(t, 3.7, p.Eval(nil).Col.([]float64)[0], 1e-9)
+}
+
+func TestPercentileMemoryLimit(t *testing.T) {
+	m := mheap.New(guest.New(32, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	require.NoError(t, vector.Append(vec, []int32{4, 1, 3, 2, 10}))
+
+	r := NewPercentile(vec.Typ, 0.5)
+	require.NoError(t, r.Grow(m))
+	r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+	require.NoError(t, r.Err())
+	require.Equal(t, int64(32), m.Gm.Size())
+	r.Fill(0, 4, 1, vec)
+	require.Error(t, r.Err())
+
+	r.Free(m)
+	require.Equal(t, int64(0), m.Gm.Size())
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// PercentileRing computes the continuous percentile P of each group, the
+// values of a group are kept until the ring is evaluated.
+type PercentileRing struct {
+	// Typ is the type of the values
+	Typ types.Type
+	// P is the percentile between 0 and 1, median is the percentile 0.5
+	P float64
+	// Vs[i] are the values of the i-th group
+	Vs [][]float64
+
+	ring.Memory
+}
@@ -109,3 +109,35 @@ type Ring interface {
 	// Mul is the function to merge 2 rings when join
 	Mul(interface{}, int64, int64, int64)
 }
+
+// OrderedRing is a ring whose result depends on the order of its values,
+// such as group_concat(x order by y).
+type OrderedRing interface {
+	Ring
+
+	// SetOrderVecs sets the vectors of the order by expressions of the
+	// rows which will be filled next, a row is ordered by the values of
+	// the same row of the vectors.
+	SetOrderVecs([]*vector.Vector)
+}
+
+// AccountedRing is a ring which keeps the values of its groups, such as
+// percentile_cont(x), so its memory grows as it is filled. The memory is
+// accounted on the mheap given to Grow and Grows and the memory limit of
+// the query applies to it.
+type AccountedRing interface {
+	Ring
+
+	// Err returns the error met while the memory of the ring was accounted,
+	// such as the memory limit of the query is exceeded.
+	Err() error
+}
+
+// Memory accounts the memory of the values kept by an AccountedRing.
+type Memory struct {
+	// Mp is the mheap the memory is accounted on
+	Mp *mheap.Mheap
+
+	size int64
+	err  error
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ring
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// AppendValue appends the sel-th value of the vector to buf, the values of
+// a type are appended in the same layout so they can be used as map keys.
+// A string value is prefixed by its length.
+func AppendValue(buf []byte, vec *vector.Vector, sel int64) []byte {
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		v := vs.Get(sel)
+		buf = append(buf, encoding.EncodeUint32(uint32(len(v)))...)
+		return append(buf, v...)
+	case []bool:
+		return appendFixed(buf, vs, sel)
+	case []int8:
+		return appendFixed(buf, vs, sel)
+	case []int16:
+		return appendFixed(buf, vs, sel)
+	case []int32:
+		return appendFixed(buf, vs, sel)
+	case []int64:
+		return appendFixed(buf, vs, sel)
+	case []uint8:
+		return appendFixed(buf, vs, sel)
+	case []uint16:
+		return appendFixed(buf, vs, sel)
+	case []uint32:
+		return appendFixed(buf, vs, sel)
+	case []uint64:
+		return appendFixed(buf, vs, sel)
+	case []float32:
+		return appendFixed(buf, vs, sel)
+	case []float64:
+		return appendFixed(buf, vs, sel)
+	case []types.Date:
+		return appendFixed(buf, vs, sel)
+	case []types.Datetime:
+		return appendFixed(buf, vs, sel)
+	case []types.Timestamp:
+		return appendFixed(buf, vs, sel)
+	case []types.Decimal64:
+		return appendFixed(buf, vs, sel)
+	case []types.Decimal128:
+		return appendFixed(buf, vs, sel)
+	}
+	panic(fmt.Sprintf("unexpected type %s", vec.Typ))
+}
+
+func appendFixed[T any](buf []byte, vs []T, sel int64) []byte {
+	return append(buf, unsafe.Slice((*byte)(unsafe.Pointer(&vs[sel])), unsafe.Sizeof(vs[sel]))...)
+}
@@ -40,14 +40,9 @@ const (
 	Saturday
 )
 
-var startupTime time.Time
-var localTZ int64
-
-func init() {
-	startupTime = time.Now()
-	_, offset := startupTime.Zone()
-	localTZ = int64(offset)
-}
+// localTZ is the server's local time zone. The offset from UTC is looked up
+// for every value, as it changes with the daylight saving time
+var localTZ = time.Local
 
 var (
 	errIncorrectDateValue = errors.New(errno.DataException, "Incorrect date value")
@@ -120,8 +115,7 @@ func (d Date) String() string {
 
 // Holds number of days since January 1, year 1 in Gregorian calendar
 func Today() Date {
-	sec := Now().sec()
-	return Date((sec + localTZ) / secsPerDay)
+	return Timestamp(Now()).ToDatetime(localTZ).ToDate()
 }
 
 const dayInfoTableMinYear = 1924
@@ -42,8 +42,8 @@ func (dt Datetime) String() string {
 
 const (
 	//tsMask         = ^uint64(0) >> 1
-	hasMonotonic = 1 << 63
-	//unixToInternal = (1969*365 + 1969/4 - 1969/100 + 1969/400) * secsPerDay
+	hasMonotonic   = 1 << 63
+	unixToInternal = (1969*365 + 1969/4 - 1969/100 + 1969/400) * secsPerDay
 	wallToInternal = (1884*365 + 1884/4 - 1884/100 + 1884/400) * secsPerDay
 
 	minHourInDay, maxHourInDay           = 0, 23
@@ -147,7 +147,7 @@ func validTimeInDay(h, m, s uint8) bool {
 
 // UTC turn local datetime to utc datetime
 func (dt Datetime) UTC() Datetime {
-	return Datetime((dt.sec() - localTZ) << 20)
+	return Datetime(FromDatetime(Datetime(dt.sec()<<20), localTZ))
 }
 
 func Now() Datetime {
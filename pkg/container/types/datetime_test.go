@@ -149,8 +149,8 @@ func TestParseDatetime(t *testing.T) {
 func TestUTC(t *testing.T) {
 	args, _ := ParseDatetime("1987-08-25 00:00:00")
 	utc := args.UTC()
-	_, offset := time.Now().Local().Zone()
-	if args.sec()-utc.sec() != localTZ {
+	_, offset := time.Date(1987, 8, 25, 0, 0, 0, 0, time.Local).Zone()
+	if args.sec()-utc.sec() != int64(offset) {
 		t.Errorf("UTC() args %v got %v and time zone UTC+%v", args, utc, offset/secsPerHour)
 	}
 }
@@ -17,6 +17,7 @@ package types
 import (
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestTimestamp_String(t *testing.T) {
@@ -119,17 +120,81 @@ func TestTimestamp_String2(t *testing.T) {
 func TestParseTimestamp(t *testing.T) {
 	a, err := ParseTimestamp("0001-01-01 00:00:00", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(0))
+	require.Equal(t, int64(a.ToDatetime(localTZ)), int64(0))
 
 	a, err = ParseTimestamp("0001-01-01 00:00:00.123", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123000))
+	require.Equal(t, int64(a.ToDatetime(localTZ)), int64(123000))
 
 	a, err = ParseTimestamp("0001-01-01 00:00:00.123456", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123456))
+	require.Equal(t, int64(a.ToDatetime(localTZ)), int64(123456))
 
 	a, err = ParseTimestamp("0001-01-01 00:00:00.123456", 3)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123000))
+	require.Equal(t, int64(a.ToDatetime(localTZ)), int64(123000))
+}
+
+func TestTimestamp_Location(t *testing.T) {
+	shanghai, err := ParseTimeZone("Asia/Shanghai")
+	require.NoError(t, err)
+	utc, err := ParseTimeZone("+00:00")
+	require.NoError(t, err)
+
+	a, err := ParseTimestampInLocation("2022-05-01 11:11:11.123", 3, shanghai)
+	require.NoError(t, err)
+	require.Equal(t, "2022-05-01 03:11:11.123", a.String2InLocation(utc, 3))
+	require.Equal(t, "2022-05-01 11:11:11", a.String2InLocation(shanghai, 0))
+
+	// daylight saving time
+	ny, err := ParseTimeZone("America/New_York")
+	require.NoError(t, err)
+	a, err = ParseTimestampInLocation("2022-07-01 12:00:00", 0, ny)
+	require.NoError(t, err)
+	require.Equal(t, "2022-07-01 16:00:00", a.String2InLocation(utc, 0))
+	a, err = ParseTimestampInLocation("2022-01-01 12:00:00", 0, ny)
+	require.NoError(t, err)
+	require.Equal(t, "2022-01-01 17:00:00", a.String2InLocation(utc, 0))
+
+	dts, err := TimestampToDatetimeInLocation([]Timestamp{a}, make([]Datetime, 1), utc)
+	require.NoError(t, err)
+	require.Equal(t, "2022-01-01 17:00:00", dts[0].String())
+
+	dt, err := ParseDatetime("2022-01-01 00:00:00")
+	require.NoError(t, err)
+	plus, err := ParseTimeZone("+05:30")
+	require.NoError(t, err)
+	require.Equal(t, "2022-01-01 05:30:00", ConvertTimeZone(dt, utc, plus).String())
+	require.Equal(t, "2021-12-31 16:00:00", ConvertTimeZone(dt, shanghai, utc).String())
+
+	for _, s := range []string{"", "+14:01", "-14:00", "+1:60", "Mars/Olympus", "Local"} {
+		_, err = ParseTimeZone(s)
+		require.Error(t, err, s)
+	}
+	loc, err := ParseTimeZone("system")
+	require.NoError(t, err)
+	require.Equal(t, time.Local, loc)
+}
+
+func TestTimestamp_LocalDaylightSaving(t *testing.T) {
+	ny, err := ParseTimeZone("America/New_York")
+	require.NoError(t, err)
+	old := localTZ
+	localTZ = ny
+	defer func() {
+		localTZ = old
+	}()
+
+	// the offset of the server's time zone follows the daylight saving time
+	for _, s := range []string{"2022-07-01 12:00:00", "2022-01-01 12:00:00"} {
+		a, err := ParseTimestamp(s, 0)
+		require.NoError(t, err)
+		require.Equal(t, s, a.String2(0))
+		b, err := ParseTimestampInLocation(s, 0, ny)
+		require.NoError(t, err)
+		require.Equal(t, b, a)
+	}
+	dt, err := ParseDatetime("2022-07-01 12:00:00")
+	require.NoError(t, err)
+	require.Equal(t, "2022-07-01 16:00:00", dt.UTC().String())
 }
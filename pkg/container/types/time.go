@@ -0,0 +1,177 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// Time is a MySQL TIME value: a signed duration in microseconds.
+// It is not restricted to a single day, the valid range is
+// '-838:59:59.000000' to '838:59:59.000000'.
+
+const (
+	microSecsPerSec = 1000000
+	maxHourInTime   = 838
+
+	MaxTime = Time((maxHourInTime*secsPerHour + 59*secsPerMinute + 59) * microSecsPerSec)
+	MinTime = -MaxTime
+)
+
+var (
+	errIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time value")
+)
+
+// ParseTime will parse a string to be a Time
+// Support Format:
+// 1. [-][d ]hh:mm:ss(.msec), hh:mm and [-]hhh:mm:ss
+// 2. [-]hhmmss(.msec), mmss and ss
+// 3. a datetime value, only the clock part is kept
+func ParseTime(s string) (Time, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return 0, errIncorrectTimeValue
+	}
+	if len(s) >= 19 && s[4] == '-' {
+		dt, err := ParseDatetime(s)
+		if err != nil {
+			return 0, errIncorrectTimeValue
+		}
+		return dt.TimeOfDay(), nil
+	}
+	neg := false
+	if s[0] == '-' {
+		neg = true
+		s = s[1:]
+	}
+	var msec uint32
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		frac := s[i+1:]
+		if len(frac) == 0 || len(frac) > 6 {
+			return 0, errIncorrectTimeValue
+		}
+		m, err := strconv.ParseUint(frac, 10, 32)
+		if err != nil {
+			return 0, errIncorrectTimeValue
+		}
+		for j := len(frac); j < 6; j++ {
+			m *= 10
+		}
+		msec = uint32(m)
+		s = s[:i]
+	}
+	var day, hour, minute, second uint64
+	var err error
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		if day, err = strconv.ParseUint(s[:i], 10, 32); err != nil {
+			return 0, errIncorrectTimeValue
+		}
+		s = strings.TrimSpace(s[i+1:])
+	}
+	if strings.IndexByte(s, ':') >= 0 {
+		parts := strings.Split(s, ":")
+		if len(parts) > 3 {
+			return 0, errIncorrectTimeValue
+		}
+		vs := make([]uint64, 3)
+		for i, p := range parts {
+			if len(p) == 0 || (i > 0 && len(p) != 2) {
+				return 0, errIncorrectTimeValue
+			}
+			if vs[i], err = strconv.ParseUint(p, 10, 32); err != nil {
+				return 0, errIncorrectTimeValue
+			}
+		}
+		hour, minute, second = vs[0], vs[1], vs[2]
+	} else {
+		if day != 0 {
+			return 0, errIncorrectTimeValue
+		}
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, errIncorrectTimeValue
+		}
+		hour, minute, second = n/10000, n/100%100, n%100
+	}
+	hour += day * 24
+	if minute > maxMinuteInHour || second > maxSecondInMinute {
+		return 0, errIncorrectTimeValue
+	}
+	if hour > maxHourInTime {
+		return 0, errIncorrectTimeValue
+	}
+	return FromTimeClock(neg, hour, uint8(minute), uint8(second), msec), nil
+}
+
+// FromTimeClock builds a Time from its clock parts
+func FromTimeClock(neg bool, hour uint64, min, sec uint8, msec uint32) Time {
+	t := (int64(hour)*secsPerHour+int64(min)*secsPerMinute+int64(sec))*microSecsPerSec + int64(msec)
+	if neg {
+		t = -t
+	}
+	return Time(t)
+}
+
+// ClockFormat returns the sign and clock parts of a Time
+func (t Time) ClockFormat() (neg bool, hour uint64, min, sec uint8, msec uint32) {
+	v := int64(t)
+	if v < 0 {
+		neg = true
+		v = -v
+	}
+	msec = uint32(v % microSecsPerSec)
+	secs := v / microSecsPerSec
+	hour = uint64(secs / secsPerHour)
+	min = uint8(secs % secsPerHour / secsPerMinute)
+	sec = uint8(secs % secsPerMinute)
+	return
+}
+
+func (t Time) String() string {
+	return t.String2(microSecondsDigits)
+}
+
+// String2 stringify time, including its fractional seconds precision part(fsp)
+func (t Time) String2(precision int32) string {
+	neg, hour, min, sec, msec := t.ClockFormat()
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	if precision > 0 {
+		if precision > microSecondsDigits {
+			precision = microSecondsDigits
+		}
+		msecInstr := fmt.Sprintf("%06d", msec)[:precision]
+		return fmt.Sprintf("%s%02d:%02d:%02d.%s", sign, hour, min, sec, msecInstr)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hour, min, sec)
+}
+
+// ValidTime returns true if t is in the MySQL TIME range
+func (t Time) ValidTime() bool {
+	return t >= MinTime && t <= MaxTime
+}
+
+// TimeOfDay returns the clock part of a Datetime
+func (dt Datetime) TimeOfDay() Time {
+	h, m, s := dt.Clock()
+	return FromTimeClock(false, uint64(h), uint8(m), uint8(s), uint32(int64(dt)&0xFFFFF))
+}
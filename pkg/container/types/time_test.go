@@ -0,0 +1,123 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    string
+		wantErr bool
+	}{
+		// 1. hh:mm:ss(.msec)
+		{
+			name: "hh:mm:ss",
+			args: "12:34:56",
+			want: "12:34:56.000000",
+		},
+		{
+			name: "hh:mm:ss.msec",
+			args: "12:34:56.789",
+			want: "12:34:56.789000",
+		},
+		{
+			name: "hhh:mm:ss",
+			args: "-838:59:59",
+			want: "-838:59:59.000000",
+		},
+		{
+			name: "d hh:mm",
+			args: "2 01:02",
+			want: "49:02:00.000000",
+		},
+		// 2. hhmmss(.msec)
+		{
+			name: "hhmmss",
+			args: "123456",
+			want: "12:34:56.000000",
+		},
+		{
+			name: "ss",
+			args: "7",
+			want: "00:00:07.000000",
+		},
+		// 3. datetime
+		{
+			name: "datetime",
+			args: "1987-08-25 11:22:33",
+			want: "11:22:33.000000",
+		},
+		// 4. out of range
+		{
+			name:    "out of range 1",
+			args:    "839:00:00",
+			wantErr: true,
+		},
+		{
+			name:    "out of range 2",
+			args:    "12:60:00",
+			wantErr: true,
+		},
+		// 5. wrong format
+		{
+			name:    "wrong format",
+			args:    "12:3:4",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+			require.True(t, got.ValidTime())
+		})
+	}
+}
+
+func TestTimeClockFormat(t *testing.T) {
+	tm := FromTimeClock(true, 100, 2, 3, 4)
+	neg, h, m, s, ms := tm.ClockFormat()
+	require.True(t, neg)
+	require.Equal(t, uint64(100), h)
+	require.Equal(t, uint8(2), m)
+	require.Equal(t, uint8(3), s)
+	require.Equal(t, uint32(4), ms)
+	require.Equal(t, "-100:02:03.000004", tm.String())
+}
+
+func TestTime_String2(t *testing.T) {
+	tm, err := ParseTime("12:34:56.789123")
+	require.NoError(t, err)
+	require.Equal(t, "12:34:56", tm.String2(0))
+	require.Equal(t, "12:34:56.789", tm.String2(3))
+	require.Equal(t, "12:34:56.789123", tm.String2(6))
+
+	tm, err = ParseTime("-838:59:59.5")
+	require.NoError(t, err)
+	require.Equal(t, "-838:59:59", tm.String2(0))
+	require.Equal(t, "-838:59:59.500", tm.String2(3))
+	require.Equal(t, "-838:59:59.500000", tm.String2(6))
+}
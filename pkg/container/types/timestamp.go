@@ -27,22 +27,27 @@
 // you may otherwise encounter by using DATETIME
 //
 // Internal representation:
-// timestamp values are represented using a 64bit integer, the higher 40 bits stores the secs since January 1, year 1, local time zone, in Gregorian
+// timestamp values are represented using a 64bit integer, the higher 40 bits stores the secs since January 1, year 1, UTC, in Gregorian
 // calendar, and lower 20 bits hold the number of microseconds
 // the default fractional seconds precision(fsp) for TIMESTAMP is 6, as SQL standard requires.
+//
+// Time zone:
+// the values are converted from and to the wall clock of a time zone, the session's time_zone
+// is used by ParseTimestampInLocation and String2InLocation, and the server's local time zone
+// is used by ParseTimestamp and String2
 
 package types
 
 import (
 	"fmt"
 	"strconv"
-	"unsafe"
+	"time"
 )
 
 const microSecondsDigits = 6
 
 func (ts Timestamp) String() string {
-	dt := Datetime(int64(ts) + localTZ<<20)
+	dt := ts.ToDatetime(localTZ)
 	y, m, d, _ := dt.ToDate().Calendar(true)
 	hour, minute, sec := dt.Clock()
 	msec := int64(ts) & 0xfffff // the lower 20 bits of timestamp stores the microseconds value
@@ -51,7 +56,30 @@ func (ts Timestamp) String() string {
 
 // String2 stringify timestamp, including its fractional seconds precision part(fsp)
 func (ts Timestamp) String2(precision int32) string {
-	dt := Datetime(int64(ts) + localTZ<<20)
+	return formatTimestamp(ts.ToDatetime(localTZ), precision)
+}
+
+// String2InLocation stringify timestamp in the time zone loc, including its fsp
+func (ts Timestamp) String2InLocation(loc *time.Location, precision int32) string {
+	return formatTimestamp(ts.ToDatetime(loc), precision)
+}
+
+// ToDatetime returns the wall clock of timestamp in the time zone loc
+func (ts Timestamp) ToDatetime(loc *time.Location) Datetime {
+	_, offset := time.Unix(int64(ts)>>20-unixToInternal, 0).In(loc).Zone()
+	return Datetime(int64(ts) + int64(offset)<<20)
+}
+
+// FromDatetime returns the timestamp of the wall clock dt in the time zone loc.
+// For the wall clock skipped or repeated by daylight saving time, the offset
+// chosen by time.Date is used.
+func FromDatetime(dt Datetime, loc *time.Location) Timestamp {
+	t := time.Unix(dt.sec()-unixToInternal, 0).UTC()
+	_, offset := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc).Zone()
+	return Timestamp(int64(dt) - int64(offset)<<20)
+}
+
+func formatTimestamp(dt Datetime, precision int32) string {
 	y, m, d, _ := dt.ToDate().Calendar(true)
 	hour, minute, sec := dt.Clock()
 	if precision > 0 {
@@ -65,15 +93,31 @@ func (ts Timestamp) String2(precision int32) string {
 	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, minute, sec)
 }
 
-// ParseTimestamp will parse a string to be a Timestamp
+// ParseTimestamp will parse a string to be a Timestamp, the string is
+// the wall clock in the server's local time zone
 // Support Format:
 // 1. all the Date value
 // 2. yyyy-mm-dd hh:mm:ss(.msec)
 // 3. yyyymmddhhmmss(.msec)
 func ParseTimestamp(s string, precision int32) (Timestamp, error) {
+	return ParseTimestampInLocation(s, precision, localTZ)
+}
+
+// ParseTimestampInLocation is like ParseTimestamp, but the string is the
+// wall clock in the time zone loc
+func ParseTimestampInLocation(s string, precision int32, loc *time.Location) (Timestamp, error) {
+	dt, err := parseTimestampClock(s, precision)
+	if err != nil {
+		return -1, err
+	}
+	return FromDatetime(dt, loc), nil
+}
+
+// parseTimestampClock parses the wall clock of a timestamp string
+func parseTimestampClock(s string, precision int32) (Datetime, error) {
 	if len(s) < 14 {
 		if d, err := ParseDate(s); err == nil {
-			return Timestamp(d.ToTime()), nil
+			return d.ToTime(), nil
 		}
 		return -1, errIncorrectDatetimeValue
 	}
@@ -161,24 +205,23 @@ func ParseTimestamp(s string, precision int32) (Timestamp, error) {
 			}
 		}
 	}
-	result := FromClockUTC(year, month, day, hour, minute, second, msec)
-
-	return result, nil
+	return FromClock(year, month, day, hour, minute, second, msec), nil
 }
 
 func TimestampToDatetime(xs []Timestamp, rs []Datetime) ([]Datetime, error) {
-	localTZAligned := localTZ << 20
-	xsInInt64 := *(*[]int64)(unsafe.Pointer(&xs))
-	rsInInt64 := *(*[]int64)(unsafe.Pointer(&rs))
-	for i, x := range xsInInt64 {
-		rsInInt64[i] = x + localTZAligned
+	return TimestampToDatetimeInLocation(xs, rs, localTZ)
+}
+
+// TimestampToDatetimeInLocation is like TimestampToDatetime, but the wall
+// clocks are in the time zone loc
+func TimestampToDatetimeInLocation(xs []Timestamp, rs []Datetime, loc *time.Location) ([]Datetime, error) {
+	for i, x := range xs {
+		rs[i] = x.ToDatetime(loc)
 	}
 	return rs, nil
 }
 
 // FromClockUTC gets the utc time value in Timestamp
 func FromClockUTC(year int32, month, day, hour, min, sec uint8, msec uint32) Timestamp {
-	days := FromCalendar(year, month, day)
-	secs := int64(days)*secsPerDay + int64(hour)*secsPerHour + int64(min)*secsPerMinute + int64(sec) - localTZ
-	return Timestamp((secs << 20) + int64(msec))
+	return FromDatetime(FromClock(year, month, day, hour, min, sec, msec), localTZ)
 }
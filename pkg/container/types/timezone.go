@@ -0,0 +1,76 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	// the range of the time zone offsets, as MySQL
+	minTimeZoneOffset = -(13*secsPerHour + 59*secsPerMinute)
+	maxTimeZoneOffset = 14 * secsPerHour
+)
+
+// locations caches the time zones loaded from the tz database, name -> *time.Location
+var locations sync.Map
+
+// ParseTimeZone returns the location of a time zone, which can be
+// 1. SYSTEM, the server's local time zone
+// 2. an offset from UTC, such as +08:00 or -05:30
+// 3. a named time zone in the tz database, such as UTC or Asia/Shanghai
+func ParseTimeZone(s string) (*time.Location, error) {
+	if strings.EqualFold(s, "SYSTEM") {
+		return time.Local, nil
+	}
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		var hour, minute int
+		if n, err := fmt.Sscanf(s[1:], "%d:%d", &hour, &minute); err != nil || n != 2 || minute > 59 || minute < 0 || hour < 0 {
+			return nil, errUnknownTimeZone(s)
+		}
+		offset := hour*secsPerHour + minute*secsPerMinute
+		if s[0] == '-' {
+			offset = -offset
+		}
+		if offset < minTimeZoneOffset || offset > maxTimeZoneOffset {
+			return nil, errUnknownTimeZone(s)
+		}
+		return time.FixedZone(s, offset), nil
+	}
+	if loc, ok := locations.Load(s); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(s)
+	if err != nil || s == "" || strings.EqualFold(s, "Local") {
+		return nil, errUnknownTimeZone(s)
+	}
+	locations.Store(s, loc)
+	return loc, nil
+}
+
+// ConvertTimeZone converts the wall clock dt from the time zone from to the time zone to
+func ConvertTimeZone(dt Datetime, from, to *time.Location) Datetime {
+	return FromDatetime(dt, from).ToDatetime(to)
+}
+
+func errUnknownTimeZone(s string) error {
+	return errors.New(errno.DataException, fmt.Sprintf("Unknown or incorrect time zone: '%s'", s))
+}
@@ -47,6 +47,7 @@ const (
 
 	// date family
 	T_date      T = T(plan.Type_DATE)
+	T_time      T = T(plan.Type_TIME)
 	T_datetime  T = T(plan.Type_DATETIME)
 	T_timestamp T = T(plan.Type_TIMESTAMP)
 	T_interval  T = T(plan.Type_INTERVAL)
@@ -54,6 +55,12 @@ const (
 	// string family
 	T_char    T = T(plan.Type_CHAR)
 	T_varchar T = T(plan.Type_VARCHAR)
+	T_text    T = T(plan.Type_TEXT)
+
+	// binary family
+	T_binary    T = T(plan.Type_BINARY)
+	T_varbinary T = T(plan.Type_VARBINARY)
+	T_blob      T = T(plan.Type_BLOB)
 
 	// json family
 	T_json T = T(plan.Type_JSON)
@@ -87,6 +94,7 @@ type Bytes struct {
 
 type Date int32
 
+type Time int64
 type Datetime int64
 type Timestamp int64
 
@@ -118,12 +126,18 @@ var Types map[string]T = map[string]T{
 	"double": T_float64,
 
 	"date":      T_date,
+	"time":      T_time,
 	"datetime":  T_datetime,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
 
 	"char":    T_char,
 	"varchar": T_varchar,
+	"text":    T_text,
+
+	"binary":    T_binary,
+	"varbinary": T_varbinary,
+	"blob":      T_blob,
 
 	"json": T_json,
 }
@@ -147,7 +161,7 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
@@ -165,6 +179,8 @@ func (t T) ToType() Type {
 		typ.Size = 24
 	case T_varchar:
 		typ.Size = 24
+	case T_text, T_binary, T_varbinary, T_blob:
+		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	case T_decimal64:
@@ -201,6 +217,8 @@ func (t T) String() string {
 		return "DOUBLE"
 	case T_date:
 		return "DATE"
+	case T_time:
+		return "TIME"
 	case T_datetime:
 		return "DATETIME"
 	case T_timestamp:
@@ -209,6 +227,14 @@ func (t T) String() string {
 		return "CHAR"
 	case T_varchar:
 		return "VARCHAR"
+	case T_text:
+		return "TEXT"
+	case T_binary:
+		return "BINARY"
+	case T_varbinary:
+		return "VARBINARY"
+	case T_blob:
+		return "BLOB"
 	case T_json:
 		return "JSON"
 	case T_sel:
@@ -254,8 +280,18 @@ func (t T) OidString() string {
 		return "T_char"
 	case T_varchar:
 		return "T_varchar"
+	case T_text:
+		return "T_text"
+	case T_binary:
+		return "T_binary"
+	case T_varbinary:
+		return "T_varbinary"
+	case T_blob:
+		return "T_blob"
 	case T_date:
 		return "T_date"
+	case T_time:
+		return "T_time"
 	case T_datetime:
 		return "T_datetime"
 	case T_timestamp:
@@ -295,10 +331,12 @@ func (t T) GoType() string {
 		return "int64"
 	case T_char:
 		return "string"
-	case T_varchar:
+	case T_varchar, T_text, T_binary, T_varbinary, T_blob:
 		return "string"
 	case T_date:
 		return "date"
+	case T_time:
+		return "time"
 	case T_datetime:
 		return "datetime"
 	case T_timestamp:
@@ -313,7 +351,7 @@ func (t T) GoType() string {
 
 // GoGoType returns special go type string for T
 func (t T) GoGoType() string {
-	if t == T_char || t == T_varchar {
+	if t.IsString() {
 		return "Str"
 	}
 	k := t.GoType()
@@ -329,7 +367,7 @@ func (t T) TypeLen() int {
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		return 8
 	case T_uint8:
 		return 1
@@ -347,6 +385,8 @@ func (t T) TypeLen() int {
 		return 24
 	case T_varchar:
 		return 24
+	case T_text, T_binary, T_varbinary, T_blob:
+		return 24
 	case T_sel:
 		return 8
 	case T_decimal64:
@@ -365,7 +405,7 @@ func (t T) FixedLength() int {
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_time, T_datetime, T_float64, T_timestamp:
 		return 8
 	case T_decimal64:
 		return -8
@@ -375,8 +415,30 @@ func (t T) FixedLength() int {
 		return -24
 	case T_varchar:
 		return -24
+	case T_text, T_binary, T_varbinary, T_blob:
+		return -24
 	case T_sel:
 		return 8
 	}
 	panic(moerr.NewInternalError("Unknow type %s", t))
 }
+
+// IsString returns true if values of t are stored as types.Bytes
+// and compared byte by byte
+func (t T) IsString() bool {
+	switch t {
+	case T_char, T_varchar, T_text, T_binary, T_varbinary, T_blob:
+		return true
+	}
+	return false
+}
+
+// IsBinary returns true if t is a binary string type
+func (t T) IsBinary() bool {
+	return t == T_binary || t == T_varbinary || t == T_blob
+}
+
+// IsLob returns true if values of t may be stored out of line
+func (t T) IsLob() bool {
+	return t == T_text || t == T_blob
+}
@@ -43,12 +43,27 @@ func TestT_ToType(t *testing.T) {
 	require.Equal(t, int32(2), T_uint16.ToType().Size)
 	require.Equal(t, int32(4), T_uint32.ToType().Size)
 	require.Equal(t, int32(8), T_uint64.ToType().Size)
+	require.Equal(t, int32(8), T_time.ToType().Size)
+	require.Equal(t, int32(24), T_blob.ToType().Size)
 }
 
 func TestT_String(t *testing.T) {
 	require.Equal(t, "TINYINT", T_int8.String())
 	require.Equal(t, "SMALLINT", T_int16.String())
 	require.Equal(t, "INT", T_int32.String())
+	require.Equal(t, "TIME", T_time.String())
+	require.Equal(t, "VARBINARY", T_varbinary.String())
+	require.Equal(t, "TEXT", T_text.String())
+}
+
+func TestT_IsString(t *testing.T) {
+	require.True(t, T_varchar.IsString())
+	require.True(t, T_blob.IsString())
+	require.False(t, T_json.IsString())
+	require.True(t, T_binary.IsBinary())
+	require.False(t, T_text.IsBinary())
+	require.True(t, T_text.IsLob())
+	require.False(t, T_varbinary.IsLob())
 }
 
 func TestT_OidString(t *testing.T) {
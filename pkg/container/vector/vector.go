@@ -107,6 +107,12 @@ func New(typ types.Type) *Vector {
 			Col: []types.Date{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_time:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Time{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_datetime:
 		return &Vector{
 			Typ: typ,
@@ -131,7 +137,7 @@ func New(typ types.Type) *Vector {
 			Nsp: &nulls.Nulls{},
 			Col: [][]interface{}{},
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return &Vector{
 			Typ: typ,
 			Col: &types.Bytes{},
@@ -162,7 +168,7 @@ func NewConst(typ types.Type) *Vector {
 
 func Reset(v *Vector) {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		v.Col.(*types.Bytes).Reset()
 	default:
 		*(*int)(unsafe.Pointer(uintptr((*(*emptyInterface)(unsafe.Pointer(&v.Col))).word) + uintptr(strconv.IntSize>>3))) = 0
@@ -270,6 +276,13 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeDateSlice(v.Data)[:0]
+	case types.T_time:
+		data, err := mheap.Alloc(m, int64(rows*8))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeTimeSlice(v.Data)[:0]
 	case types.T_datetime:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
@@ -284,7 +297,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeTimestampSlice(v.Data)[:0]
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
 		if err != nil {
@@ -299,7 +312,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 
 func Length(v *Vector) int {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return len(v.Col.(*types.Bytes).Offsets)
 	default:
 		return reflect.ValueOf(v.Col).Len()
@@ -337,6 +350,8 @@ func SetLength(v *Vector, n int) {
 		setLengthFixed[float64](v, n)
 	case types.T_date:
 		setLengthFixed[types.Date](v, n)
+	case types.T_time:
+		setLengthFixed[types.Time](v, n)
 	case types.T_datetime:
 		setLengthFixed[types.Datetime](v, n)
 	case types.T_timestamp:
@@ -356,7 +371,7 @@ func SetLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		m := len(vs.Offsets)
 		vs.Data = vs.Data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
@@ -546,7 +561,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		var err error
 		var data []byte
 
@@ -590,6 +605,22 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	case types.T_datetime:
 		vs := v.Col.([]types.Datetime)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -697,12 +728,15 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_tuple:
 		w.Col = v.Col.([][]interface{})[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		w.Col = v.Col.(*types.Bytes).Window(start, end)
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_date:
 		w.Col = v.Col.([]types.Date)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_time:
+		w.Col = v.Col.([]types.Time)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_datetime:
 		w.Col = v.Col.([]types.Datetime)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
@@ -747,6 +781,8 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]float64), arg.([]float64)...)
 	case types.T_date:
 		v.Col = append(v.Col.([]types.Date), arg.([]types.Date)...)
+	case types.T_time:
+		v.Col = append(v.Col.([]types.Time), arg.([]types.Time)...)
 	case types.T_datetime:
 		v.Col = append(v.Col.([]types.Datetime), arg.([]types.Datetime)...)
 	case types.T_timestamp:
@@ -755,7 +791,7 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
 		v.Col = append(v.Col.([][]interface{}), arg.([][]interface{})...)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return v.Col.(*types.Bytes).Append(arg.([][]byte))
 	case types.T_decimal64:
 		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
@@ -853,7 +889,7 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		for i, sel := range sels {
 			vs.Offsets[i] = vs.Offsets[sel]
@@ -869,6 +905,13 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_datetime:
 		vs := v.Col.([]types.Datetime)
 		for i, sel := range sels {
@@ -1017,7 +1060,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		ws := make([][]interface{}, len(vs))
 		v.Col = shuffle.TupleShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		odata, err := mheap.Alloc(m, int64(len(vs.Offsets)*4))
 		if err != nil {
@@ -1044,6 +1087,16 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.DateShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		v.Col = shuffle.TimeShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
 	case types.T_datetime:
 		vs := v.Col.([]types.Datetime)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -1398,7 +1451,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
 		vs = append(vs, ws[sel])
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		from := ws.Get(sel)
 		if len(v.Data) == 0 {
@@ -1457,6 +1510,33 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]types.Date)[sel])
 			v.Col = vs
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			vs[0] = w.Col.([]types.Time)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Time)[sel])
+			v.Col = vs
+		}
 	case types.T_datetime:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -1837,7 +1917,7 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		vs.Offsets = append(vs.Offsets, 0)
 		vs.Lengths = append(vs.Lengths, 0)
@@ -1868,6 +1948,32 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+		}
 	case types.T_datetime:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -2193,7 +2299,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for _, sel := range sels {
@@ -2236,6 +2342,27 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
+	case types.T_time:
+		cnt := len(sels)
+		ws := w.Col.([]types.Time)
+		vs := v.Col.([]types.Time)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeTimeSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
 	case types.T_datetime:
 		cnt := len(sels)
 		ws := w.Col.([]types.Datetime)
@@ -2740,7 +2867,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 		}
 		v.Col = vs
 
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for i, flag := range flags {
@@ -2824,6 +2951,49 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_time:
+		col := w.Col.([]types.Time)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	case types.T_datetime:
 		col := w.Col.([]types.Datetime)
 		if len(v.Data) == 0 {
@@ -3144,6 +3314,18 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeDateSlice(v.Col.([]types.Date)))
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeTimeSlice(v.Col.([]types.Time)))
+		return buf.Bytes(), nil
 	case types.T_datetime:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
@@ -3180,7 +3362,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeInt64Slice(v.Col.([]int64)))
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -3383,6 +3565,17 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeDateSlice(data[size:])
 		}
+	case types.T_time:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeTimeSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeTimeSlice(data[size:])
+		}
 	case types.T_datetime:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
@@ -3405,7 +3598,7 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeTimestampSlice(data[size:])
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		Col := v.Col.(*types.Bytes)
 		Col.Reset()
 		size := encoding.DecodeUint32(data)
@@ -3576,6 +3769,15 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_time:
+		col := v.Col.([]types.Time)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	case types.T_datetime:
 		col := v.Col.([]types.Datetime)
 		if len(col) == 1 {
@@ -3612,7 +3814,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3922,7 +4124,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		var i int64
 		for i = 0; i < int64(rows); i++ {
@@ -3977,6 +4179,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_datetime:
 		vs := v.Col.([]types.Datetime)
 		for i := 0; i < rows; i++ {
@@ -27,6 +27,7 @@ import (
 
 var TypeSize int
 var DateSize int
+var TimeSize int
 var DatetimeSize int
 var TimestampSize int
 var Decimal64Size int
@@ -35,6 +36,7 @@ var Decimal128Size int
 func init() {
 	TypeSize = int(unsafe.Sizeof(types.Type{}))
 	DateSize = int(unsafe.Sizeof(types.Date(0)))
+	TimeSize = int(unsafe.Sizeof(types.Time(0)))
 	DatetimeSize = int(unsafe.Sizeof(types.Datetime(0)))
 	TimestampSize = int(unsafe.Sizeof(types.Timestamp(0)))
 	Decimal64Size = int(unsafe.Sizeof(types.Decimal64(0)))
@@ -166,6 +168,14 @@ func DecodeDate(v []byte) types.Date {
 	return *(*types.Date)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeTime(v types.Time) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
+}
+
+func DecodeTime(v []byte) types.Time {
+	return *(*types.Time)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDatetime(v types.Datetime) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
 }
@@ -322,6 +332,14 @@ func DecodeDateSlice(v []byte) []types.Date {
 	return DecodeFixedSlice[types.Date](v, DateSize)
 }
 
+func EncodeTimeSlice(v []types.Time) []byte {
+	return EncodeFixedSlice(v, TimeSize)
+}
+
+func DecodeTimeSlice(v []byte) []types.Time {
+	return DecodeFixedSlice[types.Time](v, TimeSize)
+}
+
 func EncodeDatetimeSlice(v []types.Datetime) []byte {
 	return EncodeFixedSlice(v, DatetimeSize)
 }
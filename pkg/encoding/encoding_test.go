@@ -263,6 +263,21 @@ func TestEncodeDatetimeSlice(t *testing.T) {
 	}
 }
 
+func TestEncodeTime(t *testing.T) {
+	nums := []types.Time{types.MinTime, types.MaxTime, 0}
+	for _, num := range nums {
+		if DecodeTime(EncodeTime(num)) != num {
+			t.Fatalf("Time Encoding Error\n")
+		}
+	}
+	timesDecode := DecodeTimeSlice(EncodeTimeSlice(nums))
+	for i, num := range nums {
+		if timesDecode[i] != num {
+			t.Fatalf("Time Encoding Error\n")
+		}
+	}
+}
+
 func TestStringSliceEncoding(t *testing.T) {
 	xs := []string{"a", "bc", "d"}
 	data := EncodeStringSlice(xs)
@@ -279,7 +279,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					}
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_BLOB:
 			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
 				return err2
 			} else {
@@ -287,6 +287,14 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					return err
 				}
 			}
+		case defines.MYSQL_TYPE_JSON:
+			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
+				return err2
+			} else {
+				if err = formatOutputString(oq, []byte(value), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					return err
+				}
+			}
 		case defines.MYSQL_TYPE_DATE:
 			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
 				return err2
@@ -303,8 +311,16 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					return err
 				}
 			}
-		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+		case defines.MYSQL_TYPE_TIME:
+			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
+				return err2
+			} else {
+				if err = formatOutputString(oq, []byte(value), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					return err
+				}
+			}
+		case defines.MYSQL_TYPE_TIMESTAMP:
+			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP")
 		default:
 			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
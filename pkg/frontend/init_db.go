@@ -511,7 +511,7 @@ func DefineSchemaForMoGlobalVariables() *CatalogSchema {
 		IsPrimaryKey:  false,
 		Comment:       "",
 	}
-	gvVariableNameAttr.AttributeType.Width = 1024
+	gvVariableValueAttr.AttributeType.Width = 1024
 
 	attrs := []*CatalogSchemaAttribute{
 		gvVariableNameAttr,
@@ -581,9 +581,12 @@ func DefineSchemaForMoUser() *CatalogSchema {
 	return &CatalogSchema{Name: "mo_user", Attributes: attrs}
 }
 
+// rootUserName is the built-in administrator
+const rootUserName = "root"
+
 func PrepareInitialDataForMoUser() [][]string {
 	data := [][]string{
-		{"localhost", "root", "''"},
+		{"localhost", rootUserName, "''"},
 		{"localhost", "dump", "111"},
 	}
 	return data
@@ -635,6 +638,18 @@ func InitDB(tae engine.Engine) error {
 		return err
 	}
 
+	//the catalog tables have been created before the restart
+	for _, name := range catalogDB.Relations(txnCtx.GetCtx()) {
+		if name == DefineSchemaForMoGlobalVariables().GetName() {
+			err = txnCtx.Commit()
+			if err != nil {
+				logutil.Infof("txnCtx commit failed.error:%v", err)
+				return err
+			}
+			return checkCatalogAndLoadSysVars(tae)
+		}
+	}
+
 	//2. create table mo_global_variables
 	gvSch := DefineSchemaForMoGlobalVariables()
 	gvDefs := convertCatalogSchemaToTableDef(gvSch)
@@ -713,7 +728,8 @@ func InitDB(tae engine.Engine) error {
 
 	/*
 		stage 2: create information_schema database.
-		Views in the information_schema need to created by 'create view'
+		The tables in the information_schema are virtual relations
+		generated from the catalog tables by the engine on read.
 	*/
 	//1. create database information_schema
 	infoSchemaName := "information_schema"
@@ -727,14 +743,22 @@ func InitDB(tae engine.Engine) error {
 		}
 		return err
 	}
-	//TODO: create views after the computation engine is ready
 	err = txnCtx.Commit()
 	if err != nil {
 		logutil.Infof("txnCtx commit failed.error:%v", err)
 		return err
 	}
 
-	return sanityCheck(tae)
+	return checkCatalogAndLoadSysVars(tae)
+}
+
+// checkCatalogAndLoadSysVars checks the catalog and then loads the persisted
+// system variables, which override the default values
+func checkCatalogAndLoadSysVars(tae engine.Engine) error {
+	if err := sanityCheck(tae); err != nil {
+		return err
+	}
+	return loadPersistedSysVars(tae, gSysVariables)
 }
 
 // sanityCheck checks the catalog is ready or not
@@ -17,6 +17,7 @@ package frontend
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -28,6 +29,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type InsertValues struct {
@@ -36,12 +38,17 @@ type InsertValues struct {
 	currentDb string
 	dataBatch *batch.Batch
 	relation  engine.Relation
+	// loc is the time zone of the TIMESTAMP values
+	loc *time.Location
 }
 
 func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) error {
 	snapshot := mce.GetSession().GetTxnHandler().GetTxn().GetCtx()
 
-	plan := &InsertValues{currentDb: mce.GetSession().GetDatabaseName()}
+	plan := &InsertValues{
+		currentDb: mce.GetSession().GetDatabaseName(),
+		loc:       mce.GetSession().GetTimeZone(),
+	}
 
 	if err := buildInsertValues(stmt, plan, mce.GetSession().GetStorage(), snapshot); err != nil {
 		return err
@@ -392,7 +399,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 			vs := make([][]byte, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
@@ -414,6 +421,24 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_json:
+			vs := make([][]byte, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(bytejson.ByteJson).Marshal()
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_date:
 			vs := make([]types.Date, len(rows.Rows))
 			{
@@ -458,11 +483,33 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Time), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Time)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_timestamp:
 			vs := make([]types.Timestamp, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildTimestampConstant(vec.Typ, row[i], plan.loc)
 					if err != nil {
 						return err
 					}
@@ -556,7 +603,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return err
@@ -566,6 +613,8 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vec.Col = make([]types.Date, len(rows.Rows))
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_time:
+			vec.Col = make([]types.Time, len(rows.Rows))
 		case types.T_decimal64:
 			vec.Col = make([]types.Decimal64, len(rows.Rows))
 		case types.T_decimal128:
@@ -600,7 +649,7 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		res := value.(string)
 		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_date:
@@ -609,6 +658,9 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 	case types.T_datetime:
 		res := value.(types.Datetime).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_time:
+		res := value.(types.Time).String()
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
@@ -961,6 +1013,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_time:
+			return types.ParseTime(str)
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -1028,20 +1082,41 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		}
 		if !num.Negative() {
 			switch typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 				return constant.StringVal(val), nil
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
 			case types.T_datetime:
 				return types.ParseDatetime(constant.StringVal(val))
+			case types.T_time:
+				return types.ParseTime(constant.StringVal(val))
 			case types.T_timestamp:
 				return types.ParseTimestamp(constant.StringVal(val), typ.Precision)
+			case types.T_json:
+				return bytejson.ParseFromString(constant.StringVal(val))
 			}
 		}
 	}
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", val))
 }
 
+// buildTimestampConstant parses the string constant n as a TIMESTAMP value
+// in the time zone loc, the server's local time zone is used if loc is nil.
+func buildTimestampConstant(typ types.Type, n tree.Expr, loc *time.Location) (interface{}, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	v, err := buildConstant(types.Type{Oid: types.T_varchar}, n)
+	if err != nil || v == nil {
+		return v, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", v))
+	}
+	return types.ParseTimestampInLocation(s, typ.Precision, loc)
+}
+
 // rangeCheck do range check for value, and do type conversion.
 func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber int) (interface{}, error) {
 	errString := "Out of range value for column '%s' at row %d"
@@ -1106,18 +1181,20 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 		return nil, errors.New(errno.DataException, fmt.Sprintf(errString, columnName, rowNumber))
 	case string:
 		switch typ.Oid {
-		case types.T_char, types.T_varchar: // string family should compare the length but not value
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary: // string family should compare the length but not value
 			if len(v) > math.MaxUint16 {
 				return nil, errors.New(errno.DataException, "length out of uint16 is unexpected for char / varchar value")
 			}
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case types.T_text, types.T_blob:
+			return v, nil
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128, bool:
+	case types.Date, types.Datetime, types.Time, types.Timestamp, types.Decimal64, types.Decimal128, bool, bytejson.ByteJson:
 		return v, nil
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
@@ -15,12 +15,16 @@
 package frontend
 
 import (
+	"context"
 	goErrors "errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
 	"runtime/pprof"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -35,12 +39,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	compile1 "github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -222,6 +228,8 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	//Reference the shared ResultColumns of the session among multi-thread.
 	mrs.Columns = ses.Mrs.Columns
 	mrs.Name2Index = ses.Mrs.Name2Index
+	//TIMESTAMP values are shown in the time zone of the session
+	loc := ses.GetTimeZone()
 
 	begin3 := time.Now()
 	countOfResultSet := 1
@@ -236,6 +244,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	oq.reset()
 
 	row2colTime := time.Duration(0)
+	rowCount := int64(0)
 
 	procBatchBegin := time.Now()
 
@@ -262,6 +271,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 		if bat.Zs[j] <= 0 {
 			continue
 		}
+		rowCount += bat.Zs[j]
 		row, err := oq.getEmptyRow()
 		if err != nil {
 			return err
@@ -418,7 +428,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(int64(rowIndex))
 					}
 				}
-			case types.T_varchar:
+			case types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.(*types.Bytes)
 					row[i] = vs.Get(int64(rowIndex))
@@ -430,6 +440,17 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(int64(rowIndex))
 					}
 				}
+			case types.T_json:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.(*types.Bytes)
+					bj, err := bytejson.Unmarshal(vs.Get(int64(rowIndex)))
+					if err != nil {
+						return err
+					}
+					row[i] = bj
+				}
 			case types.T_date:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Date)
@@ -442,6 +463,19 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex]
 					}
 				}
+			case types.T_time:
+				precision := vec.Typ.Precision
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Time)
+					row[i] = vs[rowIndex].String2(precision)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Time)
+						row[i] = vs[rowIndex].String2(precision)
+					}
+				}
 			case types.T_datetime:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Datetime)
@@ -458,13 +492,13 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 				precision := vec.Typ.Precision
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Timestamp)
-					row[i] = vs[rowIndex].String2(precision)
+					row[i] = vs[rowIndex].String2InLocation(loc, precision)
 				} else {
 					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
 						row[i] = nil
 					} else {
 						vs := vec.Col.([]types.Timestamp)
-						row[i] = vs[rowIndex].String2(precision)
+						row[i] = vs[rowIndex].String2InLocation(loc, precision)
 					}
 				}
 			case types.T_decimal64:
@@ -517,6 +551,8 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	if err != nil {
 		return err
 	}
+	metric.RowsReturned.Add(float64(rowCount))
+	atomic.AddInt64(&ses.rowsSent, rowCount)
 
 	if enableProfile {
 		pprof.StopCPUProfile()
@@ -867,9 +903,97 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	for _, assign := range sv.Assignments {
+		//SET NAMES, SET CHARACTER SET
+		if !assign.System {
+			continue
+		}
+		name := strings.ToLower(assign.Name)
+		if _, _, ok := gSysVariables.GetGlobalSysVar(name); !ok {
+			//the clients set lots of mysql variables that have not been supported yet.
+			logutil.Infof("ignore the unsupported system variable %s", name)
+			continue
+		}
+
+		persist := assign.Persist || assign.PersistOnly
+		var value interface{}
+		if _, ok := assign.Value.(*tree.DefaultVal); ok {
+			value, err = mce.getDefaultValueOfSysVar(name, assign.Global || persist)
+		} else {
+			value, err = GetSimpleExprValue(assign.Value)
+		}
+		if err != nil {
+			return NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+		}
+
+		var persistValue interface{}
+		if persist {
+			persistValue, err = gSysVariables.CheckGlobalSysVar(name, value)
+		} else if assign.Global {
+			err = ses.SetGlobalVar(name, value)
+		} else {
+			err = ses.SetSessionVar(name, value)
+		}
+		switch err {
+		case nil:
+		case errorSystemVariableIsSession:
+			return NewMysqlError(ER_LOCAL_VARIABLE, name)
+		case errorSystemVariableIsGlobal:
+			return NewMysqlError(ER_GLOBAL_VARIABLE, name)
+		case errorSystemVariableIsReadOnly:
+			return NewMysqlError(ER_INCORRECT_GLOBAL_LOCAL_VAR, name, "read only")
+		case errorUnknownTimeZone:
+			return NewMysqlError(ER_UNKNOWN_TIME_ZONE, fmt.Sprintf("%v", value))
+		default:
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, fmt.Sprintf("%v", value))
+		}
+
+		//SET PERSIST changes the global value as well once the persisted one is committed,
+		//SET PERSIST_ONLY just writes mo_global_variables.
+		if persist {
+			txnHandler := ses.GetTxnHandler()
+			err = persistSysVar(ses.GetStorage(), txnHandler.GetTxn().GetCtx(), name, persistValue)
+			if err != nil {
+				return err
+			}
+			if assign.Persist {
+				name, persistValue := name, persistValue
+				txnHandler.AddAfterCommit(func() {
+					if err := ses.SetGlobalVar(name, persistValue); err != nil {
+						logutil.Errorf("set the persisted global variable %s failed. error:%v", name, err)
+					}
+				})
+			}
+		}
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle RESET PERSIST
+*/
+func (mce *MysqlCmdExecutor) handleResetPersist(rp *tree.ResetPersist) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	name := strings.ToLower(rp.Name)
+	removed, err := removePersistedSysVars(ses.GetStorage(), ses.GetTxnHandler().GetTxn().GetCtx(), name)
+	if err != nil {
+		return err
+	}
+	if name != "" && !removed && !rp.IfExists {
+		return NewMysqlError(ER_VAR_DOES_NOT_EXIST, name)
+	}
 
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
@@ -878,6 +1002,126 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 	return nil
 }
 
+// handleBackupDatabase copies an online snapshot of the storage into the dir.
+// Only root can back up, the dir is resolved under the configured backupDir
+func (mce *MysqlCmdExecutor) handleBackupDatabase(bd *tree.BackupDatabase) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	if ses.GetUserName() != rootUserName {
+		return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, "BACKUP_ADMIN")
+	}
+	dir, err := resolveBackupDir(ses.Pu.SV.GetBackupDir(), bd.Dir)
+	if err != nil {
+		return err
+	}
+	backuper, ok := ses.Pu.StorageEngine.(engine.Backuper)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, "the storage engine does not support backup")
+	}
+	if err = backuper.Backup(dir); err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// resolveBackupDir joins dir to the backup root. The dir must be a relative
+// path staying under the root
+func resolveBackupDir(root, dir string) (string, error) {
+	dir = filepath.Clean(dir)
+	if filepath.IsAbs(dir) || dir == "." || dir == ".." || strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+		return "", errors.New(errno.InvalidOptionValue, fmt.Sprintf("the backup dir '%s' is not a relative path under the backup root", dir))
+	}
+	return filepath.Join(root, dir), nil
+}
+
+// handleCompactTable runs the pending compaction and merge jobs of the table
+func (mce *MysqlCmdExecutor) handleCompactTable(ct *tree.CompactTable) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	compactor, ok := ses.Pu.StorageEngine.(engine.Compactor)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, "the storage engine does not support compaction")
+	}
+	dbName := string(ct.Table.SchemaName)
+	if dbName == "" {
+		dbName = proto.GetDatabaseName()
+	}
+	if err := compactor.Compact(dbName, string(ct.Table.ObjectName)); err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// getDefaultValueOfSysVar gets the value for SET var = DEFAULT.
+// The session value is set to the global value, and the global
+// value is set to the compiled-in default value.
+func (mce *MysqlCmdExecutor) getDefaultValueOfSysVar(name string, global bool) (interface{}, error) {
+	def, gVal, ok := gSysVariables.GetGlobalSysVar(name)
+	if !ok {
+		return nil, errorSystemVariableDoesNotExist
+	}
+	if global {
+		return def.GetDefault(), nil
+	}
+	return gVal, nil
+}
+
+/*
+handle "SELECT @@var" for the system variables defined in gSysVarsDefs
+*/
+func (mce *MysqlCmdExecutor) handleSelectSystemVariable(ve *tree.VarExpr) error {
+	var err error = nil
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	def, _, _ := gSysVariables.GetGlobalSysVar(ve.Name)
+	var value interface{}
+	if ve.Global {
+		value, err = ses.GetGlobalVar(ve.Name)
+		if err != nil {
+			return NewMysqlError(ER_INCORRECT_GLOBAL_LOCAL_VAR, ve.Name, "SESSION")
+		}
+	} else {
+		value, err = ses.GetSessionVar(ve.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	col := new(MysqlColumn)
+	col.SetColumnType(def.GetType().MysqlType())
+	if ve.Global {
+		col.SetName("@@global." + def.GetName())
+	} else {
+		col.SetName("@@" + def.GetName())
+	}
+	ses.Mrs.AddColumn(col)
+
+	var data = make([]interface{}, 1)
+	data[0] = value
+	ses.Mrs.AddRow(data)
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return err
+}
+
 /*
 handle show variables
 */
@@ -1231,7 +1475,7 @@ func (mce *MysqlCmdExecutor) handleShowColumns(sc *tree.ShowColumns) error {
 			nameRowID++
 			data[FIELD_POSITION] = attr.Attr.Name
 			typeStr := attr.Attr.Type.String()
-			if attr.Attr.Type.Oid == types.T_varchar {
+			if attr.Attr.Type.Oid == types.T_varchar || attr.Attr.Type.Oid == types.T_binary || attr.Attr.Type.Oid == types.T_varbinary {
 				typeStr += fmt.Sprintf("(%d)", attr.Attr.Type.Width)
 			}
 			data[TYPE_POSITION] = typeStr
@@ -1391,7 +1635,7 @@ func (mce *MysqlCmdExecutor) handleShowCreateTable(sct *tree.ShowCreateTable) er
 				createStr += ",\n"
 			}
 			typeStr := attr.Attr.Type.String()
-			if attr.Attr.Type.Oid == types.T_varchar {
+			if attr.Attr.Type.Oid == types.T_varchar || attr.Attr.Type.Oid == types.T_binary || attr.Attr.Type.Oid == types.T_varbinary {
 				typeStr += fmt.Sprintf("(%d)", attr.Attr.Type.Width)
 			}
 			createStr += fmt.Sprintf("`%s` %s %s", attr.Attr.Name, typeStr, nullOrNot)
@@ -1530,19 +1774,23 @@ func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
 
 func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
 	var err error
+	cwft.ses.profiler.StartPhase("plan")
 	cwft.plan, err = plan2.BuildPlan(cwft.ses.GetTxnCompilerContext(), cwft.stmt)
 	if err != nil {
 		return nil, err
 	}
+	cwft.ses.profiler.EndPhase()
 
 	cwft.proc.UnixTime = time.Now().UnixNano()
 	txnHandler := cwft.ses.GetTxnHandler()
 	cwft.proc.Snapshot = txnHandler.GetTxn().GetCtx()
 	comp := compile2.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), cwft.ses.GetStorage(), cwft.proc)
+	cwft.ses.profiler.StartPhase("compile")
 	err = comp.Compile(cwft.plan, cwft.ses, fill)
 	if err != nil {
 		return nil, err
 	}
+	cwft.ses.profiler.EndPhase()
 	return comp, err
 }
 
@@ -1579,6 +1827,24 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	return cw, nil
 }
 
+// getStatementContext makes the context that bounds the execution of the statement.
+// Only the SELECT statement has the time budget. The optimizer hint
+// /*+ MAX_EXECUTION_TIME(n) */ overrides the max_execution_time of the session.
+func (mce *MysqlCmdExecutor) getStatementContext(stmt tree.Statement, sql string) (context.Context, context.CancelFunc) {
+	var timeout time.Duration
+	if _, ok := stmt.(*tree.Select); ok {
+		if d, ok := GetMaxExecutionTimeHint(sql); ok {
+			timeout = d
+		} else {
+			timeout = mce.GetSession().GetMaxExecutionTime()
+		}
+	}
+	if timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
 //execute query
 func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	ses := mce.GetSession()
@@ -1601,36 +1867,59 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
 	}()
 
+	//the memory of the statement is limited by the query_memory_limit of the session
+	ses.GuestMmu.Limit = ses.GetQueryMemoryLimit()
 	proc := process.New(mheap.New(ses.GuestMmu))
 	proc.Id = mce.getNextProcessId()
-	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	proc.Lim.Size = MinInt64(ses.Pu.SV.GetProcessLimitationSize(), ses.GuestMmu.Limit)
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
 
+	parseBegin := time.Now()
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
 		proto.GetUserName(),
 		ses.Pu.StorageEngine,
 		proc, ses, usePlan2)
+	parseTime := time.Since(parseBegin)
 	if err != nil {
 		return NewMysqlError(ER_PARSE_ERROR, err,
 			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
 	}
+	//the statements of a packet are parsed together, each one is charged an equal share
+	if len(cws) > 1 {
+		parseTime /= time.Duration(len(cws))
+	}
 
 	defer func() {
 		ses.Mrs = nil
 		_ = txnHandler.CleanTxn()
 	}()
 
-	var cmpBegin time.Time
+	var cmpBegin, stmtBegin time.Time
+	var stmtSql string
 	var ret interface{}
 	var runner ComputationRunner
 	var selfHandle = false
 	var txnErr error
+	var cancelStmt context.CancelFunc
 
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		stmtBegin = time.Now()
+		ses.profiler = newPhaseProfiler()
+		ses.profiler.addPhase("parse", parseTime)
+		atomic.StoreInt64(&ses.rowsSent, 0)
+		proc.RowsRead = new(int64)
+		//a SET time_zone applies to the statements after it
+		proc.TimeZone = ses.GetTimeZone()
+		ses.txnCompileCtx.SetTimeZone(proc.TimeZone)
+		//the slow query log shows the statement itself when the sql has several ones
+		stmtSql = sql
+		if len(cws) > 1 {
+			stmtSql = ""
+		}
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
@@ -1703,6 +1992,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 								goto handleFailed
 							}
 
+							//next statement
+							goto handleSucceeded
+						} else if _, _, ok := gSysVariables.GetGlobalSysVar(ve.Name); ok && ve.System {
+							err = mce.handleSelectSystemVariable(ve)
+							if err != nil {
+								goto handleFailed
+							}
+
 							//next statement
 							goto handleSucceeded
 						}
@@ -1717,7 +2014,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar,
+				*tree.Use, *tree.SetVar, *tree.ResetPersist, *tree.BackupDatabase,
 				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
@@ -1729,6 +2026,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 					err = NewMysqlError(ER_NO_DB_ERROR)
 					goto handleFailed
 				}
+			case *tree.CompactTable:
+				if t.Table.SchemaName == "" {
+					err = NewMysqlError(ER_NO_DB_ERROR)
+					goto handleFailed
+				}
 			default:
 				err = NewMysqlError(ER_NO_DB_ERROR)
 				goto handleFailed
@@ -1774,6 +2076,24 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.ResetPersist:
+			selfHandle = true
+			err = mce.handleResetPersist(st)
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.BackupDatabase:
+			selfHandle = true
+			err = mce.handleBackupDatabase(st)
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.CompactTable:
+			selfHandle = true
+			err = mce.handleCompactTable(st)
+			if err != nil {
+				goto handleFailed
+			}
 		case *tree.ShowVariables:
 			selfHandle = true
 			err = mce.handleShowVariables(st)
@@ -1838,6 +2158,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			goto handleFailed
 		}
 
+		//the time budget covers both the compilation and the execution
+		proc.Ctx, cancelStmt = mce.getStatementContext(stmt, sql)
+
 		cmpBegin = time.Now()
 
 		if ret, err = cw.Compile(ses, getDataFromPipeline); err != nil {
@@ -1854,6 +2177,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		//produce result set
 		case *tree.Select,
 			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+			*tree.ShowCreateView,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
 			*tree.ShowIndex,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
@@ -1903,6 +2227,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				Step 2: Start pipeline
 				Producing the data row and sending the data row
 			*/
+			ses.profiler.StartPhase("execute")
 			if ses.ep.Outfile {
 				ses.ep.DefaultBufSize = ses.Pu.SV.GetExportDataDefaultFlushSize()
 				initExportFileParam(ses.ep, ses.Mrs)
@@ -1921,6 +2246,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 					goto handleFailed
 				}
 			}
+			ses.profiler.EndPhase()
 
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
@@ -1937,6 +2263,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateView, *tree.DropView,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1951,9 +2278,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			/*
 				Step 1: Start
 			*/
+			ses.profiler.StartPhase("execute")
 			if err = runner.Run(epoch); err != nil {
 				goto handleFailed
 			}
+			ses.profiler.EndPhase()
 
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
@@ -1961,7 +2290,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 
 			//record ddl drop xxx after the success
 			switch stmt.(type) {
-			case *tree.DropTable, *tree.DropDatabase,
+			case *tree.DropTable, *tree.DropDatabase, *tree.DropView,
 				*tree.DropIndex, *tree.DropUser, *tree.DropRole:
 				//test ddl
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
@@ -1987,12 +2316,30 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		}
 	handleSucceeded:
+		if cancelStmt != nil {
+			cancelStmt()
+			cancelStmt = nil
+		}
+		ses.profiler.EndPhase()
 		txnErr = txnHandler.CommitAfterAutocommitOnly()
+		observeStatement(stmt, stmtBegin, txnErr)
+		mce.logSlowQuery(cw, stmtSql, stmtBegin, proc.RowsRead, txnErr)
 		if txnErr != nil {
 			return txnErr
 		}
 		goto handleNext
 	handleFailed:
+		if cancelStmt != nil {
+			cancelStmt()
+			cancelStmt = nil
+		}
+		//the phase interrupted by the error still counts
+		ses.profiler.EndPhase()
+		if goErrors.Is(err, context.DeadlineExceeded) {
+			err = NewMysqlError(ER_QUERY_TIMEOUT)
+		}
+		observeStatement(stmt, stmtBegin, err)
+		mce.logSlowQuery(cw, stmtSql, stmtBegin, proc.RowsRead, err)
 		txnErr = txnHandler.RollbackAfterAutocommitOnly()
 		if txnErr != nil {
 			return txnErr
@@ -2004,6 +2351,70 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	return nil
 }
 
+// statementType returns the name of the type of the statement, e.g. Select
+func statementType(stmt tree.Statement) string {
+	typ := reflect.TypeOf(stmt)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
+
+// observeStatement records the status and the latency of the statement
+func observeStatement(stmt tree.Statement, begin time.Time, err error) {
+	typ := statementType(stmt)
+	status := metric.StatusOK
+	if err != nil {
+		status = metric.StatusError
+	}
+	metric.Queries.WithLabelValues(typ, status).Inc()
+	metric.ObserveSince(metric.QueryDuration.WithLabelValues(typ), begin)
+}
+
+// logSlowQuery records the statement in the slow query log if it runs longer
+// than the long_query_time of the session. The statement is formatted from its
+// ast when sql is empty.
+func (mce *MysqlCmdExecutor) logSlowQuery(cw ComputationWrapper, sql string, begin time.Time, rowsRead *int64, err error) {
+	ses := mce.GetSession()
+	duration := time.Since(begin)
+	if duration < ses.GetLongQueryTime() {
+		return
+	}
+	if sql == "" {
+		sql = tree.String(cw.GetAst(), dialect.MYSQL)
+	}
+	sq := &slowQuery{
+		begin:        begin,
+		user:         ses.GetUserName(),
+		db:           ses.GetDatabaseName(),
+		connectionID: ses.protocol.ConnectionID(),
+		duration:     duration,
+		rowsSent:     atomic.LoadInt64(&ses.rowsSent),
+		phases:       ses.profiler.ToString(),
+		sql:          sql,
+		err:          err,
+	}
+	if rowsRead != nil {
+		sq.rowsExamined = atomic.LoadInt64(rowsRead)
+	}
+	if tcw, ok := cw.(*TxnComputationWrapper); ok {
+		sq.plan = explainPlan(tcw.plan)
+	}
+	getSlowQueryLog(ses.Pu.SV.GetSlowQueryLogFile()).write(sq)
+}
+
+// explainPlan returns the EXPLAIN text of the query plan
+func explainPlan(p *plan2.Plan) []string {
+	if p == nil || p.GetQuery() == nil {
+		return nil
+	}
+	buffer := explain.NewExplainDataBuffer()
+	if err := explain.NewExplainQueryImpl(p.GetQuery()).ExplainPlan(buffer, explain.NewExplainDefaultOptions()); err != nil {
+		return nil
+	}
+	return buffer.Lines
+}
+
 func (mce *MysqlCmdExecutor) handleDDl(ses *Session, stmt tree.Statement, epoch uint64) error {
 	txnHandler := ses.GetTxnHandler()
 	switch ddl := stmt.(type) {
@@ -2196,8 +2607,26 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_text:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG))
+	case types.T_binary:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetBinary()
+	case types.T_varbinary:
+		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+		col.SetBinary()
+	case types.T_blob:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG))
+		col.SetBinary()
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG))
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
 	case types.T_timestamp:
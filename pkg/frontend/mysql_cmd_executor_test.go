@@ -1,8 +1,10 @@
 package frontend
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
@@ -15,6 +17,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -640,7 +643,7 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleCmdFieldList("A")
 		convey.So(err, convey.ShouldBeNil)
 
-		err = mce.handleSetVar(nil)
+		err = mce.handleSetVar(&tree.SetVar{})
 		convey.So(err, convey.ShouldBeNil)
 
 		req := &Request{
@@ -813,11 +816,17 @@ func Test_typeconvert(t *testing.T) {
 			types.T_varchar,
 			types.T_date,
 			types.T_datetime,
+			types.T_time,
+			types.T_text,
+			types.T_binary,
+			types.T_varbinary,
+			types.T_blob,
 		}
 
 		type kase struct {
 			tp     uint8
 			signed bool
+			binary bool
 		}
 		output := []kase{
 			{tp: defines.MYSQL_TYPE_TINY, signed: true},
@@ -834,6 +843,11 @@ func Test_typeconvert(t *testing.T) {
 			{tp: defines.MYSQL_TYPE_VARCHAR, signed: true},
 			{tp: defines.MYSQL_TYPE_DATE, signed: true},
 			{tp: defines.MYSQL_TYPE_DATETIME, signed: true},
+			{tp: defines.MYSQL_TYPE_TIME, signed: true},
+			{tp: defines.MYSQL_TYPE_BLOB, signed: true},
+			{tp: defines.MYSQL_TYPE_STRING, signed: true, binary: true},
+			{tp: defines.MYSQL_TYPE_VAR_STRING, signed: true, binary: true},
+			{tp: defines.MYSQL_TYPE_BLOB, signed: true, binary: true},
 		}
 
 		convey.So(len(input), convey.ShouldEqual, len(output))
@@ -845,6 +859,7 @@ func Test_typeconvert(t *testing.T) {
 			convey.So(col.columnType, convey.ShouldEqual, output[i].tp)
 			convey.So(col.IsSigned() && output[i].signed ||
 				!col.IsSigned() && !output[i].signed, convey.ShouldBeTrue)
+			convey.So(col.IsBinary(), convey.ShouldEqual, output[i].binary)
 		}
 	})
 }
@@ -942,6 +957,163 @@ func Test_handleSelectVariables(t *testing.T) {
 	})
 }
 
+func Test_handleSetVar(t *testing.T) {
+	convey.Convey("handleSetVar succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any(), nil).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu, sysVars: gSysVariables.CopySysVarsToSession()}
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		setVar := func(sql string) error {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			return mce.handleSetVar(stmt.(*tree.SetVar))
+		}
+
+		convey.So(setVar("set max_execution_time = 100, query_memory_limit = 1024"), convey.ShouldBeNil)
+		convey.So(ses.GetMaxExecutionTime(), convey.ShouldEqual, 100*time.Millisecond)
+		convey.So(ses.GetQueryMemoryLimit(), convey.ShouldEqual, 1024)
+
+		convey.So(setVar("set max_execution_time = default"), convey.ShouldBeNil)
+		convey.So(ses.GetMaxExecutionTime(), convey.ShouldEqual, 0)
+
+		convey.So(ses.GetLongQueryTime(), convey.ShouldEqual, 10*time.Second)
+		convey.So(setVar("set long_query_time = 0.5"), convey.ShouldBeNil)
+		convey.So(ses.GetLongQueryTime(), convey.ShouldEqual, 500*time.Millisecond)
+
+		convey.So(setVar("set query_memory_limit = 0"), convey.ShouldBeNil)
+		convey.So(ses.GetQueryMemoryLimit(), convey.ShouldEqual, pu.SV.GetGuestMmuLimitation())
+
+		//the unsupported variables are ignored
+		convey.So(setVar("set autocommit = 1"), convey.ShouldBeNil)
+
+		err = setVar("set max_execution_time = -1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_WRONG_VALUE_FOR_VAR)
+
+		err = setVar("set max_execution_time = 'abc'")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_WRONG_VALUE_FOR_VAR)
+
+		//the value is checked before it is persisted
+		err = setVar("set persist max_execution_time = -1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_WRONG_VALUE_FOR_VAR)
+		err = setVar("set persist_only time_zone = 'Mars/Olympus'")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNKNOWN_TIME_ZONE)
+
+		ve := &tree.VarExpr{Name: "max_execution_time", System: true}
+		convey.So(mce.handleSelectSystemVariable(ve), convey.ShouldBeNil)
+
+		convey.So(ses.GetTimeZone(), convey.ShouldEqual, time.Local)
+		convey.So(setVar("set time_zone = '+08:00'"), convey.ShouldBeNil)
+		_, offset := time.Date(2022, 1, 1, 0, 0, 0, 0, ses.GetTimeZone()).Zone()
+		convey.So(offset, convey.ShouldEqual, 8*3600)
+		convey.So(setVar("set time_zone = 'Asia/Shanghai'"), convey.ShouldBeNil)
+		convey.So(ses.GetTimeZone().String(), convey.ShouldEqual, "Asia/Shanghai")
+
+		err = setVar("set time_zone = 'Mars/Olympus'")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNKNOWN_TIME_ZONE)
+
+		ses.Mrs = &MysqlResultSet{}
+		ve = &tree.VarExpr{Name: "time_zone", System: true}
+		convey.So(mce.handleSelectSystemVariable(ve), convey.ShouldBeNil)
+	})
+}
+
+func Test_handleBackupDatabase(t *testing.T) {
+	convey.Convey("handleBackupDatabase", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		proto.SetUserName("dump")
+		err = mce.handleBackupDatabase(&tree.BackupDatabase{Dir: "b1"})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_SPECIFIC_ACCESS_DENIED_ERROR)
+
+		proto.SetUserName(rootUserName)
+		convey.So(mce.handleBackupDatabase(&tree.BackupDatabase{Dir: "../b1"}), convey.ShouldNotBeNil)
+		//the mock engine does not support backup
+		convey.So(mce.handleBackupDatabase(&tree.BackupDatabase{Dir: "b1"}), convey.ShouldNotBeNil)
+	})
+}
+
+func Test_resolveBackupDir(t *testing.T) {
+	convey.Convey("resolveBackupDir", t, func() {
+		dir, err := resolveBackupDir("/backup", "b1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(dir, convey.ShouldEqual, "/backup/b1")
+		dir, err = resolveBackupDir("/backup", "b1/../b2/")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(dir, convey.ShouldEqual, "/backup/b2")
+
+		for _, bad := range []string{"", ".", "..", "../b1", "b1/../../b2", "/tmp/b1"} {
+			_, err = resolveBackupDir("/backup", bad)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+	})
+}
+
+func Test_getStatementContext(t *testing.T) {
+	convey.Convey("getStatementContext succ", t, func() {
+		ses := &Session{sysVars: gSysVariables.CopySysVarsToSession()}
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		sql := "select /*+ MAX_EXECUTION_TIME(10) */ 1"
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		ctx, cancel := mce.getStatementContext(stmt, sql)
+		<-ctx.Done()
+		convey.So(ctx.Err() == context.DeadlineExceeded, convey.ShouldBeTrue)
+		cancel()
+
+		sql = "select 1"
+		stmt, err = parsers.ParseOne(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		ctx, cancel = mce.getStatementContext(stmt, sql)
+		_, ok := ctx.Deadline()
+		convey.So(ok, convey.ShouldBeFalse)
+		cancel()
+
+		convey.So(ses.SetSessionVar("max_execution_time", 10), convey.ShouldBeNil)
+		ctx, cancel = mce.getStatementContext(stmt, sql)
+		_, ok = ctx.Deadline()
+		convey.So(ok, convey.ShouldBeTrue)
+		cancel()
+	})
+}
+
 func Test_handleShowVariables(t *testing.T) {
 	convey.Convey("handleShowVariables succ", t, func() {
 		ctrl := gomock.NewController(t)
@@ -19,6 +19,9 @@ import (
 	"strings"
 )
 
+//the collation id of the binary character set, used by binary string columns
+const binaryCollationID = 63
+
 //tuple (collation name, charset)
 type charsetCollationName struct {
 	charset       string
@@ -0,0 +1,176 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+// getMoGlobalVariables gets the table mo_global_variables in the transaction
+func getMoGlobalVariables(eng engine.Engine, snapshot engine.Snapshot) (moengine.Relation, error) {
+	db, err := eng.Database("mo_catalog", snapshot)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(DefineSchemaForMoGlobalVariables().GetName(), snapshot)
+	if err != nil {
+		return nil, err
+	}
+	taeRel, ok := rel.(moengine.Relation)
+	if !ok {
+		return nil, errorIsNotTaeEngine
+	}
+	return taeRel, nil
+}
+
+// readPersistedSysVars gets the rows of mo_global_variables as name -> value
+func readPersistedSysVars(rel engine.Relation, snapshot engine.Snapshot) (map[string]string, error) {
+	attrs := []string{"gv_variable_name", "gv_variable_value"}
+	cs := make([]uint64, len(attrs))
+	vars := make(map[string]string)
+	for _, rd := range rel.NewReader(1, nil, nil, snapshot) {
+		for {
+			bat, err := rd.Read(cs, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			names := bat.Vecs[0].Col.(*types.Bytes)
+			values := bat.Vecs[1].Col.(*types.Bytes)
+			for i := range names.Lengths {
+				vars[string(names.Get(int64(i)))] = string(values.Get(int64(i)))
+			}
+		}
+	}
+	return vars, nil
+}
+
+// persistSysVar writes the value of the system variable into mo_global_variables.
+// The old value is replaced.
+func persistSysVar(eng engine.Engine, snapshot engine.Snapshot, name string, value interface{}) error {
+	rel, err := getMoGlobalVariables(eng, snapshot)
+	if err != nil {
+		return err
+	}
+	vars, err := readPersistedSysVars(rel, snapshot)
+	if err != nil {
+		return err
+	}
+	if _, ok := vars[name]; ok {
+		if err = rel.DeleteByPrimaryKey([]byte(name)); err != nil {
+			return err
+		}
+	}
+	bat := PrepareInitialDataForSchema(DefineSchemaForMoGlobalVariables(), [][]string{{name, fmt.Sprint(value)}})
+	return rel.Write(0, bat, snapshot)
+}
+
+// removePersistedSysVars removes the persisted value of the system variable from mo_global_variables,
+// or those of all the system variables when the name is empty.
+// It returns false if nothing has been removed.
+func removePersistedSysVars(eng engine.Engine, snapshot engine.Snapshot, name string) (bool, error) {
+	rel, err := getMoGlobalVariables(eng, snapshot)
+	if err != nil {
+		return false, err
+	}
+	vars, err := readPersistedSysVars(rel, snapshot)
+	if err != nil {
+		return false, err
+	}
+	removed := false
+	for persisted := range vars {
+		//the rows of the server settings written by InitDB are kept
+		if _, ok := gSysVarsDefs[persisted]; !ok {
+			continue
+		}
+		if name != "" && name != persisted {
+			continue
+		}
+		if err = rel.DeleteByPrimaryKey([]byte(persisted)); err != nil {
+			return false, err
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
+// parseSysVarValue parses the persisted text of the value of the system variable
+func parseSysVarValue(def SystemVariable, value string) (interface{}, error) {
+	switch def.GetType().Type() {
+	case types.T_bool, types.T_int64:
+		return strconv.ParseInt(value, 10, 64)
+	case types.T_uint64:
+		return strconv.ParseUint(value, 10, 64)
+	case types.T_float64:
+		return strconv.ParseFloat(value, 64)
+	}
+	return value, nil
+}
+
+// loadPersistedSysVars sets the global values of the system variables
+// persisted in mo_global_variables. They override the default values.
+func loadPersistedSysVars(tae engine.Engine, gsv *GlobalSystemVariables) error {
+	taeEngine, ok := tae.(moengine.TxnEngine)
+	if !ok {
+		return errorIsNotTaeEngine
+	}
+
+	txnCtx, err := taeEngine.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	var vars map[string]string
+	rel, err := getMoGlobalVariables(tae, txnCtx.GetCtx())
+	if err == nil {
+		vars, err = readPersistedSysVars(rel, txnCtx.GetCtx())
+	}
+	if err != nil {
+		logutil.Infof("read table mo_global_variables failed.error:%v", err)
+		err2 := txnCtx.Rollback()
+		if err2 != nil {
+			logutil.Infof("txnCtx rollback failed. error:%v", err2)
+			return err2
+		}
+		return err
+	}
+	err = txnCtx.Commit()
+	if err != nil {
+		logutil.Infof("txnCtx commit failed.error:%v", err)
+		return err
+	}
+
+	for name, value := range vars {
+		def, ok := gSysVarsDefs[name]
+		if !ok {
+			continue
+		}
+		v, err := parseSysVarValue(def, value)
+		if err == nil {
+			err = gsv.SetGlobalSysVar(name, v)
+		}
+		if err != nil {
+			logutil.Infof("ignore the persisted system variable %s = %s. error:%v", name, value, err)
+		}
+	}
+	return nil
+}
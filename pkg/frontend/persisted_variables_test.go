@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestPersistedSysVars(t *testing.T) {
+	convey.Convey("persist, load and reset", t, func() {
+		dir := testutils.InitTestEnv("frontend", t)
+		tae, err := db.Open(dir, nil)
+		convey.So(err, convey.ShouldBeNil)
+		defer tae.Close()
+		eng := moengine.NewEngine(tae)
+		convey.So(InitDB(eng), convey.ShouldBeNil)
+
+		txn, err := eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(persistSysVar(eng, txn.GetCtx(), "max_execution_time", int64(100)), convey.ShouldBeNil)
+		convey.So(persistSysVar(eng, txn.GetCtx(), "long_query_time", float64(2.5)), convey.ShouldBeNil)
+		convey.So(txn.Commit(), convey.ShouldBeNil)
+
+		//the old value is replaced
+		txn, err = eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(persistSysVar(eng, txn.GetCtx(), "max_execution_time", int64(200)), convey.ShouldBeNil)
+		convey.So(txn.Commit(), convey.ShouldBeNil)
+
+		gsv := &GlobalSystemVariables{sysVars: make(map[string]interface{})}
+		InitGlobalSystemVariables(gsv)
+		convey.So(loadPersistedSysVars(eng, gsv), convey.ShouldBeNil)
+		_, v, _ := gsv.GetGlobalSysVar("max_execution_time")
+		convey.So(v, convey.ShouldEqual, int64(200))
+		_, v, _ = gsv.GetGlobalSysVar("long_query_time")
+		convey.So(v, convey.ShouldEqual, float64(2.5))
+		_, v, _ = gsv.GetGlobalSysVar("time_zone")
+		convey.So(v, convey.ShouldEqual, "SYSTEM")
+
+		txn, err = eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		removed, err := removePersistedSysVars(eng, txn.GetCtx(), "long_query_time")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(removed, convey.ShouldBeTrue)
+		removed, err = removePersistedSysVars(eng, txn.GetCtx(), "time_zone")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(removed, convey.ShouldBeFalse)
+		removed, err = removePersistedSysVars(eng, txn.GetCtx(), "")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(removed, convey.ShouldBeTrue)
+		convey.So(txn.Commit(), convey.ShouldBeNil)
+
+		//the server settings are kept by RESET PERSIST
+		txn, err = eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		rel, err := getMoGlobalVariables(eng, txn.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		vars, err := readPersistedSysVars(rel, txn.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vars, convey.ShouldNotContainKey, "max_execution_time")
+		convey.So(vars, convey.ShouldNotContainKey, "long_query_time")
+		convey.So(vars, convey.ShouldContainKey, "port")
+		convey.So(txn.Commit(), convey.ShouldBeNil)
+
+		//InitDB passes on the initialized catalog
+		convey.So(InitDB(eng), convey.ShouldBeNil)
+	})
+}
@@ -14,6 +14,12 @@
 
 package frontend
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 /**
 phase statistics
 */
@@ -33,6 +39,60 @@ type PhaseProfiler interface {
 	ToString() string
 }
 
+var _ PhaseProfiler = &phaseProfiler{}
+
+type phase struct {
+	name     string
+	duration time.Duration
+}
+
+// phaseProfiler records the durations of the phases of a statement.
+// The methods do nothing on the nil profiler.
+type phaseProfiler struct {
+	phases []phase
+	name   string
+	begin  time.Time
+}
+
+func newPhaseProfiler() *phaseProfiler {
+	return &phaseProfiler{}
+}
+
+func (pp *phaseProfiler) StartPhase(name string) {
+	if pp == nil {
+		return
+	}
+	pp.name = name
+	pp.begin = time.Now()
+}
+
+func (pp *phaseProfiler) EndPhase() {
+	if pp == nil || pp.name == "" {
+		return
+	}
+	pp.addPhase(pp.name, time.Since(pp.begin))
+	pp.name = ""
+}
+
+// addPhase records a phase measured outside the profiler
+func (pp *phaseProfiler) addPhase(name string, d time.Duration) {
+	if pp == nil {
+		return
+	}
+	pp.phases = append(pp.phases, phase{name: name, duration: d})
+}
+
+func (pp *phaseProfiler) ToString() string {
+	if pp == nil {
+		return ""
+	}
+	parts := make([]string, len(pp.phases))
+	for i, p := range pp.phases {
+		parts[i] = fmt.Sprintf("%s: %s", p.name, p.duration)
+	}
+	return strings.Join(parts, ", ")
+}
+
 //OperatorProfiler : operator statistics
 type OperatorProfiler interface {
 	//start the statistics for the operator
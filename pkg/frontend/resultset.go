@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 )
 
@@ -206,6 +207,16 @@ func (mc *MysqlColumn) IsSigned() bool {
 	return mc.flag&uint16(defines.UNSIGNED_FLAG) == 0
 }
 
+// SetBinary marks the column as a binary string with the binary character set
+func (mc *MysqlColumn) SetBinary() {
+	mc.flag = mc.flag | uint16(defines.BINARY_FLAG)
+	mc.charset = binaryCollationID
+}
+
+func (mc *MysqlColumn) IsBinary() bool {
+	return mc.flag&uint16(defines.BINARY_FLAG) != 0
+}
+
 type MysqlResultSet struct {
 	//column information
 	Columns []Column
@@ -480,6 +491,8 @@ func (mrs *MysqlResultSet) GetString(rindex, cindex uint64) (string, error) {
 		return strconv.FormatInt(int64(v), 10), nil
 	case uint:
 		return strconv.FormatUint(uint64(v), 10), nil
+	case bytejson.ByteJson:
+		return v.String(), nil
 	default:
 		return "", fmt.Errorf("unsupported type %d ", v)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 )
 
 type RoutineManager struct {
@@ -59,6 +60,8 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	defer rm.rwlock.Unlock()
 
 	rm.clients[rs] = routine
+	metric.ConnectionsTotal.Inc()
+	metric.Connections.Inc()
 }
 
 /*
@@ -75,6 +78,7 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 	}
 	logutil.Infof("will close iosession")
 	rt.Quit()
+	metric.Connections.Dec()
 }
 
 /*
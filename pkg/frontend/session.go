@@ -18,6 +18,7 @@ import (
 	goErrors "errors"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -26,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"time"
 )
 
 var (
@@ -131,6 +133,8 @@ type TxnHandler struct {
 	storage  engine.Engine
 	taeTxn   moengine.Txn
 	txnState *TxnState
+	// afterCommit are called once the txn has been committed
+	afterCommit []func()
 }
 
 func InitTxnHandler(storage engine.Engine) *TxnHandler {
@@ -166,6 +170,13 @@ type Session struct {
 	txnCompileCtx *TxnCompilerContext
 	storage       engine.Engine
 	sql           string
+
+	//the values of the system variables in the session
+	sysVars map[string]interface{}
+
+	//the phases and the rows sent of the running statement
+	profiler *phaseProfiler
+	rowsSent int64
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit) *Session {
@@ -185,6 +196,7 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempo
 		//TODO:fix database name after the catalog is ready
 		txnCompileCtx: InitTxnCompilerContext(txnHandler, proto.GetDatabaseName()),
 		storage:       config.StorageEngine,
+		sysVars:       gSysVariables.CopySysVarsToSession(),
 	}
 }
 
@@ -230,6 +242,99 @@ func (ses *Session) GetUserName() string {
 	return ses.protocol.GetUserName()
 }
 
+// SetGlobalVar sets the value of system variable in global.
+// It does not change the value of the variable in the existing sessions.
+func (ses *Session) SetGlobalVar(name string, value interface{}) error {
+	return gSysVariables.SetGlobalSysVar(name, value)
+}
+
+// GetGlobalVar gets the value of system variable in global
+func (ses *Session) GetGlobalVar(name string) (interface{}, error) {
+	def, val, ok := gSysVariables.GetGlobalSysVar(name)
+	if !ok {
+		return nil, errorSystemVariableDoesNotExist
+	}
+	if def.GetScope() == ScopeSession {
+		return nil, errorSystemVariableIsSession
+	}
+	return val, nil
+}
+
+// SetSessionVar sets the value of system variable in session
+func (ses *Session) SetSessionVar(name string, value interface{}) error {
+	def, _, ok := gSysVariables.GetGlobalSysVar(name)
+	if !ok {
+		return errorSystemVariableDoesNotExist
+	}
+	if def.GetScope() == ScopeGlobal {
+		return errorSystemVariableIsGlobal
+	}
+	if !def.GetDynamic() {
+		return errorSystemVariableIsReadOnly
+	}
+	val, err := def.GetType().Convert(value)
+	if err != nil {
+		return err
+	}
+	ses.sysVars[def.GetName()] = val
+	return nil
+}
+
+// GetSessionVar gets the value of system variable in session
+func (ses *Session) GetSessionVar(name string) (interface{}, error) {
+	def, gVal, ok := gSysVariables.GetGlobalSysVar(name)
+	if !ok {
+		return nil, errorSystemVariableDoesNotExist
+	}
+	if def.GetScope() == ScopeGlobal {
+		return gVal, nil
+	}
+	return ses.sysVars[def.GetName()], nil
+}
+
+// GetMaxExecutionTime gets the execution timeout of the statement in the session.
+// 0 denotes no timeout.
+func (ses *Session) GetMaxExecutionTime() time.Duration {
+	val, err := ses.GetSessionVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	return time.Duration(val.(int64)) * time.Millisecond
+}
+
+// GetQueryMemoryLimit gets the maximum memory that a statement can use in the session.
+// The guest mmu limitation of the server is used when the variable is 0.
+func (ses *Session) GetQueryMemoryLimit() int64 {
+	val, err := ses.GetSessionVar("query_memory_limit")
+	if err != nil || val.(int64) == 0 {
+		return ses.Pu.SV.GetGuestMmuLimitation()
+	}
+	return val.(int64)
+}
+
+// GetTimeZone gets the time zone of the session.
+// The local time zone of the server is used when the variable is SYSTEM.
+func (ses *Session) GetTimeZone() *time.Location {
+	val, err := ses.GetSessionVar("time_zone")
+	if err != nil {
+		return time.Local
+	}
+	loc, err := types.ParseTimeZone(val.(string))
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// GetLongQueryTime gets the threshold of the slow query log in the session.
+func (ses *Session) GetLongQueryTime() time.Duration {
+	val, err := ses.GetSessionVar("long_query_time")
+	if err != nil {
+		return 10 * time.Second
+	}
+	return time.Duration(val.(float64) * float64(time.Second))
+}
+
 func (th *TxnHandler) GetStorage() engine.Engine {
 	return th.storage
 }
@@ -373,13 +478,23 @@ func (th *TxnHandler) commit(option int) error {
 	if switchTxnState {
 		if err == nil {
 			th.txnState.switchToState(TxnEnd, err)
+			for _, f := range th.afterCommit {
+				f()
+			}
 		} else {
 			th.txnState.switchToState(TxnErr, err)
 		}
+		th.afterCommit = nil
 	}
 	return err
 }
 
+// AddAfterCommit registers f to be called after the current txn has been committed.
+// f is dropped if the txn is rolled back or fails to commit.
+func (th *TxnHandler) AddAfterCommit(f func()) {
+	th.afterCommit = append(th.afterCommit, f)
+}
+
 // CommitAfterBegin commits the tae txn started by the BEGIN statement
 func (th *TxnHandler) CommitAfterBegin() error {
 	logutil.Infof("commit began")
@@ -440,6 +555,7 @@ func (th *TxnHandler) rollback(option int) error {
 		} else {
 			th.txnState.switchToState(TxnErr, err)
 		}
+		th.afterCommit = nil
 	}
 
 	return err
@@ -467,10 +583,12 @@ func (th *TxnHandler) CleanTxn() error {
 	case TxnInit, TxnEnd:
 		th.taeTxn = InitTaeTxnImpl()
 		th.txnState.switchToState(TxnInit, nil)
+		th.afterCommit = nil
 	case TxnErr:
 		logutil.Errorf("clean txn. Get error:%v txnError:%v", th.txnState.getError(), th.taeTxn.GetError())
 		th.taeTxn = InitTaeTxnImpl()
 		th.txnState.switchToState(TxnInit, nil)
+		th.afterCommit = nil
 	}
 	return nil
 }
@@ -480,6 +598,8 @@ var _ plan2.CompilerContext = &TxnCompilerContext{}
 type TxnCompilerContext struct {
 	dbName     string
 	txnHandler *TxnHandler
+	// loc is the time zone of the session
+	loc *time.Location
 }
 
 func InitTxnCompilerContext(txn *TxnHandler, db string) *TxnCompilerContext {
@@ -497,6 +617,17 @@ func (tcc *TxnCompilerContext) DefaultDatabase() string {
 	return tcc.dbName
 }
 
+func (tcc *TxnCompilerContext) SetTimeZone(loc *time.Location) {
+	tcc.loc = loc
+}
+
+func (tcc *TxnCompilerContext) TimeZone() *time.Location {
+	if tcc.loc == nil {
+		return time.Local
+	}
+	return tcc.loc
+}
+
 func (tcc *TxnCompilerContext) DatabaseExists(name string) bool {
 	var err error
 	//open database
@@ -534,7 +665,33 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	engineDefs := table.TableDefs(tcc.txnHandler.GetTxn().GetCtx())
 
 	var defs []*plan2.ColDef
+	var defTypes []*plan.TableDef_DefType
 	for _, def := range engineDefs {
+		if view, ok := def.(*engine.ViewDef); ok {
+			defTypes = append(defTypes, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_View{
+					View: &plan.ViewDef{
+						View: view.View,
+					},
+				},
+			})
+		}
+		if props, ok := def.(*engine.PropertiesDef); ok {
+			properties := make([]*plan.Property, len(props.Properties))
+			for i, p := range props.Properties {
+				properties[i] = &plan.Property{
+					Key:   p.Key,
+					Value: p.Value,
+				}
+			}
+			defTypes = append(defTypes, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: properties,
+					},
+				},
+			})
+		}
 		if attr, ok := def.(*engine.AttributeDef); ok {
 			defs = append(defs, &plan2.ColDef{
 				Name: attr.Attr.Name,
@@ -557,6 +714,7 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	tableDef := &plan2.TableDef{
 		Name: tableName,
 		Cols: defs,
+		Defs: defTypes,
 	}
 	return obj, tableDef
 }
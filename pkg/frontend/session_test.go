@@ -143,6 +143,44 @@ func TestTxnHandler(t *testing.T) {
 		convey.So(txn.getTxnState(), convey.ShouldEqual, TxnInit)
 	})
 
+	convey.Convey("tae after commit", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tae := mock_frontend.NewMockTxnEngine(ctrl)
+		txn := InitTxnHandler(tae)
+		txnImpl := mock_frontend.NewMockTxn(ctrl)
+		txnImpl.EXPECT().GetError().Return(nil).AnyTimes()
+		gomock.InOrder(
+			txnImpl.EXPECT().Commit().Return(nil),
+			txnImpl.EXPECT().Commit().Return(errors.New("commit failed")),
+		)
+		txnImpl.EXPECT().Rollback().Return(nil).AnyTimes()
+		tae.EXPECT().StartTxn(gomock.Any()).Return(txnImpl, nil).AnyTimes()
+
+		called := 0
+		after := func() { called++ }
+
+		//the statements of the txn started by BEGIN do not commit it
+		convey.So(txn.StartByBegin(), convey.ShouldBeNil)
+		txn.AddAfterCommit(after)
+		convey.So(txn.CommitAfterAutocommitOnly(), convey.ShouldBeNil)
+		convey.So(called, convey.ShouldEqual, 0)
+		convey.So(txn.CommitAfterBegin(), convey.ShouldBeNil)
+		convey.So(called, convey.ShouldEqual, 1)
+
+		convey.So(txn.StartByAutocommit(), convey.ShouldBeNil)
+		txn.AddAfterCommit(after)
+		convey.So(txn.RollbackAfterAutocommitOnly(), convey.ShouldBeNil)
+		convey.So(called, convey.ShouldEqual, 1)
+
+		convey.So(txn.StartByAutocommit(), convey.ShouldBeNil)
+		txn.AddAfterCommit(after)
+		convey.So(txn.CommitAfterAutocommitOnly(), convey.ShouldNotBeNil)
+		convey.So(called, convey.ShouldEqual, 1)
+		convey.So(txn.CleanTxn(), convey.ShouldBeNil)
+	})
+
 	convey.Convey("tae begin ... clean ... clean", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,104 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// slowQuery is a statement running longer than the long_query_time of the session
+type slowQuery struct {
+	begin        time.Time
+	user         string
+	db           string
+	connectionID uint32
+	duration     time.Duration
+	rowsExamined int64
+	rowsSent     int64
+	// the durations of the phases from the PhaseProfiler
+	phases string
+	// the EXPLAIN text of the plan
+	plan []string
+	sql  string
+	err  error
+}
+
+// String formats the slow query like the slow query log of MySQL,
+// the lines of the header start with #
+func (sq *slowQuery) String() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# Time: %s\n", sq.begin.Format(time.RFC3339Nano))
+	fmt.Fprintf(&buf, "# User: %s  Db: %s  Id: %d\n", sq.user, sq.db, sq.connectionID)
+	fmt.Fprintf(&buf, "# Query_time: %f  Rows_examined: %d  Rows_sent: %d\n",
+		sq.duration.Seconds(), sq.rowsExamined, sq.rowsSent)
+	fmt.Fprintf(&buf, "# Phases: %s\n", sq.phases)
+	if sq.err != nil {
+		fmt.Fprintf(&buf, "# Error: %v\n", sq.err)
+	}
+	if len(sq.plan) > 0 {
+		buf.WriteString("# Plan:\n")
+		for _, line := range sq.plan {
+			fmt.Fprintf(&buf, "#   %s\n", line)
+		}
+	}
+	buf.WriteString(strings.TrimRight(sq.sql, "; \t\n"))
+	buf.WriteString(";\n")
+	return buf.String()
+}
+
+// slowQueryLog writes the slow queries into the slowQueryLogFile of the server,
+// or into the server log when the file is not set
+type slowQueryLog struct {
+	sync.Mutex
+	w io.Writer
+}
+
+var gSlowQueryLog struct {
+	once sync.Once
+	log  *slowQueryLog
+}
+
+func getSlowQueryLog(file string) *slowQueryLog {
+	gSlowQueryLog.once.Do(func() {
+		gSlowQueryLog.log = &slowQueryLog{}
+		if file != "" {
+			gSlowQueryLog.log.w = &lumberjack.Logger{
+				Filename:  file,
+				MaxSize:   512,
+				LocalTime: true,
+			}
+		}
+	})
+	return gSlowQueryLog.log
+}
+
+func (sl *slowQueryLog) write(sq *slowQuery) {
+	if sl.w == nil {
+		logutil.Warnf("slow query\n%s", sq.String())
+		return
+	}
+	sl.Lock()
+	defer sl.Unlock()
+	if _, err := io.WriteString(sl.w, sq.String()); err != nil {
+		logutil.Errorf("write slow query log failed. error:%v", err)
+	}
+}
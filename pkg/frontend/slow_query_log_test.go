@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_phaseProfiler(t *testing.T) {
+	convey.Convey("phaseProfiler succ", t, func() {
+		pp := newPhaseProfiler()
+		pp.addPhase("parse", time.Millisecond)
+		pp.StartPhase("plan")
+		pp.EndPhase()
+		//EndPhase without StartPhase is ignored
+		pp.EndPhase()
+		convey.So(len(pp.phases), convey.ShouldEqual, 2)
+		convey.So(pp.phases[1].name, convey.ShouldEqual, "plan")
+		convey.So(pp.ToString(), convey.ShouldStartWith, "parse: 1ms, plan: ")
+
+		//the nil profiler does nothing
+		var np *phaseProfiler
+		np.StartPhase("plan")
+		np.EndPhase()
+		convey.So(np.ToString(), convey.ShouldEqual, "")
+	})
+}
+
+func Test_slowQueryLog(t *testing.T) {
+	convey.Convey("slowQueryLog succ", t, func() {
+		var buf bytes.Buffer
+		sl := &slowQueryLog{w: &buf}
+		sl.write(&slowQuery{
+			begin:        time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC),
+			user:         "root",
+			db:           "tpch",
+			connectionID: 7,
+			duration:     1500 * time.Millisecond,
+			rowsExamined: 100,
+			rowsSent:     3,
+			phases:       "parse: 1ms, plan: 2ms, compile: 1ms, execute: 1.496s",
+			plan:         []string{"Project", "  ->  Table Scan on tpch.lineitem"},
+			sql:          "select * from lineitem;",
+		})
+		convey.So(buf.String(), convey.ShouldEqual, "# Time: 2022-06-01T10:00:00Z\n"+
+			"# User: root  Db: tpch  Id: 7\n"+
+			"# Query_time: 1.500000  Rows_examined: 100  Rows_sent: 3\n"+
+			"# Phases: parse: 1ms, plan: 2ms, compile: 1ms, execute: 1.496s\n"+
+			"# Plan:\n"+
+			"#   Project\n"+
+			"#     ->  Table Scan on tpch.lineitem\n"+
+			"select * from lineitem;\n")
+
+		buf.Reset()
+		sl.write(&slowQuery{sql: "select 1", err: errors.New("canceled")})
+		convey.So(buf.String(), convey.ShouldContainSubstring, "# Error: canceled\nselect 1;\n")
+	})
+}
+
+func Test_explainPlan(t *testing.T) {
+	convey.Convey("explainPlan succ", t, func() {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, "select n_name from nation where n_nationkey > 10")
+		convey.So(err, convey.ShouldBeNil)
+		p, err := plan2.BuildPlan(plan2.NewMockCompilerContext(), stmt)
+		convey.So(err, convey.ShouldBeNil)
+		lines := explainPlan(p)
+		convey.So(len(lines), convey.ShouldBeGreaterThan, 0)
+		convey.So(strings.Join(lines, "\n"), convey.ShouldContainSubstring, "nation")
+
+		convey.So(explainPlan(nil), convey.ShouldBeNil)
+	})
+}
@@ -16,8 +16,11 @@ package frontend
 
 import (
 	"bytes"
+	goErrors "errors"
 	"fmt"
+	"go/constant"
 	"os"
+	"regexp"
 	"runtime"
 	"strconv"
 	"sync/atomic"
@@ -31,6 +34,7 @@ import (
 
 	mo_config "github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -228,6 +232,76 @@ func (t *Timeout) isTimeout() bool {
 	return true
 }
 
+var (
+	errorUnsupportedExprForVariable = goErrors.New("unsupported expression for the variable value")
+
+	//the optimizer hint /*+ MAX_EXECUTION_TIME(n) */
+	maxExecutionTimeHint = regexp.MustCompile(`(?i)/\*\+[^*]*\bMAX_EXECUTION_TIME\s*\(\s*(\d+)\s*\)`)
+)
+
+/*
+GetMaxExecutionTimeHint gets the timeout in milliseconds from the
+optimizer hint /*+ MAX_EXECUTION_TIME(n) *\/ in the sql.
+false denotes there is no hint.
+*/
+func GetMaxExecutionTimeHint(sql string) (time.Duration, bool) {
+	matches := maxExecutionTimeHint.FindStringSubmatch(sql)
+	if len(matches) != 2 {
+		return 0, false
+	}
+	ms, err := strconv.ParseUint(matches[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+// GetSimpleExprValue gets the value of the constant expression
+// in the SET statement.
+func GetSimpleExprValue(e tree.Expr) (interface{}, error) {
+	switch v := e.(type) {
+	case *tree.NumVal:
+		switch v.Value.Kind() {
+		case constant.Bool:
+			return constant.BoolVal(v.Value), nil
+		case constant.Int:
+			if i, ok := constant.Int64Val(v.Value); ok {
+				return i, nil
+			}
+			if u, ok := constant.Uint64Val(v.Value); ok {
+				return u, nil
+			}
+		case constant.Float:
+			f, _ := constant.Float64Val(v.Value)
+			return f, nil
+		case constant.String:
+			return constant.StringVal(v.Value), nil
+		}
+	case *tree.UnaryExpr:
+		val, err := GetSimpleExprValue(v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		switch v.Op {
+		case tree.UNARY_PLUS:
+			return val, nil
+		case tree.UNARY_MINUS:
+			switch x := val.(type) {
+			case int64:
+				return -x, nil
+			case float64:
+				return -x, nil
+			}
+		}
+	case *tree.UnresolvedName:
+		//SET var = ON / OFF
+		if v.NumParts == 1 {
+			return v.Parts[0], nil
+		}
+	}
+	return nil, errorUnsupportedExprForVariable
+}
+
 /*
 length:
 -1, complete string.
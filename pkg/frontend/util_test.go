@@ -15,6 +15,9 @@
 package frontend
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	cvey "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -144,3 +147,50 @@ func Test_makedebuginfo(t *testing.T) {
 			6, 3)
 	})
 }
+
+func Test_GetMaxExecutionTimeHint(t *testing.T) {
+	cvey.Convey("max execution time hint", t, func() {
+		d, ok := GetMaxExecutionTimeHint("select /*+ MAX_EXECUTION_TIME(1000) */ * from t")
+		cvey.So(ok, cvey.ShouldBeTrue)
+		cvey.So(d, cvey.ShouldEqual, time.Second)
+
+		d, ok = GetMaxExecutionTimeHint("select /*+ BKA(t1) max_execution_time( 20 ) */ * from t")
+		cvey.So(ok, cvey.ShouldBeTrue)
+		cvey.So(d, cvey.ShouldEqual, 20*time.Millisecond)
+
+		_, ok = GetMaxExecutionTimeHint("select /* MAX_EXECUTION_TIME(1000) */ * from t")
+		cvey.So(ok, cvey.ShouldBeFalse)
+
+		_, ok = GetMaxExecutionTimeHint("select * from t")
+		cvey.So(ok, cvey.ShouldBeFalse)
+	})
+}
+
+func Test_GetSimpleExprValue(t *testing.T) {
+	cvey.Convey("simple expr value", t, func() {
+		kases := []struct {
+			sql     string
+			want    interface{}
+			wantErr bool
+		}{
+			{"set @@max_execution_time = 10", int64(10), false},
+			{"set @@max_execution_time = -10", int64(-10), false},
+			{"set @@max_execution_time = 1.5", 1.5, false},
+			{"set @@max_execution_time = 'abc'", "abc", false},
+			{"set @@max_execution_time = on", "on", false},
+			{"set @@max_execution_time = a + 1", nil, true},
+		}
+		for _, kase := range kases {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, kase.sql)
+			cvey.So(err, cvey.ShouldBeNil)
+			sv := stmt.(*tree.SetVar)
+			value, err := GetSimpleExprValue(sv.Assignments[0].Value)
+			if kase.wantErr {
+				cvey.So(err, cvey.ShouldNotBeNil)
+			} else {
+				cvey.So(err, cvey.ShouldBeNil)
+				cvey.So(value, cvey.ShouldEqual, kase.want)
+			}
+		}
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"math"
 	"strings"
+	"sync"
 )
 
 var (
@@ -14,6 +16,7 @@ var (
 	errorConvertToUintFailed   = errors.New("convert to the system variable uint type failed")
 	errorConvertToDoubleFailed = errors.New("convert to the system variable double type failed")
 	errorConvertToEnumFailed   = errors.New("convert to the system variable enum type failed")
+	errorUnknownTimeZone       = errors.New("unknown or incorrect time zone")
 )
 
 type Scope int
@@ -67,6 +70,7 @@ var _ SystemVariableType = SystemVariableIntType{}
 var _ SystemVariableType = SystemVariableUintType{}
 var _ SystemVariableType = SystemVariableDoubleType{}
 var _ SystemVariableType = SystemVariableEnumType{}
+var _ SystemVariableType = SystemVariableTimeZoneType{}
 
 type SystemVariableBoolType struct {
 	name string
@@ -314,6 +318,14 @@ type SystemVariableDoubleType struct {
 	maximum float64
 }
 
+func InitSystemVariableDoubleType(name string, minimum, maximum float64) SystemVariableDoubleType {
+	return SystemVariableDoubleType{
+		name:    name,
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
 func (svdt SystemVariableDoubleType) String() string {
 	return "DOUBLE"
 }
@@ -441,6 +453,42 @@ func (svet SystemVariableEnumType) Zero() interface{} {
 	return ""
 }
 
+// SystemVariableTimeZoneType is the time zone name, such as SYSTEM, +08:00 or Asia/Shanghai
+type SystemVariableTimeZoneType struct {
+	name string
+}
+
+func InitSystemVariableTimeZoneType(name string) SystemVariableTimeZoneType {
+	return SystemVariableTimeZoneType{
+		name: name,
+	}
+}
+
+func (svtzt SystemVariableTimeZoneType) String() string {
+	return "TIME ZONE"
+}
+
+func (svtzt SystemVariableTimeZoneType) Convert(value interface{}) (interface{}, error) {
+	if v, ok := value.(string); ok {
+		if _, err := types.ParseTimeZone(v); err == nil {
+			return v, nil
+		}
+	}
+	return nil, errorUnknownTimeZone
+}
+
+func (svtzt SystemVariableTimeZoneType) Type() types.T {
+	return types.T_varchar
+}
+
+func (svtzt SystemVariableTimeZoneType) MysqlType() uint8 {
+	return defines.MYSQL_TYPE_VARCHAR
+}
+
+func (svtzt SystemVariableTimeZoneType) Zero() interface{} {
+	return ""
+}
+
 const (
 	//reference : https://dev.mysql.com/doc/refman/8.0/en/storage-requirements.html#data-types-storage-reqs-strings
 	MaxMemberCountOfSetType = 64
@@ -468,3 +516,170 @@ func InitSystemVariableSetType(name string, values ...string) SystemVariableSetT
 	//TODO: add initial
 	return SystemVariableSetType{}
 }
+
+var (
+	errorSystemVariableDoesNotExist = errors.New("the system variable does not exist")
+	errorSystemVariableIsSession    = errors.New("the system variable is session")
+	errorSystemVariableIsGlobal     = errors.New("the system variable is global")
+	errorSystemVariableIsReadOnly   = errors.New("the system variable is read only")
+)
+
+// SystemVariable defines the attributes of the system variable
+type SystemVariable struct {
+	Name string
+
+	Scope Scope
+
+	// Dynamic denotes the variable can be changed at runtime
+	Dynamic bool
+
+	// SetVarHintApplies denotes the variable can be set by the optimizer hint
+	SetVarHintApplies bool
+
+	Type SystemVariableType
+
+	Default interface{}
+}
+
+func (sv SystemVariable) GetName() string {
+	return sv.Name
+}
+
+func (sv SystemVariable) GetScope() Scope {
+	return sv.Scope
+}
+
+func (sv SystemVariable) GetDynamic() bool {
+	return sv.Dynamic
+}
+
+func (sv SystemVariable) GetSetVarHintApplies() bool {
+	return sv.SetVarHintApplies
+}
+
+func (sv SystemVariable) GetType() SystemVariableType {
+	return sv.Type
+}
+
+func (sv SystemVariable) GetDefault() interface{} {
+	return sv.Default
+}
+
+// definitions of the system variables
+var gSysVarsDefs = map[string]SystemVariable{
+	// the execution timeout of the SELECT statement in milliseconds.
+	// 0 denotes no timeout.
+	"max_execution_time": {
+		Name:              "max_execution_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("max_execution_time", 0, 4294967295, false),
+		Default:           int64(0),
+	},
+	// the maximum memory in bytes that a statement can use.
+	// 0 denotes the guest mmu limitation of the server.
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
+	// the time zone of the session. TIMESTAMP values are converted from it
+	// to UTC for storage, and back from UTC for retrieval.
+	"time_zone": {
+		Name:              "time_zone",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableTimeZoneType("time_zone"),
+		Default:           "SYSTEM",
+	},
+	// the statements running longer than it in seconds are recorded
+	// in the slow query log.
+	"long_query_time": {
+		Name:              "long_query_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableDoubleType("long_query_time", 0, 31536000),
+		Default:           float64(10),
+	},
+}
+
+// GlobalSystemVariables holds the global values of the system variables
+type GlobalSystemVariables struct {
+	mu sync.Mutex
+	// name -> value
+	sysVars map[string]interface{}
+}
+
+var gSysVariables = &GlobalSystemVariables{
+	sysVars: make(map[string]interface{}),
+}
+
+func init() {
+	InitGlobalSystemVariables(gSysVariables)
+}
+
+// InitGlobalSystemVariables initializes the global values with the default values
+func InitGlobalSystemVariables(gsv *GlobalSystemVariables) {
+	gsv.mu.Lock()
+	defer gsv.mu.Unlock()
+	for _, def := range gSysVarsDefs {
+		gsv.sysVars[def.GetName()] = def.GetDefault()
+	}
+}
+
+// CopySysVarsToSession gets the copy of the global values for the new session
+func (gsv *GlobalSystemVariables) CopySysVarsToSession() map[string]interface{} {
+	gsv.mu.Lock()
+	defer gsv.mu.Unlock()
+	sesSysVars := make(map[string]interface{}, len(gsv.sysVars))
+	for name, value := range gsv.sysVars {
+		sesSysVars[name] = value
+	}
+	return sesSysVars
+}
+
+// GetGlobalSysVar gets the definition and the global value of the system variable
+func (gsv *GlobalSystemVariables) GetGlobalSysVar(name string) (SystemVariable, interface{}, bool) {
+	gsv.mu.Lock()
+	defer gsv.mu.Unlock()
+	name = strings.ToLower(name)
+	if def, ok := gSysVarsDefs[name]; ok {
+		return def, gsv.sysVars[name], true
+	}
+	return SystemVariable{}, nil, false
+}
+
+// CheckGlobalSysVar checks the value can be the global value of the system variable
+// and converts it to the type of the system variable
+func (gsv *GlobalSystemVariables) CheckGlobalSysVar(name string, value interface{}) (interface{}, error) {
+	def, ok := gSysVarsDefs[strings.ToLower(name)]
+	if !ok {
+		return nil, errorSystemVariableDoesNotExist
+	}
+	if def.GetScope() == ScopeSession {
+		return nil, errorSystemVariableIsSession
+	}
+	if !def.GetDynamic() {
+		return nil, errorSystemVariableIsReadOnly
+	}
+	return def.GetType().Convert(value)
+}
+
+// SetGlobalSysVar sets the global value of the system variable
+func (gsv *GlobalSystemVariables) SetGlobalSysVar(name string, value interface{}) error {
+	name = strings.ToLower(name)
+	val, err := gsv.CheckGlobalSysVar(name, value)
+	if err != nil {
+		return err
+	}
+	gsv.mu.Lock()
+	defer gsv.mu.Unlock()
+	gsv.sysVars[name] = val
+	return nil
+}
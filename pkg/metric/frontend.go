@@ -0,0 +1,66 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// Connections is the number of the open client connections
+	Connections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "frontend",
+		Name:      "connections",
+		Help:      "Number of the open client connections.",
+	})
+	// ConnectionsTotal is the number of the accepted client connections
+	ConnectionsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "frontend",
+		Name:      "connections_total",
+		Help:      "Number of the accepted client connections.",
+	})
+	// Queries is the number of the statements by type and by status, the
+	// status is ok or error
+	Queries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "frontend",
+		Name:      "queries_total",
+		Help:      "Number of the executed statements by statement type and status.",
+	}, []string{"type", "status"})
+	// QueryDuration is the latency of the statements by type
+	QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "frontend",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of the statements by statement type.",
+		Buckets:   durationBuckets,
+	}, []string{"type"})
+	// RowsReturned is the number of the rows sent to the clients
+	RowsReturned = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "frontend",
+		Name:      "rows_returned_total",
+		Help:      "Number of the result rows sent to the clients.",
+	})
+)
+
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+func init() {
+	registry.MustRegister(Connections, ConnectionsTotal, Queries, QueryDuration, RowsReturned)
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	registry.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mheap",
+		Name:      "allocated_bytes_total",
+		Help:      "Bytes allocated by the heaps of the queries.",
+	}, func() float64 {
+		return float64(mheap.Allocated())
+	}))
+}
+
+// RegisterHostMmu exposes the usage and the limit of the mmu of the server
+func RegisterHostMmu(m *host.Mmu) {
+	Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mmu",
+		Name:      "host_bytes",
+		Help:      "Bytes in use of the host mmu.",
+	}, func() float64 {
+		return float64(m.Size())
+	}))
+	Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mmu",
+		Name:      "host_limit_bytes",
+		Help:      "Limit of the host mmu.",
+	}, func() float64 {
+		return float64(m.Limit())
+	}))
+}
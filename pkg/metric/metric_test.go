@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	m := host.New(1 << 20)
+	require.NoError(t, m.Alloc(100))
+	RegisterHostMmu(m)
+	// registering the collectors again is ignored
+	RegisterHostMmu(m)
+
+	Queries.WithLabelValues("Select", StatusOK).Inc()
+	ObserveSince(QueryDuration.WithLabelValues("Select"), time.Now().Add(-time.Second))
+	TxnDone.WithLabelValues(TxnCommit).Inc()
+	CheckpointLag.Set(3)
+
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	body := string(data)
+	for _, line := range []string{
+		`mo_frontend_queries_total{status="ok",type="Select"} 1`,
+		`mo_frontend_query_duration_seconds_count{type="Select"} 1`,
+		`mo_tae_txn_total{result="commit"} 1`,
+		`mo_tae_checkpoint_lag 3`,
+		`mo_mmu_host_bytes 100`,
+		`mo_mmu_host_limit_bytes 1.048576e+06`,
+		`go_goroutines`,
+	} {
+		require.True(t, strings.Contains(body, line), line)
+	}
+}
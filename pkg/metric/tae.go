@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// TxnDone is the number of the TAE transactions by result, the result is
+	// commit or rollback
+	TxnDone = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "txn_total",
+		Help:      "Number of the finished transactions by result.",
+	}, []string{"result"})
+	// WalAppendDuration is the latency of writing an entry to the WAL file
+	WalAppendDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "wal_append_duration_seconds",
+		Help:      "Latency of writing an entry to the WAL.",
+		Buckets:   durationBuckets,
+	})
+	// WalSyncDuration is the latency of syncing the WAL file
+	WalSyncDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "wal_sync_duration_seconds",
+		Help:      "Latency of syncing the WAL.",
+		Buckets:   durationBuckets,
+	})
+	// CheckpointLag is the number of the WAL entries not checkpointed yet
+	CheckpointLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "checkpoint_lag",
+		Help:      "Number of the committed WAL entries not checkpointed yet.",
+	})
+	// BufferPins is the number of the pins of the buffer manager nodes by
+	// pool and result, the result is hit if the node is loaded or miss
+	BufferPins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "buffer_pins_total",
+		Help:      "Number of the buffer node pins by pool and result.",
+	}, []string{"pool", "result"})
+	// BufferEvictions is the number of the nodes unloaded to make room by pool
+	BufferEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "buffer_evictions_total",
+		Help:      "Number of the buffer nodes unloaded to make room by pool.",
+	}, []string{"pool"})
+	// JobDuration is the latency of the background jobs by type
+	JobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tae",
+		Name:      "job_duration_seconds",
+		Help:      "Latency of the merge, compaction and flush jobs.",
+		Buckets:   durationBuckets,
+	}, []string{"type"})
+)
+
+const (
+	TxnCommit   = "commit"
+	TxnRollback = "rollback"
+
+	PinHit  = "hit"
+	PinMiss = "miss"
+
+	JobMergeBlocks   = "merge_blocks"
+	JobCompactBlock  = "compact_block"
+	JobCompactABlock = "compact_ablock"
+	JobFlushBlock    = "flush_block"
+)
+
+func init() {
+	registry.MustRegister(TxnDone, WalAppendDuration, WalSyncDuration, CheckpointLag,
+		BufferPins, BufferEvictions, JobDuration)
+}
@@ -23,8 +23,10 @@ const (
 type CompressType int32
 
 const (
-	CompressType_None CompressType = 0
-	CompressType_Lz4  CompressType = 1
+	CompressType_None   CompressType = 0
+	CompressType_Lz4    CompressType = 1
+	CompressType_Zstd   CompressType = 2
+	CompressType_Snappy CompressType = 3
 )
 
 // Enum value maps for CompressType.
@@ -32,10 +34,14 @@ var (
 	CompressType_name = map[int32]string{
 		0: "None",
 		1: "Lz4",
+		2: "Zstd",
+		3: "Snappy",
 	}
 	CompressType_value = map[string]int32{
-		"None": 0,
-		"Lz4":  1,
+		"None":   0,
+		"Lz4":    1,
+		"Zstd":   2,
+		"Snappy": 3,
 	}
 )
 
@@ -159,8 +165,10 @@ const (
 	Type_CHAR      Type_TypeId = 60
 	Type_VARCHAR   Type_TypeId = 61
 	Type_JSON      Type_TypeId = 62
+	Type_TEXT      Type_TypeId = 63
 	Type_BINARY    Type_TypeId = 70
 	Type_VARBINARY Type_TypeId = 71
+	Type_BLOB      Type_TypeId = 72
 	// Special
 	Type_ARRAY      Type_TypeId = 90
 	Type_FLEXBUFFER Type_TypeId = 91
@@ -212,8 +220,10 @@ var (
 		60:  "CHAR",
 		61:  "VARCHAR",
 		62:  "JSON",
+		63:  "TEXT",
 		70:  "BINARY",
 		71:  "VARBINARY",
+		72:  "BLOB",
 		90:  "ARRAY",
 		91:  "FLEXBUFFER",
 		100: "BYTEA8",
@@ -259,8 +269,10 @@ var (
 		"CHAR":       60,
 		"VARCHAR":    61,
 		"JSON":       62,
+		"TEXT":       63,
 		"BINARY":     70,
 		"VARBINARY":  71,
+		"BLOB":       72,
 		"ARRAY":      90,
 		"FLEXBUFFER": 91,
 		"BYTEA8":     100,
@@ -479,7 +491,7 @@ func (x OrderBySpec_OrderByFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrderBySpec_OrderByFlag.Descriptor instead.
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{23, 0}
+	return file_plan_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type Node_NodeType int32
@@ -608,7 +620,7 @@ func (x Node_NodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_NodeType.Descriptor instead.
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26, 0}
+	return file_plan_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type Node_JoinFlag int32
@@ -669,7 +681,7 @@ func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_JoinFlag.Descriptor instead.
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26, 1}
+	return file_plan_proto_rawDescGZIP(), []int{27, 1}
 }
 
 type Node_AggMode int32
@@ -718,7 +730,7 @@ func (x Node_AggMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_AggMode.Descriptor instead.
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26, 2}
+	return file_plan_proto_rawDescGZIP(), []int{27, 2}
 }
 
 type Query_StatementType int32
@@ -776,7 +788,7 @@ func (x Query_StatementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_StatementType.Descriptor instead.
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27, 0}
+	return file_plan_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type TransationControl_TclType int32
@@ -825,7 +837,7 @@ func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationControl_TclType.Descriptor instead.
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{28, 0}
+	return file_plan_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -874,7 +886,7 @@ func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationBegin_TransationMode.Descriptor instead.
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{29, 0}
+	return file_plan_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -901,6 +913,9 @@ const (
 	DataDefinition_SHOW_ERRORS         DataDefinition_DdlType = 18
 	DataDefinition_SHOW_STATUS         DataDefinition_DdlType = 19
 	DataDefinition_SHOW_PROCESSLIST    DataDefinition_DdlType = 20
+	DataDefinition_CREATE_VIEW         DataDefinition_DdlType = 21
+	DataDefinition_DROP_VIEW           DataDefinition_DdlType = 22
+	DataDefinition_SHOW_CREATEVIEW     DataDefinition_DdlType = 23
 )
 
 // Enum value maps for DataDefinition_DdlType.
@@ -927,6 +942,9 @@ var (
 		18: "SHOW_ERRORS",
 		19: "SHOW_STATUS",
 		20: "SHOW_PROCESSLIST",
+		21: "CREATE_VIEW",
+		22: "DROP_VIEW",
+		23: "SHOW_CREATEVIEW",
 	}
 	DataDefinition_DdlType_value = map[string]int32{
 		"CREATE_DATABASE":     0,
@@ -950,6 +968,9 @@ var (
 		"SHOW_ERRORS":         18,
 		"SHOW_STATUS":         19,
 		"SHOW_PROCESSLIST":    20,
+		"CREATE_VIEW":         21,
+		"DROP_VIEW":           22,
+		"SHOW_CREATEVIEW":     23,
 	}
 )
 
@@ -977,7 +998,7 @@ func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataDefinition_DdlType.Descriptor instead.
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{33, 0}
+	return file_plan_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type Type struct {
@@ -1616,6 +1637,10 @@ type Function struct {
 
 	Func *ObjectRef `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
 	Args []*Expr    `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// aggregate only the distinct values of the args
+	Distinct bool `protobuf:"varint,3,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	// the order of the rows of an order-sensitive aggregate
+	OrderBy []*OrderBySpec `protobuf:"bytes,4,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
 func (x *Function) Reset() {
@@ -1664,6 +1689,20 @@ func (x *Function) GetArgs() []*Expr {
 	return nil
 }
 
+func (x *Function) GetDistinct() bool {
+	if x != nil {
+		return x.Distinct
+	}
+	return false
+}
+
+func (x *Function) GetOrderBy() []*OrderBySpec {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 type Expr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2483,6 +2522,54 @@ func (x *PropertiesDef) GetProperties() []*Property {
 	return nil
 }
 
+// ViewDef marks a TableDef as a view, view is the sql text of its definition
+type ViewDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *ViewDef) Reset() {
+	*x = ViewDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewDef) ProtoMessage() {}
+
+func (x *ViewDef) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewDef.ProtoReflect.Descriptor instead.
+func (*ViewDef) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ViewDef) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
 type TableDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2497,7 +2584,7 @@ type TableDef struct {
 func (x *TableDef) Reset() {
 	*x = TableDef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[19]
+		mi := &file_plan_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2510,7 +2597,7 @@ func (x *TableDef) String() string {
 func (*TableDef) ProtoMessage() {}
 
 func (x *TableDef) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[19]
+	mi := &file_plan_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2523,7 +2610,7 @@ func (x *TableDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableDef.ProtoReflect.Descriptor instead.
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19}
+	return file_plan_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TableDef) GetName() string {
@@ -2569,7 +2656,7 @@ type Cost struct {
 func (x *Cost) Reset() {
 	*x = Cost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[20]
+		mi := &file_plan_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2582,7 +2669,7 @@ func (x *Cost) String() string {
 func (*Cost) ProtoMessage() {}
 
 func (x *Cost) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[20]
+	mi := &file_plan_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,7 +2682,7 @@ func (x *Cost) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cost.ProtoReflect.Descriptor instead.
 func (*Cost) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{20}
+	return file_plan_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Cost) GetCard() float64 {
@@ -2651,7 +2738,7 @@ type ColData struct {
 func (x *ColData) Reset() {
 	*x = ColData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[21]
+		mi := &file_plan_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2664,7 +2751,7 @@ func (x *ColData) String() string {
 func (*ColData) ProtoMessage() {}
 
 func (x *ColData) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[21]
+	mi := &file_plan_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,7 +2764,7 @@ func (x *ColData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColData.ProtoReflect.Descriptor instead.
 func (*ColData) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{21}
+	return file_plan_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ColData) GetRowCount() int32 {
@@ -2748,7 +2835,7 @@ type RowsetData struct {
 func (x *RowsetData) Reset() {
 	*x = RowsetData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[22]
+		mi := &file_plan_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2761,7 +2848,7 @@ func (x *RowsetData) String() string {
 func (*RowsetData) ProtoMessage() {}
 
 func (x *RowsetData) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[22]
+	mi := &file_plan_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2774,7 +2861,7 @@ func (x *RowsetData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowsetData.ProtoReflect.Descriptor instead.
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{22}
+	return file_plan_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RowsetData) GetSchema() *TableDef {
@@ -2804,7 +2891,7 @@ type OrderBySpec struct {
 func (x *OrderBySpec) Reset() {
 	*x = OrderBySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[23]
+		mi := &file_plan_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2817,7 +2904,7 @@ func (x *OrderBySpec) String() string {
 func (*OrderBySpec) ProtoMessage() {}
 
 func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[23]
+	mi := &file_plan_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2830,7 +2917,7 @@ func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderBySpec.ProtoReflect.Descriptor instead.
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{23}
+	return file_plan_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OrderBySpec) GetExpr() *Expr {
@@ -2868,7 +2955,7 @@ type WindowSpec struct {
 func (x *WindowSpec) Reset() {
 	*x = WindowSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[24]
+		mi := &file_plan_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2881,7 +2968,7 @@ func (x *WindowSpec) String() string {
 func (*WindowSpec) ProtoMessage() {}
 
 func (x *WindowSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[24]
+	mi := &file_plan_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2894,7 +2981,7 @@ func (x *WindowSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WindowSpec.ProtoReflect.Descriptor instead.
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24}
+	return file_plan_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *WindowSpec) GetPartitionBy() []*Expr {
@@ -2937,7 +3024,7 @@ type UpdateList struct {
 func (x *UpdateList) Reset() {
 	*x = UpdateList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[25]
+		mi := &file_plan_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2950,7 +3037,7 @@ func (x *UpdateList) String() string {
 func (*UpdateList) ProtoMessage() {}
 
 func (x *UpdateList) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[25]
+	mi := &file_plan_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2963,7 +3050,7 @@ func (x *UpdateList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateList.ProtoReflect.Descriptor instead.
 func (*UpdateList) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{25}
+	return file_plan_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateList) GetColumns() []*Expr {
@@ -3010,7 +3097,7 @@ type Node struct {
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[26]
+		mi := &file_plan_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3023,7 +3110,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[26]
+	mi := &file_plan_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,7 +3123,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26}
+	return file_plan_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Node) GetNodeType() Node_NodeType {
@@ -3198,7 +3285,7 @@ type Query struct {
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[27]
+		mi := &file_plan_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3211,7 +3298,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[27]
+	mi := &file_plan_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3224,7 +3311,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27}
+	return file_plan_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Query) GetStmtType() Query_StatementType {
@@ -3272,7 +3359,7 @@ type TransationControl struct {
 func (x *TransationControl) Reset() {
 	*x = TransationControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[28]
+		mi := &file_plan_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3285,7 +3372,7 @@ func (x *TransationControl) String() string {
 func (*TransationControl) ProtoMessage() {}
 
 func (x *TransationControl) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[28]
+	mi := &file_plan_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,7 +3385,7 @@ func (x *TransationControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationControl.ProtoReflect.Descriptor instead.
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{28}
+	return file_plan_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TransationControl) GetTclType() TransationControl_TclType {
@@ -3369,7 +3456,7 @@ type TransationBegin struct {
 func (x *TransationBegin) Reset() {
 	*x = TransationBegin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[29]
+		mi := &file_plan_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3382,7 +3469,7 @@ func (x *TransationBegin) String() string {
 func (*TransationBegin) ProtoMessage() {}
 
 func (x *TransationBegin) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[29]
+	mi := &file_plan_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3395,7 +3482,7 @@ func (x *TransationBegin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationBegin.ProtoReflect.Descriptor instead.
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{29}
+	return file_plan_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TransationBegin) GetMode() TransationBegin_TransationMode {
@@ -3416,7 +3503,7 @@ type TransationCommit struct {
 func (x *TransationCommit) Reset() {
 	*x = TransationCommit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[30]
+		mi := &file_plan_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3429,7 +3516,7 @@ func (x *TransationCommit) String() string {
 func (*TransationCommit) ProtoMessage() {}
 
 func (x *TransationCommit) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[30]
+	mi := &file_plan_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3442,7 +3529,7 @@ func (x *TransationCommit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationCommit.ProtoReflect.Descriptor instead.
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{30}
+	return file_plan_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TransationCommit) GetCompletionType() TransationCompletionType {
@@ -3463,7 +3550,7 @@ type TransationRollback struct {
 func (x *TransationRollback) Reset() {
 	*x = TransationRollback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[31]
+		mi := &file_plan_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3476,7 +3563,7 @@ func (x *TransationRollback) String() string {
 func (*TransationRollback) ProtoMessage() {}
 
 func (x *TransationRollback) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[31]
+	mi := &file_plan_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +3576,7 @@ func (x *TransationRollback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationRollback.ProtoReflect.Descriptor instead.
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{31}
+	return file_plan_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TransationRollback) GetCompletionType() TransationCompletionType {
@@ -3514,7 +3601,7 @@ type Plan struct {
 func (x *Plan) Reset() {
 	*x = Plan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[32]
+		mi := &file_plan_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3527,7 +3614,7 @@ func (x *Plan) String() string {
 func (*Plan) ProtoMessage() {}
 
 func (x *Plan) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[32]
+	mi := &file_plan_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3540,7 +3627,7 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plan.ProtoReflect.Descriptor instead.
 func (*Plan) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{32}
+	return file_plan_proto_rawDescGZIP(), []int{33}
 }
 
 func (m *Plan) GetPlan() isPlan_Plan {
@@ -3622,7 +3709,7 @@ type DataDefinition struct {
 func (x *DataDefinition) Reset() {
 	*x = DataDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[33]
+		mi := &file_plan_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3635,7 +3722,7 @@ func (x *DataDefinition) String() string {
 func (*DataDefinition) ProtoMessage() {}
 
 func (x *DataDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[33]
+	mi := &file_plan_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3648,7 +3735,7 @@ func (x *DataDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataDefinition.ProtoReflect.Descriptor instead.
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{33}
+	return file_plan_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DataDefinition) GetDdlType() DataDefinition_DdlType {
@@ -3831,7 +3918,7 @@ type CreateDatabase struct {
 func (x *CreateDatabase) Reset() {
 	*x = CreateDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[34]
+		mi := &file_plan_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3844,7 +3931,7 @@ func (x *CreateDatabase) String() string {
 func (*CreateDatabase) ProtoMessage() {}
 
 func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[34]
+	mi := &file_plan_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3857,7 +3944,7 @@ func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDatabase.ProtoReflect.Descriptor instead.
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{34}
+	return file_plan_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateDatabase) GetIfNotExists() bool {
@@ -3886,7 +3973,7 @@ type AlterDatabase struct {
 func (x *AlterDatabase) Reset() {
 	*x = AlterDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[35]
+		mi := &file_plan_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3899,7 +3986,7 @@ func (x *AlterDatabase) String() string {
 func (*AlterDatabase) ProtoMessage() {}
 
 func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[35]
+	mi := &file_plan_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3912,7 +3999,7 @@ func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterDatabase.ProtoReflect.Descriptor instead.
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{35}
+	return file_plan_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AlterDatabase) GetIfExists() bool {
@@ -3941,7 +4028,7 @@ type DropDatabase struct {
 func (x *DropDatabase) Reset() {
 	*x = DropDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[36]
+		mi := &file_plan_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3954,7 +4041,7 @@ func (x *DropDatabase) String() string {
 func (*DropDatabase) ProtoMessage() {}
 
 func (x *DropDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[36]
+	mi := &file_plan_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3967,7 +4054,7 @@ func (x *DropDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropDatabase.ProtoReflect.Descriptor instead.
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{36}
+	return file_plan_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DropDatabase) GetIfExists() bool {
@@ -3998,7 +4085,7 @@ type CreateTable struct {
 func (x *CreateTable) Reset() {
 	*x = CreateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[37]
+		mi := &file_plan_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4011,7 +4098,7 @@ func (x *CreateTable) String() string {
 func (*CreateTable) ProtoMessage() {}
 
 func (x *CreateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[37]
+	mi := &file_plan_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4024,7 +4111,7 @@ func (x *CreateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTable.ProtoReflect.Descriptor instead.
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{37}
+	return file_plan_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateTable) GetIfNotExists() bool {
@@ -4067,7 +4154,7 @@ type AlterTable struct {
 func (x *AlterTable) Reset() {
 	*x = AlterTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[38]
+		mi := &file_plan_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4080,7 +4167,7 @@ func (x *AlterTable) String() string {
 func (*AlterTable) ProtoMessage() {}
 
 func (x *AlterTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[38]
+	mi := &file_plan_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4093,7 +4180,7 @@ func (x *AlterTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterTable.ProtoReflect.Descriptor instead.
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{38}
+	return file_plan_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AlterTable) GetTable() string {
@@ -4123,7 +4210,7 @@ type DropTable struct {
 func (x *DropTable) Reset() {
 	*x = DropTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[39]
+		mi := &file_plan_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4136,7 +4223,7 @@ func (x *DropTable) String() string {
 func (*DropTable) ProtoMessage() {}
 
 func (x *DropTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[39]
+	mi := &file_plan_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,7 +4236,7 @@ func (x *DropTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropTable.ProtoReflect.Descriptor instead.
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{39}
+	return file_plan_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DropTable) GetIfExists() bool {
@@ -4185,7 +4272,7 @@ type CreateIndex struct {
 func (x *CreateIndex) Reset() {
 	*x = CreateIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[40]
+		mi := &file_plan_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4198,7 +4285,7 @@ func (x *CreateIndex) String() string {
 func (*CreateIndex) ProtoMessage() {}
 
 func (x *CreateIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[40]
+	mi := &file_plan_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4211,7 +4298,7 @@ func (x *CreateIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIndex.ProtoReflect.Descriptor instead.
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{40}
+	return file_plan_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CreateIndex) GetIfNotExists() bool {
@@ -4239,7 +4326,7 @@ type AlterIndex struct {
 func (x *AlterIndex) Reset() {
 	*x = AlterIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[41]
+		mi := &file_plan_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4252,7 +4339,7 @@ func (x *AlterIndex) String() string {
 func (*AlterIndex) ProtoMessage() {}
 
 func (x *AlterIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[41]
+	mi := &file_plan_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4265,7 +4352,7 @@ func (x *AlterIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterIndex.ProtoReflect.Descriptor instead.
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{41}
+	return file_plan_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AlterIndex) GetIndex() string {
@@ -4287,7 +4374,7 @@ type DropIndex struct {
 func (x *DropIndex) Reset() {
 	*x = DropIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[42]
+		mi := &file_plan_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4300,7 +4387,7 @@ func (x *DropIndex) String() string {
 func (*DropIndex) ProtoMessage() {}
 
 func (x *DropIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[42]
+	mi := &file_plan_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4313,7 +4400,7 @@ func (x *DropIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropIndex.ProtoReflect.Descriptor instead.
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{42}
+	return file_plan_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DropIndex) GetIfExists() bool {
@@ -4341,7 +4428,7 @@ type TruncateTable struct {
 func (x *TruncateTable) Reset() {
 	*x = TruncateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[43]
+		mi := &file_plan_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4354,7 +4441,7 @@ func (x *TruncateTable) String() string {
 func (*TruncateTable) ProtoMessage() {}
 
 func (x *TruncateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[43]
+	mi := &file_plan_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4367,7 +4454,7 @@ func (x *TruncateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TruncateTable.ProtoReflect.Descriptor instead.
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{43}
+	return file_plan_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *TruncateTable) GetTable() string {
@@ -4389,7 +4476,7 @@ type ShowVariables struct {
 func (x *ShowVariables) Reset() {
 	*x = ShowVariables{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[44]
+		mi := &file_plan_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4402,7 +4489,7 @@ func (x *ShowVariables) String() string {
 func (*ShowVariables) ProtoMessage() {}
 
 func (x *ShowVariables) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[44]
+	mi := &file_plan_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,7 +4502,7 @@ func (x *ShowVariables) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowVariables.ProtoReflect.Descriptor instead.
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{44}
+	return file_plan_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ShowVariables) GetGlobal() bool {
@@ -4441,13 +4528,14 @@ type TableDef_DefType struct {
 	//	*TableDef_DefType_Pk
 	//	*TableDef_DefType_Idx
 	//	*TableDef_DefType_Properties
+	//	*TableDef_DefType_View
 	Def isTableDef_DefType_Def `protobuf_oneof:"def"`
 }
 
 func (x *TableDef_DefType) Reset() {
 	*x = TableDef_DefType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[45]
+		mi := &file_plan_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4460,7 +4548,7 @@ func (x *TableDef_DefType) String() string {
 func (*TableDef_DefType) ProtoMessage() {}
 
 func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[45]
+	mi := &file_plan_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4473,7 +4561,7 @@ func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableDef_DefType.ProtoReflect.Descriptor instead.
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19, 0}
+	return file_plan_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (m *TableDef_DefType) GetDef() isTableDef_DefType_Def {
@@ -4504,6 +4592,13 @@ func (x *TableDef_DefType) GetProperties() *PropertiesDef {
 	return nil
 }
 
+func (x *TableDef_DefType) GetView() *ViewDef {
+	if x, ok := x.GetDef().(*TableDef_DefType_View); ok {
+		return x.View
+	}
+	return nil
+}
+
 type isTableDef_DefType_Def interface {
 	isTableDef_DefType_Def()
 }
@@ -4520,16 +4615,22 @@ type TableDef_DefType_Properties struct {
 	Properties *PropertiesDef `protobuf:"bytes,3,opt,name=properties,proto3,oneof"`
 }
 
+type TableDef_DefType_View struct {
+	View *ViewDef `protobuf:"bytes,4,opt,name=view,proto3,oneof"`
+}
+
 func (*TableDef_DefType_Pk) isTableDef_DefType_Def() {}
 
 func (*TableDef_DefType_Idx) isTableDef_DefType_Def() {}
 
 func (*TableDef_DefType_Properties) isTableDef_DefType_Def() {}
 
+func (*TableDef_DefType_View) isTableDef_DefType_Def() {}
+
 var File_plan_proto protoreflect.FileDescriptor
 
 var file_plan_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x05, 0x0a,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x05, 0x0a,
 	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x0c, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
@@ -4539,7 +4640,7 @@ var file_plan_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
 	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa5, 0x04,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xb9, 0x04,
 	0x0a, 0x06, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10,
 	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42,
 	0x4f, 0x4f, 0x4c, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x14, 0x12,
@@ -4567,59 +4668,66 @@ var file_plan_proto_rawDesc = []byte{
 	0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x36, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x59, 0x54, 0x49,
 	0x4d, 0x45, 0x10, 0x3b, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x48, 0x41, 0x52, 0x10, 0x3c, 0x12, 0x0b,
 	0x0a, 0x07, 0x56, 0x41, 0x52, 0x43, 0x48, 0x41, 0x52, 0x10, 0x3d, 0x12, 0x08, 0x0a, 0x04, 0x4a,
-	0x53, 0x4f, 0x4e, 0x10, 0x3e, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10,
-	0x46, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x52, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x47,
-	0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x5a, 0x12, 0x0e, 0x0a, 0x0a, 0x46,
-	0x4c, 0x45, 0x58, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x5b, 0x12, 0x0a, 0x0a, 0x06, 0x42,
-	0x59, 0x54, 0x45, 0x41, 0x38, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x54, 0x45, 0x41,
-	0x31, 0x36, 0x10, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x41, 0x10, 0x66, 0x12,
-	0x08, 0x0a, 0x03, 0x53, 0x45, 0x4c, 0x10, 0xc8, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x54, 0x55, 0x50,
-	0x4c, 0x45, 0x10, 0xc9, 0x01, 0x22, 0x6a, 0x0a, 0x05, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04,
-	0x64, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x64, 0x76,
-	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a,
-	0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x22,
-	0x1c, 0x0a, 0x06, 0x56, 0x61, 0x72, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a,
-	0x06, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x5f, 0x70,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x6c, 0x50, 0x6f, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x6f, 0x72,
-	0x72, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x53, 0x4f, 0x4e, 0x10, 0x3e, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x3f, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x46, 0x12, 0x0d, 0x0a, 0x09, 0x56,
+	0x41, 0x52, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x47, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4c,
+	0x4f, 0x42, 0x10, 0x48, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x5a, 0x12,
+	0x0e, 0x0a, 0x0a, 0x46, 0x4c, 0x45, 0x58, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x5b, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x59, 0x54, 0x45, 0x41, 0x38, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x42,
+	0x59, 0x54, 0x45, 0x41, 0x31, 0x36, 0x10, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45,
+	0x41, 0x10, 0x66, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x45, 0x4c, 0x10, 0xc8, 0x01, 0x12, 0x0a, 0x0a,
+	0x05, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xc9, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x69,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x69, 0x76, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x04, 0x64, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a,
+	0x04, 0x62, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x62,
+	0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x08,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x22, 0x1c, 0x0a, 0x06, 0x56, 0x61,
+	0x72, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x52,
+	0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x50, 0x6f, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x43, 0x6f, 0x6c, 0x52,
+	0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x50, 0x6f, 0x73, 0x22, 0x25, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x08, 0x53,
+	0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x22, 0x25, 0x0a, 0x08, 0x45, 0x78, 0x70,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x22, 0x65, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x64, 0x62, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x62, 0x6a, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6f, 0x62, 0x6a, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01,
-	0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x75,
-	0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x46, 0x6c,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x64, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x62, 0x6a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f,
+	0x62, 0x6a, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x08, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
+	0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x46, 0x6c,
 	0x61, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
 	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
 	0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x4f, 0x4c, 0x41, 0x54, 0x49,
@@ -4713,339 +4821,347 @@ var file_plan_proto_rawDesc = []byte{
 	0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
 	0x44, 0x65, 0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfe,
-	0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f,
-	0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x44, 0x65,
-	0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65,
-	0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48,
-	0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22,
-	0x72, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f,
-	0x77, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x6e, 0x64, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x75, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
-	0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x65, 0x78, 0x70,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x5b, 0x0a,
-	0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c,
-	0x61, 0x67, 0x22, 0x4c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x22, 0xec, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x43, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46,
-	0x6c, 0x61, 0x67, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x07, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0a, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12,
-	0x20, 0x0a, 0x08, 0x61, 0x67, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x61, 0x67, 0x67, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f,
-	0x73, 0x70, 0x65, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x66, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff, 0x02, 0x0a,
-	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f,
-	0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f,
-	0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
-	0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11,
-	0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10,
-	0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43,
-	0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f,
-	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04,
-	0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10,
-	0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06,
-	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41,
-	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54,
-	0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e,
-	0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
-	0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35, 0x22, 0x55,
-	0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
-	0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x4e,
-	0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x08,
-	0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x50,
-	0x50, 0x4c, 0x59, 0x10, 0x20, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f,
-	0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x22,
-	0xe5, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x6d,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57,
-	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45,
-	0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
-	0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a,
-	0x08, 0x74, 0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x63, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x2b,
-	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72,
-	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2e,
-	0x0a, 0x07, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x47,
-	0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x08,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x22, 0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
-	0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x10,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7b,
-	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c, 0x12, 0x23,
-	0x0a, 0x03, 0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03,
-	0x64, 0x64, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xc4, 0x08, 0x0a, 0x0e,
-	0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
-	0x0a, 0x08, 0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e,
-	0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44,
-	0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64,
-	0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2e,
-	0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b,
-	0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e,
-	0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b,
-	0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00,
-	0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0e, 0x74,
-	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53,
-	0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d,
-	0x73, 0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x94, 0x03,
-	0x0a, 0x07, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42,
-	0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
-	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52,
-	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50,
-	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c,
-	0x54, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
-	0x52, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54,
-	0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x12,
-	0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57,
-	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x12,
-	0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x53,
-	0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
-	0x53, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x4f, 0x4c, 0x55,
-	0x4d, 0x4e, 0x53, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x49, 0x4e,
-	0x44, 0x45, 0x58, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x56, 0x41,
-	0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x4f,
-	0x57, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x11, 0x12, 0x0f, 0x0a, 0x0b,
-	0x53, 0x48, 0x4f, 0x57, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x12, 0x12, 0x0f, 0x0a,
-	0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x13, 0x12, 0x14,
-	0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4c, 0x49,
-	0x53, 0x54, 0x10, 0x14, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e,
-	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x47,
-	0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
-	0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x4a, 0x0a,
-	0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x02,
+	0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x1d,
+	0x0a, 0x07, 0x56, 0x69, 0x65, 0x77, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x9e, 0x02,
+	0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x1a, 0xa3, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x66,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66,
+	0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x65, 0x66, 0x48,
+	0x00, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22, 0x72,
+	0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f,
+	0x77, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x77,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x6e, 0x64, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x75,
+	0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52,
-	0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x5a, 0x0a, 0x09, 0x44, 0x72, 0x6f,
-	0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e,
-	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x22,
-	0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x5b, 0x0a, 0x0b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x61,
+	0x67, 0x22, 0x4c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0xec, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c,
+	0x61, 0x67, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x07,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a,
+	0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x20,
+	0x0a, 0x08, 0x61, 0x67, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x61, 0x67, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x08,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x53,
+	0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53,
+	0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
+	0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45,
+	0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x14,
+	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x54,
+	0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x22,
+	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10,
+	0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53,
+	0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35, 0x22, 0x55, 0x0a,
+	0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x4e, 0x54,
+	0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x08, 0x12,
+	0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x50, 0x50,
+	0x4c, 0x59, 0x10, 0x20, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x54,
+	0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x22, 0xe5,
+	0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x6d, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52,
+	0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x08,
+	0x74, 0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x63, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2e, 0x0a,
+	0x07, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x47, 0x49,
+	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x08, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x22, 0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
+	0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7b, 0x0a,
+	0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c, 0x12, 0x23, 0x0a,
+	0x03, 0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64,
+	0x64, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xf9, 0x08, 0x0a, 0x0e, 0x44,
+	0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x08, 0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x3a, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44, 0x72,
+	0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x72,
+	0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2e, 0x0a,
+	0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a,
+	0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a,
+	0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52,
+	0x09, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x68,
+	0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x73,
+	0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xc9, 0x03, 0x0a,
+	0x07, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41,
+	0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54,
+	0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52,
+	0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x41, 0x54,
+	0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x53, 0x10,
+	0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53,
+	0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d,
+	0x4e, 0x53, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x58, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x56, 0x41, 0x52,
+	0x49, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x4f, 0x57,
+	0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x11, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x48, 0x4f, 0x57, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x12, 0x12, 0x0f, 0x0a, 0x0b,
+	0x53, 0x48, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x13, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4c, 0x49, 0x53,
+	0x54, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x49,
+	0x45, 0x57, 0x10, 0x15, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x56, 0x49, 0x45,
+	0x57, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x17, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
+	0x66, 0x22, 0x4a, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x5a, 0x0a,
+	0x09, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66,
+	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
 	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x0d, 0x53, 0x68, 0x6f,
-	0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x2a,
-	0x21, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x7a, 0x34,
-	0x10, 0x01, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
-	0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f,
-	0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41,
-	0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x78, 0x22, 0x22, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x44, 0x0a,
+	0x0d, 0x53, 0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x2a, 0x37, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x7a, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x73, 0x74, 0x64, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x79, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x18,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x07,
+	0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5061,7 +5177,7 @@ func file_plan_proto_rawDescGZIP() []byte {
 }
 
 var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_plan_proto_goTypes = []interface{}{
 	(CompressType)(0),                   // 0: CompressType
 	(TransationCompletionType)(0),       // 1: TransationCompletionType
@@ -5095,119 +5211,122 @@ var file_plan_proto_goTypes = []interface{}{
 	(*PrimaryKeyDef)(nil),               // 29: PrimaryKeyDef
 	(*Property)(nil),                    // 30: Property
 	(*PropertiesDef)(nil),               // 31: PropertiesDef
-	(*TableDef)(nil),                    // 32: TableDef
-	(*Cost)(nil),                        // 33: Cost
-	(*ColData)(nil),                     // 34: ColData
-	(*RowsetData)(nil),                  // 35: RowsetData
-	(*OrderBySpec)(nil),                 // 36: OrderBySpec
-	(*WindowSpec)(nil),                  // 37: WindowSpec
-	(*UpdateList)(nil),                  // 38: UpdateList
-	(*Node)(nil),                        // 39: Node
-	(*Query)(nil),                       // 40: Query
-	(*TransationControl)(nil),           // 41: TransationControl
-	(*TransationBegin)(nil),             // 42: TransationBegin
-	(*TransationCommit)(nil),            // 43: TransationCommit
-	(*TransationRollback)(nil),          // 44: TransationRollback
-	(*Plan)(nil),                        // 45: Plan
-	(*DataDefinition)(nil),              // 46: DataDefinition
-	(*CreateDatabase)(nil),              // 47: CreateDatabase
-	(*AlterDatabase)(nil),               // 48: AlterDatabase
-	(*DropDatabase)(nil),                // 49: DropDatabase
-	(*CreateTable)(nil),                 // 50: CreateTable
-	(*AlterTable)(nil),                  // 51: AlterTable
-	(*DropTable)(nil),                   // 52: DropTable
-	(*CreateIndex)(nil),                 // 53: CreateIndex
-	(*AlterIndex)(nil),                  // 54: AlterIndex
-	(*DropIndex)(nil),                   // 55: DropIndex
-	(*TruncateTable)(nil),               // 56: TruncateTable
-	(*ShowVariables)(nil),               // 57: ShowVariables
-	(*TableDef_DefType)(nil),            // 58: TableDef.DefType
+	(*ViewDef)(nil),                     // 32: ViewDef
+	(*TableDef)(nil),                    // 33: TableDef
+	(*Cost)(nil),                        // 34: Cost
+	(*ColData)(nil),                     // 35: ColData
+	(*RowsetData)(nil),                  // 36: RowsetData
+	(*OrderBySpec)(nil),                 // 37: OrderBySpec
+	(*WindowSpec)(nil),                  // 38: WindowSpec
+	(*UpdateList)(nil),                  // 39: UpdateList
+	(*Node)(nil),                        // 40: Node
+	(*Query)(nil),                       // 41: Query
+	(*TransationControl)(nil),           // 42: TransationControl
+	(*TransationBegin)(nil),             // 43: TransationBegin
+	(*TransationCommit)(nil),            // 44: TransationCommit
+	(*TransationRollback)(nil),          // 45: TransationRollback
+	(*Plan)(nil),                        // 46: Plan
+	(*DataDefinition)(nil),              // 47: DataDefinition
+	(*CreateDatabase)(nil),              // 48: CreateDatabase
+	(*AlterDatabase)(nil),               // 49: AlterDatabase
+	(*DropDatabase)(nil),                // 50: DropDatabase
+	(*CreateTable)(nil),                 // 51: CreateTable
+	(*AlterTable)(nil),                  // 52: AlterTable
+	(*DropTable)(nil),                   // 53: DropTable
+	(*CreateIndex)(nil),                 // 54: CreateIndex
+	(*AlterIndex)(nil),                  // 55: AlterIndex
+	(*DropIndex)(nil),                   // 56: DropIndex
+	(*TruncateTable)(nil),               // 57: TruncateTable
+	(*ShowVariables)(nil),               // 58: ShowVariables
+	(*TableDef_DefType)(nil),            // 59: TableDef.DefType
 }
 var file_plan_proto_depIdxs = []int32{
 	2,  // 0: Type.id:type_name -> Type.TypeId
 	23, // 1: ExprList.list:type_name -> Expr
 	21, // 2: Function.func:type_name -> ObjectRef
 	23, // 3: Function.args:type_name -> Expr
-	13, // 4: Expr.typ:type_name -> Type
-	14, // 5: Expr.c:type_name -> Const
-	15, // 6: Expr.p:type_name -> ParamRef
-	16, // 7: Expr.v:type_name -> VarRef
-	17, // 8: Expr.col:type_name -> ColRef
-	22, // 9: Expr.f:type_name -> Function
-	19, // 10: Expr.list:type_name -> ExprList
-	20, // 11: Expr.sub:type_name -> SubQuery
-	18, // 12: Expr.corr:type_name -> CorrColRef
-	25, // 13: DefaultExpr.value:type_name -> ConstantValue
-	26, // 14: ConstantValue.decimal128_v:type_name -> decimal128
-	0,  // 15: ColDef.alg:type_name -> CompressType
-	13, // 16: ColDef.typ:type_name -> Type
-	24, // 17: ColDef.default:type_name -> DefaultExpr
-	4,  // 18: IndexDef.typ:type_name -> IndexDef.IndexType
-	30, // 19: PropertiesDef.properties:type_name -> Property
-	27, // 20: TableDef.cols:type_name -> ColDef
-	58, // 21: TableDef.defs:type_name -> TableDef.DefType
-	32, // 22: RowsetData.schema:type_name -> TableDef
-	34, // 23: RowsetData.cols:type_name -> ColData
-	23, // 24: OrderBySpec.expr:type_name -> Expr
-	5,  // 25: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
-	23, // 26: WindowSpec.partition_by:type_name -> Expr
-	36, // 27: WindowSpec.order_by:type_name -> OrderBySpec
-	23, // 28: UpdateList.columns:type_name -> Expr
-	23, // 29: UpdateList.values:type_name -> Expr
-	6,  // 30: Node.node_type:type_name -> Node.NodeType
-	33, // 31: Node.cost:type_name -> Cost
-	23, // 32: Node.project_list:type_name -> Expr
-	7,  // 33: Node.join_type:type_name -> Node.JoinFlag
-	23, // 34: Node.on_list:type_name -> Expr
-	23, // 35: Node.where_list:type_name -> Expr
-	23, // 36: Node.group_by:type_name -> Expr
-	23, // 37: Node.grouping_set:type_name -> Expr
-	23, // 38: Node.agg_list:type_name -> Expr
-	36, // 39: Node.order_by:type_name -> OrderBySpec
-	38, // 40: Node.update_list:type_name -> UpdateList
-	37, // 41: Node.win_spec:type_name -> WindowSpec
-	23, // 42: Node.limit:type_name -> Expr
-	23, // 43: Node.offset:type_name -> Expr
-	32, // 44: Node.table_def:type_name -> TableDef
-	21, // 45: Node.obj_ref:type_name -> ObjectRef
-	35, // 46: Node.rowset_data:type_name -> RowsetData
-	9,  // 47: Query.stmt_type:type_name -> Query.StatementType
-	39, // 48: Query.nodes:type_name -> Node
-	23, // 49: Query.params:type_name -> Expr
-	10, // 50: TransationControl.tcl_type:type_name -> TransationControl.TclType
-	42, // 51: TransationControl.begin:type_name -> TransationBegin
-	43, // 52: TransationControl.commit:type_name -> TransationCommit
-	44, // 53: TransationControl.rollback:type_name -> TransationRollback
-	11, // 54: TransationBegin.mode:type_name -> TransationBegin.TransationMode
-	1,  // 55: TransationCommit.completion_type:type_name -> TransationCompletionType
-	1,  // 56: TransationRollback.completion_type:type_name -> TransationCompletionType
-	40, // 57: Plan.query:type_name -> Query
-	41, // 58: Plan.tcl:type_name -> TransationControl
-	46, // 59: Plan.ddl:type_name -> DataDefinition
-	12, // 60: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
-	40, // 61: DataDefinition.query:type_name -> Query
-	47, // 62: DataDefinition.create_database:type_name -> CreateDatabase
-	48, // 63: DataDefinition.alter_database:type_name -> AlterDatabase
-	49, // 64: DataDefinition.drop_database:type_name -> DropDatabase
-	50, // 65: DataDefinition.create_table:type_name -> CreateTable
-	51, // 66: DataDefinition.alter_table:type_name -> AlterTable
-	52, // 67: DataDefinition.drop_table:type_name -> DropTable
-	53, // 68: DataDefinition.create_index:type_name -> CreateIndex
-	54, // 69: DataDefinition.alter_index:type_name -> AlterIndex
-	55, // 70: DataDefinition.drop_index:type_name -> DropIndex
-	56, // 71: DataDefinition.truncate_table:type_name -> TruncateTable
-	57, // 72: DataDefinition.show_variables:type_name -> ShowVariables
-	32, // 73: CreateTable.table_def:type_name -> TableDef
-	32, // 74: AlterTable.table_def:type_name -> TableDef
-	23, // 75: ShowVariables.where:type_name -> Expr
-	29, // 76: TableDef.DefType.pk:type_name -> PrimaryKeyDef
-	28, // 77: TableDef.DefType.idx:type_name -> IndexDef
-	31, // 78: TableDef.DefType.properties:type_name -> PropertiesDef
-	79, // [79:79] is the sub-list for method output_type
-	79, // [79:79] is the sub-list for method input_type
-	79, // [79:79] is the sub-list for extension type_name
-	79, // [79:79] is the sub-list for extension extendee
-	0,  // [0:79] is the sub-list for field type_name
+	37, // 4: Function.order_by:type_name -> OrderBySpec
+	13, // 5: Expr.typ:type_name -> Type
+	14, // 6: Expr.c:type_name -> Const
+	15, // 7: Expr.p:type_name -> ParamRef
+	16, // 8: Expr.v:type_name -> VarRef
+	17, // 9: Expr.col:type_name -> ColRef
+	22, // 10: Expr.f:type_name -> Function
+	19, // 11: Expr.list:type_name -> ExprList
+	20, // 12: Expr.sub:type_name -> SubQuery
+	18, // 13: Expr.corr:type_name -> CorrColRef
+	25, // 14: DefaultExpr.value:type_name -> ConstantValue
+	26, // 15: ConstantValue.decimal128_v:type_name -> decimal128
+	0,  // 16: ColDef.alg:type_name -> CompressType
+	13, // 17: ColDef.typ:type_name -> Type
+	24, // 18: ColDef.default:type_name -> DefaultExpr
+	4,  // 19: IndexDef.typ:type_name -> IndexDef.IndexType
+	30, // 20: PropertiesDef.properties:type_name -> Property
+	27, // 21: TableDef.cols:type_name -> ColDef
+	59, // 22: TableDef.defs:type_name -> TableDef.DefType
+	33, // 23: RowsetData.schema:type_name -> TableDef
+	35, // 24: RowsetData.cols:type_name -> ColData
+	23, // 25: OrderBySpec.expr:type_name -> Expr
+	5,  // 26: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
+	23, // 27: WindowSpec.partition_by:type_name -> Expr
+	37, // 28: WindowSpec.order_by:type_name -> OrderBySpec
+	23, // 29: UpdateList.columns:type_name -> Expr
+	23, // 30: UpdateList.values:type_name -> Expr
+	6,  // 31: Node.node_type:type_name -> Node.NodeType
+	34, // 32: Node.cost:type_name -> Cost
+	23, // 33: Node.project_list:type_name -> Expr
+	7,  // 34: Node.join_type:type_name -> Node.JoinFlag
+	23, // 35: Node.on_list:type_name -> Expr
+	23, // 36: Node.where_list:type_name -> Expr
+	23, // 37: Node.group_by:type_name -> Expr
+	23, // 38: Node.grouping_set:type_name -> Expr
+	23, // 39: Node.agg_list:type_name -> Expr
+	37, // 40: Node.order_by:type_name -> OrderBySpec
+	39, // 41: Node.update_list:type_name -> UpdateList
+	38, // 42: Node.win_spec:type_name -> WindowSpec
+	23, // 43: Node.limit:type_name -> Expr
+	23, // 44: Node.offset:type_name -> Expr
+	33, // 45: Node.table_def:type_name -> TableDef
+	21, // 46: Node.obj_ref:type_name -> ObjectRef
+	36, // 47: Node.rowset_data:type_name -> RowsetData
+	9,  // 48: Query.stmt_type:type_name -> Query.StatementType
+	40, // 49: Query.nodes:type_name -> Node
+	23, // 50: Query.params:type_name -> Expr
+	10, // 51: TransationControl.tcl_type:type_name -> TransationControl.TclType
+	43, // 52: TransationControl.begin:type_name -> TransationBegin
+	44, // 53: TransationControl.commit:type_name -> TransationCommit
+	45, // 54: TransationControl.rollback:type_name -> TransationRollback
+	11, // 55: TransationBegin.mode:type_name -> TransationBegin.TransationMode
+	1,  // 56: TransationCommit.completion_type:type_name -> TransationCompletionType
+	1,  // 57: TransationRollback.completion_type:type_name -> TransationCompletionType
+	41, // 58: Plan.query:type_name -> Query
+	42, // 59: Plan.tcl:type_name -> TransationControl
+	47, // 60: Plan.ddl:type_name -> DataDefinition
+	12, // 61: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
+	41, // 62: DataDefinition.query:type_name -> Query
+	48, // 63: DataDefinition.create_database:type_name -> CreateDatabase
+	49, // 64: DataDefinition.alter_database:type_name -> AlterDatabase
+	50, // 65: DataDefinition.drop_database:type_name -> DropDatabase
+	51, // 66: DataDefinition.create_table:type_name -> CreateTable
+	52, // 67: DataDefinition.alter_table:type_name -> AlterTable
+	53, // 68: DataDefinition.drop_table:type_name -> DropTable
+	54, // 69: DataDefinition.create_index:type_name -> CreateIndex
+	55, // 70: DataDefinition.alter_index:type_name -> AlterIndex
+	56, // 71: DataDefinition.drop_index:type_name -> DropIndex
+	57, // 72: DataDefinition.truncate_table:type_name -> TruncateTable
+	58, // 73: DataDefinition.show_variables:type_name -> ShowVariables
+	33, // 74: CreateTable.table_def:type_name -> TableDef
+	33, // 75: AlterTable.table_def:type_name -> TableDef
+	23, // 76: ShowVariables.where:type_name -> Expr
+	29, // 77: TableDef.DefType.pk:type_name -> PrimaryKeyDef
+	28, // 78: TableDef.DefType.idx:type_name -> IndexDef
+	31, // 79: TableDef.DefType.properties:type_name -> PropertiesDef
+	32, // 80: TableDef.DefType.view:type_name -> ViewDef
+	81, // [81:81] is the sub-list for method output_type
+	81, // [81:81] is the sub-list for method input_type
+	81, // [81:81] is the sub-list for extension type_name
+	81, // [81:81] is the sub-list for extension extendee
+	0,  // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_plan_proto_init() }
@@ -5445,7 +5564,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableDef); i {
+			switch v := v.(*ViewDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5457,7 +5576,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cost); i {
+			switch v := v.(*TableDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5469,7 +5588,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColData); i {
+			switch v := v.(*Cost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5481,7 +5600,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RowsetData); i {
+			switch v := v.(*ColData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5493,7 +5612,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBySpec); i {
+			switch v := v.(*RowsetData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5505,7 +5624,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindowSpec); i {
+			switch v := v.(*OrderBySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5517,7 +5636,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateList); i {
+			switch v := v.(*WindowSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5529,7 +5648,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+			switch v := v.(*UpdateList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5541,7 +5660,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5553,7 +5672,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationControl); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5565,7 +5684,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationBegin); i {
+			switch v := v.(*TransationControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5577,7 +5696,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationCommit); i {
+			switch v := v.(*TransationBegin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5589,7 +5708,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationRollback); i {
+			switch v := v.(*TransationCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5601,7 +5720,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Plan); i {
+			switch v := v.(*TransationRollback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5613,7 +5732,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataDefinition); i {
+			switch v := v.(*Plan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5625,7 +5744,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabase); i {
+			switch v := v.(*DataDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5637,7 +5756,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterDatabase); i {
+			switch v := v.(*CreateDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5649,7 +5768,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropDatabase); i {
+			switch v := v.(*AlterDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5661,7 +5780,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTable); i {
+			switch v := v.(*DropDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5673,7 +5792,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterTable); i {
+			switch v := v.(*CreateTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5685,7 +5804,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropTable); i {
+			switch v := v.(*AlterTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5697,7 +5816,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateIndex); i {
+			switch v := v.(*DropTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5709,7 +5828,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterIndex); i {
+			switch v := v.(*CreateIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5721,7 +5840,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropIndex); i {
+			switch v := v.(*AlterIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5733,7 +5852,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TruncateTable); i {
+			switch v := v.(*DropIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5745,7 +5864,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowVariables); i {
+			switch v := v.(*TruncateTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5757,6 +5876,18 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowVariables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TableDef_DefType); i {
 			case 0:
 				return &v.state
@@ -5798,17 +5929,17 @@ func file_plan_proto_init() {
 		(*ConstantValue_TimeStampV)(nil),
 		(*ConstantValue_StringV)(nil),
 	}
-	file_plan_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*TransationControl_Begin)(nil),
 		(*TransationControl_Commit)(nil),
 		(*TransationControl_Rollback)(nil),
 	}
-	file_plan_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*Plan_Query)(nil),
 		(*Plan_Tcl)(nil),
 		(*Plan_Ddl)(nil),
 	}
-	file_plan_proto_msgTypes[33].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*DataDefinition_CreateDatabase)(nil),
 		(*DataDefinition_AlterDatabase)(nil),
 		(*DataDefinition_DropDatabase)(nil),
@@ -5821,10 +5952,11 @@ func file_plan_proto_init() {
 		(*DataDefinition_TruncateTable)(nil),
 		(*DataDefinition_ShowVariables)(nil),
 	}
-	file_plan_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*TableDef_DefType_Pk)(nil),
 		(*TableDef_DefType_Idx)(nil),
 		(*TableDef_DefType_Properties)(nil),
+		(*TableDef_DefType_View)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5832,7 +5964,7 @@ func file_plan_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plan_proto_rawDesc,
 			NumEnums:      13,
-			NumMessages:   46,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
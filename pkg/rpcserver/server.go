@@ -45,9 +45,10 @@ func (s *server) Run() error {
 	return s.app.Start()
 }
 
+// Register adds a handler and returns its command id, which is the Cmd of the messages it handles
 func (s *server) Register(f func(uint64, interface{}, goetty.IOSession) error) int {
 	s.fs = append(s.fs, f)
-	return len(s.fs)
+	return len(s.fs) - 1
 }
 
 func (s *server) onMessage(sess goetty.IOSession, value interface{}, seq uint64) error {
@@ -79,6 +79,13 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			int64s.Sort(vec.Col.([]int64), os)
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)
+		if desc {
+			dint64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		} else {
+			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		}
 	case types.T_datetime:
 		vs := vec.Col.([]types.Datetime)
 		if desc {
@@ -122,7 +129,8 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			float64s.Sort(vec.Col.([]float64), os)
 		}
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar,
+		types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		if desc {
 			dvarchar.Sort(vec.Col.(*types.Bytes), os)
 		} else {
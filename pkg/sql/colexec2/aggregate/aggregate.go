@@ -27,11 +27,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/mode"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 )
 
 var sumReturnTypes = map[types.T]types.T{
@@ -71,12 +76,32 @@ func ReturnType(op int, typ types.T) types.T {
 		return types.T_uint64
 	case StdDevPop:
 		return types.T_float64
+	case GroupConcat:
+		return types.T_varchar
+	case Median, PercentileCont:
+		return types.T_float64
+	case Mode:
+		return typ
 	}
 	return 0
 }
 
-func New(op int, typ types.Type) (ring.Ring, error) {
-	switch op {
+// New returns the ring of the aggregation, the ring only counts the
+// distinct values if dist is true.
+func New(agg Aggregate, typ types.Type) (ring.Ring, error) {
+	r, err := newRing(agg, typ)
+	if err != nil || !agg.Dist {
+		return r, err
+	}
+	switch agg.Op {
+	case Sum, Avg, Count:
+		return distinct.NewDistinct(r, typ), nil
+	}
+	return nil, fmt.Errorf("'%s' not support distinct", Names[agg.Op])
+}
+
+func newRing(agg Aggregate, typ types.Type) (ring.Ring, error) {
+	switch agg.Op {
 	case Sum:
 		return NewSum(typ)
 	case Avg:
@@ -104,10 +129,30 @@ func New(op int, typ types.Type) (ring.Ring, error) {
 		return NewBitOr(typ)
 	case StdDevPop:
 		return stddevpop.NewStdDevPopRingWithTypeCheck(typ)
+	case GroupConcat:
+		desc := make([]bool, len(agg.Orders))
+		for i, o := range agg.Orders {
+			desc[i] = o.Flag&plan.OrderBySpec_DESC != 0
+		}
+		return groupconcat.NewGroupConcat(typ, agg.Sep, desc), nil
+	case Median:
+		return NewPercentile(typ, 0.5)
+	case PercentileCont:
+		return NewPercentile(typ, agg.Frac)
+	case Mode:
+		return mode.NewMode(typ), nil
 	}
 	return nil, nil
 }
 
+func NewPercentile(typ types.Type, p float64) (ring.Ring, error) {
+	switch typ.Oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
+		return percentile.NewPercentile(typ, p), nil
+	}
+	return nil, fmt.Errorf("'%v' not support Percentile", typ)
+}
+
 func NewBitAnd(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
@@ -29,6 +29,10 @@ const (
 	BitXor
 	BitOr
 	StdDevPop
+	GroupConcat
+	Median
+	PercentileCont
+	Mode
 )
 
 var Names = [...]string{
@@ -44,9 +48,20 @@ var Names = [...]string{
 	BitXor:              "bit_xor",
 	BitOr:               "bit_or",
 	StdDevPop:           "stddev_pop",
+	GroupConcat:         "group_concat",
+	Median:              "median",
+	PercentileCont:      "percentile_cont",
+	Mode:                "mode",
 }
 
 type Aggregate struct {
-	Op int
-	E  *plan.Expr
+	Op   int
+	Dist bool // only the distinct values are aggregated
+	E    *plan.Expr
+	// Orders are the order by expressions of group_concat
+	Orders []*plan.OrderBySpec
+	// Sep is the separator of group_concat
+	Sep string
+	// Frac is the percentile of percentile_cont
+	Frac float64
 }
@@ -85,10 +85,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return true, err
+			}
 			if bat == nil {
 				ctr.state = End
-				ctr.bat.Clean(proc.Mp)
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -109,15 +114,19 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	if ap.IsPreBuild {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		return nil
 	}
-	var err error
-
 	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
 			break
 		}
@@ -137,6 +146,9 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		}
 		bat.Clean(proc.Mp)
 	}
+	if ctr.bat == nil { // the build side is empty
+		return nil
+	}
 	count := len(ctr.bat.Zs)
 	for i := 0; i < count; i += UnitLimit {
 		n := count - i
@@ -176,7 +188,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				} else {
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
+							ctr.zValues[k] = 0
 						} else {
 							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
 						}
@@ -252,7 +264,7 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 				} else {
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
+							ctr.zValues[k] = 0
 						} else {
 							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
 						}
@@ -270,10 +282,8 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 			ctr.keys[k] = ctr.keys[k][:0]
 		}
 		for k := 0; k < n; k++ {
-			if ctr.zValues[k] == 0 {
-				continue
-			}
-			if ctr.values[k] != 0 {
+			// a null key never matches, so the row belongs to the complement
+			if ctr.zValues[k] != 0 && ctr.values[k] != 0 {
 				continue
 			}
 			for j, pos := range ap.Result {
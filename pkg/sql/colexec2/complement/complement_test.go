@@ -200,6 +200,62 @@ func TestComplement(t *testing.T) {
 	}
 }
 
+func TestComplementRows(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []int32{0},
+		[][]Condition{
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+		})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows/2)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	ok, err := Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+	// rows 5-9 have no match and row 0 has a null key
+	require.Equal(t, Rows/2+1, len(tc.proc.Reg.InputBatch.Zs))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	ok, err = Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
+func TestComplementEmptyBuild(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []int32{0},
+		[][]Condition{
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+		})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	ok, err := Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, Rows, len(tc.proc.Reg.InputBatch.Zs))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	ok, err = Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
 func BenchmarkComplement(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
@@ -40,12 +41,19 @@ func String(arg interface{}, buf *bytes.Buffer) {
 		}
 		buf.WriteString(fmt.Sprintf("%v", expr))
 	}
+	if len(ap.GroupingSets) > 0 {
+		buf.WriteString(fmt.Sprintf(", grouping sets %v", ap.GroupingSets))
+	}
 	buf.WriteString("], [")
 	for i, agg := range ap.Aggs {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(fmt.Sprintf("%v(%v)", aggregate.Names[agg.Op], agg.E))
+		if agg.Dist {
+			buf.WriteString(fmt.Sprintf("%v(distinct %v)", aggregate.Names[agg.Op], agg.E))
+		} else {
+			buf.WriteString(fmt.Sprintf("%v(%v)", aggregate.Names[agg.Op], agg.E))
+		}
 	}
 	buf.WriteString("])")
 }
@@ -114,7 +122,7 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 		ctr.bat.Zs = []int64{0}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.New(agg, ctr.aggVecs[i].vec.Typ); err != nil {
 				return false, err
 			}
 		}
@@ -125,10 +133,19 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 			}
 		}
 	}
+	if err := ctr.evalOrders(bat, ap, proc); err != nil {
+		return false, err
+	}
+	defer ctr.cleanOrders(proc)
 	if err := ctr.processH0(bat, ap, proc); err != nil {
 		ctr.bat.Clean(proc.Mp)
 		return false, err
 	}
+	if err := ring.Err(ctr.bat.Rs); err != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+		return false, err
+	}
 	return false, nil
 }
 
@@ -218,9 +235,16 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			}
 		}
 	}()
+	var sets [][]evalVector
+	if len(ap.GroupingSets) > 0 {
+		sets = ctr.groupingSetVecs(bat, ap)
+		vecs := ctr.groupVecs
+		defer func() { ctr.groupVecs = vecs }()
+		ctr.groupVecs = sets[0]
+	}
 	if ctr.bat == nil {
 		size := 0
-		ctr.bat = batch.NewWithSize(len(ap.Exprs))
+		ctr.bat = batch.NewWithSize(len(ctr.groupVecs))
 		for i := range ctr.groupVecs {
 			vec := ctr.groupVecs[i].vec
 			ctr.bat.Vecs[i] = vector.New(vec.Typ)
@@ -245,7 +269,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.New(agg, ctr.aggVecs[i].vec.Typ); err != nil {
 				return false, err
 			}
 		}
@@ -285,24 +309,140 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			ctr.strHashMap.Init()
 		}
 	}
+	if err = ctr.evalOrders(bat, ap, proc); err != nil {
+		return false, err
+	}
+	defer ctr.cleanOrders(proc)
+	if len(sets) == 0 {
+		err = ctr.processHx(bat, ap, proc)
+	}
+	// all the grouping sets are evaluated over the same input batch
+	for _, vecs := range sets {
+		ctr.groupVecs = vecs
+		if err = ctr.processHx(bat, ap, proc); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = ring.Err(ctr.bat.Rs)
+	}
+	if err != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+		return false, err
+	}
+	return false, err
+}
+
+func (ctr *Container) processHx(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	switch ctr.typ {
 	case H8:
-		err = ctr.processH8(bat, ap, proc)
+		return ctr.processH8(bat, ap, proc)
 	case H24:
-		err = ctr.processH24(bat, ap, proc)
+		return ctr.processH24(bat, ap, proc)
 	case H32:
-		err = ctr.processH32(bat, ap, proc)
+		return ctr.processH32(bat, ap, proc)
 	case H40:
-		err = ctr.processH40(bat, ap, proc)
+		return ctr.processH40(bat, ap, proc)
 	default:
-		err = ctr.processHStr(bat, ap, proc)
+		return ctr.processHStr(bat, ap, proc)
 	}
-	if err != nil {
-		ctr.bat.Clean(proc.Mp)
-		ctr.bat = nil
-		return false, err
+}
+
+// groupingSetVecs returns the group vectors of each grouping set. The columns out of
+// the set are replaced by all null vectors, and the grouping set id, whose bit i is
+// set if the i-th group column is out of the set, is appended as the last group column.
+func (ctr *Container) groupingSetVecs(bat *batch.Batch, ap *Argument) [][]evalVector {
+	count := len(bat.Zs)
+	rows := make([]uint64, count)
+	for i := range rows {
+		rows[i] = uint64(i)
+	}
+	allNulls := &nulls.Nulls{}
+	nulls.Add(allNulls, rows...)
+
+	sets := make([][]evalVector, len(ap.GroupingSets))
+	for i, set := range ap.GroupingSets {
+		var gid int64
+
+		vecs := make([]evalVector, len(ctr.groupVecs)+1)
+		for j := range ctr.groupVecs {
+			vecs[j].vec = ctr.groupVecs[j].vec
+			if !inGroupingSet(set, j) {
+				vec := *ctr.groupVecs[j].vec
+				vec.Nsp = allNulls
+				vecs[j].vec = &vec
+				gid |= 1 << j
+			}
+		}
+		gids := make([]int64, count)
+		for k := range gids {
+			gids[k] = gid
+		}
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		vec.Data = encoding.EncodeInt64Slice(gids)
+		vec.Col = gids
+		vecs[len(ctr.groupVecs)].vec = vec
+		sets[i] = vecs
+	}
+	return sets
+}
+
+func inGroupingSet(set []int32, pos int) bool {
+	for _, p := range set {
+		if int(p) == pos {
+			return true
+		}
+	}
+	return false
+}
+
+// evalOrders evaluates the order by expressions of the aggregations and
+// passes their vectors to the rings which depend on the order of the rows.
+func (ctr *Container) evalOrders(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if len(ctr.orderVecs) == 0 {
+		ctr.orderVecs = make([][]evalVector, len(ap.Aggs))
+	}
+	for i, agg := range ap.Aggs {
+		if len(agg.Orders) == 0 {
+			continue
+		}
+		r, ok := ctr.bat.Rs[i].(ring.OrderedRing)
+		if !ok {
+			continue
+		}
+		ctr.orderVecs[i] = ctr.orderVecs[i][:0]
+		vecs := make([]*vector.Vector, len(agg.Orders))
+		for j, o := range agg.Orders {
+			vec, err := colexec.EvalExpr(bat, proc, o.Expr)
+			if err != nil {
+				ctr.cleanOrders(proc)
+				return err
+			}
+			needFree := true
+			for k := range bat.Vecs {
+				if bat.Vecs[k] == vec {
+					needFree = false
+					break
+				}
+			}
+			ctr.orderVecs[i] = append(ctr.orderVecs[i], evalVector{vec: vec, needFree: needFree})
+			vecs[j] = vec
+		}
+		r.SetOrderVecs(vecs)
+	}
+	return nil
+}
+
+func (ctr *Container) cleanOrders(proc *process.Process) {
+	for i := range ctr.orderVecs {
+		for _, evec := range ctr.orderVecs[i] {
+			if evec.needFree {
+				vector.Clean(evec.vec, proc.Mp)
+			}
+		}
+		ctr.orderVecs[i] = ctr.orderVecs[i][:0]
 	}
-	return false, err
 }
 
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
@@ -90,6 +90,30 @@ func init() {
 			{Oid: types.T_varchar},
 			{Oid: types.T_decimal128},
 		}, []*plan.Expr{newExpression(1), newExpression(2), newExpression(3)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []*plan.Expr{}, []aggregate.Aggregate{{Op: aggregate.Count, Dist: true, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_varchar},
+		}, []*plan.Expr{newExpression(0)}, []aggregate.Aggregate{{Op: aggregate.Count, Dist: true, E: newExpression(1)}, {Op: aggregate.Avg, Dist: true, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_varchar},
+		}, []*plan.Expr{}, []aggregate.Aggregate{
+			{Op: aggregate.GroupConcat, E: newExpression(1), Sep: ";", Orders: []*plan.OrderBySpec{{Expr: newExpression(0), Flag: plan.OrderBySpec_DESC}}},
+			{Op: aggregate.PercentileCont, E: newExpression(0), Frac: 0.9},
+		}),
+		newTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_varchar},
+		}, []*plan.Expr{newExpression(0)}, []aggregate.Aggregate{
+			{Op: aggregate.GroupConcat, E: newExpression(0), Sep: ",", Orders: []*plan.OrderBySpec{{Expr: newExpression(1)}}},
+			{Op: aggregate.Median, E: newExpression(0)},
+			{Op: aggregate.Mode, E: newExpression(1)},
+		}),
+		newGroupingSetsTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_varchar},
+		}, []*plan.Expr{newExpression(0), newExpression(1)}, []aggregate.Aggregate{{Op: aggregate.Count, E: newExpression(0)}}, [][]int32{{0, 1}, {0}, {}}),
 	}
 }
 
@@ -132,6 +156,37 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupingSets(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newGroupingSetsTestCase(mheap.New(gm), []bool{false, false}, []types.Type{
+		{Oid: types.T_int64},
+		{Oid: types.T_int64},
+	}, []*plan.Expr{newExpression(0), newExpression(1)}, []aggregate.Aggregate{{Op: aggregate.Count, E: newExpression(0)}}, [][]int32{{0, 1}, {0}, {}})
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	_, err := Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = nil
+	_, err = Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+
+	bat := tc.proc.Reg.InputBatch
+	require.Equal(t, 3, len(bat.Vecs))
+	require.Equal(t, 2*Rows+1, len(bat.Zs))
+	// the grouping set ids follow the group columns
+	cnts := make(map[int64]int64)
+	for i, gid := range bat.Vecs[2].Col.([]int64) {
+		cnts[gid] += bat.Zs[i]
+		if gid&2 != 0 {
+			require.True(t, nulls.Contains(bat.Vecs[1].Nsp, uint64(i)))
+		}
+	}
+	require.Equal(t, map[int64]int64{0: Rows, 2: Rows, 3: Rows}, cnts)
+	bat.Clean(tc.proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -175,6 +230,12 @@ func newTestCase(m *mheap.Mheap, flgs []bool, ts []types.Type, exprs []*plan.Exp
 	}
 }
 
+func newGroupingSetsTestCase(m *mheap.Mheap, flgs []bool, ts []types.Type, exprs []*plan.Expr, aggs []aggregate.Aggregate, sets [][]int32) groupTestCase {
+	tc := newTestCase(m, flgs, ts, exprs, aggs)
+	tc.arg.GroupingSets = sets
+	return tc
+}
+
 func newExpression(pos int32) *plan.Expr {
 	return &plan.Expr{
 		Expr: &plan.Expr_Col{
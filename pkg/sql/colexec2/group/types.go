@@ -54,6 +54,8 @@ type Container struct {
 
 	aggVecs   []evalVector
 	groupVecs []evalVector
+	// orderVecs[i] are the vectors of the order by expressions of the i-th aggregation
+	orderVecs [][]evalVector
 
 	h8 struct {
 		keys  []uint64
@@ -81,4 +83,7 @@ type Argument struct {
 	ctr   *Container
 	Exprs []*plan.Expr          // group Expressions
 	Aggs  []aggregate.Aggregate // aggregations
+	// GroupingSets are the positions of the group expressions in each grouping set,
+	// the grouping set id is emitted after the group columns if it's not empty
+	GroupingSets [][]int32
 }
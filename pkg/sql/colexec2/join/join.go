@@ -98,7 +98,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return true, err
+			}
 			if bat == nil {
 				ctr.state = End
 				ctr.bat.Clean(proc.Mp)
@@ -122,16 +125,20 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	if ap.IsPreBuild {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		return nil
 	}
 	if ctr.flg {
-		var err error
-
 		for {
-			bat := <-proc.Reg.MergeReceivers[1].Ch
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				break
 			}
@@ -221,7 +228,10 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		return nil
 	}
 	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
 			return nil
 		}
@@ -0,0 +1,176 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"golang.org/x/exp/constraints"
+)
+
+// compareFunc returns the ordering of lv[li] and rv[ri], both of which are not null.
+type compareFunc func(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int
+
+func newCompare(l, r types.Type) (compareFunc, error) {
+	if l.Oid == r.Oid {
+		switch l.Oid {
+		case types.T_bool:
+			return compareBool, nil
+		case types.T_int8:
+			return compareFixed[int8], nil
+		case types.T_int16:
+			return compareFixed[int16], nil
+		case types.T_int32:
+			return compareFixed[int32], nil
+		case types.T_int64:
+			return compareFixed[int64], nil
+		case types.T_uint8:
+			return compareFixed[uint8], nil
+		case types.T_uint16:
+			return compareFixed[uint16], nil
+		case types.T_uint32:
+			return compareFixed[uint32], nil
+		case types.T_uint64:
+			return compareFixed[uint64], nil
+		case types.T_float32:
+			return compareFixed[float32], nil
+		case types.T_float64:
+			return compareFixed[float64], nil
+		case types.T_date:
+			return compareFixed[types.Date], nil
+		case types.T_datetime:
+			return compareFixed[types.Datetime], nil
+		case types.T_timestamp:
+			return compareFixed[types.Timestamp], nil
+		case types.T_decimal64:
+			return func(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+				return int(types.CompareDecimal64Decimal64(lv.Col.([]types.Decimal64)[li], rv.Col.([]types.Decimal64)[ri], l.Scale, r.Scale))
+			}, nil
+		case types.T_decimal128:
+			return func(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+				return int(types.CompareDecimal128Decimal128(lv.Col.([]types.Decimal128)[li], rv.Col.([]types.Decimal128)[ri], l.Scale, r.Scale))
+			}, nil
+		}
+	}
+	if isString(l.Oid) && isString(r.Oid) {
+		return compareString, nil
+	}
+	if isNumeric(l.Oid) && isNumeric(r.Oid) {
+		return compareNumeric, nil
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("cannot compare '%s' with '%s'", l, r))
+}
+
+func compareFixed[T constraints.Ordered](lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+	a, b := lv.Col.([]T)[li], rv.Col.([]T)[ri]
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBool(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+	a, b := lv.Col.([]bool)[li], rv.Col.([]bool)[ri]
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareString(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+	return bytes.Compare(lv.Col.(*types.Bytes).Get(li), rv.Col.(*types.Bytes).Get(ri))
+}
+
+func compareNumeric(lv *vector.Vector, li int64, rv *vector.Vector, ri int64) int {
+	a, b := toFloat64(lv, li), toFloat64(rv, ri)
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toFloat64(v *vector.Vector, i int64) float64 {
+	switch vs := v.Col.(type) {
+	case []int8:
+		return float64(vs[i])
+	case []int16:
+		return float64(vs[i])
+	case []int32:
+		return float64(vs[i])
+	case []int64:
+		return float64(vs[i])
+	case []uint8:
+		return float64(vs[i])
+	case []uint16:
+		return float64(vs[i])
+	case []uint32:
+		return float64(vs[i])
+	case []uint64:
+		return float64(vs[i])
+	case []float32:
+		return float64(vs[i])
+	default:
+		return v.Col.([]float64)[i]
+	}
+}
+
+func isString(oid types.T) bool {
+	return oid == types.T_char || oid == types.T_varchar
+}
+
+func isNumeric(oid types.T) bool {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64:
+		return true
+	}
+	return false
+}
+
+// satisfy reports whether the ordering r of two values satisfies op.
+func satisfy(op int, r int) bool {
+	switch op {
+	case EQ:
+		return r == 0
+	case NE:
+		return r != 0
+	case LT:
+		return r < 0
+	case LE:
+		return r <= 0
+	case GT:
+		return r > 0
+	default:
+		return r >= 0
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" ⋉ₘ ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.keys = make([][]byte, UnitLimit)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.inserted = make([]uint8, UnitLimit)
+	ap.ctr.zInserted = make([]uint8, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	mp := make(map[int32]int)
+	for i, cond := range ap.Conditions[0] { // aligning the precision of decimal
+		mp[ap.Conditions[1][i].Pos]++
+		switch cond.Typ.Oid {
+		case types.T_decimal64:
+			typ := ap.Conditions[1][i]
+			if typ.Scale > cond.Typ.Scale {
+				cond.Scale = typ.Scale - cond.Typ.Scale
+			} else if typ.Scale < cond.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Typ.Scale - typ.Scale
+			}
+		case types.T_decimal128:
+			typ := ap.Conditions[1][i]
+			if typ.Scale > cond.Typ.Scale {
+				cond.Scale = typ.Scale - cond.Typ.Scale
+			} else if typ.Scale < cond.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Typ.Scale - typ.Scale
+			}
+		}
+	}
+	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
+	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	for {
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.NewWithSize(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			bat.Clean(proc.Mp)
+			ctr.bat.Clean(proc.Mp)
+			return err
+		}
+		bat.Clean(proc.Mp)
+	}
+	if ctr.bat == nil { // the build side is empty
+		return nil
+	}
+	count := len(ctr.bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		for _, cond := range ap.Conditions[1] {
+			vec := ctr.bat.Vecs[cond.Pos]
+			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+			case 1:
+				fillGroupStr[uint8](ctr, vec, n, 1, i)
+			case 2:
+				fillGroupStr[uint16](ctr, vec, n, 2, i)
+			case 4:
+				fillGroupStr[uint32](ctr, vec, n, 4, i)
+			case 8:
+				fillGroupStr[uint64](ctr, vec, n, 8, i)
+			case -8:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[uint64](ctr, vec, n, 8, i)
+				}
+			case -16:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+				}
+			default:
+				vs := vec.Col.(*types.Bytes)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+					}
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(i + k)) {
+							ctr.zValues[k] = 0
+						} else {
+							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+						}
+					}
+				}
+			}
+		}
+		for k := 0; k < n; k++ {
+			if l := len(ctr.keys[k]); l < 16 {
+				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+			}
+		}
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			if v > ctr.rows {
+				ctr.rows++
+				ctr.sels = append(ctr.sels, make([]int64, 0, 8))
+			}
+			ai := int64(v) - 1
+			ctr.sels[ai] = append(ctr.sels[ai], int64(i+k))
+		}
+		for k := 0; k < n; k++ {
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	if ap.Cond != nil && ctr.bat != nil && ctr.cmp == nil {
+		cmp, err := newCompare(bat.Vecs[ap.Cond.Left].Typ, ctr.bat.Vecs[ap.Cond.Right].Typ)
+		if err != nil {
+			return err
+		}
+		ctr.cmp = cmp
+	}
+	rbat := batch.NewWithSize(len(ap.Result) + 1)
+	for i, pos := range ap.Result {
+		rbat.Vecs[i] = vector.New(bat.Vecs[pos].Typ)
+	}
+	mvec, err := process.Get(proc, int64(len(bat.Zs)), types.Type{Oid: types.T_bool, Size: 1})
+	if err != nil {
+		return err
+	}
+	marks := encoding.DecodeBoolSlice(mvec.Data)[:len(bat.Zs)]
+	mvec.Col = marks
+	rbat.Vecs[len(ap.Result)] = mvec
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		for _, cond := range ap.Conditions[0] {
+			vec := bat.Vecs[cond.Pos]
+			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+			case 1:
+				fillGroupStr[uint8](ctr, vec, n, 1, i)
+			case 2:
+				fillGroupStr[uint16](ctr, vec, n, 2, i)
+			case 4:
+				fillGroupStr[uint32](ctr, vec, n, 4, i)
+			case 8:
+				fillGroupStr[uint64](ctr, vec, n, 8, i)
+			case -8:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[uint64](ctr, vec, n, 8, i)
+				}
+			case -16:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+				}
+			default:
+				vs := vec.Col.(*types.Bytes)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+					}
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(i + k)) {
+							ctr.zValues[k] = 0
+						} else {
+							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+						}
+					}
+				}
+			}
+		}
+		for k := 0; k < n; k++ {
+			if l := len(ctr.keys[k]); l < 16 {
+				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+			}
+		}
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+		for k := 0; k < n; k++ {
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+		for k := 0; k < n; k++ {
+			var sels []int64
+			if ctr.bat != nil && ctr.zValues[k] != 0 && ctr.values[k] != 0 {
+				sels = ctr.sels[ctr.values[k]-1]
+			}
+			isNull := false
+			marks[i+k], isNull = ctr.mark(bat, int64(i+k), sels, ap)
+			if isNull {
+				nulls.Add(mvec.Nsp, uint64(i+k))
+			}
+			for j, pos := range ap.Result {
+				if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[pos], int64(i+k), proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// mark evaluates the mark of the probe row with the build rows sels that
+// match it, following the three-valued logic of EXISTS, ANY and ALL.
+func (ctr *Container) mark(bat *batch.Batch, row int64, sels []int64, ap *Argument) (bool, bool) {
+	if ap.Cond == nil {
+		return len(sels) > 0, false
+	}
+	if len(sels) == 0 {
+		return ap.Cond.All, false
+	}
+	lvec, rvec := bat.Vecs[ap.Cond.Left], ctr.bat.Vecs[ap.Cond.Right]
+	if nulls.Contains(lvec.Nsp, uint64(row)) {
+		return false, true
+	}
+	hasNull := false
+	for _, sel := range sels {
+		if nulls.Contains(rvec.Nsp, uint64(sel)) {
+			hasNull = true
+			continue
+		}
+		ok := satisfy(ap.Cond.Op, ctr.cmp(lvec, row, rvec, sel))
+		if ok && !ap.Cond.All {
+			return true, false
+		}
+		if !ok && ap.Cond.All {
+			return false, false
+		}
+	}
+	if hasNull {
+		return false, true
+	}
+	return ap.Cond.All, false
+}
+
+func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+	vs := vector.DecodeFixedCol[T](vec, sz)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
+	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
+	vs := ctr.decimal128Slice[:n]
+	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+			}
+		}
+	}
+}
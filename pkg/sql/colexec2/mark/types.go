@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+type Container struct {
+	state         int
+	rows          uint64
+	keys          [][]byte
+	values        []uint64
+	zValues       []int64
+	hashes        []uint64
+	inserted      []uint8
+	zInserted     []uint8
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	sels [][]int64
+
+	bat *batch.Batch
+
+	cmp compareFunc
+
+	decimal64Slice  []types.Decimal64
+	decimal128Slice []types.Decimal128
+}
+
+// comparison operators of a quantified mark condition
+const (
+	EQ = iota
+	NE
+	LT
+	LE
+	GT
+	GE
+)
+
+// MarkCondition is the comparison `left op ANY/ALL (right)` that decides
+// the mark of a probe row once its matched build rows are known.
+type MarkCondition struct {
+	Op    int
+	All   bool
+	Left  int32 // position in the probe batch
+	Right int32 // position in the build batch
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32
+	Typ   types.Type
+}
+
+type Argument struct {
+	ctr        *Container
+	Result     []int32
+	Conditions [][]Condition
+	Cond       *MarkCondition // nil for EXISTS
+}
@@ -37,7 +37,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			return true, nil
 		}
 		reg := proc.Reg.MergeReceivers[n.ctr.i]
-		bat := <-reg.Ch
+		bat, err := process.ReceiveBatch(proc, reg)
+		if err != nil {
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:n.ctr.i], proc.Reg.MergeReceivers[n.ctr.i+1:]...)
 			if n.ctr.i >= len(proc.Reg.MergeReceivers) {
@@ -100,6 +100,17 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeCancel(t *testing.T) {
+	tc := newTestCase(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	Prepare(tc.proc, tc.arg)
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.proc.Ctx = ctx
+	cancel()
+	// nothing is sent to the receivers, the call returns once the statement is cancelled
+	_, err := Call(tc.proc, tc.arg)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 func newTestCase(m *mheap.Mheap) mergeTestCase {
 	proc := process.New(m)
 	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
@@ -69,7 +70,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 func (ctr *Container) build(proc *process.Process) error {
 	if len(proc.Reg.MergeReceivers) == 1 {
 		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				return nil
 			}
@@ -81,7 +85,10 @@ func (ctr *Container) build(proc *process.Process) error {
 		}
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
 			continue
 		}
@@ -173,6 +180,9 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 	default:
 		err = ctr.processHStr(bat, proc)
 	}
+	if err == nil && ctr.bat != nil {
+		err = ring.Err(ctr.bat.Rs)
+	}
 	if err != nil {
 		ctr.bat.Clean(proc.Mp)
 		ctr.bat = nil
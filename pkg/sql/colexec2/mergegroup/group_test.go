@@ -306,7 +306,15 @@ func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process,
 		bat.Vecs[i] = vec
 	}
 	{
-		r, _ := aggregate.New(aggregate.Max, ts[0])
+		r, _ := aggregate.New(aggregate.Aggregate{Op: aggregate.Max}, ts[0])
+		r.Grows(int(rows), proc.Mp)
+		for i := int64(0); i < rows; i++ {
+			r.Fill(i, i, 1, bat.Vecs[0])
+		}
+		bat.Rs = append(bat.Rs, r)
+	}
+	{
+		r, _ := aggregate.New(aggregate.Aggregate{Op: aggregate.Count, Dist: true}, ts[0])
 		r.Grows(int(rows), proc.Mp)
 		for i := int64(0); i < rows; i++ {
 			r.Fill(i, i, 1, bat.Vecs[0])
@@ -0,0 +1,242 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" ⨝ₘ ")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.cmps = make([]compare.Compare, len(ap.Conditions[0]))
+	for i, cond := range ap.Conditions[0] {
+		ap.ctr.cmps[i] = compare.New(cond.Typ.Oid, false)
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Probe:
+			bat, end, err := ctr.probe(ap, proc)
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			if end {
+				ctr.state = End
+				ctr.clean(proc)
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// probe walks both sides in step as their batches arrive, joining the runs
+// of rows with equal keys. It returns the rows joined once a side needs its
+// next batch, and end once a side has sent all its batches
+func (ctr *Container) probe(ap *Argument, proc *process.Process) (*batch.Batch, bool, error) {
+	var rbat *batch.Batch
+	for {
+		for i := range ctr.bats {
+			if ctr.bats[i] != nil && ctr.rows[i] < int64(len(ctr.bats[i].Zs)) {
+				continue
+			}
+			if rbat != nil {
+				return rbat, false, nil
+			}
+			ok, err := ctr.next(proc, i)
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				return nil, true, nil
+			}
+		}
+		if hasNull(ap, 0, ctr.bats[0], ctr.rows[0]) {
+			ctr.rows[0]++
+			continue
+		}
+		if hasNull(ap, 1, ctr.bats[1], ctr.rows[1]) {
+			ctr.rows[1]++
+			continue
+		}
+		switch r := ctr.compare(ap, 0, ctr.bats[0], ctr.rows[0], 1, ctr.bats[1], ctr.rows[1]); {
+		case r < 0:
+			ctr.rows[0]++
+		case r > 0:
+			ctr.rows[1]++
+		default:
+			if rbat == nil {
+				rbat = batch.NewWithSize(len(ap.Result))
+				for i, rp := range ap.Result {
+					rbat.Vecs[i] = vector.New(ctr.bats[rp.Rel].Vecs[rp.Pos].Typ)
+				}
+			}
+			err := ctr.collectRun(ap, proc, 0)
+			if err == nil {
+				err = ctr.collectRun(ap, proc, 1)
+			}
+			if err == nil {
+				err = ctr.joinRuns(ap, proc, rbat)
+			}
+			if err != nil {
+				rbat.Clean(proc.Mp)
+				return nil, false, err
+			}
+		}
+	}
+}
+
+// next receives the next batch of a side, it returns false once the side
+// has sent all its batches
+func (ctr *Container) next(proc *process.Process, i int) (bool, error) {
+	if ctr.bats[i] != nil {
+		ctr.bats[i].Clean(proc.Mp)
+		ctr.bats[i] = nil
+	}
+	for !ctr.done[i] {
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return false, err
+		}
+		if bat == nil {
+			ctr.done[i] = true
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		ctr.bats[i], ctr.rows[i] = bat, 0
+		return true, nil
+	}
+	return false, nil
+}
+
+// collectRun moves the rows of the side with the key at its current row
+// into the run of the side, receiving the next batches while the key lasts
+func (ctr *Container) collectRun(ap *Argument, proc *process.Process, i int) error {
+	bat := ctr.bats[i]
+	run := batch.NewWithSize(len(bat.Vecs))
+	for j, vec := range bat.Vecs {
+		run.Vecs[j] = vector.New(vec.Typ)
+	}
+	ctr.runs[i] = run
+	for {
+		if ctr.bats[i] == nil || ctr.rows[i] >= int64(len(ctr.bats[i].Zs)) {
+			ok, err := ctr.next(proc, i)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+		}
+		bat, row := ctr.bats[i], ctr.rows[i]
+		if hasNull(ap, i, bat, row) {
+			ctr.rows[i]++
+			continue
+		}
+		if len(run.Zs) > 0 && ctr.compare(ap, i, run, 0, i, bat, row) != 0 {
+			return nil
+		}
+		for j, vec := range bat.Vecs {
+			if err := vector.UnionOne(run.Vecs[j], vec, row, proc.Mp); err != nil {
+				return err
+			}
+		}
+		run.Zs = append(run.Zs, bat.Zs[row])
+		ctr.rows[i]++
+	}
+}
+
+// joinRuns appends the product of the runs of both sides to the result
+func (ctr *Container) joinRuns(ap *Argument, proc *process.Process, rbat *batch.Batch) error {
+	defer ctr.cleanRuns(proc)
+	rows := [2]int64{}
+	for rows[0] = 0; rows[0] < int64(len(ctr.runs[0].Zs)); rows[0]++ {
+		for rows[1] = 0; rows[1] < int64(len(ctr.runs[1].Zs)); rows[1]++ {
+			for k, rp := range ap.Result {
+				if err := vector.UnionOne(rbat.Vecs[k], ctr.runs[rp.Rel].Vecs[rp.Pos], rows[rp.Rel], proc.Mp); err != nil {
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, ctr.runs[0].Zs[rows[0]]*ctr.runs[1].Zs[rows[1]])
+		}
+	}
+	return nil
+}
+
+// compare compares the keys of a row of side i in bi with a row of side j in bj
+func (ctr *Container) compare(ap *Argument, i int, bi *batch.Batch, ri int64, j int, bj *batch.Batch, rj int64) int {
+	for k, cmp := range ctr.cmps {
+		cmp.Set(0, bi.Vecs[ap.Conditions[i][k].Pos])
+		cmp.Set(1, bj.Vecs[ap.Conditions[j][k].Pos])
+		if r := cmp.Compare(0, 1, ri, rj); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// hasNull checks a key of the row of side i is null, a null never equals any key
+func hasNull(ap *Argument, i int, bat *batch.Batch, row int64) bool {
+	for _, cond := range ap.Conditions[i] {
+		if nulls.Contains(bat.Vecs[cond.Pos].Nsp, uint64(row)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctr *Container) cleanRuns(proc *process.Process) {
+	for i, run := range ctr.runs {
+		if run != nil {
+			run.Clean(proc.Mp)
+			ctr.runs[i] = nil
+		}
+	}
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	ctr.cleanRuns(proc)
+	for i, bat := range ctr.bats {
+		if bat != nil {
+			bat.Clean(proc.Mp)
+			ctr.bats[i] = nil
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type joinTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(newTestCase().arg, buf)
+	require.Equal(t, " ⨝ₘ ", buf.String())
+}
+
+func TestMergeJoin(t *testing.T) {
+	tc := newTestCase()
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	// the row with the null key must not join
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.proc, []int32{0, 1, 1, 2, 3, 3}, 4)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.proc, []int32{1, 1, 2, 3, 4}, -1)
+	tc.proc.Reg.MergeReceivers[1].Ch <- &batch.Batch{}
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	var left, right []int32
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		left = append(left, bat.Vecs[0].Col.([]int32)...)
+		right = append(right, bat.Vecs[1].Col.([]int32)...)
+		bat.Clean(tc.proc.Mp)
+	}
+	require.Equal(t, []int32{1, 1, 1, 1, 2, 3}, left)
+	require.Equal(t, []int32{1, 1, 1, 1, 2, 3}, right)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
+func TestMergeJoinRuns(t *testing.T) {
+	tc := newTestCase()
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	for _, reg := range tc.proc.Reg.MergeReceivers {
+		reg.Ch = make(chan *batch.Batch, 8)
+	}
+	call := func() ([]int32, []int32, bool) {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			return nil, nil, true
+		}
+		bat := tc.proc.Reg.InputBatch
+		left := append([]int32{}, bat.Vecs[0].Col.([]int32)...)
+		right := append([]int32{}, bat.Vecs[1].Col.([]int32)...)
+		bat.Clean(tc.proc.Mp)
+		return left, right, false
+	}
+
+	// the rows joined are sent before the next batches arrive
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.proc, []int32{0, 1, 2}, -1)
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.proc, []int32{1, 3}, -1)
+	left, right, end := call()
+	require.False(t, end)
+	require.Equal(t, []int32{1}, left)
+	require.Equal(t, []int32{1}, right)
+
+	// the runs of the keys 3 and 5 cross the batches of both sides
+	for _, vs := range [][]int32{{2, 3}, {3}, {3, 5}} {
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.proc, vs, -1)
+	}
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	for _, vs := range [][]int32{{3, 3}, {4, 5}, {5}} {
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.proc, vs, -1)
+	}
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	left, right = nil, nil
+	for {
+		l, r, end := call()
+		if end {
+			break
+		}
+		left = append(left, l...)
+		right = append(right, r...)
+	}
+	require.Equal(t, []int32{3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 5}, left)
+	require.Equal(t, []int32{3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 5}, right)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
+func TestMergeJoinEmpty(t *testing.T) {
+	tc := newTestCase()
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.proc, []int32{1, 2}, -1)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	ok, err := Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
+func TestMergeJoinCancel(t *testing.T) {
+	tc := newTestCase()
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.proc.Ctx = ctx
+	cancel()
+	// nothing is sent to the receivers, the call returns once the statement is cancelled
+	_, err := Call(tc.proc, tc.arg)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func newTestCase() joinTestCase {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	return joinTestCase{
+		proc:   proc,
+		cancel: cancel,
+		arg: &Argument{
+			Result: []ResultPos{{0, 0}, {1, 0}},
+			Conditions: [][]Condition{
+				{{0, types.Type{Oid: types.T_int32}}},
+				{{0, types.Type{Oid: types.T_int32}}},
+			},
+		},
+	}
+}
+
+// create a new block with one int32 column, the row null is null if not negative
+func newBatch(t *testing.T, proc *process.Process, vs []int32, null int) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.InitZsOne(len(vs))
+	vec := vector.New(types.Type{Oid: types.T_int32})
+	data, err := mheap.Alloc(proc.Mp, int64(len(vs))*4)
+	require.NoError(t, err)
+	vec.Data = data
+	col := encoding.DecodeInt32Slice(vec.Data)[:len(vs)]
+	copy(col, vs)
+	vec.Col = col
+	if null >= 0 {
+		nulls.Add(vec.Nsp, uint64(null))
+	}
+	bat.Vecs[0] = vec
+	return bat
+}
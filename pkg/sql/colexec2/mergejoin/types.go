@@ -0,0 +1,59 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Probe = iota
+	End
+)
+
+type Container struct {
+	state int
+	// done is set once a side has sent all its batches
+	done [2]bool
+	// rows are the next rows to probe in the current batches
+	rows [2]int64
+	// bats are the current batches of both sides
+	bats [2]*batch.Batch
+	// runs are the rows of both sides with the key being joined, which may
+	// span several batches
+	runs [2]*batch.Batch
+	cmps []compare.Compare
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+type Condition struct {
+	Pos int32
+	Typ types.Type
+}
+
+// Argument of the merge join, an inner equi-join of two inputs sorted on
+// the join keys. Each input has to be a single sorted stream, as the merge
+// order operator gives
+type Argument struct {
+	ctr        *Container
+	Result     []ResultPos
+	Conditions [][]Condition
+}
@@ -37,7 +37,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat, err := process.ReceiveBatch(proc, reg)
+		if err != nil {
+			return true, err
+		}
 
 		// deal special case for bat
 		{
@@ -56,6 +56,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Eval
 		case Eval:
+			ctr.state = End
+			if ctr.bat == nil {
+				continue
+			}
 			for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
 				vector.Clean(ctr.bat.Vecs[i], proc.Mp)
 			}
@@ -79,7 +83,10 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		}
 		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 			reg := proc.Reg.MergeReceivers[i]
-			bat := <-reg.Ch
+			bat, err := process.ReceiveBatch(proc, reg)
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 				i--
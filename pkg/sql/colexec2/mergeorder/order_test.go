@@ -104,6 +104,17 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderCancel(t *testing.T) {
+	tc := newTestCase(mheap.New(guest.New(1<<30, host.New(1<<30))), []bool{false}, []types.Type{{Oid: types.T_int8}}, []order.Field{{E: newExpression(0), Type: 0}})
+	Prepare(tc.proc, tc.arg)
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.proc.Ctx = ctx
+	cancel()
+	// nothing is sent to the receivers, the call returns once the statement is cancelled
+	_, err := Call(tc.proc, tc.arg)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -75,7 +75,10 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		}
 		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 			reg := proc.Reg.MergeReceivers[i]
-			bat := <-reg.Ch
+			bat, err := process.ReceiveBatch(proc, reg)
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 				i--
@@ -44,7 +44,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return true, err
+			}
 			if bat == nil {
 				ctr.state = End
 				ctr.bat.Clean(proc.Mp)
@@ -67,10 +70,11 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 }
 
 func (ctr *Container) build(ap *Argument, proc *process.Process) error {
-	var err error
-
 	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.ReceiveBatch(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
 			break
 		}
@@ -29,6 +29,11 @@ func InitAddress(addr string) {
 	Address = addr
 }
 
+// InitRemotePortOffset is used to set the offset from the port of a node to the one of its rpcserver
+func InitRemotePortOffset(offset int64) {
+	RemotePortOffset = offset
+}
+
 // New is used to new an object of compile
 func New(db string, sql string, uid string,
 	e engine.Engine, proc *process.Process) *compile {
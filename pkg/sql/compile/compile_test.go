@@ -15,6 +15,7 @@
 package compile
 
 import (
+	"context"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -77,6 +78,34 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCompileCancel(t *testing.T) {
+	InitAddress("127.0.0.1")
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	proc.Ctx = ctx
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{
+		"SELECT userID, MIN(score) FROM t1 GROUP BY userID;",
+		"select * from R join S on R.uid = S.uid",
+	} {
+		es, err := New("test", query, "", e, proc).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range es {
+			if err := e.Compile(nil, sqlOutput); err != nil {
+				t.Fatal(err)
+			}
+			if err := e.Run(0); err != context.Canceled {
+				t.Fatalf("%s: expected %v, got %v", query, context.Canceled, err)
+			}
+		}
+	}
+}
+
 func sqlOutput(_ interface{}, bat *batch.Batch) error {
 	fmt.Printf("%v\n", bat.Zs)
 	fmt.Printf("%v\n", bat)
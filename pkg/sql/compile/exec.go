@@ -51,7 +51,7 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	// do ast rewrite
 	e.stmt = rewrite.AstRewrite(e.stmt)
 
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).SetTimeZone(e.c.proc.TimeZone).BuildStatement(e.stmt)
 	if err != nil {
 		return err
 	}
@@ -378,6 +378,8 @@ func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -557,6 +559,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -609,6 +613,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -676,6 +682,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -722,6 +730,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -798,6 +808,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.Ctx = e.c.proc.Ctx
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -824,6 +836,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -879,6 +893,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1002,6 +1018,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1048,6 +1066,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.Ctx = e.c.proc.Ctx
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructTransform(op),
@@ -1065,6 +1085,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1104,6 +1126,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1191,6 +1215,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1234,6 +1260,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1273,6 +1301,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1312,6 +1342,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1351,6 +1383,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1519,6 +1553,8 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1641,6 +1677,8 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.Ctx = e.c.proc.Ctx
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -1673,6 +1711,8 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1761,6 +1801,8 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.Ctx = e.c.proc.Ctx
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructCAQTransform(op),
@@ -1793,6 +1835,8 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Ctx = e.c.proc.Ctx
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -602,7 +602,7 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 	conn := goetty.NewIOSession(goetty.WithCodec(encoder, decoder))
 	defer conn.Close()
 	addr, _ := net.ResolveTCPAddr("tcp", s.NodeInfo.Addr)
-	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), time.Second*3); err != nil {
+	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+int(RemotePortOffset)), time.Second*3); err != nil {
 		select {
 		case <-arg.Reg.Ctx.Done():
 		case arg.Reg.Ch <- nil:
@@ -743,6 +743,8 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 	}
 	{
 		var flg bool
@@ -911,6 +913,8 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 	}
 	if len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
@@ -984,47 +988,27 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(s.PreScopes))
 		for i := range s.PreScopes {
 			switch s.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(s.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(s.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(s.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(s.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
 			reg := s.Proc.Reg.MergeReceivers[i]
@@ -1053,6 +1037,29 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				flg = true
 			}
 		}
+		for i := 0; i < len(s.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], s.Proc.Mp)
+				}
+			}
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1110,6 +1117,8 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1141,6 +1150,8 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = s.Proc.Id
 	rs.Proc.Lim = s.Proc.Lim
+	rs.Proc.Ctx = s.Proc.Ctx
+	rs.Proc.TimeZone = s.Proc.TimeZone
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1171,6 +1182,7 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
 		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc.Ctx = s.Proc.Ctx
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1204,47 +1216,27 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(rs.PreScopes))
 		for i := range rs.PreScopes {
 			switch rs.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(rs.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(rs.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(rs.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(rs.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(rs.Proc.Reg.MergeReceivers); i++ {
 			reg := rs.Proc.Reg.MergeReceivers[i]
@@ -1273,6 +1265,29 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 				flg = true
 			}
 		}
+		for i := 0; i < len(rs.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], rs.Proc.Mp)
+				}
+			}
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1347,47 +1362,27 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 
 			}
 		}
+		errChan := make(chan error, len(s.PreScopes))
 		for i := range s.PreScopes {
 			switch s.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(s.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(s.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(s.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(s.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
 			reg := s.Proc.Reg.MergeReceivers[i]
@@ -1416,6 +1411,29 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				flg = true
 			}
 		}
+		for i := 0; i < len(s.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], s.Proc.Mp)
+				}
+			}
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1473,6 +1491,8 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1523,6 +1543,8 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = s.Proc.Id
 	rs.Proc.Lim = s.Proc.Lim
+	rs.Proc.Ctx = s.Proc.Ctx
+	rs.Proc.TimeZone = s.Proc.TimeZone
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1553,6 +1575,7 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
 		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc.Ctx = s.Proc.Ctx
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1586,47 +1609,27 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(rs.PreScopes))
 		for i := range rs.PreScopes {
 			switch rs.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(rs.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(rs.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(rs.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(rs.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(rs.Proc.Reg.MergeReceivers); i++ {
 			reg := rs.Proc.Reg.MergeReceivers[i]
@@ -1655,6 +1658,29 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 				flg = true
 			}
 		}
+		for i := 0; i < len(rs.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], rs.Proc.Mp)
+				}
+			}
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1718,6 +1744,8 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1772,6 +1800,8 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1826,6 +1856,8 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1883,6 +1915,8 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1937,6 +1971,8 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -60,6 +60,9 @@ const (
 // Address is the ip:port of local node
 var Address string
 
+// RemotePortOffset is the offset from the port of a node to the one its rpcserver listens on
+var RemotePortOffset int64
+
 // Source contains information of a relation which will be used in execution,
 type Source struct {
 	IsMerge      bool
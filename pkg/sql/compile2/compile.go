@@ -38,6 +38,16 @@ func InitAddress(addr string) {
 	Address = addr
 }
 
+// InitRemotePortOffset is used to set the offset from the port of a node to the one of its rpcserver
+func InitRemotePortOffset(offset int64) {
+	RemotePortOffset = offset
+}
+
+// InitRemoteCmd is used to set the command id of the handler running remote scopes
+func InitRemoteCmd(cmd uint64) {
+	RemoteCmd = cmd
+}
+
 // New is used to new an object of compile
 func New(db string, sql string, uid string,
 	e engine.Engine, proc *process.Process) *compile {
@@ -112,6 +122,10 @@ func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
 	case *plan.Plan_Query:
 		return c.compileQuery(qry.Query)
 	case *plan.Plan_Ddl:
+		// show statements are rewritten to a query over mo_catalog
+		if qry.Ddl.Query != nil {
+			return c.compileQuery(qry.Ddl.Query)
+		}
 		switch qry.Ddl.DdlType {
 		case plan.DataDefinition_CREATE_DATABASE:
 			return &Scope{
@@ -123,12 +137,12 @@ func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropDatabase,
 				Plan:  pn,
 			}, nil
-		case plan.DataDefinition_CREATE_TABLE:
+		case plan.DataDefinition_CREATE_TABLE, plan.DataDefinition_CREATE_VIEW:
 			return &Scope{
 				Magic: CreateTable,
 				Plan:  pn,
 			}, nil
-		case plan.DataDefinition_DROP_TABLE:
+		case plan.DataDefinition_DROP_TABLE, plan.DataDefinition_DROP_VIEW:
 			return &Scope{
 				Magic: DropTable,
 				Plan:  pn,
@@ -164,9 +178,12 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.Merge,
@@ -216,6 +233,7 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
 			Attributes:   make([]string, len(n.TableDef.Cols)),
+			Sorted:       isSortedScan(n),
 		}
 		for i, col := range n.TableDef.Cols {
 			src.Attributes[i] = col.Name
@@ -230,9 +248,16 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			}
 			ss[i].Proc = process.New(mheap.New(c.proc.Mp.Gm))
 			ss[i].Proc.Id = c.proc.Id
+			ss[i].Proc.RowsRead = c.proc.RowsRead
 			ss[i].Proc.Lim = c.proc.Lim
+			ss[i].Proc.TimeZone = c.proc.TimeZone
 			ss[i].Proc.UnixTime = c.proc.UnixTime
 			ss[i].Proc.Snapshot = c.proc.Snapshot
+			ss[i].Proc.Ctx = c.proc.Ctx
+		}
+		// the order by of a scan is the order of its batches, its parent merges them
+		if src.Sorted {
+			return c.compileProjection(n, c.compileRestrict(n, ss)), nil
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_PROJECT:
@@ -257,13 +282,20 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		if err != nil {
 			return nil, err
 		}
-		return c.compileSort(n, c.compileJoin(n, ss, children)), nil
+		if isSortedScan(ns[n.Children[0]]) && isSortedScan(ns[n.Children[1]]) {
+			return c.compileSort(n, c.compileMergeJoin(n, ns[n.Children[0]], ns[n.Children[1]], ss, children)), nil
+		}
+		return c.compileSort(n, c.compileJoin(n, ns[n.Children[1]], ss, children)), nil
 	case plan.Node_SORT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
 			return nil, err
 		}
-		ss = c.compileSort(n, ss)
+		if isSortedScan(ns[n.Children[0]]) {
+			ss = c.compileLimitOffset(n, c.compileMergeOrder(n, ss))
+		} else {
+			ss = c.compileSort(n, ss)
+		}
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
 	default:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
@@ -293,7 +325,7 @@ func (c *compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
-func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*Scope {
+func (c *compile) compileJoin(n, right *plan.Node, ss []*Scope, children []*Scope) []*Scope {
 	rs := make([]*Scope, len(ss))
 	for i := range ss {
 		chp := &Scope{
@@ -305,9 +337,12 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 			chp.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 			chp.Proc.Cancel = cancel
 			chp.Proc.Id = c.proc.Id
+			chp.Proc.RowsRead = c.proc.RowsRead
 			chp.Proc.Lim = c.proc.Lim
+			chp.Proc.TimeZone = c.proc.TimeZone
 			chp.Proc.UnixTime = c.proc.UnixTime
 			chp.Proc.Snapshot = c.proc.Snapshot
+			chp.Proc.Ctx = c.proc.Ctx
 			chp.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(children))
 			{
 				for j := 0; j < len(children); j++ {
@@ -335,9 +370,12 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 		rs[i].Proc = process.New(mheap.New(c.proc.Mp.Gm))
 		rs[i].Proc.Cancel = cancel
 		rs[i].Proc.Id = c.proc.Id
+		rs[i].Proc.RowsRead = c.proc.RowsRead
 		rs[i].Proc.Lim = c.proc.Lim
+		rs[i].Proc.TimeZone = c.proc.TimeZone
 		rs[i].Proc.UnixTime = c.proc.UnixTime
 		rs[i].Proc.Snapshot = c.proc.Snapshot
+		rs[i].Proc.Ctx = c.proc.Ctx
 		rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
 		{
 			rs[i].Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -366,7 +404,7 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 		})
 	}
 	switch n.JoinType {
-	case plan.Node_INNER, plan.Node_SEMI:
+	case plan.Node_INNER:
 		if len(n.OnList) == 0 {
 			for i := range rs {
 				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
@@ -389,6 +427,13 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 				Arg: constructLeft(n, c.proc),
 			})
 		}
+	case plan.Node_SEMI:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Semi,
+				Arg: constructSemi(n, c.proc),
+			})
+		}
 	case plan.Node_ANTI:
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
@@ -396,25 +441,82 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 				Arg: constructComplement(n, c.proc),
 			})
 		}
+	case plan.Node_MARK:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Mark,
+				Arg: constructMark(n, c.proc),
+			})
+		}
+	case plan.Node_SINGLE:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Single,
+				Arg: constructSingle(n, right, c.proc),
+			})
+		}
 	default:
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join typ '%v' not support now", n.JoinType)))
 	}
 	return rs
 }
 
+// compileMergeJoin joins two table scans whose batches are sorted on the join
+// keys, the batches of each side are merged into one sorted stream first
+func (c *compile) compileMergeJoin(n, left, right *plan.Node, ss []*Scope, children []*Scope) []*Scope {
+	rs := &Scope{
+		PreScopes: append(c.compileMergeOrder(left, ss), c.compileMergeOrder(right, children)...),
+		Magic:     Merge,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
+	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  overload.MergeJoin,
+		Arg: constructMergeJoin(n, c.proc),
+	})
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(rs.PreScopes))
+	for i := range rs.PreScopes {
+		rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		rs.PreScopes[i].Instructions = append(rs.PreScopes[i].Instructions, vm.Instruction{
+			Op: overload.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}
+}
+
 func (c *compile) compileSort(n *plan.Node, ss []*Scope) []*Scope {
 	switch {
 	case n.Limit != nil && n.Offset == nil && len(n.OrderBy) > 0: // top
 		return c.compileTop(n, ss)
-	case n.Limit == nil && n.Offset != nil && len(n.OrderBy) > 0: // order and offset
-		return c.compileOffset(n, c.compileOrder(n, ss))
-	case n.Limit != nil && n.Offset != nil && len(n.OrderBy) > 0: // order and offset and limit
-		return c.compileLimit(n, c.compileOffset(n, c.compileOrder(n, ss)))
-	case n.Limit != nil && n.Offset == nil && len(n.OrderBy) == 0: // limit
+	case len(n.OrderBy) > 0: // order and offset and limit
+		return c.compileLimitOffset(n, c.compileOrder(n, ss))
+	default:
+		return c.compileLimitOffset(n, ss)
+	}
+}
+
+func (c *compile) compileLimitOffset(n *plan.Node, ss []*Scope) []*Scope {
+	switch {
+	case n.Limit != nil && n.Offset == nil: // limit
 		return c.compileLimit(n, ss)
-	case n.Limit == nil && n.Offset != nil && len(n.OrderBy) == 0: // offset
+	case n.Limit == nil && n.Offset != nil: // offset
 		return c.compileOffset(n, ss)
-	case n.Limit != nil && n.Offset != nil && len(n.OrderBy) == 0: // limit and offset
+	case n.Limit != nil && n.Offset != nil: // limit and offset
 		return c.compileLimit(n, c.compileOffset(n, ss))
 	default:
 		return ss
@@ -436,9 +538,12 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeTop,
 		Arg: constructMergeTop(n, c.proc),
@@ -471,6 +576,12 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 			Arg: constructOrder(n, c.proc),
 		})
 	}
+	return c.compileMergeOrder(n, ss)
+}
+
+// compileMergeOrder merges the batches of the scopes, which have to be sorted
+// on the order by of n already
+func (c *compile) compileMergeOrder(n *plan.Node, ss []*Scope) []*Scope {
 	rs := &Scope{
 		PreScopes: ss,
 		Magic:     Merge,
@@ -479,9 +590,12 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOrder,
 		Arg: constructMergeOrder(n, c.proc),
@@ -522,9 +636,12 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOffset,
 		Arg: constructMergeOffset(n, c.proc),
@@ -565,9 +682,12 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeLimit,
 		Arg: constructMergeLimit(n, c.proc),
@@ -608,9 +728,12 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
+	rs.Proc.RowsRead = c.proc.RowsRead
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Ctx = c.proc.Ctx
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeGroup,
 		Arg: constructMergeGroup(n, true),
@@ -635,3 +758,9 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	return []*Scope{rs}
 }
+
+// isSortedScan returns true if n is a table scan whose batches are sorted on
+// its order by
+func isSortedScan(n *plan.Node) bool {
+	return n.NodeType == plan.Node_TABLE_SCAN && len(n.OrderBy) > 0
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeorder"
@@ -40,6 +42,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
@@ -73,6 +77,11 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *mergejoin.Argument:
+		rin.Arg = &mergejoin.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
 	case *left.Argument:
 		rin.Arg = &left.Argument{
 			IsPreBuild: arg.IsPreBuild,
@@ -89,6 +98,24 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *semi.Argument:
+		rin.Arg = &semi.Argument{
+			IsPreBuild: arg.IsPreBuild,
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
+	case *mark.Argument:
+		rin.Arg = &mark.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+			Cond:       arg.Cond,
+		}
+	case *single.Argument:
+		rin.Arg = &single.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+			Typs:       arg.Typs,
+		}
 	case *offset.Argument:
 		rin.Arg = &offset.Argument{
 			Offset: arg.Offset,
@@ -170,6 +197,27 @@ func constructJoin(n *plan.Node, proc *process.Process) *join.Argument {
 	}
 }
 
+func constructMergeJoin(n *plan.Node, proc *process.Process) *mergejoin.Argument {
+	result := make([]mergejoin.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]mergejoin.Condition, 2)
+	{
+		conds[0] = make([]mergejoin.Condition, len(n.OnList))
+		conds[1] = make([]mergejoin.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+	}
+	return &mergejoin.Argument{
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
 func constructLeft(n *plan.Node, proc *process.Process) *left.Argument {
 	result := make([]left.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -229,6 +277,148 @@ func constructComplement(n *plan.Node, proc *process.Process) *complement.Argume
 
 }
 
+func constructSemi(n *plan.Node, proc *process.Process) *semi.Argument {
+	result := make([]int32, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		rel, pos := constructJoinResult(expr)
+		if rel != 0 {
+			panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("semi result '%s' not support now", expr)))
+		}
+		result[i] = pos
+	}
+	conds := make([][]semi.Condition, 2)
+	{
+		conds[0] = make([]semi.Condition, len(n.OnList))
+		conds[1] = make([]semi.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+		conds[0][i].Scale, conds[1][i].Scale = ltyp.Scale, rtyp.Scale
+	}
+	return &semi.Argument{
+		IsPreBuild: false,
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
+func constructSingle(n, right *plan.Node, proc *process.Process) *single.Argument {
+	result := make([]single.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]single.Condition, 2)
+	{
+		conds[0] = make([]single.Condition, len(n.OnList))
+		conds[1] = make([]single.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+		conds[0][i].Scale, conds[1][i].Scale = ltyp.Scale, rtyp.Scale
+	}
+	typs := make([]types.Type, len(right.ProjectList))
+	for i, expr := range right.ProjectList {
+		typs[i] = constructType(expr.Typ)
+	}
+	return &single.Argument{
+		Conditions: conds,
+		Result:     result,
+		Typs:       typs,
+	}
+}
+
+// constructMark builds a mark join, the mark column is the last one of the
+// project list and the quantified comparison, if any, is an any/all function
+// of the on list.
+func constructMark(n *plan.Node, proc *process.Process) *mark.Argument {
+	result := make([]int32, 0, len(n.ProjectList))
+	for _, expr := range n.ProjectList[:len(n.ProjectList)-1] {
+		rel, pos := constructJoinResult(expr)
+		if rel != 0 {
+			panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark result '%s' not support now", expr)))
+		}
+		result = append(result, pos)
+	}
+	var cond *mark.MarkCondition
+	conds := make([][]mark.Condition, 2)
+	for _, expr := range n.OnList {
+		if e, ok := expr.Expr.(*plan.Expr_F); ok {
+			if name := e.F.Func.GetObjName(); name == "any" || name == "all" {
+				cond = constructMarkCondition(e.F.Args[0], name == "all")
+				continue
+			}
+		}
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0] = append(conds[0], mark.Condition{Pos: lpos, Typ: ltyp, Scale: ltyp.Scale})
+		conds[1] = append(conds[1], mark.Condition{Pos: rpos, Typ: rtyp, Scale: rtyp.Scale})
+	}
+	return &mark.Argument{
+		Conditions: conds,
+		Result:     result,
+		Cond:       cond,
+	}
+}
+
+func constructMarkCondition(expr *plan.Expr, all bool) *mark.MarkCondition {
+	e, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || len(e.F.Args) != 2 {
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark condition '%s' not support now", expr)))
+	}
+	cond := &mark.MarkCondition{All: all}
+	switch e.F.Func.GetObjName() {
+	case "=":
+		cond.Op = mark.EQ
+	case "<>":
+		cond.Op = mark.NE
+	case "<":
+		cond.Op = mark.LT
+	case "<=":
+		cond.Op = mark.LE
+	case ">":
+		cond.Op = mark.GT
+	case ">=":
+		cond.Op = mark.GE
+	default:
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark condition '%s' not support now", expr)))
+	}
+	left, ok := stripCast(e.F.Args[0]).Expr.(*plan.Expr_Col)
+	if !ok || left.Col.RelPos != 0 {
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark condition '%s' not support now", expr)))
+	}
+	right, ok := stripCast(e.F.Args[1]).Expr.(*plan.Expr_Col)
+	if !ok || right.Col.RelPos != 1 {
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark condition '%s' not support now", expr)))
+	}
+	cond.Left, cond.Right = left.Col.ColPos, right.Col.ColPos
+	return cond
+}
+
+// stripCast removes the implicit casts of a comparison argument, the mark
+// join compares values of different numeric types by itself.
+func stripCast(expr *plan.Expr) *plan.Expr {
+	for {
+		e, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || e.F.Func.GetObjName() != "cast" {
+			return expr
+		}
+		expr = e.F.Args[0]
+	}
+}
+
+func constructType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+}
+
 func constructOrder(n *plan.Node, proc *process.Process) *order.Argument {
 	fs := make([]order.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
@@ -271,15 +461,38 @@ func constructGroup(n *plan.Node) *group.Argument {
 				panic(err)
 			}
 			aggs[i] = aggregate.Aggregate{
-				Op: fun.AggregateInfo,
-				E:  f.F.Args[0],
+				Op:     fun.AggregateInfo,
+				Dist:   f.F.Distinct,
+				E:      f.F.Args[0],
+				Orders: f.F.OrderBy,
+			}
+			// the separator of group_concat and the percentile of percentile_cont
+			// are passed as the second argument
+			switch fun.AggregateInfo {
+			case aggregate.GroupConcat:
+				aggs[i].Sep = f.F.Args[1].Expr.(*plan.Expr_C).C.GetSval()
+			case aggregate.PercentileCont:
+				aggs[i].Frac = f.F.Args[1].Expr.(*plan.Expr_C).C.GetDval()
+			}
+		}
+	}
+
+	var sets [][]int32
+	if len(n.GroupingSet) > 0 {
+		sets = make([][]int32, len(n.GroupingSet))
+		for i, set := range n.GroupingSet {
+			list := set.Expr.(*plan.Expr_List).List.List
+			sets[i] = make([]int32, len(list))
+			for j, e := range list {
+				sets[i][j] = e.Expr.(*plan.Expr_Col).Col.ColPos
 			}
 		}
 	}
 
 	return &group.Argument{
-		Aggs:  aggs,
-		Exprs: n.GroupBy,
+		Aggs:         aggs,
+		Exprs:        n.GroupBy,
+		GroupingSets: sets,
 	}
 }
 
@@ -0,0 +1,183 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	remoteConnectTimeout = time.Second * 3
+	remoteMaxMessageSize = 1 << 30
+)
+
+// isRemote returns true if the scope reads the data held by another node.
+// Only the scopes reading from the storage engine directly are sent, and
+// a node has to know its own address to run the scopes remotely. All the
+// instructions except the last one run on the remote node, so they must
+// be encodable.
+func (s *Scope) isRemote() bool {
+	if len(Address) == 0 || len(s.NodeInfo.Addr) == 0 || s.NodeInfo.Addr == Address {
+		return false
+	}
+	// the remote node reads the relation unsorted
+	if s.DataSource != nil && s.DataSource.Sorted {
+		return false
+	}
+	if s.DataSource == nil || s.DataSource.Bat != nil || len(s.PreScopes) > 0 || len(s.Instructions) < 2 {
+		return false
+	}
+	for _, in := range s.Instructions[:len(s.Instructions)-1] {
+		if !protocol.IsPipelineInstructionEncodable(in) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transfer is used to transfer a Scope to protocol.Scope, the instructions
+// are replaced by ins.
+func Transfer(s *Scope, ins vm.Instructions) protocol.Scope {
+	var ps protocol.Scope
+
+	ps.Ins = ins
+	ps.Magic = s.Magic
+	if s.DataSource != nil {
+		ps.DataSource.SchemaName = s.DataSource.SchemaName
+		ps.DataSource.RelationName = s.DataSource.RelationName
+		ps.DataSource.Attributes = s.DataSource.Attributes
+	}
+	ps.NodeInfo.Id = s.NodeInfo.Id
+	ps.NodeInfo.Addr = s.NodeInfo.Addr
+	ps.NodeInfo.Data = s.NodeInfo.Data
+	ps.PreScopes = make([]protocol.Scope, len(s.PreScopes))
+	for i := range s.PreScopes {
+		ps.PreScopes[i] = Transfer(s.PreScopes[i], s.PreScopes[i].Instructions)
+	}
+	return ps
+}
+
+// remoteReader is an engine.Reader which sends a scope to the node holding its data,
+// and reads the batches produced by the node. The connection is closed once the
+// context of the process is done, so that a blocked read returns.
+type remoteReader struct {
+	s      *Scope
+	proc   *process.Process
+	conn   goetty.IOSession
+	closed chan struct{}
+}
+
+func newRemoteReader(s *Scope) *remoteReader {
+	return &remoteReader{
+		s:      s,
+		proc:   s.Proc,
+		closed: make(chan struct{}),
+	}
+}
+
+func (r *remoteReader) Read(_ []uint64, _ []string) (*batch.Batch, error) {
+	if err := r.proc.Ctx.Err(); err != nil {
+		return nil, err
+	}
+	if r.conn == nil {
+		if err := r.open(); err != nil {
+			return nil, r.ctxErr(err)
+		}
+	}
+	val, err := r.conn.Read()
+	if err != nil {
+		return nil, r.ctxErr(err)
+	}
+	msg := val.(*message.Message)
+	if len(msg.Code) > 0 {
+		return nil, errors.New(errno.SystemError, string(msg.Code))
+	}
+	if msg.Sid == 1 { // end of the scope
+		return nil, nil
+	}
+	bat, _, err := protocol.DecodeBatchWithProcess(msg.Data, r.proc)
+	return bat, err
+}
+
+// open connects to the remote node and sends the scope without its last instruction,
+// which sends the batches to the local consumers.
+func (r *remoteReader) open() error {
+	var buf bytes.Buffer
+
+	s := r.s
+	p := protocol.Pipeline{
+		UnixTime: s.Proc.UnixTime,
+		Snapshot: s.Proc.Snapshot,
+		Scope:    Transfer(s, s.Instructions[:len(s.Instructions)-1]),
+	}
+	if err := protocol.EncodePipeline(p, &buf); err != nil {
+		return err
+	}
+	addr, err := remoteAddress(s.NodeInfo.Addr)
+	if err != nil {
+		return err
+	}
+	encoder, decoder := rpcserver.NewCodec(remoteMaxMessageSize)
+	r.conn = goetty.NewIOSession(goetty.WithCodec(encoder, decoder))
+	if _, err := r.conn.Connect(addr, remoteConnectTimeout); err != nil {
+		return err
+	}
+	go func(conn goetty.IOSession) {
+		select {
+		case <-r.proc.Ctx.Done():
+			conn.Close()
+		case <-r.closed:
+		}
+	}(r.conn)
+	return r.conn.WriteAndFlush(&message.Message{Cmd: RemoteCmd, Data: buf.Bytes()})
+}
+
+// ctxErr returns the error of the context if it is done, the connection
+// is closed by it
+func (r *remoteReader) ctxErr(err error) error {
+	if ctxErr := r.proc.Ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+func (r *remoteReader) close() {
+	close(r.closed)
+	if r.conn != nil {
+		r.conn.Close()
+	}
+}
+
+// remoteAddress returns the address of the rpcserver of a node
+func remoteAddress(addr string) (string, error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v:%v", tcpAddr.IP, tcpAddr.Port+int(RemotePortOffset)), nil
+}
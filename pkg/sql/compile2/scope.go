@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
@@ -36,6 +37,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -119,6 +121,10 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
 			exeDefs[i] = &engine.PropertiesDef{
 				Properties: properties,
 			}
+		case *plan.TableDef_DefType_View:
+			exeDefs[i] = &engine.ViewDef{
+				View: defVal.View.GetView(),
+			}
 		}
 	}
 	return exeDefs
@@ -133,6 +139,10 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 			alg = compress.None
 		case plan.CompressType_Lz4:
 			alg = compress.Lz4
+		case plan.CompressType_Zstd:
+			alg = compress.Zstd
+		case plan.CompressType_Snappy:
+			alg = compress.Snappy
 		}
 		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
@@ -309,9 +319,12 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 		ctx, cancel := context.WithCancel(context.Background())
 		ss[i].Proc = process.New(mheap.New(s.Proc.Mp.Gm))
 		ss[i].Proc.Id = s.Proc.Id
+		ss[i].Proc.RowsRead = s.Proc.RowsRead
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
+		ss[i].Proc.Ctx = s.Proc.Ctx
 		ss[i].Proc.Cancel = cancel
 		ss[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(s.PreScopes))
 		for j := 0; j < len(s.PreScopes); j++ {
@@ -359,8 +372,19 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 }
 
 // RemoteRun send the scope to a remote node (if target node is itself, it is same to function ParallelRun) and run it.
+// The batches produced by the remote node are streamed back and sent to the consumers by the last instruction
+// of the scope, which is a connector or a dispatch.
 func (s *Scope) RemoteRun(e engine.Engine) error {
-	return s.ParallelRun(e)
+	if !s.isRemote() {
+		return s.ParallelRun(e)
+	}
+	r := newRemoteReader(s)
+	defer r.close()
+	p := pipeline2.New(nil, s.Instructions[len(s.Instructions)-1:], s.Reg)
+	if _, err := p.Run(r, s.Proc); err != nil {
+		return err
+	}
+	return nil
 }
 
 // ParallelRun try to execute the scope in parallel way.
@@ -381,7 +405,15 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		if s.DataSource.Sorted {
+			srel, ok := rel.(engine.SortedRelation)
+			if !ok {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("relation '%s' is not sorted", s.DataSource.RelationName))
+			}
+			rds = srel.NewSortedReader(mcpu, s.Proc.Mp, snap)
+		} else {
+			rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -392,13 +424,17 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
 				Attributes:   s.DataSource.Attributes,
+				Sorted:       s.DataSource.Sorted,
 			},
 		}
 		ss[i].Proc = process.New(mheap.New(s.Proc.Mp.Gm))
 		ss[i].Proc.Id = s.Proc.Id
+		ss[i].Proc.RowsRead = s.Proc.RowsRead
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 	{
 		var flg bool
@@ -40,6 +40,12 @@ const (
 // Address is the ip:port of local node
 var Address string
 
+// RemoteCmd is the command id of the rpcserver handler which runs the scopes sent by other nodes
+var RemoteCmd uint64
+
+// RemotePortOffset is the offset from the port of a node to the one its rpcserver listens on
+var RemotePortOffset int64
+
 // Source contains information of a relation which will be used in execution,
 type Source struct {
 	SchemaName   string
@@ -47,6 +53,8 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// Sorted is set if every batch read has to be sorted on the cluster by columns
+	Sorted bool
 }
 
 // Col is the information of attribute
@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// ProcessPipeline runs the scope of compile2 sent by Scope.RemoteRun of another node,
+// and writes the batches back as soon as they are produced.
+func (hp *Handler) ProcessPipeline(_ uint64, val interface{}, conn goetty.IOSession) error {
+	if err := hp.runPipeline(val.(*message.Message).Data, conn); err != nil {
+		conn.WriteAndFlush(&message.Message{Code: []byte(err.Error())})
+	}
+	return conn.WriteAndFlush(&message.Message{Sid: 1})
+}
+
+func (hp *Handler) runPipeline(data []byte, conn goetty.IOSession) error {
+	p, _, err := protocol.DecodePipeline(data)
+	if err != nil {
+		return err
+	}
+	s, err := recoverPipelineScope(p, hp.proc)
+	if err != nil {
+		return err
+	}
+	s.Instructions = append(s.Instructions, vm.Instruction{
+		Op: overload.Output,
+		Arg: &output.Argument{
+			Data: conn,
+			Func: writeBack,
+		},
+	})
+	return s.ParallelRun(hp.engine)
+}
+
+func recoverPipelineScope(p protocol.Pipeline, proc *process.Process) (*compile2.Scope, error) {
+	ps := p.Scope
+	if len(ps.PreScopes) > 0 || len(ps.Ins) == 0 {
+		return nil, fmt.Errorf("scope with %v pre-scopes and %v instructions can not be run remotely", len(ps.PreScopes), len(ps.Ins))
+	}
+	s := &compile2.Scope{
+		Magic:        ps.Magic,
+		Instructions: ps.Ins,
+		DataSource: &compile2.Source{
+			SchemaName:   ps.DataSource.SchemaName,
+			RelationName: ps.DataSource.RelationName,
+			Attributes:   ps.DataSource.Attributes,
+		},
+		NodeInfo: engine.Node{
+			Id:   ps.NodeInfo.Id,
+			Addr: ps.NodeInfo.Addr,
+			Data: ps.NodeInfo.Data,
+		},
+	}
+	s.Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+	s.Proc.Lim = proc.Lim
+	s.Proc.UnixTime = p.UnixTime
+	s.Proc.Snapshot = p.Snapshot
+	return s, nil
+}
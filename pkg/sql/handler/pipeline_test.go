@@ -0,0 +1,188 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLocalAddress = "127.0.0.1:41000"
+	testNodePort     = 41001
+	testNodeCount    = 3
+	testPortOffset   = 100
+)
+
+type testResult struct {
+	sync.Mutex
+	rows int
+}
+
+func (r *testResult) fill(_ interface{}, bat *batch.Batch) error {
+	r.Lock()
+	defer r.Unlock()
+	r.rows += vector.Length(bat.Vecs[0])
+	return nil
+}
+
+func TestProcessPipeline(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	gm := guest.New(1<<30, host.New(1<<30))
+
+	// start the rpcservers of the nodes holding the data
+	compile2.InitAddress(testLocalAddress)
+	compile2.InitRemotePortOffset(testPortOffset)
+	nodes := make([]engine.Node, testNodeCount)
+	for i := range nodes {
+		nodes[i] = engine.Node{
+			Id:   fmt.Sprintf("%v", i),
+			Addr: fmt.Sprintf("127.0.0.1:%v", testNodePort+i),
+		}
+		srv, err := rpcserver.New(fmt.Sprintf("127.0.0.1:%v", testNodePort+i+testPortOffset), 1<<30, logutil.GetGlobalLogger())
+		require.NoError(t, err)
+		compile2.InitRemoteCmd(uint64(srv.Register(New(e, process.New(mheap.New(gm))).ProcessPipeline)))
+		require.NoError(t, srv.Run())
+		defer srv.Stop()
+	}
+
+	local := &testResult{}
+	require.NoError(t, newTestScope("r", []engine.Node{{Id: "local", Addr: testLocalAddress}}, gm, local).MergeRun(e))
+	require.Less(t, 0, local.rows)
+
+	remote := &testResult{}
+	require.NoError(t, newTestScope("r", nodes, gm, remote).MergeRun(e))
+	require.Equal(t, testNodeCount*local.rows, remote.rows)
+
+	// the errors of the remote nodes are sent back
+	require.Error(t, newTestScope("not_exist", nodes, gm, &testResult{}).MergeRun(e))
+}
+
+func TestRowsRead(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	gm := guest.New(1<<30, host.New(1<<30))
+	compile2.InitAddress(testLocalAddress)
+
+	r := &testResult{}
+	rs := newTestScope("r", []engine.Node{{Id: "local", Addr: testLocalAddress}}, gm, r)
+	rowsRead := new(int64)
+	rs.PreScopes[0].Proc.RowsRead = rowsRead
+	require.NoError(t, rs.MergeRun(e))
+	require.Less(t, 0, r.rows)
+	require.Equal(t, int64(r.rows), *rowsRead)
+}
+
+func TestRemoteRunCancel(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	gm := guest.New(1<<30, host.New(1<<30))
+	compile2.InitAddress(testLocalAddress)
+	compile2.InitRemotePortOffset(testPortOffset)
+
+	// the node accepts the scope but never replies
+	addr := fmt.Sprintf("127.0.0.1:%v", testNodePort+testNodeCount)
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%v", testNodePort+testNodeCount+testPortOffset))
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	s := newTestScope("r", []engine.Node{{Id: "0", Addr: addr}}, gm, &testResult{}).PreScopes[0]
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	s.Proc.Ctx = ctx
+	require.ErrorIs(t, s.RemoteRun(e), context.DeadlineExceeded)
+}
+
+// newTestScope returns a scope which merges the uid column of the relation read by the nodes
+func newTestScope(rel string, nodes []engine.Node, gm *guest.Mmu, r *testResult) *compile2.Scope {
+	ctx, cancel := context.WithCancel(context.Background())
+	rs := &compile2.Scope{
+		Magic: compile2.Merge,
+		Instructions: vm.Instructions{
+			{Op: overload.Merge, Arg: &merge.Argument{}},
+			{Op: overload.Output, Arg: &output.Argument{Func: r.fill}},
+		},
+	}
+	rs.Proc = process.New(mheap.New(gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(nodes))
+	for i := range nodes {
+		rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		s := &compile2.Scope{
+			Magic:    compile2.Remote,
+			NodeInfo: nodes[i],
+			DataSource: &compile2.Source{
+				SchemaName:   "test",
+				RelationName: rel,
+				Attributes:   []string{"orderid", "uid", "price"},
+			},
+			Instructions: vm.Instructions{
+				{
+					Op: overload.Projection,
+					Arg: &projection.Argument{
+						Es: []*plan.Expr{{
+							Typ:  &plan.Type{Id: plan.Type_UINT32},
+							Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}},
+						}},
+					},
+				},
+				{
+					Op: overload.Connector,
+					Arg: &connector.Argument{
+						Mmu: rs.Proc.Mp.Gm,
+						Reg: rs.Proc.Reg.MergeReceivers[i],
+					},
+				},
+			},
+		}
+		s.Proc = process.New(mheap.New(gm))
+		rs.PreScopes = append(rs.PreScopes, s)
+	}
+	return rs
+}
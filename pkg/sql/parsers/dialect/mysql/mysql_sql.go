@@ -55,392 +55,405 @@ const NEXT = 57377
 const VALUE = 57378
 const SHARE = 57379
 const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const ROLLUP = 57381
+const CUBE = 57382
+const GROUPING = 57383
+const SETS = 57384
+const ANY = 57385
+const SOME = 57386
+const SQL_NO_CACHE = 57387
+const SQL_CACHE = 57388
+const JOIN = 57389
+const STRAIGHT_JOIN = 57390
+const LEFT = 57391
+const RIGHT = 57392
+const INNER = 57393
+const OUTER = 57394
+const CROSS = 57395
+const NATURAL = 57396
+const USE = 57397
+const FORCE = 57398
+const ON = 57399
+const USING = 57400
+const SUBQUERY_AS_EXPR = 57401
+const ID = 57402
+const AT_ID = 57403
+const AT_AT_ID = 57404
+const STRING = 57405
+const VALUE_ARG = 57406
+const LIST_ARG = 57407
+const COMMENT = 57408
+const COMMENT_KEYWORD = 57409
+const INTEGRAL = 57410
+const HEX = 57411
+const HEXNUM = 57412
+const BIT_LITERAL = 57413
+const FLOAT = 57414
+const NULL = 57415
+const TRUE = 57416
+const FALSE = 57417
+const EMPTY_FROM_CLAUSE = 57418
+const LOWER_THAN_CHARSET = 57419
+const CHARSET = 57420
+const UNIQUE = 57421
+const KEY = 57422
+const OR = 57423
+const XOR = 57424
+const AND = 57425
+const NOT = 57426
+const BETWEEN = 57427
+const CASE = 57428
+const WHEN = 57429
+const THEN = 57430
+const ELSE = 57431
+const END = 57432
+const LE = 57433
+const GE = 57434
+const NE = 57435
+const NULL_SAFE_EQUAL = 57436
+const IS = 57437
+const LIKE = 57438
+const REGEXP = 57439
+const IN = 57440
+const ASSIGNMENT = 57441
+const SHIFT_LEFT = 57442
+const SHIFT_RIGHT = 57443
+const JSON_EXTRACT_OP = 57444
+const JSON_UNQUOTE_EXTRACT_OP = 57445
+const DIV = 57446
+const MOD = 57447
+const UNARY = 57448
+const COLLATE = 57449
+const BINARY = 57450
+const UNDERSCORE_BINARY = 57451
+const INTERVAL = 57452
+const BEGIN = 57453
+const START = 57454
+const TRANSACTION = 57455
+const COMMIT = 57456
+const ROLLBACK = 57457
+const WORK = 57458
+const CONSISTENT = 57459
+const SNAPSHOT = 57460
+const CHAIN = 57461
+const NO = 57462
+const RELEASE = 57463
+const BIT = 57464
+const TINYINT = 57465
+const SMALLINT = 57466
+const MEDIUMINT = 57467
+const INT = 57468
+const INTEGER = 57469
+const BIGINT = 57470
+const INTNUM = 57471
+const REAL = 57472
+const DOUBLE = 57473
+const FLOAT_TYPE = 57474
+const DECIMAL = 57475
+const NUMERIC = 57476
+const TIME = 57477
+const TIMESTAMP = 57478
+const DATETIME = 57479
+const YEAR = 57480
+const CHAR = 57481
+const VARCHAR = 57482
+const BOOL = 57483
+const CHARACTER = 57484
+const VARBINARY = 57485
+const NCHAR = 57486
+const TEXT = 57487
+const TINYTEXT = 57488
+const MEDIUMTEXT = 57489
+const LONGTEXT = 57490
+const BLOB = 57491
+const TINYBLOB = 57492
+const MEDIUMBLOB = 57493
+const LONGBLOB = 57494
+const JSON = 57495
+const ENUM = 57496
+const GEOMETRY = 57497
+const POINT = 57498
+const LINESTRING = 57499
+const POLYGON = 57500
+const GEOMETRYCOLLECTION = 57501
+const MULTIPOINT = 57502
+const MULTILINESTRING = 57503
+const MULTIPOLYGON = 57504
+const INT1 = 57505
+const INT2 = 57506
+const INT3 = 57507
+const INT4 = 57508
+const INT8 = 57509
+const CREATE = 57510
+const ALTER = 57511
+const DROP = 57512
+const RENAME = 57513
+const ANALYZE = 57514
+const ADD = 57515
+const SCHEMA = 57516
+const TABLE = 57517
+const INDEX = 57518
+const VIEW = 57519
+const TO = 57520
+const IGNORE = 57521
+const IF = 57522
+const PRIMARY = 57523
+const COLUMN = 57524
+const CONSTRAINT = 57525
+const SPATIAL = 57526
+const FULLTEXT = 57527
+const FOREIGN = 57528
+const KEY_BLOCK_SIZE = 57529
+const SHOW = 57530
+const DESCRIBE = 57531
+const EXPLAIN = 57532
+const DATE = 57533
+const ESCAPE = 57534
+const REPAIR = 57535
+const OPTIMIZE = 57536
+const TRUNCATE = 57537
+const MAXVALUE = 57538
+const PARTITION = 57539
+const REORGANIZE = 57540
+const LESS = 57541
+const THAN = 57542
+const PROCEDURE = 57543
+const TRIGGER = 57544
+const STATUS = 57545
+const VARIABLES = 57546
+const ROLE = 57547
+const PROXY = 57548
+const AVG_ROW_LENGTH = 57549
+const STORAGE = 57550
+const DISK = 57551
+const MEMORY = 57552
+const CHECKSUM = 57553
+const COMPRESSION = 57554
+const DATA = 57555
+const DIRECTORY = 57556
+const DELAY_KEY_WRITE = 57557
+const ENCRYPTION = 57558
+const ENGINE = 57559
+const MAX_ROWS = 57560
+const MIN_ROWS = 57561
+const PACK_KEYS = 57562
+const ROW_FORMAT = 57563
+const STATS_AUTO_RECALC = 57564
+const STATS_PERSISTENT = 57565
+const STATS_SAMPLE_PAGES = 57566
+const DYNAMIC = 57567
+const COMPRESSED = 57568
+const REDUNDANT = 57569
+const COMPACT = 57570
+const FIXED = 57571
+const COLUMN_FORMAT = 57572
+const AUTO_RANDOM = 57573
+const CLUSTER = 57574
+const RESTRICT = 57575
+const CASCADE = 57576
+const ACTION = 57577
+const PARTIAL = 57578
+const SIMPLE = 57579
+const CHECK = 57580
+const ENFORCED = 57581
+const RANGE = 57582
+const LIST = 57583
+const ALGORITHM = 57584
+const LINEAR = 57585
+const PARTITIONS = 57586
+const SUBPARTITION = 57587
+const SUBPARTITIONS = 57588
+const TYPE = 57589
+const PROPERTIES = 57590
+const PARSER = 57591
+const VISIBLE = 57592
+const INVISIBLE = 57593
+const BTREE = 57594
+const HASH = 57595
+const RTREE = 57596
+const BSI = 57597
+const ZONEMAP = 57598
+const EXPIRE = 57599
+const ACCOUNT = 57600
+const UNLOCK = 57601
+const DAY = 57602
+const NEVER = 57603
+const SECOND = 57604
+const ASCII = 57605
+const COALESCE = 57606
+const COLLATION = 57607
+const HOUR = 57608
+const MICROSECOND = 57609
+const MINUTE = 57610
+const MONTH = 57611
+const QUARTER = 57612
+const REPEAT = 57613
+const REVERSE = 57614
+const ROW_COUNT = 57615
+const WEEK = 57616
+const REVOKE = 57617
+const FUNCTION = 57618
+const PRIVILEGES = 57619
+const TABLESPACE = 57620
+const EXECUTE = 57621
+const SUPER = 57622
+const GRANT = 57623
+const OPTION = 57624
+const REFERENCES = 57625
+const REPLICATION = 57626
+const SLAVE = 57627
+const CLIENT = 57628
+const USAGE = 57629
+const RELOAD = 57630
+const FILE = 57631
+const TEMPORARY = 57632
+const ROUTINE = 57633
+const EVENT = 57634
+const SHUTDOWN = 57635
+const NULLX = 57636
+const AUTO_INCREMENT = 57637
+const APPROXNUM = 57638
+const SIGNED = 57639
+const UNSIGNED = 57640
+const ZEROFILL = 57641
+const USER = 57642
+const IDENTIFIED = 57643
+const CIPHER = 57644
+const ISSUER = 57645
+const X509 = 57646
+const SUBJECT = 57647
+const SAN = 57648
+const REQUIRE = 57649
+const SSL = 57650
+const NONE = 57651
+const PASSWORD = 57652
+const MAX_QUERIES_PER_HOUR = 57653
+const MAX_UPDATES_PER_HOUR = 57654
+const MAX_CONNECTIONS_PER_HOUR = 57655
+const MAX_USER_CONNECTIONS = 57656
+const FORMAT = 57657
+const VERBOSE = 57658
+const CONNECTION = 57659
+const LOAD = 57660
+const INFILE = 57661
+const TERMINATED = 57662
+const OPTIONALLY = 57663
+const ENCLOSED = 57664
+const ESCAPED = 57665
+const STARTING = 57666
+const LINES = 57667
+const DATABASES = 57668
+const TABLES = 57669
+const EXTENDED = 57670
+const FULL = 57671
+const PROCESSLIST = 57672
+const FIELDS = 57673
+const COLUMNS = 57674
+const OPEN = 57675
+const ERRORS = 57676
+const WARNINGS = 57677
+const INDEXES = 57678
+const NAMES = 57679
+const GLOBAL = 57680
+const SESSION = 57681
+const ISOLATION = 57682
+const LEVEL = 57683
+const READ = 57684
+const WRITE = 57685
+const ONLY = 57686
+const REPEATABLE = 57687
+const COMMITTED = 57688
+const UNCOMMITTED = 57689
+const SERIALIZABLE = 57690
+const LOCAL = 57691
+const EXCEPT = 57692
+const PERSIST = 57693
+const PERSIST_ONLY = 57694
+const RESET = 57695
+const BACKUP = 57696
+const CURRENT_TIMESTAMP = 57697
+const DATABASE = 57698
+const CURRENT_TIME = 57699
+const LOCALTIME = 57700
+const LOCALTIMESTAMP = 57701
+const UTC_DATE = 57702
+const UTC_TIME = 57703
+const UTC_TIMESTAMP = 57704
+const REPLACE = 57705
+const CONVERT = 57706
+const SEPARATOR = 57707
+const CURRENT_DATE = 57708
+const CURRENT_USER = 57709
+const CURRENT_ROLE = 57710
+const SECOND_MICROSECOND = 57711
+const MINUTE_MICROSECOND = 57712
+const MINUTE_SECOND = 57713
+const HOUR_MICROSECOND = 57714
+const HOUR_SECOND = 57715
+const HOUR_MINUTE = 57716
+const DAY_MICROSECOND = 57717
+const DAY_SECOND = 57718
+const DAY_MINUTE = 57719
+const DAY_HOUR = 57720
+const YEAR_MONTH = 57721
+const SQL_TSI_HOUR = 57722
+const SQL_TSI_DAY = 57723
+const SQL_TSI_WEEK = 57724
+const SQL_TSI_MONTH = 57725
+const SQL_TSI_QUARTER = 57726
+const SQL_TSI_YEAR = 57727
+const SQL_TSI_SECOND = 57728
+const SQL_TSI_MINUTE = 57729
+const RECURSIVE = 57730
+const MATCH = 57731
+const AGAINST = 57732
+const BOOLEAN = 57733
+const LANGUAGE = 57734
+const WITH = 57735
+const QUERY = 57736
+const EXPANSION = 57737
+const ADDDATE = 57738
+const BIT_AND = 57739
+const BIT_OR = 57740
+const BIT_XOR = 57741
+const CAST = 57742
+const COUNT = 57743
+const APPROX_COUNT_DISTINCT = 57744
+const APPROX_PERCENTILE = 57745
+const CURDATE = 57746
+const CURTIME = 57747
+const DATE_ADD = 57748
+const DATE_SUB = 57749
+const EXTRACT = 57750
+const GROUP_CONCAT = 57751
+const MAX = 57752
+const MID = 57753
+const MIN = 57754
+const NOW = 57755
+const POSITION = 57756
+const SESSION_USER = 57757
+const STD = 57758
+const STDDEV = 57759
+const STDDEV_POP = 57760
+const STDDEV_SAMP = 57761
+const SUBDATE = 57762
+const SUBSTR = 57763
+const SUBSTRING = 57764
+const SUM = 57765
+const SYSDATE = 57766
+const SYSTEM_USER = 57767
+const TRANSLATE = 57768
+const TRIM = 57769
+const VARIANCE = 57770
+const VAR_POP = 57771
+const VAR_SAMP = 57772
+const AVG = 57773
+const ROW = 57774
+const OUTFILE = 57775
+const HEADER = 57776
+const MAX_FILE_SIZE = 57777
+const FORCE_QUOTE = 57778
+const UNUSED = 57779
 
 var yyToknames = [...]string{
 	"$end",
@@ -481,6 +494,12 @@ var yyToknames = [...]string{
 	"VALUE",
 	"SHARE",
 	"MODE",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
+	"ANY",
+	"SOME",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
 	"JOIN",
@@ -547,6 +566,8 @@ var yyToknames = [...]string{
 	"'&'",
 	"SHIFT_LEFT",
 	"SHIFT_RIGHT",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"'+'",
 	"'-'",
 	"'*'",
@@ -683,6 +704,7 @@ var yyToknames = [...]string{
 	"FIXED",
 	"COLUMN_FORMAT",
 	"AUTO_RANDOM",
+	"CLUSTER",
 	"RESTRICT",
 	"CASCADE",
 	"ACTION",
@@ -801,6 +823,10 @@ var yyToknames = [...]string{
 	"SERIALIZABLE",
 	"LOCAL",
 	"EXCEPT",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"RESET",
+	"BACKUP",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -894,1895 +920,2142 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6320
+//line mysql_sql.y:6551
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 353,
-	-2, 334,
-	-1, 57,
-	185, 495,
-	-2, 531,
-	-1, 66,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 313,
-	58, 1288,
-	443, 1288,
-	-2, 92,
-	-1, 332,
-	58, 658,
-	443, 658,
-	-2, 493,
-	-1, 333,
-	58, 486,
-	443, 486,
-	-2, 494,
-	-1, 339,
-	17, 354,
-	-2, 317,
-	-1, 563,
-	17, 354,
-	-2, 317,
-	-1, 593,
-	54, 1309,
-	-2, 1322,
-	-1, 594,
-	54, 1310,
-	-2, 1323,
-	-1, 598,
-	54, 1311,
-	-2, 1329,
-	-1, 599,
-	54, 784,
-	-2, 1332,
-	-1, 600,
-	54, 785,
-	-2, 1333,
-	-1, 601,
-	54, 786,
-	-2, 1334,
-	-1, 603,
-	54, 794,
-	-2, 1337,
-	-1, 604,
-	54, 793,
-	-2, 1338,
-	-1, 610,
-	54, 868,
-	-2, 1233,
-	-1, 611,
-	54, 879,
-	-2, 1293,
-	-1, 612,
-	54, 881,
-	-2, 1303,
-	-1, 613,
-	54, 869,
-	-2, 1308,
-	-1, 766,
-	1, 521,
-	56, 521,
-	442, 521,
-	-2, 528,
-	-1, 883,
-	17, 353,
-	-2, 716,
-	-1, 930,
-	119, 1007,
-	-2, 1005,
-	-1, 932,
-	119, 435,
-	-2, 1002,
-	-1, 933,
-	119, 436,
-	-2, 1003,
-	-1, 1127,
-	1, 522,
-	56, 522,
-	442, 522,
-	-2, 528,
-	-1, 1549,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 568,
-	-1, 1551,
-	246, 683,
-	-2, 664,
-	-1, 1669,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 569,
-	-1, 1697,
-	246, 683,
-	-2, 665,
-	-1, 2088,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2092,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2104,
-	55, 547,
-	56, 547,
-	-2, 528,
-	-1, 2107,
-	55, 548,
-	56, 548,
-	-2, 528,
+	-1, 58,
+	17, 365,
+	-2, 346,
+	-1, 63,
+	193, 518,
+	-2, 554,
+	-1, 73,
+	220, 252,
+	221, 252,
+	-2, 272,
+	-1, 317,
+	1, 126,
+	62, 126,
+	455, 126,
+	-2, 221,
+	-1, 330,
+	64, 1335,
+	456, 1335,
+	-2, 95,
+	-1, 349,
+	64, 682,
+	456, 682,
+	-2, 516,
+	-1, 350,
+	64, 509,
+	456, 509,
+	-2, 517,
+	-1, 356,
+	17, 366,
+	-2, 329,
+	-1, 589,
+	17, 366,
+	-2, 329,
+	-1, 620,
+	60, 1356,
+	-2, 1369,
+	-1, 621,
+	60, 1357,
+	-2, 1370,
+	-1, 626,
+	60, 1358,
+	-2, 1376,
+	-1, 627,
+	60, 814,
+	-2, 1379,
+	-1, 628,
+	60, 815,
+	-2, 1380,
+	-1, 629,
+	60, 816,
+	-2, 1381,
+	-1, 631,
+	60, 824,
+	-2, 1384,
+	-1, 632,
+	60, 823,
+	-2, 1385,
+	-1, 639,
+	60, 898,
+	-2, 1280,
+	-1, 640,
+	60, 909,
+	-2, 1340,
+	-1, 641,
+	60, 911,
+	-2, 1350,
+	-1, 642,
+	60, 899,
+	-2, 1355,
+	-1, 799,
+	1, 544,
+	62, 544,
+	455, 544,
+	-2, 551,
+	-1, 923,
+	17, 365,
+	-2, 741,
+	-1, 971,
+	127, 1043,
+	-2, 1041,
+	-1, 973,
+	127, 458,
+	-2, 1038,
+	-1, 974,
+	127, 459,
+	-2, 1039,
+	-1, 1172,
+	1, 545,
+	62, 545,
+	455, 545,
+	-2, 551,
+	-1, 1610,
+	81, 551,
+	123, 551,
+	156, 551,
+	159, 551,
+	-2, 591,
+	-1, 1612,
+	255, 708,
+	-2, 688,
+	-1, 1737,
+	81, 551,
+	123, 551,
+	156, 551,
+	159, 551,
+	-2, 592,
+	-1, 1766,
+	255, 708,
+	-2, 689,
+	-1, 2186,
+	61, 566,
+	62, 566,
+	-2, 551,
+	-1, 2190,
+	61, 566,
+	62, 566,
+	-2, 551,
+	-1, 2202,
+	61, 570,
+	62, 570,
+	-2, 551,
+	-1, 2205,
+	61, 571,
+	62, 571,
+	-2, 551,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17430
+const yyLast = 19812
 
 var yyAct = [...]int{
-	756, 1179, 2094, 2092, 2091, 2099, 2065, 616, 2039, 1742,
-	745, 614, 1929, 634, 2010, 1180, 2054, 1709, 1991, 1905,
-	550, 1992, 1665, 1882, 84, 516, 1543, 289, 1114, 1740,
-	1908, 818, 1837, 548, 1741, 1893, 1732, 87, 1810, 454,
-	84, 302, 300, 389, 1344, 293, 19, 504, 1610, 334,
-	334, 1731, 1628, 643, 52, 1439, 1630, 1627, 1443, 1467,
-	1698, 802, 574, 1427, 1639, 584, 83, 1635, 1476, 1320,
-	1455, 1448, 1596, 390, 1444, 1120, 1493, 1494, 1380, 411,
-	52, 912, 295, 84, 825, 739, 927, 520, 615, 558,
-	922, 930, 921, 697, 913, 1257, 625, 1243, 795, 51,
-	292, 12, 290, 6, 291, 5, 3, 1673, 742, 1314,
-	1128, 758, 1181, 740, 1194, 340, 577, 492, 339, 1178,
-	714, 799, 771, 772, 770, 420, 19, 1096, 400, 402,
-	282, 820, 1087, 456, 52, 431, 855, 285, 731, 559,
-	382, 305, 410, 541, 442, 296, 80, 306, 304, 1103,
-	471, 1755, 1661, 1542, 753, 915, 408, 309, 309, 1099,
-	79, 341, 1296, 79, 1957, 23, 39, 24, 79, 79,
-	23, 39, 24, 79, 79, 401, 527, 525, 1428, 1315,
-	77, 12, 1946, 6, 502, 5, 1303, 417, 789, 396,
-	406, 405, 398, 694, 523, 491, 691, 1306, 336, 1979,
-	1404, 784, 785, 517, 518, 1995, 1996, 369, 75, 515,
-	774, 75, 514, 517, 518, 352, 75, 693, 1977, 383,
-	404, 75, 75, 528, 748, 486, 482, 2014, 1835, 1431,
-	359, 1838, 1839, 1840, 1841, 397, 1917, 1432, 1920, 1433,
-	1758, 1544, 752, 1456, 1457, 1458, 1459, 434, 1283, 1477,
-	425, 1323, 1321, 1318, 1322, 1324, 1480, 1317, 1316, 796,
-	1323, 1321, 1101, 1322, 1324, 1099, 370, 1809, 1718, 1717,
-	473, 484, 485, 1714, 1658, 483, 1539, 732, 472, 1826,
-	1622, 1981, 84, 424, 1618, 1495, 1894, 1895, 1896, 1898,
-	1897, 2005, 423, 1816, 2100, 84, 1956, 2084, 1994, 1460,
-	2019, 1479, 1621, 734, 1976, 1931, 2026, 477, 1506, 1503,
-	1504, 1505, 1954, 1500, 403, 1499, 1498, 1496, 1804, 2075,
-	1907, 458, 354, 1326, 1327, 1328, 1329, 1927, 1928, 1773,
-	1931, 1795, 351, 350, 2057, 478, 1772, 438, 459, 338,
-	52, 52, 402, 1983, 1984, 1937, 537, 513, 512, 434,
-	480, 2101, 2095, 346, 2066, 464, 1761, 419, 1959, 1960,
-	1381, 505, 526, 393, 422, 1304, 407, 1915, 468, 1497,
-	524, 1300, 1150, 1107, 481, 760, 507, 733, 1540, 334,
-	1452, 294, 1637, 1636, 1342, 390, 390, 390, 401, 436,
-	435, 1619, 503, 1146, 506, 497, 508, 1148, 1147, 531,
-	787, 463, 788, 1332, 529, 530, 374, 475, 1145, 786,
-	411, 706, 707, 580, 371, 372, 2079, 2043, 1434, 476,
-	479, 809, 696, 553, 427, 428, 1354, 1294, 579, 474,
-	1799, 1293, 868, 2058, 1282, 1276, 395, 349, 711, 1334,
-	424, 84, 84, 84, 84, 521, 1140, 345, 1112, 715,
-	1081, 561, 728, 1867, 366, 376, 375, 837, 699, 1420,
-	555, 437, 421, 2061, 692, 1982, 1183, 1182, 334, 334,
-	424, 334, 52, 1422, 1501, 1502, 458, 540, 509, 746,
-	494, 429, 2052, 52, 517, 518, 1906, 309, 1453, 334,
-	334, 436, 435, 459, 710, 729, 1468, 1958, 1941, 353,
-	488, 1428, 709, 517, 518, 334, 510, 334, 1278, 766,
-	84, 755, 1098, 1333, 759, 562, 564, 797, 398, 563,
-	1617, 1152, 496, 1421, 779, 1122, 334, 536, 765, 1323,
-	1321, 547, 1322, 1324, 1102, 470, 1797, 1085, 334, 390,
-	1796, 334, 1620, 1297, 1522, 2055, 2056, 539, 777, 426,
-	761, 78, 767, 1188, 78, 1258, 810, 803, 393, 78,
-	78, 397, 1097, 803, 78, 78, 573, 702, 334, 334,
-	817, 84, 780, 411, 750, 1312, 826, 309, 560, 747,
-	835, 567, 568, 569, 570, 571, 716, 717, 718, 719,
-	762, 519, 821, 522, 511, 727, 763, 838, 1767, 363,
-	768, 769, 832, 751, 544, 545, 546, 364, 735, 822,
-	776, 781, 754, 819, 775, 309, 744, 1449, 1452, 1800,
-	1801, 885, 542, 1258, 1391, 1386, 749, 1175, 833, 834,
-	832, 395, 1806, 543, 1334, 884, 1524, 554, 1176, 773,
-	1805, 1651, 1790, 892, 834, 832, 309, 460, 461, 462,
-	551, 1600, 812, 815, 798, 764, 1250, 1595, 1868, 1870,
-	1871, 1872, 1869, 1355, 2074, 460, 461, 462, 551, 808,
-	1248, 1249, 1247, 883, 373, 894, 811, 309, 1650, 794,
-	895, 813, 460, 461, 462, 1612, 793, 1666, 805, 806,
-	807, 833, 834, 832, 919, 919, 924, 73, 1191, 549,
-	833, 834, 832, 2090, 814, 2073, 552, 1193, 1878, 886,
-	887, 888, 889, 826, 926, 823, 1988, 816, 2071, 401,
-	932, 2036, 2020, 890, 552, 2015, 1453, 460, 461, 462,
-	551, 1446, 1966, 2004, 1111, 1447, 1450, 933, 833, 834,
-	832, 1613, 1913, 862, 1877, 910, 377, 402, 869, 870,
-	871, 872, 873, 874, 875, 868, 361, 52, 362, 369,
-	1912, 84, 84, 360, 358, 357, 365, 1884, 367, 368,
-	1876, 1110, 399, 1862, 289, 871, 872, 873, 874, 875,
-	868, 1142, 1861, 1860, 918, 902, 552, 1451, 1857, 1851,
-	334, 1095, 821, 401, 833, 834, 832, 1082, 1389, 1115,
-	1116, 1388, 1848, 1117, 1119, 1847, 1875, 1083, 1813, 822,
-	334, 1874, 1911, 925, 1756, 1750, 398, 833, 834, 832,
-	1749, 803, 803, 803, 833, 834, 832, 1748, 1747, 580,
-	1744, 84, 1606, 931, 833, 834, 832, 1172, 1173, 1963,
-	1080, 1079, 1833, 1605, 579, 1604, 1603, 1873, 1169, 1170,
-	1171, 1092, 833, 834, 832, 1189, 1190, 1143, 1134, 1864,
-	1416, 1131, 1132, 1133, 833, 834, 832, 1186, 841, 842,
-	843, 844, 845, 846, 1129, 839, 700, 1106, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	910, 1987, 1821, 1252, 1253, 1863, 2104, 1136, 309, 1138,
-	773, 1139, 1645, 1135, 1137, 1266, 1177, 1883, 2082, 1259,
-	1948, 1530, 1262, 1168, 833, 834, 832, 1521, 1157, 1935,
-	1268, 1165, 1934, 1865, 833, 834, 832, 1153, 1154, 1155,
-	1158, 1858, 1159, 833, 834, 832, 1854, 1853, 1149, 833,
-	834, 832, 1852, 1811, 1572, 1166, 1792, 1757, 1701, 867,
-	866, 876, 877, 869, 870, 871, 872, 873, 874, 875,
-	868, 1345, 1184, 1185, 1515, 1187, 1664, 1662, 1514, 1251,
-	1614, 1224, 1225, 1226, 1227, 1245, 1228, 1229, 1230, 1513,
-	1465, 1464, 1512, 1704, 1463, 1511, 833, 834, 832, 1699,
-	833, 834, 832, 1462, 1109, 1712, 1713, 1510, 1108, 906,
-	1700, 833, 834, 832, 833, 834, 832, 833, 834, 832,
-	1260, 460, 461, 462, 1962, 1281, 1261, 1263, 1264, 833,
-	834, 832, 905, 904, 1270, 701, 1942, 1267, 1395, 1269,
-	1560, 1357, 1394, 1891, 1705, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 1579, 1583, 1585, 1587, 1589,
-	1590, 1592, 1828, 1506, 1503, 1504, 1505, 1509, 1574, 1575,
-	1576, 1577, 1558, 1559, 1580, 1827, 1561, 1652, 1562, 1563,
-	1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571, 1578, 833,
-	834, 832, 1284, 1361, 1649, 424, 1582, 1584, 1586, 1588,
-	1591, 1648, 343, 1626, 715, 1492, 1357, 2109, 1491, 1549,
-	334, 1288, 342, 334, 1289, 1531, 424, 1291, 334, 1711,
-	1490, 1445, 1482, 1309, 1573, 1299, 2060, 833, 834, 832,
-	833, 834, 832, 1254, 2103, 2102, 1307, 1308, 1481, 759,
-	1105, 2085, 833, 834, 832, 1398, 1707, 1396, 833, 834,
-	832, 1339, 1393, 566, 1392, 833, 834, 832, 2081, 2080,
-	1390, 334, 1105, 2069, 1105, 2068, 2042, 2041, 1706, 1708,
-	1366, 84, 84, 1823, 2002, 1350, 1823, 1997, 1161, 1985,
-	1974, 1973, 1363, 1331, 1823, 1952, 1823, 1951, 1823, 1950,
-	1311, 1823, 1949, 1940, 1939, 1889, 1890, 1889, 1888, 1362,
-	1832, 1831, 1356, 1301, 1830, 1829, 1341, 1358, 1265, 1286,
-	1359, 1360, 398, 1347, 1348, 1287, 1823, 1822, 19, 698,
-	1714, 1298, 730, 1295, 1164, 1534, 52, 1357, 1516, 1357,
-	1507, 565, 1702, 487, 1336, 1310, 1337, 466, 1335, 1357,
-	1365, 1357, 1364, 1164, 1285, 1129, 1343, 1357, 1330, 830,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1271, 1375, 1280,
-	1279, 1346, 1084, 1340, 1274, 1273, 1164, 1163, 1105, 1104,
-	467, 1378, 1379, 12, 1338, 6, 465, 5, 1550, 1349,
-	466, 1383, 704, 703, 1387, 919, 1099, 1408, 919, 1532,
-	1353, 1411, 468, 828, 1277, 1255, 1399, 1161, 803, 1113,
-	572, 826, 538, 334, 803, 79, 2105, 334, 334, 2051,
-	883, 334, 2045, 1414, 468, 2027, 2024, 2022, 1965, 1581,
-	1903, 1887, 1885, 1880, 424, 1842, 1629, 1819, 439, 1405,
-	1415, 1818, 1817, 1442, 1814, 1803, 84, 52, 1788, 444,
-	447, 448, 449, 445, 1403, 446, 450, 1377, 1728, 1725,
-	1410, 1724, 1815, 75, 1245, 1376, 401, 1631, 575, 1640,
-	1643, 1608, 1385, 1601, 84, 1487, 1246, 1407, 1313, 1290,
-	1272, 1162, 1151, 1144, 1125, 1406, 1400, 911, 1466, 1412,
-	1409, 909, 908, 1489, 1413, 1418, 1417, 907, 1419, 903,
-	856, 900, 898, 1508, 897, 896, 1426, 893, 75, 1461,
-	1518, 865, 1469, 1470, 444, 447, 448, 449, 445, 864,
-	446, 450, 1523, 863, 861, 1423, 1425, 1527, 860, 1529,
-	859, 867, 866, 876, 877, 869, 870, 871, 872, 873,
-	874, 875, 868, 1526, 858, 334, 1473, 857, 698, 1528,
-	1471, 1472, 854, 853, 852, 1487, 1486, 84, 851, 850,
-	849, 848, 847, 712, 695, 469, 1594, 2032, 1520, 1088,
-	1089, 2030, 1993, 1325, 1160, 1091, 1517, 444, 447, 448,
-	449, 445, 489, 446, 450, 1525, 303, 724, 722, 1094,
-	1519, 1093, 725, 723, 726, 721, 448, 449, 720, 1548,
-	2089, 1547, 1275, 2007, 556, 557, 1533, 1625, 1130, 52,
-	1611, 1115, 1116, 1436, 1429, 493, 1598, 1536, 1123, 783,
-	1624, 1609, 1538, 1759, 1537, 413, 415, 416, 495, 1435,
-	824, 79, 452, 23, 39, 24, 335, 1078, 1593, 1597,
-	1557, 1597, 1599, 1183, 1182, 1602, 499, 500, 2046, 1970,
-	1607, 65, 1968, 1535, 1922, 72, 1921, 334, 334, 1919,
-	1647, 84, 1845, 1843, 1616, 1663, 1623, 1546, 803, 1545,
-	1485, 424, 1670, 343, 40, 498, 1632, 1633, 1634, 75,
-	1442, 342, 1352, 342, 1484, 698, 1367, 1615, 1292, 1641,
-	1638, 1644, 866, 876, 877, 869, 870, 871, 872, 873,
-	874, 875, 868, 1659, 1646, 2034, 2033, 2034, 281, 2033,
-	451, 355, 1, 501, 1654, 708, 1733, 1735, 433, 1733,
-	1733, 705, 1657, 432, 430, 74, 1695, 2072, 1256, 424,
-	1715, 1667, 1721, 1195, 1719, 1720, 644, 1739, 1722, 1723,
-	914, 920, 1881, 2006, 2038, 68, 69, 1964, 70, 71,
-	2009, 633, 1726, 1734, 1729, 1730, 617, 1914, 1430, 1834,
-	1916, 1836, 2049, 1305, 1752, 1655, 1656, 1302, 1738, 490,
-	1736, 1737, 867, 866, 876, 877, 869, 870, 871, 872,
-	873, 874, 875, 868, 1401, 1402, 657, 1751, 1746, 647,
-	899, 648, 1763, 690, 414, 646, 1745, 1478, 344, 412,
-	356, 1808, 57, 67, 76, 1753, 38, 867, 866, 876,
-	877, 869, 870, 871, 872, 873, 874, 875, 868, 1541,
-	1716, 1642, 66, 64, 63, 1727, 1192, 2098, 2088, 2064,
-	2044, 1930, 2083, 1791, 1975, 84, 2025, 1766, 2018, 1926,
-	1760, 307, 790, 532, 380, 1904, 1611, 387, 713, 1454,
-	1319, 1764, 1765, 1121, 1768, 1769, 1770, 1771, 1100, 1735,
-	1774, 1775, 1776, 1777, 1778, 1779, 1780, 1781, 1782, 1783,
-	1784, 1785, 1786, 1787, 1793, 741, 1715, 1807, 1789, 1825,
-	308, 1955, 1886, 347, 1812, 1846, 1124, 348, 1127, 1126,
-	840, 1244, 901, 891, 582, 1820, 1384, 624, 618, 1475,
-	1474, 1710, 778, 26, 453, 831, 928, 1879, 48, 645,
-	86, 1141, 929, 1923, 49, 1754, 2011, 458, 632, 631,
-	1824, 630, 629, 443, 441, 1844, 440, 299, 298, 1351,
-	1483, 827, 829, 52, 459, 424, 1859, 1990, 424, 424,
-	424, 1989, 1944, 1945, 424, 1660, 1849, 1850, 1802, 1866,
-	1798, 50, 1855, 1856, 1794, 1936, 1669, 1668, 1696, 1697,
-	1703, 1556, 1552, 1924, 1892, 1554, 1555, 1900, 1901, 1902,
-	1553, 1551, 1899, 1910, 1440, 1441, 1438, 1437, 1909, 1090,
-	1086, 916, 923, 418, 757, 81, 1925, 297, 1382, 1918,
-	1167, 576, 11, 18, 17, 16, 47, 46, 45, 44,
-	15, 84, 8, 1932, 1933, 43, 42, 41, 424, 867,
-	866, 876, 877, 869, 870, 871, 872, 873, 874, 875,
-	868, 1943, 78, 14, 424, 13, 37, 36, 35, 34,
-	33, 32, 1938, 31, 30, 29, 28, 1947, 27, 9,
-	56, 55, 54, 819, 53, 20, 21, 22, 62, 61,
-	60, 59, 58, 1953, 25, 10, 7, 4, 2, 0,
-	1961, 0, 1969, 1967, 1971, 1972, 0, 0, 0, 2047,
-	0, 0, 0, 1978, 1980, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1986, 0, 2013, 0, 0, 0,
-	0, 0, 0, 0, 0, 2017, 0, 0, 0, 2012,
-	1998, 1999, 2000, 2001, 0, 0, 0, 0, 0, 2021,
-	0, 2023, 0, 2016, 867, 866, 876, 877, 869, 870,
-	871, 872, 873, 874, 875, 868, 0, 0, 2028, 0,
-	0, 2031, 0, 2029, 0, 2040, 2003, 0, 0, 0,
-	2035, 0, 0, 424, 0, 424, 2037, 0, 0, 0,
-	0, 0, 746, 2048, 746, 2050, 0, 0, 0, 2053,
-	0, 0, 0, 2013, 2063, 0, 0, 0, 0, 0,
-	0, 2059, 424, 0, 0, 0, 2012, 2062, 0, 2067,
-	0, 746, 2070, 0, 0, 0, 0, 0, 2040, 2076,
-	0, 0, 0, 0, 0, 0, 0, 2078, 0, 0,
-	2086, 0, 0, 0, 0, 0, 0, 0, 2087, 0,
-	0, 0, 0, 0, 0, 2097, 0, 2096, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2108, 2107, 2106,
-	2097, 1046, 1032, 0, 994, 1048, 966, 982, 1056, 984,
-	985, 1019, 944, 1003, 211, 980, 936, 969, 970, 938,
-	977, 939, 967, 996, 155, 965, 1035, 1006, 180, 1054,
-	182, 0, 0, 240, 195, 0, 0, 999, 1037, 1001,
-	1024, 993, 1020, 952, 1013, 1049, 981, 1017, 1050, 0,
-	0, 0, 0, 460, 461, 462, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 1016, 1042, 979, 0,
-	0, 953, 1047, 1000, 1018, 0, 937, 1014, 0, 942,
-	945, 1055, 1040, 974, 975, 0, 0, 0, 0, 0,
-	0, 0, 997, 1002, 1021, 990, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 971, 0, 1010, 0, 0,
-	0, 947, 943, 0, 995, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	1044, 1045, 149, 275, 946, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 1066, 1067,
-	1068, 1069, 1070, 951, 0, 972, 1022, 0, 935, 1031,
-	1038, 992, 269, 1041, 989, 988, 1073, 0, 1072, 244,
-	1074, 1075, 179, 1036, 968, 978, 973, 976, 230, 213,
-	1043, 1009, 218, 228, 183, 255, 222, 260, 246, 268,
-	1025, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1071, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 934, 264, 0, 209, 1033, 940, 950, 948,
-	986, 1011, 1012, 205, 280, 1027, 1030, 1028, 1057, 233,
-	1215, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 941,
-	0, 241, 262, 274, 265, 987, 959, 998, 273, 962,
-	960, 1026, 961, 1015, 1059, 199, 200, 201, 202, 983,
-	0, 142, 1007, 991, 1060, 1061, 1062, 1063, 1064, 1065,
-	964, 1039, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 958, 963, 957, 1004, 1005,
-	1051, 1052, 1053, 1023, 949, 1034, 954, 956, 955, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1029, 1008,
-	124, 0, 181, 1058, 224, 160, 0, 0, 0, 0,
-	0, 1211, 0, 1208, 0, 0, 0, 1210, 1207, 1209,
-	1213, 1214, 0, 0, 0, 1212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 79, 0, 653, 0,
-	0, 0, 1076, 1077, 277, 278, 279, 263, 211, 0,
-	0, 0, 0, 0, 626, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 669, 675, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 619, 0, 0, 583, 659, 658,
-	635, 0, 0, 0, 138, 636, 0, 641, 0, 637,
-	640, 638, 639, 0, 0, 661, 0, 0, 0, 0,
-	0, 581, 623, 0, 627, 0, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1218, 1219, 1220,
-	1221, 1222, 1223, 1216, 1217, 620, 621, 0, 0, 0,
-	0, 654, 0, 622, 0, 0, 656, 0, 642, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 651, 652, 149, 612, 649, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 667,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	650, 0, 230, 213, 678, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 665, 209,
-	677, 660, 662, 663, 666, 670, 671, 610, 613, 672,
-	674, 676, 679, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 611, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 655, 199,
-	200, 201, 202, 668, 0, 142, 0, 0, 1653, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 685,
-	664, 684, 686, 687, 683, 688, 689, 673, 628, 0,
-	681, 680, 682, 867, 866, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 78, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	593, 594, 595, 101, 596, 103, 597, 598, 106, 107,
-	599, 600, 601, 602, 112, 603, 604, 605, 606, 117,
-	118, 119, 120, 607, 608, 609, 653, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 626, 0, 0, 0, 155, 804, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 1397, 0, 0,
-	0, 669, 675, 0, 0, 0, 0, 0, 0, 800,
-	0, 0, 619, 0, 0, 583, 659, 658, 635, 0,
-	0, 0, 138, 636, 0, 641, 0, 637, 640, 638,
-	639, 0, 0, 661, 0, 0, 0, 0, 0, 581,
-	623, 0, 627, 867, 866, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 0, 0, 0, 0, 0,
-	0, 0, 0, 620, 621, 0, 0, 0, 0, 654,
-	0, 622, 0, 0, 801, 0, 642, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 651, 652, 149, 612, 649, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 667, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 650, 0,
-	230, 213, 678, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 665, 209, 677, 660,
-	662, 663, 666, 670, 671, 610, 613, 672, 674, 676,
-	679, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 611, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 655, 199, 200, 201,
-	202, 668, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 685, 664, 684,
-	686, 687, 683, 688, 689, 673, 628, 0, 681, 680,
-	682, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 593, 594,
-	595, 101, 596, 103, 597, 598, 106, 107, 599, 600,
-	601, 602, 112, 603, 604, 605, 606, 117, 118, 119,
-	120, 607, 608, 609, 653, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	626, 0, 0, 0, 155, 2077, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 669,
-	675, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	619, 0, 0, 583, 659, 658, 635, 0, 0, 0,
-	138, 636, 0, 641, 0, 637, 640, 638, 639, 0,
-	0, 661, 0, 0, 0, 0, 0, 581, 623, 0,
-	627, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 620, 621, 0, 0, 0, 0, 654, 0, 622,
-	0, 0, 656, 0, 642, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	651, 652, 149, 612, 649, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 667, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 650, 0, 230, 213,
-	678, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 665, 209, 677, 660, 662, 663,
-	666, 670, 671, 610, 613, 672, 674, 676, 679, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 611, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 655, 199, 200, 201, 202, 668,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 685, 664, 684, 686, 687,
-	683, 688, 689, 673, 628, 0, 681, 680, 682, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 585, 586, 587,
-	588, 589, 590, 591, 96, 592, 593, 594, 595, 101,
-	596, 103, 597, 598, 106, 107, 599, 600, 601, 602,
-	112, 603, 604, 605, 606, 117, 118, 119, 120, 607,
-	608, 609, 653, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 626, 0,
-	0, 0, 155, 804, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 669, 675, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 619, 0,
-	0, 583, 659, 658, 635, 0, 0, 0, 138, 636,
-	0, 641, 0, 637, 640, 638, 639, 0, 0, 661,
-	0, 0, 0, 0, 0, 581, 623, 0, 627, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 620,
-	621, 0, 0, 0, 0, 654, 0, 622, 0, 0,
-	656, 0, 642, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 651, 652,
-	149, 612, 649, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 667, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 650, 0, 230, 213, 678, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 665, 209, 677, 660, 662, 663, 666, 670,
-	671, 610, 613, 672, 674, 676, 679, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 611, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 655, 199, 200, 201, 202, 668, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 685, 664, 684, 686, 687, 683, 688,
-	689, 673, 628, 0, 681, 680, 682, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 585, 586, 587, 588, 589,
-	590, 591, 96, 592, 593, 594, 595, 101, 596, 103,
-	597, 598, 106, 107, 599, 600, 601, 602, 112, 603,
-	604, 605, 606, 117, 118, 119, 120, 607, 608, 609,
-	653, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 626, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 669, 675, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 619, 0, 0, 583,
-	659, 658, 635, 0, 0, 0, 138, 636, 0, 641,
-	0, 637, 640, 638, 639, 0, 0, 661, 0, 0,
-	0, 0, 0, 581, 623, 0, 627, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 620, 621, 578,
-	0, 0, 0, 654, 0, 622, 0, 0, 656, 0,
-	642, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 651, 652, 149, 612,
-	649, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 667, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 650, 0, 230, 213, 678, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	665, 209, 677, 660, 662, 663, 666, 670, 671, 610,
-	613, 672, 674, 676, 679, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	611, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	655, 199, 200, 201, 202, 668, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 685, 664, 684, 686, 687, 683, 688, 689, 673,
-	628, 0, 681, 680, 682, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 593, 594, 595, 101, 596, 103, 597, 598,
-	106, 107, 599, 600, 601, 602, 112, 603, 604, 605,
-	606, 117, 118, 119, 120, 607, 608, 609, 653, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 626, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 669, 675, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 619, 0, 0, 583, 659, 658,
-	635, 0, 0, 0, 138, 636, 0, 641, 0, 637,
-	640, 638, 639, 0, 0, 661, 0, 0, 0, 0,
-	0, 581, 623, 0, 627, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 620, 621, 0, 0, 0,
-	0, 654, 0, 622, 0, 0, 656, 0, 642, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 651, 652, 149, 612, 649, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 667,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	650, 0, 230, 213, 678, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 665, 209,
-	677, 660, 662, 663, 666, 670, 671, 610, 613, 672,
-	674, 676, 679, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 611, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 655, 199,
-	200, 201, 202, 668, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 685,
-	664, 684, 686, 687, 683, 688, 689, 673, 628, 0,
-	681, 680, 682, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	593, 594, 595, 101, 596, 103, 597, 598, 106, 107,
-	599, 600, 601, 602, 112, 603, 604, 605, 606, 117,
-	118, 119, 120, 607, 608, 609, 653, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 626, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 669, 675, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 619, 0, 0, 583, 659, 658, 635, 0,
-	0, 0, 138, 636, 0, 641, 0, 637, 640, 638,
-	639, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	623, 0, 627, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 620, 621, 0, 0, 0, 0, 654,
-	0, 622, 0, 0, 656, 0, 642, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 651, 652, 149, 612, 649, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 667, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 650, 0,
-	230, 213, 678, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 665, 209, 677, 660,
-	662, 663, 666, 670, 671, 610, 613, 672, 674, 676,
-	679, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 611, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 655, 199, 200, 201,
-	202, 668, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 685, 664, 684,
-	686, 687, 683, 688, 689, 673, 628, 0, 681, 680,
-	682, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 593, 594,
-	595, 101, 596, 103, 597, 598, 106, 107, 599, 600,
-	601, 602, 112, 603, 604, 605, 606, 117, 118, 119,
-	120, 607, 608, 609, 0, 0, 277, 278, 279, 263,
-	319, 0, 318, 322, 314, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 310, 0, 0, 879, 0, 882,
-	0, 0, 155, 0, 0, 329, 180, 0, 182, 0,
-	0, 240, 195, 880, 881, 878, 0, 867, 866, 876,
-	877, 869, 870, 871, 872, 873, 874, 875, 868, 0,
-	0, 332, 0, 0, 333, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 0, 318, 322, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 312, 311, 315, 0, 0, 0, 0, 0, 317,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 321, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 313, 246, 268, 0, 337,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 312, 311, 315, 0, 0, 162,
-	0, 264, 317, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 321, 0, 0, 233, 0, 0,
-	0, 316, 320, 323, 215, 324, 325, 0, 736, 326,
-	327, 328, 0, 0, 330, 331, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 320, 737, 0, 324, 738,
-	0, 0, 326, 327, 328, 0, 0, 330, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	0, 0, 277, 278, 279, 263, 319, 0, 318, 322,
-	314, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	310, 0, 0, 0, 0, 0, 0, 0, 155, 0,
-	0, 329, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
-	333, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 312, 311, 315,
-	0, 0, 0, 0, 0, 317, 269, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 179, 321, 0, 0,
-	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
-	222, 313, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 316, 320, 323,
-	215, 324, 325, 0, 0, 326, 327, 328, 0, 0,
-	330, 331, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 0, 0, 277, 278,
-	279, 263, 79, 0, 23, 39, 24, 0, 0, 0,
-	0, 0, 0, 0, 211, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 0, 85, 0, 0, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 284,
-	286, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 78, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 211, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 85, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1449, 1452, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1453,
-	269, 0, 0, 0, 1446, 0, 1445, 244, 1447, 1450,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	1451, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	211, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	155, 379, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	391, 392, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	395, 267, 133, 394, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 378, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	381, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 388, 384, 385,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 386,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
+	789, 1230, 2192, 2190, 2189, 2197, 2163, 645, 2137, 1812,
+	778, 2021, 663, 2108, 2152, 1231, 1779, 643, 2088, 1991,
+	2089, 576, 1733, 2001, 1994, 1967, 540, 91, 672, 58,
+	303, 1604, 57, 314, 1159, 1810, 853, 1921, 1811, 1979,
+	1802, 94, 474, 91, 316, 574, 1693, 1687, 1889, 1671,
+	1503, 408, 1767, 1400, 1801, 351, 351, 1696, 58, 528,
+	90, 1499, 1694, 307, 22, 601, 1487, 611, 835, 1705,
+	1701, 1376, 1536, 1515, 1508, 1657, 727, 1504, 1165, 1553,
+	409, 953, 644, 1554, 1439, 357, 431, 861, 309, 584,
+	91, 962, 968, 772, 971, 963, 954, 1312, 654, 1296,
+	3, 544, 828, 306, 12, 304, 6, 305, 5, 791,
+	419, 421, 1229, 1370, 1741, 420, 58, 1173, 773, 744,
+	417, 1232, 1245, 440, 604, 804, 516, 1527, 832, 856,
+	1141, 805, 1132, 803, 775, 299, 321, 451, 476, 296,
+	891, 430, 399, 811, 764, 322, 462, 1148, 585, 565,
+	323, 22, 310, 495, 87, 400, 1832, 1729, 1603, 786,
+	956, 428, 84, 1818, 358, 86, 415, 26, 42, 27,
+	86, 2050, 86, 86, 26, 42, 27, 1144, 1352, 356,
+	86, 551, 1488, 86, 1371, 2039, 526, 1713, 437, 326,
+	326, 12, 353, 6, 724, 5, 1359, 721, 318, 369,
+	1464, 425, 424, 426, 547, 317, 548, 822, 515, 1362,
+	376, 817, 818, 539, 541, 542, 538, 541, 542, 82,
+	2092, 2093, 386, 807, 723, 781, 82, 82, 510, 506,
+	2112, 423, 552, 1919, 82, 485, 2075, 82, 1491, 2073,
+	1922, 1923, 1924, 1925, 1605, 416, 2009, 1492, 2012, 1493,
+	1835, 785, 1339, 445, 454, 1516, 1517, 1518, 1519, 1379,
+	1377, 1374, 1378, 1380, 1537, 1373, 1372, 1379, 1377, 1540,
+	1378, 1380, 1146, 387, 1144, 829, 1888, 1788, 1787, 497,
+	508, 509, 1784, 1726, 507, 1600, 1520, 501, 765, 496,
+	1905, 1683, 2102, 2182, 1682, 1895, 91, 444, 1980, 1981,
+	1982, 1984, 1983, 2198, 2077, 2049, 443, 1679, 371, 91,
+	2117, 2072, 2124, 2091, 767, 502, 1539, 2023, 368, 367,
+	91, 1382, 1383, 1384, 1385, 2047, 422, 1993, 1883, 58,
+	58, 421, 2029, 2173, 2155, 420, 478, 2019, 2020, 363,
+	2023, 1850, 458, 1849, 484, 355, 2079, 2080, 483, 1877,
+	561, 1873, 504, 488, 537, 536, 479, 2199, 2193, 2164,
+	1838, 439, 1440, 1512, 1356, 529, 454, 486, 2052, 2053,
+	391, 2007, 793, 492, 1398, 1195, 1152, 487, 505, 531,
+	1360, 1601, 427, 319, 442, 549, 308, 521, 499, 766,
+	1191, 1680, 1703, 1702, 1193, 1192, 351, 456, 455, 527,
+	500, 503, 409, 409, 409, 409, 409, 555, 553, 554,
+	498, 820, 819, 821, 1190, 736, 737, 389, 388, 2177,
+	2141, 393, 392, 530, 366, 532, 1494, 1410, 1350, 1349,
+	431, 906, 1338, 607, 362, 1332, 447, 448, 1951, 545,
+	1185, 2156, 726, 1157, 1126, 587, 873, 2159, 606, 1482,
+	729, 581, 457, 441, 579, 844, 1480, 1555, 741, 2150,
+	444, 91, 91, 91, 91, 383, 566, 58, 564, 745,
+	921, 922, 758, 1513, 1528, 1234, 1233, 567, 58, 2034,
+	1566, 1563, 1564, 1565, 534, 1560, 409, 1559, 1558, 1556,
+	370, 2078, 351, 351, 444, 351, 1992, 478, 533, 449,
+	740, 1481, 722, 779, 588, 590, 518, 512, 739, 456,
+	455, 2051, 1817, 351, 351, 1334, 1197, 479, 759, 1379,
+	1377, 520, 1378, 1380, 541, 542, 1488, 541, 542, 351,
+	326, 351, 560, 799, 91, 788, 830, 1167, 792, 1147,
+	589, 1557, 356, 1681, 563, 494, 1878, 1879, 812, 812,
+	1143, 351, 1678, 798, 2153, 2154, 573, 568, 569, 570,
+	571, 572, 351, 409, 1875, 351, 1130, 800, 1874, 85,
+	1239, 446, 1353, 810, 85, 1583, 85, 85, 535, 586,
+	1313, 836, 845, 416, 85, 732, 794, 85, 543, 836,
+	546, 600, 1509, 1512, 351, 351, 852, 91, 91, 1313,
+	431, 1445, 1142, 862, 412, 356, 783, 871, 1222, 814,
+	870, 868, 1418, 746, 747, 748, 749, 757, 380, 1223,
+	857, 808, 795, 550, 874, 1368, 381, 326, 809, 780,
+	854, 854, 784, 796, 855, 868, 768, 1885, 801, 802,
+	858, 761, 1884, 777, 815, 787, 1844, 1561, 1562, 1661,
+	925, 1952, 1954, 1955, 1956, 1953, 782, 1656, 593, 594,
+	595, 596, 597, 598, 924, 326, 806, 797, 869, 870,
+	868, 1719, 932, 869, 870, 868, 1585, 923, 847, 414,
+	1156, 420, 1390, 831, 80, 412, 1868, 850, 480, 481,
+	482, 577, 813, 869, 870, 868, 326, 1411, 826, 934,
+	1242, 2188, 843, 1513, 935, 827, 2172, 1450, 1506, 1244,
+	2169, 846, 1507, 1510, 1718, 1962, 848, 1960, 838, 839,
+	840, 841, 842, 1155, 960, 960, 965, 1388, 1958, 326,
+	2134, 851, 926, 927, 928, 929, 869, 870, 868, 849,
+	1734, 859, 390, 862, 967, 2170, 869, 870, 868, 578,
+	2118, 973, 421, 2171, 930, 2062, 420, 1961, 1303, 1959,
+	414, 2005, 58, 1390, 1948, 1511, 418, 2113, 950, 2004,
+	1957, 974, 1301, 1302, 1300, 899, 378, 1970, 379, 386,
+	869, 870, 868, 377, 375, 374, 382, 2101, 384, 385,
+	905, 904, 914, 915, 91, 91, 907, 908, 909, 910,
+	911, 912, 913, 906, 580, 1946, 1947, 303, 1945, 480,
+	481, 482, 1673, 966, 575, 1187, 1944, 2059, 942, 959,
+	1128, 394, 1941, 351, 1140, 1935, 857, 1162, 1164, 1932,
+	1127, 909, 910, 911, 912, 913, 906, 1389, 480, 481,
+	482, 577, 1931, 1892, 1833, 351, 858, 1825, 480, 481,
+	482, 577, 1824, 1823, 836, 836, 836, 836, 836, 877,
+	878, 879, 880, 881, 882, 607, 875, 91, 972, 1124,
+	1674, 1822, 1125, 1219, 1220, 1814, 1667, 1176, 1177, 1178,
+	606, 1137, 1666, 1665, 1216, 1217, 1218, 1664, 2085, 1476,
+	730, 1240, 1241, 2084, 1188, 480, 481, 482, 2202, 578,
+	1968, 1160, 1161, 1237, 2041, 1179, 2027, 2026, 1969, 578,
+	869, 870, 868, 1151, 1174, 1949, 1942, 950, 1938, 1283,
+	1937, 1936, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
+	1292, 1293, 1294, 1295, 1180, 1890, 806, 1305, 1306, 1181,
+	1212, 1183, 1184, 2180, 1224, 1321, 1182, 1870, 1834, 1314,
+	1827, 1401, 1317, 1325, 1732, 1215, 1730, 326, 1633, 1194,
+	869, 870, 868, 1579, 1675, 1525, 1323, 1198, 1199, 1200,
+	1201, 1202, 1448, 1524, 1205, 1447, 1206, 1523, 1522, 1204,
+	1997, 1308, 1307, 1154, 905, 904, 914, 915, 2058, 1213,
+	907, 908, 909, 910, 911, 912, 913, 906, 869, 870,
+	868, 1304, 869, 870, 868, 1153, 946, 1235, 1236, 917,
+	1238, 920, 945, 944, 763, 1298, 1275, 1276, 1277, 1278,
+	1279, 731, 1280, 1281, 1282, 918, 919, 916, 2035, 905,
+	904, 914, 915, 1414, 2207, 907, 908, 909, 910, 911,
+	912, 913, 906, 1454, 356, 1977, 1414, 1453, 1912, 1316,
+	1318, 1319, 1621, 2201, 2200, 1911, 1337, 1826, 1315, 1720,
+	1322, 1717, 1324, 1150, 2183, 2179, 2178, 1640, 1644, 1646,
+	1648, 1650, 1651, 1653, 1326, 1566, 1563, 1564, 1565, 1917,
+	1635, 1636, 1637, 1638, 1619, 1620, 1641, 1716, 1622, 1692,
+	1623, 1624, 1625, 1626, 1627, 1628, 1629, 1630, 1631, 1632,
+	1639, 869, 870, 868, 1150, 2167, 1150, 2166, 1643, 1645,
+	1647, 1649, 1652, 905, 904, 914, 915, 1610, 1900, 907,
+	908, 909, 910, 911, 912, 913, 906, 1340, 2140, 2139,
+	444, 1592, 1711, 1902, 2099, 1542, 1634, 2147, 1541, 745,
+	869, 870, 868, 1902, 2094, 351, 1344, 1457, 351, 1345,
+	1455, 444, 1347, 351, 869, 870, 868, 1591, 1365, 360,
+	1355, 907, 908, 909, 910, 911, 912, 913, 906, 359,
+	1452, 1363, 1364, 1451, 792, 1582, 1449, 1770, 1576, 869,
+	870, 868, 905, 904, 914, 915, 1395, 1423, 907, 908,
+	909, 910, 911, 912, 913, 906, 351, 869, 870, 868,
+	869, 870, 868, 1150, 2082, 1208, 2081, 1420, 91, 91,
+	2070, 2069, 1406, 1413, 1342, 1397, 592, 1320, 1773, 2056,
+	2055, 1575, 1902, 2045, 1768, 760, 1387, 728, 1367, 591,
+	1782, 1783, 1902, 2044, 2158, 1769, 1574, 1327, 58, 1611,
+	1419, 1403, 1404, 869, 870, 868, 1357, 1144, 1415, 1343,
+	1593, 1416, 1417, 1902, 2043, 866, 1391, 1573, 869, 870,
+	868, 1902, 2042, 1351, 2033, 2032, 1414, 1999, 1366, 1414,
+	1998, 1774, 1414, 22, 1975, 1976, 1129, 1425, 1409, 869,
+	870, 868, 1354, 1392, 1572, 1393, 492, 1174, 1333, 1399,
+	1386, 1310, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1208,
+	1402, 1434, 1396, 1975, 1974, 864, 869, 870, 868, 491,
+	1405, 1916, 1915, 12, 1158, 6, 1571, 5, 1394, 599,
+	1437, 1438, 1914, 1913, 1412, 562, 1442, 2203, 923, 1446,
+	2149, 960, 420, 1468, 960, 359, 1642, 1471, 869, 870,
+	868, 1570, 1459, 1894, 836, 1552, 1781, 862, 1505, 351,
+	836, 2143, 1551, 351, 351, 1902, 1901, 351, 58, 492,
+	1474, 1211, 1595, 869, 870, 868, 1550, 869, 870, 868,
+	444, 1414, 1577, 1776, 869, 870, 868, 1777, 2125, 1502,
+	1475, 1414, 91, 1433, 1436, 1414, 1567, 2122, 869, 870,
+	868, 1414, 1458, 1465, 1309, 1775, 1778, 1463, 2120, 1298,
+	1435, 1414, 1422, 1470, 511, 869, 870, 868, 490, 1444,
+	91, 1547, 2061, 1467, 86, 1526, 869, 870, 868, 1414,
+	1421, 1211, 1341, 1821, 1460, 1469, 1466, 1336, 1335, 1989,
+	1549, 1472, 1477, 1473, 1478, 1330, 1329, 1211, 1210, 1973,
+	1568, 951, 1479, 1150, 1149, 734, 733, 1521, 1784, 489,
+	1486, 1971, 1906, 490, 1965, 1964, 1926, 1910, 1695, 1898,
+	1771, 1584, 1897, 1896, 1893, 1882, 1588, 1866, 82, 1531,
+	1532, 904, 914, 915, 1590, 1820, 907, 908, 909, 910,
+	911, 912, 913, 906, 459, 351, 2145, 1483, 1485, 1819,
+	1798, 1795, 1794, 1697, 1587, 1547, 1533, 91, 1546, 602,
+	1589, 464, 467, 468, 469, 465, 1655, 466, 470, 1581,
+	1706, 1569, 1709, 1669, 1170, 1662, 1529, 1530, 1299, 82,
+	1369, 1578, 1346, 1580, 58, 1328, 1209, 1586, 1196, 1189,
+	1609, 905, 904, 914, 915, 952, 951, 907, 908, 909,
+	910, 911, 912, 913, 906, 949, 1594, 948, 947, 943,
+	892, 1672, 940, 938, 937, 936, 933, 903, 902, 1608,
+	1685, 1688, 901, 900, 898, 1599, 897, 896, 1659, 895,
+	894, 893, 890, 1670, 889, 888, 887, 886, 885, 884,
+	883, 1654, 1658, 742, 1658, 1660, 1618, 728, 1663, 725,
+	493, 2130, 1668, 1133, 1134, 2128, 2090, 1721, 351, 351,
+	1381, 1715, 91, 1207, 1136, 1698, 1699, 1700, 1677, 836,
+	1676, 513, 444, 1738, 756, 754, 468, 469, 320, 1596,
+	755, 1502, 464, 467, 468, 469, 465, 752, 466, 470,
+	1139, 1138, 753, 1704, 1707, 1727, 1710, 751, 750, 2187,
+	1331, 2105, 905, 904, 914, 915, 582, 1714, 907, 908,
+	909, 910, 911, 912, 913, 906, 583, 1803, 1805, 1175,
+	1803, 1803, 1722, 1785, 1789, 1489, 1725, 517, 1792, 1793,
+	444, 1456, 1441, 1764, 352, 1735, 1266, 1790, 1809, 1160,
+	1161, 1597, 1796, 1791, 1799, 1800, 1496, 1168, 1598, 1123,
+	762, 1836, 1495, 905, 904, 914, 915, 860, 1804, 907,
+	908, 909, 910, 911, 912, 913, 906, 433, 435, 436,
+	519, 472, 1234, 1233, 1806, 1807, 2144, 905, 904, 914,
+	915, 1808, 2066, 907, 908, 909, 910, 911, 912, 913,
+	906, 2064, 1723, 1724, 2014, 1828, 523, 524, 1816, 2013,
+	1840, 914, 915, 2011, 1929, 907, 908, 909, 910, 911,
+	912, 913, 906, 1927, 1830, 464, 467, 468, 469, 465,
+	1865, 466, 470, 1731, 1684, 1607, 1606, 1545, 360, 522,
+	359, 1544, 1408, 728, 2132, 2131, 2132, 1424, 359, 1348,
+	295, 2131, 471, 372, 1, 91, 525, 738, 1869, 453,
+	735, 452, 450, 1843, 81, 1311, 1672, 1246, 1262, 673,
+	1259, 955, 961, 1966, 1261, 1258, 1260, 1264, 1265, 1805,
+	2104, 2136, 1263, 2060, 2107, 662, 646, 1785, 1886, 1867,
+	2006, 1908, 1909, 1490, 1871, 1918, 2008, 1920, 1361, 1904,
+	1829, 1358, 514, 1461, 1688, 1462, 687, 676, 939, 677,
+	720, 434, 1930, 675, 1891, 1815, 1538, 361, 432, 1899,
+	373, 1887, 1602, 1786, 1708, 1797, 1243, 2196, 2186, 1903,
+	2162, 2142, 2022, 2181, 1963, 58, 1907, 1841, 1842, 2071,
+	1845, 1846, 1847, 1848, 2123, 478, 1851, 1852, 1853, 1854,
+	1855, 1856, 1857, 1858, 1859, 1860, 1861, 1862, 1863, 1864,
+	2116, 2018, 1837, 324, 444, 479, 1943, 444, 444, 444,
+	1928, 823, 556, 444, 397, 1880, 1990, 1247, 1248, 1249,
+	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1269, 1270,
+	1271, 1272, 1273, 1274, 1267, 1268, 2003, 2016, 406, 1978,
+	743, 1514, 1986, 1987, 1988, 1985, 1375, 1166, 1996, 1145,
+	774, 1995, 325, 2048, 1972, 364, 1169, 365, 1172, 1171,
+	2017, 1225, 876, 1297, 941, 2000, 1686, 931, 2010, 609,
+	1443, 653, 647, 1535, 1534, 91, 444, 1780, 29, 473,
+	867, 1933, 1934, 969, 444, 779, 2031, 1939, 1940, 2024,
+	2025, 674, 93, 1186, 970, 2015, 1831, 2036, 2109, 661,
+	444, 660, 659, 658, 463, 461, 460, 313, 854, 312,
+	1407, 2030, 1543, 863, 925, 865, 2087, 2086, 2037, 2040,
+	2038, 1728, 1881, 1950, 1876, 1872, 2028, 1737, 924, 1736,
+	1765, 1766, 1772, 1712, 1617, 2046, 1613, 1615, 1616, 1614,
+	1612, 923, 2054, 1500, 2065, 420, 2067, 2068, 1501, 1498,
+	2063, 1497, 1135, 1131, 957, 964, 2074, 2076, 438, 790,
+	88, 311, 1214, 603, 11, 21, 20, 19, 18, 2083,
+	17, 16, 2111, 50, 49, 48, 47, 2095, 2096, 2097,
+	2098, 2115, 2003, 15, 2110, 8, 46, 45, 44, 14,
+	2103, 13, 40, 39, 38, 37, 36, 35, 34, 2114,
+	33, 2119, 32, 2121, 31, 30, 9, 62, 61, 60,
+	59, 23, 24, 25, 69, 2126, 68, 66, 2129, 2127,
+	67, 65, 64, 2138, 28, 10, 2133, 7, 4, 2,
+	0, 444, 0, 444, 2135, 0, 0, 0, 0, 0,
+	779, 2146, 779, 2148, 0, 0, 0, 0, 0, 0,
+	0, 2111, 2161, 2151, 0, 0, 0, 2157, 0, 0,
+	444, 0, 0, 2110, 2160, 0, 2165, 0, 0, 779,
+	2168, 0, 0, 0, 0, 0, 2138, 2174, 0, 0,
+	0, 0, 0, 0, 0, 2176, 0, 2100, 2184, 0,
+	0, 0, 0, 0, 0, 0, 2185, 0, 0, 0,
+	0, 0, 0, 2195, 0, 2194, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2206, 2205, 2204, 2195, 1091,
+	1077, 0, 1039, 1093, 1011, 1027, 1101, 1029, 1030, 1064,
+	989, 1048, 225, 1025, 981, 1014, 1015, 983, 1022, 984,
+	1012, 1041, 169, 1010, 1080, 1051, 194, 1099, 196, 0,
+	0, 254, 209, 136, 976, 977, 137, 978, 979, 0,
+	0, 1044, 1082, 1046, 1069, 1038, 1065, 997, 1058, 1094,
+	1026, 1062, 1095, 0, 0, 0, 0, 480, 481, 482,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
+	1061, 1087, 1024, 0, 0, 998, 1092, 1045, 1063, 0,
+	982, 1059, 0, 987, 990, 1100, 1085, 1019, 1020, 0,
+	0, 0, 0, 0, 0, 0, 1042, 1047, 1066, 1035,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1016, 0, 1055, 0, 0, 0, 992, 988, 0,
+	1040, 0, 142, 259, 273, 152, 250, 286, 156, 257,
+	148, 224, 246, 144, 271, 256, 206, 188, 189, 143,
+	0, 241, 167, 180, 164, 222, 1089, 1090, 163, 289,
+	991, 281, 146, 147, 280, 221, 268, 272, 207, 201,
+	145, 270, 205, 200, 192, 171, 184, 234, 199, 235,
+	185, 211, 210, 212, 1111, 1112, 1113, 1114, 1115, 996,
+	0, 1017, 1067, 0, 980, 1076, 1083, 1037, 283, 1086,
+	1034, 1033, 1118, 0, 1117, 258, 1119, 1120, 193, 1081,
+	1013, 1023, 1018, 1021, 244, 227, 1088, 1054, 232, 242,
+	197, 269, 236, 274, 260, 282, 1070, 237, 138, 261,
+	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
+	218, 230, 249, 262, 263, 264, 165, 157, 243, 158,
+	182, 160, 139, 159, 251, 161, 140, 231, 267, 1116,
+	179, 239, 204, 141, 203, 233, 266, 265, 290, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 975,
+	278, 0, 223, 1078, 985, 995, 993, 1031, 1056, 1057,
+	219, 294, 1072, 1075, 1073, 1102, 247, 0, 0, 0,
+	0, 0, 187, 229, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 986, 0, 255, 276,
+	288, 279, 1032, 1004, 1043, 287, 1007, 1005, 1071, 1006,
+	1060, 1104, 213, 214, 215, 216, 1028, 0, 155, 1052,
+	1036, 1105, 1106, 1107, 1108, 1109, 1110, 1009, 1084, 175,
+	181, 0, 183, 154, 228, 178, 285, 190, 220, 186,
+	252, 191, 198, 240, 284, 226, 245, 153, 275, 253,
+	202, 177, 132, 133, 134, 135, 1003, 1008, 1002, 1049,
+	1050, 1096, 1097, 1098, 1068, 994, 1079, 999, 1001, 1000,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 682, 0, 1074,
+	1053, 131, 0, 195, 1103, 238, 174, 225, 0, 0,
+	0, 0, 0, 655, 0, 0, 0, 169, 0, 0,
+	0, 194, 0, 196, 0, 0, 254, 638, 136, 0,
+	686, 137, 0, 0, 0, 0, 0, 0, 699, 705,
+	0, 0, 0, 1121, 1122, 291, 292, 293, 277, 648,
+	0, 2057, 610, 689, 688, 664, 0, 0, 0, 151,
+	665, 0, 670, 0, 666, 669, 667, 668, 0, 0,
+	691, 0, 0, 0, 0, 0, 608, 652, 0, 656,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 649, 650, 0, 0, 0, 0, 683, 0,
+	651, 0, 0, 685, 0, 671, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 680, 681, 163, 641, 678, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 697, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 679, 0, 244,
+	227, 708, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 695, 223, 707, 690,
+	692, 693, 696, 700, 701, 639, 642, 702, 704, 706,
+	709, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 640, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 684, 213, 214, 215,
+	216, 698, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 715, 694, 714, 716, 717, 713, 718, 719, 703,
+	657, 0, 711, 710, 712, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 195, 85,
+	238, 174, 95, 612, 613, 614, 615, 616, 617, 618,
+	103, 619, 620, 621, 622, 623, 624, 110, 625, 626,
+	113, 114, 627, 628, 629, 630, 119, 631, 632, 633,
+	634, 124, 125, 126, 127, 635, 636, 637, 0, 0,
+	291, 292, 293, 277, 86, 0, 682, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
+	0, 0, 655, 0, 0, 0, 169, 0, 0, 0,
+	194, 0, 196, 0, 0, 254, 638, 136, 0, 686,
+	137, 0, 0, 0, 0, 0, 0, 699, 705, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 648, 0,
+	0, 610, 689, 688, 664, 0, 0, 0, 151, 665,
+	0, 670, 0, 666, 669, 667, 668, 0, 0, 691,
+	0, 0, 0, 0, 0, 608, 652, 0, 656, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 649, 650, 0, 0, 0, 0, 683, 0, 651,
+	0, 0, 685, 0, 671, 0, 142, 259, 273, 152,
+	250, 286, 156, 257, 148, 224, 246, 144, 271, 256,
+	206, 188, 189, 143, 0, 241, 167, 180, 164, 222,
+	680, 681, 163, 641, 678, 281, 146, 147, 280, 221,
+	268, 272, 207, 201, 145, 270, 205, 200, 192, 171,
+	184, 234, 199, 235, 185, 211, 210, 212, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 0, 0, 697, 0, 0, 0, 258,
+	0, 0, 193, 0, 0, 0, 679, 0, 244, 227,
+	708, 0, 232, 242, 197, 269, 236, 274, 260, 282,
+	0, 237, 138, 261, 166, 208, 149, 150, 162, 168,
+	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
+	165, 157, 243, 158, 182, 160, 139, 159, 251, 161,
+	140, 231, 267, 0, 179, 239, 204, 141, 203, 233,
+	266, 265, 290, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 278, 695, 223, 707, 690, 692,
+	693, 696, 700, 701, 639, 642, 702, 704, 706, 709,
+	247, 0, 0, 0, 0, 0, 187, 229, 0, 248,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 276, 288, 640, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, 684, 213, 214, 215, 216,
+	698, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 181, 0, 183, 154, 228, 178,
+	285, 190, 220, 186, 252, 191, 198, 240, 284, 226,
+	245, 153, 275, 253, 202, 177, 132, 133, 134, 135,
+	715, 694, 714, 716, 717, 713, 718, 719, 703, 657,
+	0, 711, 710, 712, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 195, 85, 238,
+	174, 95, 612, 613, 614, 615, 616, 617, 618, 103,
+	619, 620, 621, 622, 623, 624, 110, 625, 626, 113,
+	114, 627, 628, 629, 630, 119, 631, 632, 633, 634,
+	124, 125, 126, 127, 635, 636, 637, 682, 0, 291,
+	292, 293, 277, 0, 0, 0, 0, 225, 0, 1226,
+	0, 0, 0, 655, 0, 0, 0, 169, 0, 0,
+	0, 194, 0, 196, 0, 0, 254, 638, 136, 0,
+	686, 137, 1227, 1228, 0, 0, 0, 0, 699, 705,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 648,
+	0, 0, 610, 689, 688, 664, 0, 0, 0, 151,
+	665, 0, 670, 0, 666, 669, 667, 668, 0, 0,
+	691, 0, 0, 0, 0, 0, 0, 652, 0, 656,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 649, 650, 0, 0, 0, 0, 683, 0,
+	651, 0, 0, 685, 0, 671, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 680, 681, 163, 641, 678, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 697, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 679, 0, 244,
+	227, 708, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 695, 223, 707, 690,
+	692, 693, 696, 700, 701, 639, 642, 702, 704, 706,
+	709, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 640, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 684, 213, 214, 215,
+	216, 698, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 715, 694, 714, 716, 717, 713, 718, 719, 703,
+	657, 0, 711, 710, 712, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 195, 0,
+	238, 174, 95, 612, 613, 614, 615, 616, 617, 618,
+	103, 619, 620, 621, 622, 623, 624, 110, 625, 626,
+	113, 114, 627, 628, 629, 630, 119, 631, 632, 633,
+	634, 124, 125, 126, 127, 635, 636, 637, 682, 0,
+	291, 292, 293, 277, 0, 0, 0, 0, 225, 0,
+	0, 0, 0, 0, 655, 0, 0, 0, 169, 837,
+	0, 0, 194, 0, 196, 0, 0, 254, 638, 136,
+	0, 686, 137, 0, 0, 0, 0, 0, 0, 699,
+	705, 0, 0, 0, 0, 0, 0, 833, 0, 0,
+	648, 0, 0, 610, 689, 688, 664, 0, 0, 0,
+	151, 665, 0, 670, 0, 666, 669, 667, 668, 0,
+	0, 691, 0, 0, 0, 0, 0, 608, 652, 0,
+	656, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 649, 650, 0, 0, 0, 0, 683,
+	0, 651, 0, 0, 834, 0, 671, 0, 142, 259,
+	273, 152, 250, 286, 156, 257, 148, 224, 246, 144,
+	271, 256, 206, 188, 189, 143, 0, 241, 167, 180,
+	164, 222, 680, 681, 163, 641, 678, 281, 146, 147,
+	280, 221, 268, 272, 207, 201, 145, 270, 205, 200,
+	192, 171, 184, 234, 199, 235, 185, 211, 210, 212,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 283, 0, 0, 697, 0, 0,
+	0, 258, 0, 0, 193, 0, 0, 0, 679, 0,
+	244, 227, 708, 0, 232, 242, 197, 269, 236, 274,
+	260, 282, 0, 237, 138, 261, 166, 208, 149, 150,
+	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
+	263, 264, 165, 157, 243, 158, 182, 160, 139, 159,
+	251, 161, 140, 231, 267, 0, 179, 239, 204, 141,
+	203, 233, 266, 265, 290, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 0, 278, 695, 223, 707,
+	690, 692, 693, 696, 700, 701, 639, 642, 702, 704,
+	706, 709, 247, 0, 0, 0, 0, 0, 187, 229,
+	0, 248, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 276, 288, 640, 0, 0,
+	0, 287, 0, 0, 0, 0, 0, 684, 213, 214,
+	215, 216, 698, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 181, 0, 183, 154,
+	228, 178, 285, 190, 220, 186, 252, 191, 198, 240,
+	284, 226, 245, 153, 275, 253, 202, 177, 132, 133,
+	134, 135, 715, 694, 714, 716, 717, 713, 718, 719,
+	703, 657, 0, 711, 710, 712, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 195,
+	0, 238, 174, 95, 612, 613, 614, 615, 616, 617,
+	618, 103, 619, 620, 621, 622, 623, 624, 110, 625,
+	626, 113, 114, 627, 628, 629, 630, 119, 631, 632,
+	633, 634, 124, 125, 126, 127, 635, 636, 637, 682,
+	0, 291, 292, 293, 277, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 0, 655, 0, 0, 0, 169,
+	2175, 0, 0, 194, 0, 196, 0, 0, 254, 638,
+	136, 0, 686, 137, 0, 0, 0, 0, 0, 0,
+	699, 705, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 648, 0, 0, 610, 689, 688, 664, 0, 0,
+	0, 151, 665, 0, 670, 0, 666, 669, 667, 668,
+	0, 0, 691, 0, 0, 0, 0, 0, 608, 652,
+	0, 656, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 649, 650, 0, 0, 0, 0,
+	683, 0, 651, 0, 0, 685, 0, 671, 0, 142,
+	259, 273, 152, 250, 286, 156, 257, 148, 224, 246,
+	144, 271, 256, 206, 188, 189, 143, 0, 241, 167,
+	180, 164, 222, 680, 681, 163, 641, 678, 281, 146,
+	147, 280, 221, 268, 272, 207, 201, 145, 270, 205,
+	200, 192, 171, 184, 234, 199, 235, 185, 211, 210,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 0, 697, 0,
+	0, 0, 258, 0, 0, 193, 0, 0, 0, 679,
+	0, 244, 227, 708, 0, 232, 242, 197, 269, 236,
+	274, 260, 282, 0, 237, 138, 261, 166, 208, 149,
+	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
+	262, 263, 264, 165, 157, 243, 158, 182, 160, 139,
+	159, 251, 161, 140, 231, 267, 0, 179, 239, 204,
+	141, 203, 233, 266, 265, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 278, 695, 223,
+	707, 690, 692, 693, 696, 700, 701, 639, 642, 702,
+	704, 706, 709, 247, 0, 0, 0, 0, 0, 187,
+	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 276, 288, 640, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 684, 213,
+	214, 215, 216, 698, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 181, 0, 183,
+	154, 228, 178, 285, 190, 220, 186, 252, 191, 198,
+	240, 284, 226, 245, 153, 275, 253, 202, 177, 132,
+	133, 134, 135, 715, 694, 714, 716, 717, 713, 718,
+	719, 703, 657, 0, 711, 710, 712, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	195, 0, 238, 174, 95, 612, 613, 614, 615, 616,
+	617, 618, 103, 619, 620, 621, 622, 623, 624, 110,
+	625, 626, 113, 114, 627, 628, 629, 630, 119, 631,
+	632, 633, 634, 124, 125, 126, 127, 635, 636, 637,
+	682, 0, 291, 292, 293, 277, 0, 0, 0, 0,
+	225, 0, 0, 0, 0, 0, 655, 0, 0, 0,
+	169, 0, 0, 0, 194, 0, 196, 0, 0, 254,
+	638, 1689, 1690, 1691, 137, 0, 0, 0, 0, 0,
+	0, 699, 705, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 648, 0, 0, 610, 689, 688, 664, 0,
+	0, 0, 151, 665, 0, 670, 0, 666, 669, 667,
+	668, 0, 0, 691, 0, 0, 0, 0, 0, 608,
+	652, 0, 656, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 649, 650, 0, 0, 0,
+	0, 683, 0, 651, 0, 0, 685, 0, 671, 0,
+	142, 259, 273, 152, 250, 286, 156, 257, 148, 224,
+	246, 144, 271, 256, 206, 188, 189, 143, 0, 241,
+	167, 180, 164, 222, 680, 681, 163, 641, 678, 281,
+	146, 147, 280, 221, 268, 272, 207, 201, 145, 270,
+	205, 200, 192, 171, 184, 234, 199, 235, 185, 211,
+	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 283, 0, 0, 697,
+	0, 0, 0, 258, 0, 0, 193, 0, 0, 0,
+	679, 0, 244, 227, 708, 0, 232, 242, 197, 269,
+	236, 274, 260, 282, 0, 237, 138, 261, 166, 208,
+	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
+	249, 262, 263, 264, 165, 157, 243, 158, 182, 160,
+	139, 159, 251, 161, 140, 231, 267, 0, 179, 239,
+	204, 141, 203, 233, 266, 265, 290, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 0, 278, 695,
+	223, 707, 690, 692, 693, 696, 700, 701, 639, 642,
+	702, 704, 706, 709, 247, 0, 0, 0, 0, 0,
+	187, 229, 0, 248, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 276, 288, 640,
+	0, 0, 0, 287, 0, 0, 0, 0, 0, 684,
+	213, 214, 215, 216, 698, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 181, 0,
+	183, 154, 228, 178, 285, 190, 220, 186, 252, 191,
+	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
+	132, 133, 134, 135, 715, 694, 714, 716, 717, 713,
+	718, 719, 703, 657, 0, 711, 710, 712, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 195, 0, 238, 174, 95, 612, 613, 614, 615,
+	616, 617, 618, 103, 619, 620, 621, 622, 623, 624,
+	110, 625, 626, 113, 114, 627, 628, 629, 630, 119,
+	631, 632, 633, 634, 124, 125, 126, 127, 635, 636,
+	637, 682, 0, 291, 292, 293, 277, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 0, 655, 0, 0,
+	0, 169, 837, 0, 0, 194, 0, 196, 0, 0,
+	254, 638, 136, 0, 686, 137, 0, 0, 0, 0,
+	0, 0, 699, 705, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 648, 0, 0, 610, 689, 688, 664,
+	0, 0, 0, 151, 665, 0, 670, 0, 666, 669,
+	667, 668, 0, 0, 691, 0, 0, 0, 0, 0,
+	608, 652, 0, 656, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 649, 650, 0, 0,
+	0, 0, 683, 0, 651, 0, 0, 685, 0, 671,
+	0, 142, 259, 273, 152, 250, 286, 156, 257, 148,
+	224, 246, 144, 271, 256, 206, 188, 189, 143, 0,
+	241, 167, 180, 164, 222, 680, 681, 163, 641, 678,
+	281, 146, 147, 280, 221, 268, 272, 207, 201, 145,
+	270, 205, 200, 192, 171, 184, 234, 199, 235, 185,
+	211, 210, 212, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
+	697, 0, 0, 0, 258, 0, 0, 193, 0, 0,
+	0, 679, 0, 244, 227, 708, 0, 232, 242, 197,
+	269, 236, 274, 260, 282, 0, 237, 138, 261, 166,
+	208, 149, 150, 162, 168, 170, 172, 173, 217, 218,
+	230, 249, 262, 263, 264, 165, 157, 243, 158, 182,
+	160, 139, 159, 251, 161, 140, 231, 267, 0, 179,
+	239, 204, 141, 203, 233, 266, 265, 290, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 0, 278,
+	695, 223, 707, 690, 692, 693, 696, 700, 701, 639,
+	642, 702, 704, 706, 709, 247, 0, 0, 0, 0,
+	0, 187, 229, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
+	640, 0, 0, 0, 287, 0, 0, 0, 0, 0,
+	684, 213, 214, 215, 216, 698, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 181,
+	0, 183, 154, 228, 178, 285, 190, 220, 186, 252,
+	191, 198, 240, 284, 226, 245, 153, 275, 253, 202,
+	177, 132, 133, 134, 135, 715, 694, 714, 716, 717,
+	713, 718, 719, 703, 657, 0, 711, 710, 712, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 195, 0, 238, 174, 95, 612, 613, 614,
+	615, 616, 617, 618, 103, 619, 620, 621, 622, 623,
+	624, 110, 625, 626, 113, 114, 627, 628, 629, 630,
+	119, 631, 632, 633, 634, 124, 125, 126, 127, 635,
+	636, 637, 682, 0, 291, 292, 293, 277, 0, 0,
+	0, 0, 225, 0, 0, 0, 0, 0, 655, 0,
+	0, 0, 169, 0, 0, 0, 194, 0, 196, 0,
+	0, 254, 638, 136, 0, 686, 137, 0, 0, 0,
+	0, 0, 0, 699, 705, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 648, 0, 0, 610, 689, 688,
+	664, 0, 0, 0, 151, 665, 0, 670, 0, 666,
+	669, 667, 668, 0, 0, 691, 0, 0, 0, 0,
+	0, 608, 652, 0, 656, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 649, 650, 605,
+	0, 0, 0, 683, 0, 651, 0, 0, 685, 0,
+	671, 0, 142, 259, 273, 152, 250, 286, 156, 257,
+	148, 224, 246, 144, 271, 256, 206, 188, 189, 143,
+	0, 241, 167, 180, 164, 222, 680, 681, 163, 641,
+	678, 281, 146, 147, 280, 221, 268, 272, 207, 201,
+	145, 270, 205, 200, 192, 171, 184, 234, 199, 235,
+	185, 211, 210, 212, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
+	0, 697, 0, 0, 0, 258, 0, 0, 193, 0,
+	0, 0, 679, 0, 244, 227, 708, 0, 232, 242,
+	197, 269, 236, 274, 260, 282, 0, 237, 138, 261,
+	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
+	218, 230, 249, 262, 263, 264, 165, 157, 243, 158,
+	182, 160, 139, 159, 251, 161, 140, 231, 267, 0,
+	179, 239, 204, 141, 203, 233, 266, 265, 290, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
+	278, 695, 223, 707, 690, 692, 693, 696, 700, 701,
+	639, 642, 702, 704, 706, 709, 247, 0, 0, 0,
+	0, 0, 187, 229, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
+	288, 640, 0, 0, 0, 287, 0, 0, 0, 0,
+	0, 684, 213, 214, 215, 216, 698, 0, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	181, 0, 183, 154, 228, 178, 285, 190, 220, 186,
+	252, 191, 198, 240, 284, 226, 245, 153, 275, 253,
+	202, 177, 132, 133, 134, 135, 715, 694, 714, 716,
+	717, 713, 718, 719, 703, 657, 0, 711, 710, 712,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 195, 0, 238, 174, 95, 612, 613,
+	614, 615, 616, 617, 618, 103, 619, 620, 621, 622,
+	623, 624, 110, 625, 626, 113, 114, 627, 628, 629,
+	630, 119, 631, 632, 633, 634, 124, 125, 126, 127,
+	635, 636, 637, 682, 0, 291, 292, 293, 277, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 0, 655,
+	0, 0, 0, 169, 0, 0, 0, 194, 0, 196,
+	0, 0, 254, 638, 136, 0, 686, 137, 0, 0,
+	0, 0, 0, 0, 699, 705, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 648, 0, 0, 610, 689,
+	688, 664, 0, 0, 0, 151, 665, 0, 670, 0,
+	666, 669, 667, 668, 0, 0, 691, 0, 0, 0,
+	0, 0, 608, 652, 0, 656, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 649, 650,
+	0, 0, 0, 0, 683, 0, 651, 0, 0, 685,
+	0, 671, 0, 142, 259, 273, 152, 250, 286, 156,
+	257, 148, 224, 246, 144, 271, 256, 206, 188, 189,
+	143, 0, 241, 167, 180, 164, 222, 680, 681, 163,
+	641, 678, 281, 146, 147, 280, 221, 268, 272, 207,
+	201, 145, 270, 205, 200, 192, 171, 184, 234, 199,
+	235, 185, 211, 210, 212, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	0, 0, 697, 0, 0, 0, 258, 0, 0, 193,
+	0, 0, 0, 679, 0, 244, 227, 708, 0, 232,
+	242, 197, 269, 236, 274, 260, 282, 0, 237, 138,
+	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
+	217, 218, 230, 249, 262, 263, 264, 165, 157, 243,
+	158, 182, 160, 139, 159, 251, 161, 140, 231, 267,
+	0, 179, 239, 204, 141, 203, 233, 266, 265, 290,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	0, 278, 695, 223, 707, 690, 692, 693, 696, 700,
+	701, 639, 642, 702, 704, 706, 709, 247, 0, 0,
+	0, 0, 0, 187, 229, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	276, 288, 640, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 684, 213, 214, 215, 216, 698, 0, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 181, 0, 183, 154, 228, 178, 285, 190, 220,
+	186, 252, 191, 198, 240, 284, 226, 245, 153, 275,
+	253, 202, 177, 132, 133, 134, 135, 715, 694, 714,
+	716, 717, 713, 718, 719, 703, 657, 0, 711, 710,
+	712, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 195, 0, 238, 174, 95, 612,
+	613, 614, 615, 616, 617, 618, 103, 619, 620, 621,
+	622, 623, 624, 110, 625, 626, 113, 114, 627, 628,
+	629, 630, 119, 631, 632, 633, 634, 124, 125, 126,
+	127, 635, 636, 637, 682, 0, 291, 292, 293, 277,
+	0, 0, 0, 0, 225, 0, 0, 0, 0, 0,
+	655, 0, 0, 0, 169, 0, 0, 0, 194, 0,
+	196, 0, 0, 254, 638, 136, 0, 686, 137, 0,
+	0, 0, 0, 0, 0, 699, 705, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2002, 0, 0, 610,
+	689, 688, 664, 0, 0, 0, 151, 665, 0, 670,
+	0, 666, 669, 667, 668, 0, 0, 691, 0, 0,
+	0, 0, 0, 608, 652, 0, 656, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 649,
+	650, 0, 0, 0, 0, 683, 0, 651, 0, 0,
+	685, 0, 671, 0, 142, 259, 273, 152, 250, 286,
+	156, 257, 148, 224, 246, 144, 271, 256, 206, 188,
+	189, 143, 0, 241, 167, 180, 164, 222, 680, 681,
+	163, 641, 678, 281, 146, 147, 280, 221, 268, 272,
+	207, 201, 145, 270, 205, 200, 192, 171, 184, 234,
+	199, 235, 185, 211, 210, 212, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	283, 0, 0, 697, 0, 0, 0, 258, 0, 0,
+	193, 0, 0, 0, 679, 0, 244, 227, 708, 0,
+	232, 242, 197, 269, 236, 274, 260, 282, 0, 237,
+	138, 261, 166, 208, 149, 150, 162, 168, 170, 172,
+	173, 217, 218, 230, 249, 262, 263, 264, 165, 157,
+	243, 158, 182, 160, 139, 159, 251, 161, 140, 231,
+	267, 0, 179, 239, 204, 141, 203, 233, 266, 265,
+	290, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 278, 695, 223, 707, 690, 692, 693, 696,
+	700, 701, 639, 642, 702, 704, 706, 709, 247, 0,
+	0, 0, 0, 0, 187, 229, 0, 248, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 276, 288, 640, 0, 0, 0, 287, 0, 0,
+	0, 0, 0, 684, 213, 214, 215, 216, 698, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 181, 0, 183, 154, 228, 178, 285, 190,
+	220, 186, 252, 191, 198, 240, 284, 226, 245, 153,
+	275, 253, 202, 177, 132, 133, 134, 135, 715, 694,
+	714, 716, 717, 713, 718, 719, 703, 657, 0, 711,
+	710, 712, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 195, 0, 238, 174, 95,
+	612, 613, 614, 615, 616, 617, 618, 103, 619, 620,
+	621, 622, 623, 624, 110, 625, 626, 113, 114, 627,
+	628, 629, 630, 119, 631, 632, 633, 634, 124, 125,
+	126, 127, 635, 636, 637, 682, 0, 291, 292, 293,
+	277, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	0, 655, 0, 0, 0, 169, 0, 0, 0, 194,
+	0, 196, 0, 0, 254, 638, 136, 0, 686, 137,
+	0, 0, 0, 0, 0, 0, 699, 705, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 648, 0, 0,
+	610, 689, 688, 664, 0, 0, 0, 151, 665, 0,
+	670, 0, 666, 669, 667, 668, 0, 0, 691, 0,
+	0, 0, 0, 0, 0, 652, 0, 656, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	649, 650, 0, 0, 0, 0, 683, 0, 651, 0,
+	0, 685, 0, 671, 0, 142, 259, 273, 152, 250,
+	286, 156, 257, 148, 224, 246, 144, 271, 256, 206,
+	188, 189, 143, 0, 241, 167, 180, 164, 222, 680,
+	681, 163, 641, 678, 281, 146, 147, 280, 221, 268,
+	272, 207, 201, 145, 270, 205, 200, 192, 171, 184,
+	234, 199, 235, 185, 211, 210, 212, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 283, 0, 0, 697, 0, 0, 0, 258, 0,
+	0, 193, 0, 0, 0, 679, 0, 244, 227, 708,
+	0, 232, 242, 197, 269, 236, 274, 260, 282, 0,
+	237, 138, 261, 166, 208, 149, 150, 162, 168, 170,
+	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
+	157, 243, 158, 182, 160, 139, 159, 251, 161, 140,
+	231, 267, 0, 179, 239, 204, 141, 203, 233, 266,
+	265, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 278, 695, 223, 707, 690, 692, 693,
+	696, 700, 701, 639, 642, 702, 704, 706, 709, 247,
+	0, 0, 0, 0, 0, 187, 229, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 276, 288, 640, 0, 0, 0, 287, 0,
+	0, 0, 0, 0, 684, 213, 214, 215, 216, 698,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 181, 0, 183, 154, 228, 178, 285,
+	190, 220, 186, 252, 191, 198, 240, 284, 226, 245,
+	153, 275, 253, 202, 177, 132, 133, 134, 135, 715,
+	694, 714, 716, 717, 713, 718, 719, 703, 657, 0,
+	711, 710, 712, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 195, 0, 238, 174,
+	95, 612, 613, 614, 615, 616, 617, 618, 103, 619,
+	620, 621, 622, 623, 624, 110, 625, 626, 113, 114,
+	627, 628, 629, 630, 119, 631, 632, 633, 634, 124,
+	125, 126, 127, 635, 636, 637, 0, 0, 291, 292,
+	293, 277, 336, 0, 335, 339, 331, 0, 0, 0,
+	0, 0, 0, 0, 225, 0, 327, 0, 0, 0,
+	0, 0, 0, 0, 169, 0, 0, 346, 194, 0,
+	196, 0, 0, 254, 209, 136, 0, 0, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
+	0, 0, 350, 0, 0, 0, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 336, 0, 335, 339,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 346, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 142, 259, 273, 152, 250, 286,
+	156, 257, 148, 224, 246, 144, 271, 256, 206, 188,
+	189, 143, 0, 241, 167, 180, 164, 222, 0, 0,
+	163, 289, 0, 281, 146, 147, 280, 221, 268, 272,
+	207, 201, 145, 270, 205, 200, 192, 171, 184, 234,
+	199, 235, 185, 211, 210, 212, 0, 0, 0, 0,
+	0, 329, 328, 332, 0, 0, 0, 0, 0, 334,
+	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
+	193, 338, 0, 0, 0, 0, 244, 227, 0, 0,
+	232, 242, 197, 269, 236, 330, 260, 282, 0, 354,
+	138, 261, 166, 208, 149, 150, 162, 168, 170, 172,
+	173, 217, 218, 230, 249, 262, 263, 264, 165, 157,
+	243, 158, 182, 160, 139, 159, 251, 161, 140, 231,
+	267, 0, 179, 239, 204, 141, 203, 233, 266, 265,
+	290, 0, 0, 0, 0, 329, 328, 332, 0, 0,
+	176, 0, 278, 334, 223, 0, 0, 0, 0, 0,
+	0, 0, 219, 294, 0, 338, 0, 0, 247, 0,
+	0, 0, 333, 337, 340, 229, 341, 342, 0, 769,
+	343, 344, 345, 0, 0, 347, 348, 0, 0, 0,
+	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
+	0, 0, 0, 0, 213, 214, 215, 216, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 181, 0, 183, 154, 228, 178, 285, 190,
+	220, 186, 252, 191, 198, 240, 284, 226, 245, 153,
+	275, 253, 202, 177, 132, 133, 134, 135, 0, 0,
+	0, 0, 0, 0, 0, 0, 333, 337, 770, 0,
+	341, 771, 0, 0, 343, 344, 345, 0, 0, 347,
+	348, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 195, 0, 238, 174, 95,
 	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 79, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 75, 0, 917, 85,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 78,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 211,
-	277, 278, 279, 263, 836, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 833,
-	834, 832, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 0, 0, 291, 292, 293,
+	277, 336, 0, 335, 339, 331, 0, 0, 0, 0,
+	0, 0, 0, 225, 0, 327, 0, 0, 0, 0,
+	0, 0, 0, 169, 0, 0, 346, 194, 0, 196,
+	0, 0, 254, 209, 136, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 349, 0,
+	0, 350, 0, 0, 0, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 142, 259, 273, 152, 250, 286, 156,
+	257, 148, 224, 246, 144, 271, 256, 206, 188, 189,
+	143, 0, 241, 167, 180, 164, 222, 0, 0, 163,
+	289, 0, 281, 146, 147, 280, 221, 268, 272, 207,
+	201, 145, 270, 205, 200, 192, 171, 184, 234, 199,
+	235, 185, 211, 210, 212, 0, 0, 0, 0, 0,
+	329, 328, 332, 0, 0, 0, 0, 0, 334, 283,
+	0, 0, 0, 0, 0, 0, 258, 0, 0, 193,
+	338, 0, 0, 0, 0, 244, 227, 0, 0, 232,
+	242, 197, 269, 236, 330, 260, 282, 0, 237, 138,
+	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
+	217, 218, 230, 249, 262, 263, 264, 165, 157, 243,
+	158, 182, 160, 139, 159, 251, 161, 140, 231, 267,
+	0, 179, 239, 204, 141, 203, 233, 266, 265, 290,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
+	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
+	0, 333, 337, 340, 229, 341, 342, 0, 0, 343,
+	344, 345, 0, 0, 347, 348, 0, 0, 0, 255,
+	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 0, 213, 214, 215, 216, 0, 0, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 181, 0, 183, 154, 228, 178, 285, 190, 220,
+	186, 252, 191, 198, 240, 284, 226, 245, 153, 275,
+	253, 202, 177, 132, 133, 134, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 195, 0, 238, 174, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 391, 392, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 395, 267, 133,
-	394, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 388, 384, 385, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 386, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 0, 277, 278, 279,
-	263, 211, 0, 533, 0, 0, 0, 0, 0, 0,
-	0, 155, 534, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 333, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	535, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 0,
-	0, 277, 278, 279, 263, 211, 0, 792, 0, 0,
-	0, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 0, 0, 291, 292, 293, 277,
+	86, 0, 26, 42, 27, 0, 0, 0, 0, 0,
+	0, 0, 225, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 169, 0, 0, 0, 194, 0, 196, 0,
+	0, 254, 209, 136, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 259, 273, 152, 250, 286, 156, 257,
+	148, 224, 246, 144, 271, 256, 206, 188, 189, 143,
+	0, 241, 167, 180, 164, 222, 0, 0, 163, 289,
+	0, 281, 146, 147, 280, 221, 268, 272, 207, 201,
+	145, 270, 205, 200, 192, 171, 184, 234, 199, 235,
+	185, 211, 210, 212, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 301, 0, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 258, 0, 0, 193, 0,
+	0, 0, 0, 0, 244, 227, 0, 0, 232, 242,
+	197, 269, 236, 274, 260, 282, 0, 237, 138, 261,
+	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
+	218, 230, 249, 262, 263, 264, 165, 157, 243, 158,
+	182, 160, 139, 159, 251, 161, 140, 231, 267, 0,
+	179, 239, 204, 141, 203, 233, 266, 265, 290, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
+	278, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	219, 294, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 187, 229, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
+	288, 279, 0, 0, 0, 287, 0, 0, 0, 0,
+	0, 0, 213, 214, 215, 216, 298, 300, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	181, 0, 183, 154, 228, 178, 285, 190, 220, 186,
+	252, 191, 198, 240, 284, 226, 245, 153, 275, 253,
+	202, 177, 132, 133, 134, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 195, 85, 238, 174, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 225, 0, 291, 292, 293, 277, 0,
+	0, 0, 0, 169, 0, 0, 0, 194, 0, 196,
+	0, 0, 254, 209, 136, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1509, 1512, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 142, 259, 273, 152, 250, 286, 156,
+	257, 148, 224, 246, 144, 271, 256, 206, 188, 189,
+	143, 0, 241, 167, 180, 164, 222, 0, 0, 163,
+	289, 0, 281, 146, 147, 280, 221, 268, 272, 207,
+	201, 145, 270, 205, 200, 192, 171, 184, 234, 199,
+	235, 185, 211, 210, 212, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1513, 283,
+	0, 0, 0, 1506, 0, 1505, 258, 1507, 1510, 193,
+	0, 0, 0, 0, 0, 244, 227, 0, 0, 232,
+	242, 197, 269, 236, 274, 260, 282, 0, 237, 138,
+	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
+	217, 218, 230, 249, 262, 263, 264, 165, 157, 243,
+	158, 182, 160, 139, 159, 251, 161, 140, 231, 267,
+	1511, 179, 239, 204, 141, 203, 233, 266, 265, 290,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
+	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 187, 229, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 0, 213, 214, 215, 216, 0, 0, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 181, 0, 183, 154, 228, 178, 285, 190, 220,
+	186, 252, 191, 198, 240, 284, 226, 245, 153, 275,
+	253, 202, 177, 132, 133, 134, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 195, 0, 238, 174, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 225, 0, 291, 292, 293, 277,
+	0, 0, 0, 0, 169, 396, 0, 0, 194, 0,
+	196, 0, 0, 254, 209, 136, 0, 0, 137, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 333, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	410, 411, 0, 0, 0, 0, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 412, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 142, 259, 273, 152, 250, 286,
+	156, 257, 148, 224, 246, 144, 271, 256, 206, 188,
+	189, 143, 0, 241, 167, 180, 164, 222, 0, 0,
+	163, 289, 414, 281, 146, 413, 280, 221, 268, 272,
+	207, 201, 145, 270, 205, 200, 192, 171, 184, 234,
+	199, 235, 185, 211, 210, 212, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
+	193, 0, 0, 0, 0, 0, 244, 227, 0, 0,
+	232, 242, 197, 269, 236, 274, 260, 282, 395, 237,
+	138, 261, 166, 208, 149, 150, 162, 168, 170, 172,
+	173, 217, 218, 230, 249, 262, 263, 264, 165, 157,
+	243, 158, 182, 160, 139, 159, 251, 161, 140, 231,
+	267, 0, 179, 239, 204, 141, 203, 233, 266, 265,
+	290, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 278, 0, 223, 0, 0, 0, 0, 0,
+	0, 0, 219, 294, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 187, 229, 0, 248, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 791, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
+	0, 0, 0, 398, 213, 214, 215, 216, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 181, 0, 183, 154, 228, 178, 285, 190,
+	407, 401, 402, 191, 198, 240, 284, 226, 245, 153,
+	275, 253, 403, 177, 404, 405, 134, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	0, 0, 0, 131, 0, 195, 0, 238, 174, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 86, 0, 291, 292, 293,
+	277, 0, 0, 0, 0, 0, 0, 225, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 194, 0, 196, 0, 0, 254, 209, 136, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
+	0, 958, 92, 0, 0, 0, 0, 0, 0, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 0, 0, 163, 289, 0, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 0, 0, 244,
+	227, 0, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
+	216, 0, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 195, 85,
+	238, 174, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 0, 225,
+	291, 292, 293, 277, 872, 0, 0, 0, 0, 169,
+	0, 0, 0, 194, 0, 196, 0, 0, 254, 209,
+	136, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 0,
+	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 869, 870, 868, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
+	259, 273, 152, 250, 286, 156, 257, 148, 224, 246,
+	144, 271, 256, 206, 188, 189, 143, 0, 241, 167,
+	180, 164, 222, 0, 0, 163, 289, 0, 281, 146,
+	147, 280, 221, 268, 272, 207, 201, 145, 270, 205,
+	200, 192, 171, 184, 234, 199, 235, 185, 211, 210,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 0, 258, 0, 0, 193, 0, 0, 0, 0,
+	0, 244, 227, 0, 0, 232, 242, 197, 269, 236,
+	274, 260, 282, 0, 237, 138, 261, 166, 208, 149,
+	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
+	262, 263, 264, 165, 157, 243, 158, 182, 160, 139,
+	159, 251, 161, 140, 231, 267, 0, 179, 239, 204,
+	141, 203, 233, 266, 265, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 278, 0, 223,
+	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 187,
+	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
+	214, 215, 216, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 181, 0, 183,
+	154, 228, 178, 285, 190, 220, 186, 252, 191, 198,
+	240, 284, 226, 245, 153, 275, 253, 202, 177, 132,
+	133, 134, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	195, 0, 238, 174, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	225, 0, 291, 292, 293, 277, 0, 0, 0, 0,
+	169, 0, 0, 0, 194, 0, 196, 0, 0, 254,
+	209, 136, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 410, 411, 0, 0,
+	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 412, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 259, 273, 152, 250, 286, 156, 257, 148, 224,
+	246, 144, 271, 256, 206, 188, 189, 143, 0, 241,
+	167, 180, 164, 222, 0, 0, 163, 289, 414, 281,
+	146, 413, 280, 221, 268, 272, 207, 201, 145, 270,
+	205, 200, 192, 171, 184, 234, 199, 235, 185, 211,
+	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 258, 0, 0, 193, 0, 0, 0,
+	0, 0, 244, 227, 0, 0, 232, 242, 197, 269,
+	236, 274, 260, 282, 0, 237, 138, 261, 166, 208,
+	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
+	249, 262, 263, 264, 165, 157, 243, 158, 182, 160,
+	139, 159, 251, 161, 140, 231, 267, 0, 179, 239,
+	204, 141, 203, 233, 266, 265, 290, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 0, 278, 0,
+	223, 0, 0, 0, 0, 0, 0, 0, 219, 294,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
+	187, 229, 0, 248, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 276, 288, 279,
+	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
+	213, 214, 215, 216, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 181, 0,
+	183, 154, 228, 178, 285, 190, 407, 401, 402, 191,
+	198, 240, 284, 226, 245, 153, 275, 253, 403, 177,
+	404, 405, 134, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 195, 0, 238, 174, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 0, 0, 291, 292, 293, 277, 225, 0, 557,
+	0, 0, 0, 0, 0, 0, 0, 169, 558, 0,
+	0, 194, 0, 196, 0, 0, 254, 209, 136, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 349, 0, 0, 350, 0, 0, 0, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 0, 0, 163, 289, 0, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 0, 0, 244,
+	227, 0, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
+	287, 0, 0, 0, 0, 559, 0, 213, 214, 215,
+	216, 0, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 195, 0,
+	238, 174, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 0, 0,
+	291, 292, 293, 277, 225, 0, 825, 0, 0, 0,
+	0, 0, 0, 0, 169, 0, 0, 0, 194, 0,
+	196, 0, 0, 254, 209, 136, 0, 0, 137, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2008, 85, 659, 0, 0, 0, 0, 0, 138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
+	0, 0, 350, 0, 0, 0, 151, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 142, 259, 273, 152, 250, 286,
+	156, 257, 148, 224, 246, 144, 271, 256, 206, 188,
+	189, 143, 0, 241, 167, 180, 164, 222, 0, 0,
+	163, 289, 0, 281, 146, 147, 280, 221, 268, 272,
+	207, 201, 145, 270, 205, 200, 192, 171, 184, 234,
+	199, 235, 185, 211, 210, 212, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
+	193, 0, 0, 0, 0, 0, 244, 227, 0, 0,
+	232, 242, 197, 269, 236, 274, 260, 282, 0, 237,
+	138, 261, 166, 208, 149, 150, 162, 168, 170, 172,
+	173, 217, 218, 230, 249, 262, 263, 264, 165, 157,
+	243, 158, 182, 160, 139, 159, 251, 161, 140, 231,
+	267, 0, 179, 239, 204, 141, 203, 233, 266, 265,
+	290, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 278, 0, 223, 0, 0, 0, 0, 0,
+	0, 0, 219, 294, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 187, 229, 0, 248, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
+	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
+	0, 0, 824, 0, 213, 214, 215, 216, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 181, 0, 183, 154, 228, 178, 285, 190,
+	220, 186, 252, 191, 198, 240, 284, 226, 245, 153,
+	275, 253, 202, 177, 132, 133, 134, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 743, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 1424, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	1156, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 743, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 659, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1743, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 195, 0, 238, 174, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 225, 0, 291, 292, 293,
+	277, 0, 0, 0, 0, 169, 0, 0, 0, 194,
+	0, 196, 0, 0, 254, 209, 136, 0, 0, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2106,
+	92, 689, 0, 0, 0, 0, 0, 151, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 0, 142, 259, 273, 152, 250,
+	286, 156, 257, 148, 224, 246, 144, 271, 256, 206,
+	188, 189, 143, 0, 241, 167, 180, 164, 222, 0,
+	0, 163, 289, 0, 281, 146, 147, 280, 221, 268,
+	272, 207, 201, 145, 270, 205, 200, 192, 171, 184,
+	234, 199, 235, 185, 211, 210, 212, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 0, 258, 0,
+	0, 193, 0, 0, 0, 0, 0, 244, 227, 0,
+	0, 232, 242, 197, 269, 236, 274, 260, 282, 0,
+	237, 138, 261, 166, 208, 149, 150, 162, 168, 170,
+	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
+	157, 243, 158, 182, 160, 139, 159, 251, 161, 140,
+	231, 267, 0, 179, 239, 204, 141, 203, 233, 266,
+	265, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 278, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 187, 229, 0, 248, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
+	0, 0, 0, 0, 0, 213, 214, 215, 216, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 181, 0, 183, 154, 228, 178, 285,
+	190, 220, 186, 252, 191, 198, 240, 284, 226, 245,
+	153, 275, 253, 202, 177, 132, 133, 134, 135, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 743, 0, 0, 0, 138,
+	0, 0, 0, 0, 131, 0, 195, 0, 238, 174,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 225, 0, 291, 292,
+	293, 277, 0, 0, 0, 0, 169, 0, 0, 0,
+	194, 0, 196, 0, 0, 254, 209, 136, 0, 0,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 776, 0, 0, 0, 151, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 142, 259, 273, 152,
+	250, 286, 156, 257, 148, 224, 246, 144, 271, 256,
+	206, 188, 189, 143, 0, 241, 167, 180, 164, 222,
+	0, 0, 163, 289, 0, 281, 146, 147, 280, 221,
+	268, 272, 207, 201, 145, 270, 205, 200, 192, 171,
+	184, 234, 199, 235, 185, 211, 210, 212, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
+	0, 0, 193, 0, 0, 0, 0, 0, 244, 227,
+	0, 0, 232, 242, 197, 269, 236, 274, 260, 282,
+	0, 237, 138, 261, 166, 208, 149, 150, 162, 168,
+	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
+	165, 157, 243, 158, 182, 160, 139, 159, 251, 161,
+	140, 231, 267, 0, 179, 239, 204, 141, 203, 233,
+	266, 265, 290, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 278, 0, 223, 0, 0, 0,
+	0, 0, 0, 0, 219, 294, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 187, 229, 0, 248,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 276, 288, 279, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, 1484, 213, 214, 215, 216,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 181, 0, 183, 154, 228, 178,
+	285, 190, 220, 186, 252, 191, 198, 240, 284, 226,
+	245, 153, 275, 253, 202, 177, 132, 133, 134, 135,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 195, 0, 238,
+	174, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 225, 0, 291,
+	292, 293, 277, 0, 0, 0, 0, 169, 1203, 0,
+	0, 194, 0, 196, 0, 0, 254, 209, 136, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 92, 0, 0, 776, 0, 0, 0, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 0, 0, 163, 289, 0, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 0, 0, 244,
+	227, 0, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
+	216, 0, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 195, 0,
+	238, 174, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1488, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 301, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1174, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	123, 124, 125, 126, 127, 128, 129, 130, 225, 0,
+	291, 292, 293, 277, 0, 0, 0, 0, 169, 0,
+	0, 0, 194, 0, 196, 0, 0, 254, 209, 136,
+	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 689, 0, 0, 0, 0, 0,
+	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 142, 259,
+	273, 152, 250, 286, 156, 257, 148, 224, 246, 144,
+	271, 256, 206, 188, 189, 143, 0, 241, 167, 180,
+	164, 222, 0, 0, 163, 289, 0, 281, 146, 147,
+	280, 221, 268, 272, 207, 201, 145, 270, 205, 200,
+	192, 171, 184, 234, 199, 235, 185, 211, 210, 212,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	0, 258, 0, 0, 193, 0, 0, 0, 0, 0,
+	244, 227, 0, 0, 232, 242, 197, 269, 236, 274,
+	260, 282, 0, 237, 138, 261, 166, 208, 149, 150,
+	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
+	263, 264, 165, 157, 243, 158, 182, 160, 139, 159,
+	251, 161, 140, 231, 267, 0, 179, 239, 204, 141,
+	203, 233, 266, 265, 290, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 0, 278, 0, 223, 0,
+	0, 0, 0, 0, 0, 0, 219, 294, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 187, 229,
+	0, 248, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 276, 288, 279, 0, 0,
+	0, 287, 0, 0, 0, 0, 0, 0, 213, 214,
+	215, 216, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 181, 0, 183, 154,
+	228, 178, 285, 190, 220, 186, 252, 191, 198, 240,
+	284, 226, 245, 153, 275, 253, 202, 177, 132, 133,
+	134, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 195,
+	0, 238, 174, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 225,
+	0, 291, 292, 293, 277, 0, 0, 0, 0, 169,
+	0, 0, 0, 194, 0, 196, 0, 0, 254, 209,
+	136, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1813, 0, 0, 92, 0, 0, 0, 0, 0,
+	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
+	259, 273, 152, 250, 286, 156, 257, 148, 224, 246,
+	144, 271, 256, 206, 188, 189, 143, 0, 241, 167,
+	180, 164, 222, 0, 0, 163, 289, 0, 281, 146,
+	147, 280, 221, 268, 272, 207, 201, 145, 270, 205,
+	200, 192, 171, 184, 234, 199, 235, 185, 211, 210,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 0, 258, 0, 0, 193, 0, 0, 0, 0,
+	0, 244, 227, 0, 0, 232, 242, 197, 269, 236,
+	274, 260, 282, 0, 237, 138, 261, 166, 208, 149,
+	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
+	262, 263, 264, 165, 157, 243, 158, 182, 160, 139,
+	159, 251, 161, 140, 231, 267, 0, 179, 239, 204,
+	141, 203, 233, 266, 265, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 278, 0, 223,
+	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 187,
+	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
+	214, 215, 216, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 181, 0, 183,
+	154, 228, 178, 285, 190, 220, 186, 252, 191, 198,
+	240, 284, 226, 245, 153, 275, 253, 202, 177, 132,
+	133, 134, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	195, 0, 238, 174, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	225, 0, 291, 292, 293, 277, 0, 0, 0, 0,
+	169, 0, 0, 0, 194, 0, 196, 0, 0, 254,
+	209, 136, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 776, 0,
+	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 259, 273, 152, 250, 286, 156, 257, 148, 224,
+	246, 144, 271, 256, 206, 188, 189, 143, 0, 241,
+	167, 180, 164, 222, 0, 0, 163, 289, 0, 281,
+	146, 147, 280, 221, 268, 272, 207, 201, 145, 270,
+	205, 200, 192, 171, 184, 234, 199, 235, 185, 211,
+	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 258, 0, 0, 193, 0, 0, 0,
+	0, 0, 244, 227, 0, 0, 232, 242, 197, 269,
+	236, 274, 260, 282, 0, 237, 138, 261, 166, 208,
+	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
+	249, 262, 263, 264, 165, 157, 243, 158, 182, 160,
+	139, 159, 251, 161, 140, 231, 267, 0, 179, 239,
+	204, 141, 203, 233, 266, 265, 290, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 0, 278, 0,
+	223, 0, 0, 0, 0, 0, 0, 0, 219, 294,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
+	187, 229, 0, 248, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 276, 288, 279,
+	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
+	213, 214, 215, 216, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 181, 0,
+	183, 154, 228, 178, 285, 190, 220, 186, 252, 191,
+	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
+	132, 133, 134, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 195, 0, 238, 174, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 225, 0, 291, 292, 293, 277, 0, 0, 0,
+	0, 169, 0, 0, 0, 194, 0, 196, 0, 0,
+	254, 209, 136, 0, 0, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1548, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 142, 259, 273, 152, 250, 286, 156, 257, 148,
+	224, 246, 144, 271, 256, 206, 188, 189, 143, 0,
+	241, 167, 180, 164, 222, 0, 0, 163, 289, 0,
+	281, 146, 147, 280, 221, 268, 272, 207, 201, 145,
+	270, 205, 200, 192, 171, 184, 234, 199, 235, 185,
+	211, 210, 212, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 0, 258, 0, 0, 193, 0, 0,
+	0, 0, 0, 244, 227, 0, 0, 232, 242, 197,
+	269, 236, 274, 260, 282, 0, 237, 138, 261, 166,
+	208, 149, 150, 162, 168, 170, 172, 173, 217, 218,
+	230, 249, 262, 263, 264, 165, 157, 243, 158, 182,
+	160, 139, 159, 251, 161, 140, 231, 267, 0, 179,
+	239, 204, 141, 203, 233, 266, 265, 290, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 0, 278,
+	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
+	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 187, 229, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
+	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
+	0, 213, 214, 215, 216, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 181,
+	0, 183, 154, 228, 178, 285, 190, 220, 186, 252,
+	191, 198, 240, 284, 226, 245, 153, 275, 253, 202,
+	177, 132, 133, 134, 135, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 195, 0, 238, 174, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 225, 0, 291, 292, 293, 277, 0, 0,
+	0, 0, 169, 0, 0, 0, 194, 0, 196, 0,
+	0, 254, 209, 136, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 259, 273, 152, 250, 286, 156, 257,
+	148, 224, 246, 144, 271, 256, 206, 188, 189, 143,
+	0, 241, 167, 180, 164, 222, 0, 0, 163, 289,
+	0, 281, 146, 147, 280, 221, 268, 272, 207, 201,
+	145, 270, 205, 200, 192, 171, 184, 234, 199, 235,
+	185, 211, 210, 212, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 258, 0, 0, 193, 0,
+	0, 0, 0, 0, 244, 227, 0, 0, 232, 242,
+	197, 269, 236, 274, 260, 282, 0, 237, 138, 261,
+	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
+	218, 230, 249, 262, 263, 264, 165, 157, 243, 158,
+	182, 160, 139, 159, 251, 161, 140, 231, 267, 0,
+	179, 239, 204, 141, 203, 233, 266, 265, 290, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
+	278, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	219, 294, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 187, 229, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
+	288, 279, 0, 0, 0, 287, 0, 0, 0, 0,
+	0, 0, 213, 214, 215, 216, 0, 0, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	181, 0, 183, 154, 228, 178, 285, 190, 220, 186,
+	252, 191, 198, 240, 284, 226, 245, 153, 275, 253,
+	202, 177, 132, 133, 134, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 195, 0, 238, 174, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 225, 0, 291, 292, 293, 277, 0,
+	0, 0, 0, 169, 0, 0, 0, 194, 0, 196,
+	0, 0, 254, 209, 136, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 142, 259, 273, 152, 250, 286, 156,
+	257, 148, 224, 246, 144, 271, 256, 206, 188, 189,
+	143, 0, 241, 167, 180, 164, 222, 0, 0, 163,
+	289, 0, 281, 146, 147, 280, 221, 268, 272, 207,
+	201, 145, 270, 205, 200, 192, 171, 184, 234, 199,
+	235, 185, 211, 210, 212, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	0, 0, 0, 0, 0, 0, 258, 0, 0, 193,
+	0, 0, 0, 0, 0, 244, 227, 0, 0, 232,
+	242, 197, 269, 236, 274, 260, 282, 0, 237, 138,
+	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
+	217, 218, 230, 249, 262, 263, 264, 165, 157, 243,
+	158, 182, 160, 139, 159, 251, 161, 140, 231, 267,
+	0, 179, 239, 204, 141, 203, 233, 266, 265, 290,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
+	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 187, 229, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 0, 213, 214, 215, 216, 0, 0, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 181, 0, 183, 154, 228, 178, 285, 190, 220,
+	186, 252, 191, 198, 240, 284, 226, 245, 153, 275,
+	253, 202, 177, 132, 133, 134, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 195, 0, 238, 174, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 225, 0, 291, 292, 293, 277,
+	0, 0, 0, 0, 169, 0, 0, 0, 194, 0,
+	196, 0, 0, 254, 209, 136, 0, 0, 137, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 333, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
+	0, 0, 350, 0, 0, 0, 151, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 142, 259, 273, 152, 250, 286,
+	156, 257, 148, 224, 246, 144, 271, 256, 206, 188,
+	189, 143, 0, 241, 167, 180, 164, 222, 0, 0,
+	163, 289, 0, 281, 146, 147, 280, 221, 268, 272,
+	207, 201, 145, 270, 205, 200, 192, 171, 184, 234,
+	199, 235, 185, 211, 210, 212, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
+	193, 0, 0, 0, 0, 0, 244, 227, 0, 0,
+	232, 242, 197, 269, 236, 274, 260, 282, 0, 237,
+	138, 261, 166, 208, 149, 150, 162, 168, 170, 172,
+	173, 217, 218, 230, 249, 262, 263, 264, 165, 157,
+	243, 158, 182, 160, 139, 159, 251, 161, 140, 231,
+	267, 0, 179, 239, 204, 141, 203, 233, 266, 265,
+	290, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 278, 0, 223, 0, 0, 0, 0, 0,
+	0, 0, 219, 294, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 187, 229, 0, 248, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
+	0, 0, 0, 0, 213, 214, 215, 216, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 181, 0, 183, 154, 228, 178, 285, 190,
+	220, 186, 252, 191, 198, 240, 284, 226, 245, 153,
+	275, 253, 202, 177, 132, 133, 134, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
+	0, 0, 0, 131, 0, 195, 0, 238, 174, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 225, 0, 291, 292, 293,
+	277, 0, 0, 0, 0, 169, 0, 0, 0, 194,
+	0, 196, 0, 0, 254, 209, 136, 0, 0, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 0, 0, 151, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 1118, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 142, 259, 273, 152, 250,
+	286, 156, 257, 148, 224, 246, 144, 271, 256, 206,
+	188, 189, 143, 0, 241, 167, 180, 164, 222, 0,
+	0, 163, 289, 0, 281, 146, 147, 280, 221, 268,
+	272, 207, 201, 145, 270, 205, 200, 192, 171, 184,
+	234, 199, 235, 185, 211, 210, 212, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
+	0, 283, 0, 0, 1163, 0, 0, 0, 258, 0,
+	0, 193, 0, 0, 0, 0, 0, 244, 227, 0,
+	0, 232, 242, 197, 269, 236, 274, 260, 282, 0,
+	237, 138, 261, 166, 208, 149, 150, 162, 168, 170,
+	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
+	157, 243, 158, 182, 160, 139, 159, 251, 161, 140,
+	231, 267, 0, 179, 239, 204, 141, 203, 233, 266,
+	265, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 278, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 187, 229, 0, 248, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
+	0, 0, 0, 0, 0, 213, 214, 215, 216, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 181, 0, 183, 154, 228, 178, 285,
+	190, 220, 186, 252, 191, 198, 240, 284, 226, 245,
+	153, 275, 253, 202, 177, 132, 133, 134, 135, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 743, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 782, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 195, 0, 238, 174,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 409, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 82, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 225, 0, 291, 292,
+	293, 277, 0, 0, 0, 0, 169, 0, 0, 0,
+	194, 0, 196, 0, 0, 254, 209, 136, 0, 0,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 776, 0, 0, 0, 151, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 0, 0, 142, 259, 273, 152,
+	250, 286, 156, 257, 148, 224, 246, 144, 271, 256,
+	206, 188, 189, 143, 0, 241, 167, 180, 164, 222,
+	0, 0, 163, 289, 0, 281, 146, 147, 280, 221,
+	268, 272, 207, 201, 145, 270, 205, 200, 192, 171,
+	184, 234, 199, 235, 185, 211, 210, 212, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
+	0, 0, 193, 0, 0, 0, 0, 0, 244, 227,
+	0, 0, 232, 242, 197, 269, 236, 274, 260, 282,
+	0, 237, 138, 261, 166, 208, 149, 150, 162, 168,
+	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
+	165, 157, 243, 158, 182, 160, 139, 159, 251, 161,
+	140, 231, 267, 0, 179, 239, 204, 141, 203, 233,
+	266, 265, 290, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 278, 0, 223, 0, 0, 0,
+	0, 0, 0, 0, 219, 294, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 187, 229, 0, 248,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 276, 288, 816, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, 0, 213, 214, 215, 216,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 181, 0, 183, 154, 228, 178,
+	285, 190, 220, 186, 252, 191, 198, 240, 284, 226,
+	245, 153, 275, 253, 202, 177, 132, 133, 134, 135,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 195, 0, 238,
+	174, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 225, 0, 291,
+	292, 293, 277, 0, 0, 0, 0, 169, 0, 0,
+	0, 194, 0, 196, 0, 0, 254, 209, 136, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 0, 0, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 259, 273,
+	152, 250, 286, 156, 257, 148, 224, 246, 144, 271,
+	256, 206, 188, 189, 143, 0, 241, 167, 180, 164,
+	222, 0, 0, 163, 289, 0, 281, 146, 147, 280,
+	221, 268, 272, 207, 201, 145, 270, 205, 200, 192,
+	171, 184, 234, 199, 235, 185, 211, 210, 212, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	258, 0, 0, 193, 0, 0, 0, 0, 0, 244,
+	227, 0, 0, 232, 242, 197, 269, 236, 274, 260,
+	282, 0, 237, 138, 261, 166, 208, 149, 150, 162,
+	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
+	264, 165, 157, 243, 158, 182, 160, 139, 159, 251,
+	161, 140, 231, 267, 0, 179, 239, 204, 141, 203,
+	233, 266, 265, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 278, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 187, 229, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
+	216, 0, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 181, 0, 183, 154, 228,
+	178, 285, 190, 220, 186, 252, 191, 198, 240, 284,
+	226, 245, 153, 275, 253, 202, 177, 132, 133, 134,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 429, 0, 131, 0, 195, 0,
+	238, 174, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 225, 0,
+	291, 292, 293, 277, 0, 0, 0, 89, 169, 0,
+	0, 0, 194, 0, 196, 0, 0, 254, 209, 136,
+	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 142, 259,
+	273, 152, 250, 286, 156, 257, 148, 224, 246, 144,
+	271, 256, 206, 188, 189, 143, 0, 241, 167, 180,
+	164, 222, 0, 0, 163, 289, 0, 281, 146, 147,
+	280, 221, 268, 272, 207, 201, 145, 270, 205, 200,
+	192, 171, 184, 234, 199, 235, 185, 211, 210, 212,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	0, 258, 0, 0, 193, 0, 0, 0, 0, 0,
+	244, 227, 0, 0, 232, 242, 197, 269, 236, 274,
+	260, 282, 0, 237, 138, 261, 166, 208, 149, 150,
+	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
+	263, 264, 165, 157, 243, 158, 182, 160, 139, 159,
+	251, 161, 140, 231, 267, 0, 179, 239, 204, 141,
+	203, 233, 266, 265, 290, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 0, 278, 0, 223, 0,
+	0, 0, 0, 0, 0, 0, 219, 294, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 187, 229,
+	0, 248, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 276, 288, 279, 0, 0,
+	0, 287, 0, 0, 0, 0, 0, 0, 213, 214,
+	215, 216, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 181, 0, 183, 154,
+	228, 178, 285, 190, 220, 186, 252, 191, 198, 240,
+	284, 226, 245, 153, 275, 253, 202, 177, 132, 133,
+	134, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 195,
+	0, 238, 174, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 225,
+	0, 291, 292, 293, 277, 0, 0, 0, 0, 169,
+	0, 0, 0, 194, 0, 196, 0, 0, 254, 209,
+	136, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 0,
+	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
+	259, 273, 152, 250, 286, 156, 257, 148, 224, 246,
+	144, 271, 256, 206, 188, 189, 143, 0, 241, 167,
+	180, 164, 222, 0, 0, 163, 289, 0, 281, 146,
+	147, 280, 221, 268, 272, 207, 201, 145, 270, 205,
+	200, 192, 171, 184, 234, 199, 235, 185, 211, 210,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 0, 258, 0, 0, 193, 0, 0, 0, 0,
+	0, 244, 227, 0, 0, 232, 242, 197, 269, 236,
+	274, 260, 282, 0, 237, 138, 261, 166, 208, 149,
+	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
+	262, 263, 264, 165, 157, 243, 158, 182, 160, 139,
+	159, 251, 161, 140, 231, 267, 0, 179, 239, 204,
+	141, 203, 233, 266, 265, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 278, 0, 223,
+	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 187,
+	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
+	214, 215, 216, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 181, 0, 183,
+	154, 228, 178, 285, 190, 220, 186, 252, 191, 198,
+	240, 284, 226, 245, 153, 275, 253, 202, 177, 132,
+	133, 134, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	195, 0, 238, 174, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 0, 211, 277, 278, 279, 263, 455,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 461, 462, 457, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 211, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 460, 461, 462, 457,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 460,
-	461, 462, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 1693, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	1130, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 2093, 1693, 0, 0, 0,
-	0, 199, 200, 201, 202, 1675, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	1130, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 1762, 0, 0, 0,
-	0, 0, 0, 0, 0, 1675, 0, 0, 0, 1693,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 0, 1130, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1675, 0,
-	277, 278, 279, 263, 0, 0, 1679, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1672, 0, 0,
-	0, 1674, 1676, 1678, 0, 1680, 1681, 1682, 1684, 1685,
-	1686, 1688, 1689, 1690, 1691, 0, 1679, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1694, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1672, 0, 0,
-	0, 1674, 1676, 1678, 0, 1680, 1681, 1682, 1684, 1685,
-	1686, 1688, 1689, 1690, 1691, 0, 0, 1692, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1679,
-	0, 0, 0, 0, 1671, 0, 0, 1694, 0, 0,
-	1683, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	0, 0, 0, 0, 0, 0, 1677, 0, 0, 0,
-	1672, 0, 0, 0, 1674, 1676, 1678, 1692, 1680, 1681,
-	1682, 1684, 1685, 1686, 1688, 1689, 1690, 1691, 0, 0,
-	0, 0, 0, 0, 1671, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	1694, 0, 0, 0, 0, 0, 1677, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1692, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1671, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1687, 0, 0, 0, 0, 0, 0, 1677,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	0, 225, 291, 292, 293, 277, 475, 0, 0, 0,
+	0, 169, 0, 0, 0, 194, 0, 196, 0, 0,
+	254, 209, 136, 0, 0, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 481, 482, 477,
+	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 142, 259, 273, 152, 250, 286, 156, 257, 148,
+	224, 246, 144, 271, 256, 206, 188, 189, 143, 0,
+	241, 167, 180, 164, 222, 0, 0, 163, 289, 0,
+	281, 146, 147, 280, 221, 268, 272, 207, 201, 145,
+	270, 205, 200, 192, 171, 184, 234, 199, 235, 185,
+	211, 210, 212, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 0, 258, 0, 0, 193, 0, 0,
+	0, 0, 0, 244, 227, 0, 0, 232, 242, 197,
+	269, 236, 274, 260, 282, 0, 237, 138, 261, 166,
+	208, 149, 150, 162, 168, 170, 172, 173, 217, 218,
+	230, 249, 262, 263, 264, 165, 157, 243, 158, 182,
+	160, 139, 159, 251, 161, 140, 231, 267, 0, 179,
+	239, 204, 141, 203, 233, 266, 265, 290, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 0, 278,
+	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
+	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 187, 229, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
+	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
+	0, 213, 214, 215, 216, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 181,
+	0, 183, 154, 228, 178, 285, 190, 220, 186, 252,
+	191, 198, 240, 284, 226, 245, 153, 275, 253, 202,
+	177, 132, 133, 134, 135, 225, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 169, 0, 0, 0, 194,
+	0, 196, 0, 0, 254, 209, 136, 0, 0, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 195, 0, 238, 174, 0, 0, 0, 0,
+	480, 481, 482, 477, 0, 0, 0, 151, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 292, 293, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 142, 259, 273, 152, 250,
+	286, 156, 257, 148, 224, 246, 144, 271, 256, 206,
+	188, 189, 143, 0, 241, 167, 180, 164, 222, 0,
+	0, 163, 289, 0, 281, 146, 147, 280, 221, 268,
+	272, 207, 201, 145, 270, 205, 200, 192, 171, 184,
+	234, 199, 235, 185, 211, 210, 212, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 0, 258, 0,
+	0, 193, 0, 0, 0, 0, 0, 244, 227, 0,
+	0, 232, 242, 197, 269, 236, 274, 260, 282, 0,
+	237, 138, 261, 166, 208, 149, 150, 162, 168, 170,
+	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
+	157, 243, 158, 182, 160, 139, 159, 251, 161, 140,
+	231, 267, 0, 179, 239, 204, 141, 203, 233, 266,
+	265, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 278, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 187, 229, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
+	0, 0, 0, 0, 0, 213, 214, 215, 216, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 181, 0, 183, 154, 228, 178, 285,
+	190, 220, 186, 252, 191, 198, 240, 284, 226, 245,
+	153, 275, 253, 202, 177, 132, 133, 134, 135, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
+	0, 0, 0, 194, 0, 196, 0, 0, 254, 209,
+	136, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 195, 0, 238, 174,
+	0, 0, 0, 0, 480, 481, 482, 0, 0, 0,
+	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 292,
+	293, 277, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
+	259, 273, 152, 250, 286, 156, 257, 148, 224, 246,
+	144, 271, 256, 206, 188, 189, 143, 0, 241, 167,
+	180, 164, 222, 0, 0, 163, 289, 0, 281, 146,
+	147, 280, 221, 268, 272, 207, 201, 145, 270, 205,
+	200, 192, 171, 184, 234, 199, 235, 185, 211, 210,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 0, 258, 0, 0, 193, 0, 0, 0, 0,
+	0, 244, 227, 0, 0, 232, 242, 197, 269, 236,
+	274, 260, 282, 0, 237, 138, 261, 166, 208, 149,
+	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
+	262, 263, 264, 165, 157, 243, 158, 182, 160, 139,
+	159, 251, 161, 140, 231, 267, 0, 179, 239, 204,
+	141, 203, 233, 266, 265, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 278, 0, 223,
+	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 187,
+	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
+	0, 1761, 287, 0, 86, 0, 26, 42, 27, 213,
+	214, 215, 216, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 72, 1175, 175, 181, 79, 183,
+	154, 228, 178, 285, 190, 220, 186, 252, 191, 198,
+	240, 284, 226, 245, 153, 275, 253, 202, 177, 132,
+	133, 134, 135, 43, 0, 0, 2191, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 1743, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1761, 0, 0, 0, 0, 0, 131, 0,
+	195, 0, 238, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1175, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	78, 0, 291, 292, 293, 277, 0, 0, 1839, 0,
+	0, 0, 0, 0, 0, 0, 0, 1743, 0, 0,
+	0, 0, 1761, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1175, 0, 0, 0,
+	0, 0, 0, 63, 74, 83, 0, 41, 0, 1747,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1751, 0, 0, 73, 71, 70, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1743, 0, 0,
+	1740, 0, 0, 0, 1742, 1744, 1746, 0, 1748, 1749,
+	1750, 1752, 1753, 1754, 1756, 1757, 1758, 1759, 0, 0,
+	0, 0, 0, 53, 0, 1762, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1763, 0, 0, 0, 0, 0, 0, 0, 0,
+	1747, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1751, 0, 0, 0, 0, 0, 0, 0, 0,
+	54, 1760, 0, 0, 0, 0, 55, 0, 0, 0,
+	0, 1740, 0, 0, 0, 1742, 1744, 1746, 1739, 1748,
+	1749, 1750, 1752, 1753, 1754, 1756, 1757, 1758, 1759, 0,
+	0, 0, 0, 1755, 0, 0, 1762, 0, 0, 0,
+	1745, 0, 0, 56, 0, 0, 0, 0, 0, 0,
+	1747, 0, 1763, 0, 0, 0, 0, 0, 0, 0,
+	0, 1751, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 51, 52,
+	0, 1740, 1760, 0, 0, 1742, 1744, 1746, 0, 1748,
+	1749, 1750, 1752, 1753, 1754, 1756, 1757, 1758, 1759, 1739,
+	0, 0, 0, 0, 0, 0, 1762, 0, 0, 0,
+	0, 0, 0, 0, 1755, 0, 0, 0, 85, 0,
+	0, 1745, 1763, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1760, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1739,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1755, 0, 0, 0, 0, 0,
+	0, 1745,
 }
 
 var yyPact = [...]int{
-	1505, -1000, -296, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	19328, -1000, -301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17440, 1769, -1000, 8364,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 194, 14854, 17871, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -163, -175, 191, 7915, 7466, 115, -1000, 1763, -1000,
+	-1000, -1000, -1000, 117, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 433, -44, 285, 287, 284, 284, 9226,
+	1763, 1408, 174, 9, -1000, 17009, 1687, 19328, 147, 17871,
+	-1000, 326, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15209, 1577, -1000, 6406, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 197, 12701,
-	15627, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5970, 5534,
-	117, -1000, 1548, -1000, -1000, -1000, -1000, 139, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 422, -42, 289, 293,
-	326, 326, 7242, 1548, 1289, 154, 6, -1000, 14791, 1485,
-	1505, 151, 15627, -1000, 343, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2800,73 +3073,76 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 14854, 17871, -81, 476, -1000,
+	159, 166, 177, 325, -1000, -1000, -1000, -1000, 17871, 1454,
+	-1000, -1000, -1000, 1688, 18303, 174, -1000, 170, 182, 17871,
+	1392, 1298, -1000, -1000, 1530, -1000, 89, -5, -29, 93,
+	-1000, -1000, 130, -1000, -1000, -1000, -1000, -1000, 35, -1000,
+	-15, -1000, -22, -1000, -1000, -1000, -116, -1000, -1000, -1000,
+	-1000, -1000, 1347, 312, 1554, -158, 1640, 1693, 1408, 1753,
+	1716, -6, 168, 168, 186, 168, -1000, -1000, -1000, -1000,
+	-1000, -1000, 473, 134, -1000, -1000, -131, -124, 336, -124,
+	12, -1000, -1000, -1000, -1000, -1000, -1000, 170, -1000, -178,
+	-1000, 272, -1000, 269, -1000, 10969, 128, 1264, 449, -1000,
+	371, 17871, 17871, 17871, 17871, 17871, 371, 785, 775, 324,
+	-1000, -1000, -1000, 1616, 1626, 1693, 1408, -1000, 1763, 1763,
+	1167, 1154, 170, 170, 170, 170, 170, 170, 1258, 17871,
+	-1000, 1439, 5694, -1000, -1000, -1000, -1000, -1000, 164, 1529,
+	-1000, 17871, 1575, -1000, 323, 819, 955, -1000, -1000, 159,
+	1384, -1000, 338, -1000, -1000, -1000, -1000, 17871, 1523, 17871,
+	14854, 14854, 14854, 14854, -1000, 1591, 1590, -1000, 1580, 1568,
+	1567, 17871, -1000, -1000, -1000, 18657, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1163, 1763, 17871, 1666, 948, -1000, 96,
+	7550, 13992, 15716, 17871, 13992, -1000, -1000, -1000, -1000, -1000,
+	-119, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 96, 13992, 13992, -85, -1000, -1000, -291, 1640, 6135,
+	-1000, -1000, 6135, -1000, -1000, 178, 168, -1000, 13992, 546,
+	15716, 832, 17871, 17871, -1000, -1000, 336, 336, -1000, 473,
+	473, -1000, -1000, -121, 1761, 7017, -134, 17871, 17871, 168,
+	16578, -149, 278, 274, 277, -1000, -1000, -160, -1000, -1000,
+	1225, 11406, 10532, 209, 13992, 3930, -1000, -1000, 371, 371,
+	371, 371, 371, 3930, 332, -1000, -1000, -1000, -1000, -1000,
+	-1000, 17871, -1000, -1000, 1640, -1000, -1000, -1000, 1693, 1640,
+	1693, -1000, -1000, 13992, 15716, 17871, 17871, 17871, 19011, 17871,
+	1258, 1674, 17871, 1244, -1000, -1000, 10101, 319, 6135, 764,
+	1520, -1000, 1519, 1518, 1517, 1516, 1515, 1514, 1512, 1490,
+	-1000, -1000, 1511, 1510, 1509, 1507, -1000, -1000, -1000, -1000,
+	1506, -1000, -1000, 1504, 1490, 1503, 1502, 1498, 1497, -1000,
+	-1000, -1000, -1000, 922, -1000, 359, -1000, -1000, 3048, 7017,
+	7017, 7017, 7017, -1000, -1000, 1459, 6135, 1496, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 633, -1000, 1495, 1494, 1493, 1492, 1490, 1489, 947,
+	946, 940, 1488, 1487, 1485, 7017, 1476, 1475, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 12701, 15627, -75, 460, -1000, 162, 157, 168, 342,
-	-1000, -1000, -1000, -1000, 15627, 1288, -1000, -1000, -1000, 1489,
-	16046, 154, -1000, 1215, 1249, -1000, -1000, 1391, -1000, 92,
-	-7, -29, 121, -1000, -1000, 136, -1000, -1000, -1000, -1000,
-	-1000, 40, -1000, -15, -1000, -22, -1000, -1000, -1000, -110,
-	-1000, -1000, -1000, -1000, -1000, 1172, 313, 1411, -162, 1468,
-	1491, 1289, 1539, 1506, 0, 172, 172, 191, 172, -1000,
-	-1000, -1000, -1000, -1000, -1000, 495, 135, -1000, -1000, -126,
-	-130, 348, -130, 10, -1000, -1000, -1000, -1000, -1000, -1000,
-	173, -1000, -174, -1000, 276, -1000, 269, -1000, 8933, 132,
-	1237, 458, -1000, 533, 15627, 15627, 15627, 533, 670, 608,
-	341, -1000, -1000, -1000, 1454, 1455, 1491, 1289, -1000, 1548,
-	1548, 1165, 1087, 173, 173, 173, 173, 173, 1235, 15627,
-	-1000, 1294, 4242, -1000, -1000, -1000, -1000, -1000, 163, 1390,
-	-1000, 15627, 1416, -1000, 339, 811, 965, -1000, -1000, 162,
-	1217, -1000, 340, -1000, -1000, -1000, -1000, 15627, 1389, 15627,
-	12701, 12701, 12701, 12701, -1000, 1437, 1434, -1000, 1427, 1426,
-	1433, 15627, -1000, -1000, -1000, 16389, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1156, 1548, 93, 5617, 11865, 13537, 15627,
-	11865, -1000, -1000, -1000, -1000, -1000, -111, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 93, 11865, 11865,
-	-85, -1000, -1000, -283, 1468, 4670, -1000, -1000, 4670, -1000,
-	-1000, 189, 172, -1000, 11865, 515, 13537, 954, 15627, 15627,
-	-1000, -1000, 348, 348, -1000, 495, 495, -1000, -1000, -125,
-	1553, 5098, -136, 15627, 172, 14373, 1475, -150, 283, 271,
-	274, -1000, -1000, -170, -1000, -1000, 1227, 9357, 8509, 199,
-	11865, 2958, -1000, -1000, 533, 533, 533, 2958, 306, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15627, -1000, -1000, 1468, -1000,
-	-1000, -1000, 1491, 1468, 1491, -1000, -1000, 11865, 13537, 15627,
-	15627, 16732, 15627, 1235, 1487, 15627, 1228, -1000, -1000, 8091,
-	338, 4670, 779, 1388, -1000, 1387, 1386, 1385, 1384, 1380,
-	1379, 1378, 1326, -1000, -1000, 1373, 1370, 1356, -1000, -1000,
-	-1000, -1000, 1354, -1000, -1000, 1350, 1326, 1349, 1345, 1337,
-	-1000, -1000, -1000, -1000, 5476, -1000, -1000, -1000, -1000, 2530,
-	5098, 5098, 5098, 5098, -1000, -1000, 1334, 4670, 1333, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 615, -1000, 1331, 1330, 1328, 1327, 1326, 1325,
-	963, 962, 939, 1323, 1318, 1317, 5098, 1313, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -289, -1000, 9669, 17871, 17871, -1000, 1755, 6135, 2204,
+	-1000, 1670, -1000, 159, 65, -1000, -1000, -1000, -1000, -1000,
+	-1000, 317, 17871, 1215, -1000, 471, 1536, 1547, 1536, -1000,
+	-1000, -1000, -1000, 1584, -1000, 1583, -1000, -1000, 1439, -1000,
+	-1000, -1000, -1000, -1000, 487, -1000, -1000, -1000, -1000, -1000,
+	-15, -22, 1186, -1000, -46, 83, -1000, -1000, 1382, -1000,
+	-1000, -1000, 487, 1186, 181, 939, 917, -1000, 662, 316,
+	1253, -1000, 876, 16147, 17871, 213, 1663, 1225, 1456, 1630,
+	1761, 1761, 1761, 336, 19011, 473, 17871, 473, -1000, -1000,
+	473, -1000, 313, -1000, 17871, 213, 1469, -1000, -1000, 279,
+	252, 257, 15716, 180, -1000, -1000, 1225, -1000, -1000, -1000,
+	1468, 421, -1000, -1000, 7017, -1000, 609, -1000, 3930, 3930,
+	3930, 3930, 3930, -1000, 12699, -1000, -1000, 1640, -1000, 1640,
+	1186, 1225, 1546, 1238, -1000, 1238, -1000, -1000, -1000, -1000,
+	1466, 1376, -1000, 1761, 5694, -1000, 14854, -1000, 6135, 6135,
+	6135, -1000, 17871, 15285, -1000, 532, 3489, -1000, -1000, -1000,
+	-1000, -1000, -1000, 6135, 1692, 1692, 1692, 6135, 455, 6135,
+	6135, -1000, 638, 1521, 1692, 1692, 1692, 1692, 1692, -1000,
+	1692, 1692, 1692, 6135, 7017, 7017, 7017, 7017, 7017, 7017,
+	7017, 7017, 7017, 7017, 7017, 7017, 1458, 669, 7017, 7017,
+	7017, 916, 915, 1154, 1332, 1230, -1000, -1000, -1000, -1000,
+	-1000, 489, 609, 6135, -1000, 1521, 6135, 6135, 6135, -1000,
+	1155, -1000, -1000, 6135, -1000, -1000, -1000, 6135, 7017, 6135,
+	-1000, 6135, 1692, 1176, -1000, 1465, -1000, 1374, 1607, -1000,
+	308, 1227, -1000, 420, 1366, -1000, 1693, 609, -1000, 305,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -281, -1000, 7672, 15627, 15627, -1000, 1546, 4670, 2106,
-	-1000, 1498, -1000, 162, 66, -1000, -1000, -1000, -1000, -1000,
-	-1000, 331, 15627, 1197, -1000, 448, 1398, 1404, 1398, -1000,
-	-1000, -1000, -1000, 1430, -1000, 1428, -1000, -1000, 1294, -1000,
-	-1000, 455, -1000, -1000, -1000, -1000, -1000, -15, -22, 1221,
-	-1000, -47, 91, -1000, -1000, 1203, -1000, -1000, -1000, 455,
-	1221, 186, 938, 934, -1000, 716, 329, 1234, -1000, 774,
-	13955, 15627, 210, 1474, 1227, 1312, 1459, 1553, 1553, 1553,
-	348, 16732, 495, 15627, 495, -1000, -1000, 495, -1000, 327,
-	15627, 210, 1309, -1000, -1000, -1000, 281, 263, 268, 13537,
-	185, -1000, -1000, 1227, -1000, -1000, -1000, 1308, 432, -1000,
-	-1000, 5098, -1000, 739, -1000, 2958, 2958, 2958, -1000, 10611,
-	-1000, -1000, 1468, -1000, 1468, 1221, 1227, 1403, 1232, -1000,
-	-1000, -1000, -1000, -1000, 1307, 1201, -1000, 1553, 4242, -1000,
-	12701, -1000, 4670, 4670, 4670, -1000, 15627, 13119, -1000, 557,
-	5098, -1000, -1000, -1000, -1000, -1000, -1000, 4670, 1503, 1503,
-	1503, 4670, 446, 4670, 4670, -1000, 642, 2243, 1503, 1503,
-	1503, 1503, -1000, 1503, 1503, 1503, 5098, 5098, 5098, 5098,
-	5098, 5098, 5098, 5098, 5098, 5098, 5098, 5098, 1302, 573,
-	5098, 5098, 5098, 1087, 1067, 1230, -1000, -1000, -1000, -1000,
-	-1000, 470, 739, 4670, -1000, 2243, 4670, 4670, 4670, -1000,
-	1142, -1000, -1000, 4670, -1000, -1000, -1000, 4670, 5098, 4670,
-	-1000, 1503, 1192, -1000, 1306, -1000, 1199, 1449, -1000, 316,
-	1229, -1000, 419, 1194, -1000, 1491, 739, -1000, 315, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2880,151 +3156,156 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -82, -1000, -1000, 17871, 1360, 1755, 17871,
+	6135, -1000, -1000, 6135, 1462, -1000, 6135, -1000, -1000, -1000,
+	-1000, 1768, 302, 301, 13992, -1000, 162, 13992, -1000, -1000,
+	17871, 169, 13992, 0, -139, 6135, 6135, 17871, 6135, -1000,
+	-1000, -1000, 1439, 538, 1460, -226, -1000, -60, -1000, 1543,
+	52, -1000, 1630, -1000, 604, -1000, -1000, -1000, -1000, 1761,
+	-1000, 336, -1000, 336, 473, 17871, -1000, -1000, -226, 1153,
+	-1000, -1000, -1000, 236, 1225, 13992, 885, 209, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17871, 17871, 19328,
+	-1000, 17871, 1759, -1000, 1217, 1708, -1000, 525, 549, -1000,
+	300, -1000, -1000, 621, -1000, 1459, -1000, -1000, -1000, 1151,
+	1211, 609, 6135, -1000, -1000, 6135, 6135, 589, 6135, 1145,
+	1358, 1340, -1000, 1125, -1000, 1766, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -77, -1000,
-	-1000, 15627, 1178, 1546, 15627, 4670, -1000, -1000, 4670, 1305,
-	-1000, 4670, -1000, -1000, -1000, -1000, 1557, 312, 308, 11865,
-	-1000, 146, 11865, -1000, -1000, 15627, 184, 11865, -2, -142,
-	4670, 4670, 15627, 4670, -1000, -1000, -1000, 1294, 494, 1304,
-	-218, -1000, -59, -1000, 1402, 63, -1000, 1459, -1000, 288,
-	-1000, -1000, -1000, -1000, 1553, -1000, 348, -1000, 348, 495,
-	15627, -1000, -1000, -218, 1140, -1000, -1000, -1000, 254, 1227,
-	11865, 901, 199, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	15627, 15627, 1505, -1000, 15627, 1549, -1000, 1225, 1353, -1000,
-	565, 522, -1000, 307, -1000, -1000, 593, -1000, 1136, 1182,
-	739, 4670, -1000, -1000, 4670, 4670, 1060, 4670, 1116, 1176,
-	1174, -1000, 1104, -1000, 1555, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 6135, 6135, 6135, 6135, 6135,
+	6135, 6135, 6135, 1321, 1632, 1363, -1000, 716, 716, 311,
+	311, 311, 311, 311, 1048, 1048, -1000, -1000, -1000, 3048,
+	1458, 7017, 7017, 7017, 153, 1006, 1586, -1000, -1000, -1000,
+	6135, 508, -1000, 6135, 914, -1000, 1114, 696, 1111, 1108,
+	-1000, 985, 1088, 1610, 1085, 1330, 6135, -289, 5253, 167,
+	17871, -289, 17871, 17871, 5253, -1000, 17871, -1000, 2204, 818,
+	-1000, -1000, 1693, -1000, 609, 609, 17871, 609, 13992, 341,
+	386, -1000, 12268, 13992, -1000, -1000, 13992, 116, 1638, -1000,
+	-1000, -104, -90, 609, 609, 299, -1000, 1669, 1662, 8795,
+	-1000, -73, -1000, -1000, -1000, 200, -1000, 912, 911, 907,
+	899, 17871, -1000, -1000, -1000, -1000, -1000, 379, 379, 379,
+	1616, -1000, 1761, 1761, 336, -1000, -11, -49, -1000, 1186,
+	1076, -1000, -1000, -1000, -1000, 1073, -1000, 1757, 1751, 14854,
+	14423, -1000, -1000, -1000, 6135, 1304, 1290, 1283, 333, 1324,
+	-1000, -1000, -1000, -1000, 6135, 1320, 1279, 1254, 1222, 1195,
+	1174, 1159, 1116, -1000, 1310, -1000, 153, 1006, 877, -1000,
+	7017, 7017, 1113, 481, -1000, 6135, 584, 333, 625, -1000,
+	6135, -1000, -1000, -1000, 625, -1000, 7017, -1000, -1000, 1095,
+	-1000, 1069, 1189, -1000, -289, -1000, -1000, 1176, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1300, 1186,
+	-1000, -1000, -1000, -1000, 13992, 1665, 213, -1000, -13, 189,
+	-293, -93, 1750, 1749, 17871, 174, 17871, 1055, 1178, -1000,
+	-1000, -1000, 928, 510, -1000, 17871, 574, 280, 168, 280,
+	566, 1455, -1000, -1000, -73, -1000, 816, 812, 811, 805,
+	-52, -1000, -1000, -1000, -1000, -1000, 1453, 625, -1000, 746,
+	898, -1000, -1000, 1761, -1000, -11, -1000, 276, 265, 17,
+	1748, -1000, -1000, -1000, 6135, 4812, 1708, -1000, -1000, 609,
+	-1000, -1000, -1000, 1027, -1000, 1398, 1433, -1000, 1398, 1398,
+	1398, 249, 249, 1450, 1450, 1452, 1450, -1000, 1070, -195,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7017,
+	-1000, -1000, -1000, -1000, 609, 6135, 1025, 999, 652, 997,
+	1535, -1000, -1000, 5253, 1176, -1000, -1000, 13992, 13992, -228,
+	-16, 17871, -295, 890, -1000, 1747, 888, 674, -1000, 1439,
+	19477, 8795, 1148, -37, -1000, -1000, -1000, 1398, -1000, 1433,
+	1398, 1398, 1398, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1432, 1431, -1000, 1398, 1430, 1398, 1398, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 4670, 4670, 4670, 4670, 4670, 4670,
-	4670, 932, 1470, -1000, 668, 668, 320, 320, 320, 320,
-	320, 643, 643, -1000, -1000, -1000, 2530, 1302, 5098, 5098,
-	5098, 159, 848, 1788, -1000, 4670, 538, -1000, 4670, 746,
-	-1000, 1094, 613, 1088, 1086, -1000, 976, 1081, 2942, 1079,
-	4670, -281, 3814, 167, 15627, -281, 15627, 15627, 3814, -1000,
-	15627, -1000, 2106, 795, -1000, -1000, 1491, -1000, 739, 739,
-	15627, 739, 11865, 352, 416, -1000, 10193, 11865, -1000, -1000,
-	11865, 104, 1467, -1000, -1000, -104, -91, 739, 739, 299,
-	-1000, 1486, 1469, 6824, -1000, -76, -1000, -1000, -1000, 219,
-	-1000, 933, 924, 921, 920, 15627, -1000, -1000, -1000, -1000,
-	-1000, 407, 407, 407, 1454, -1000, 1553, 1553, 348, -1000,
-	-17, -53, -1000, 1221, 1072, -1000, -1000, -1000, -1000, 1056,
-	-1000, 1550, 1534, 12701, 12283, -1000, -1000, 4670, 1054, 1042,
-	1039, 169, 1164, -1000, -1000, -1000, -1000, 4670, 1001, 941,
-	929, 926, 923, 912, 908, 1162, -1000, 159, 848, 1310,
-	-1000, 5098, 5098, 861, 456, -1000, 4670, 550, 169, 590,
-	-1000, 4670, -1000, -1000, -1000, 590, -1000, 5098, -1000, 855,
-	-1000, 1049, 1224, -1000, -281, -1000, -1000, 1192, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1159, 1221,
-	-1000, -1000, -1000, -1000, 11865, 1481, 210, -1000, -13, 194,
-	-285, -87, 1533, 1531, 15627, 154, 15627, 1043, 1213, -1000,
-	-1000, -1000, 914, 541, -1000, 15627, 580, 303, 172, 303,
-	574, 1299, -1000, -1000, -76, -1000, 781, 780, 778, 767,
-	-50, -1000, -1000, -1000, -1000, -1000, 1297, 590, -1000, 625,
-	910, -1000, -1000, 1553, -1000, -17, -1000, 253, 273, 15,
-	1530, -1000, -1000, -1000, 4670, 4670, 1353, -1000, -1000, 739,
-	-1000, -1000, -1000, 1037, -1000, 1262, 1293, -1000, 1262, 1262,
-	1262, 247, 247, 1295, 1295, 1296, 1295, -1000, 846, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5098, -1000,
-	-1000, -1000, -1000, 739, 4670, 1035, 1028, 622, 1011, 2802,
-	-1000, -1000, 3814, 1192, -1000, -1000, 11865, 11865, -219, -16,
-	15627, -287, 907, -1000, 1529, 906, 627, -1000, 1294, 17104,
-	6824, 919, -37, -1000, -1000, -1000, 1262, -1000, 1293, 1262,
-	1262, 1262, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1287, 1285, -1000, 1262, 1284, 1262, 1262, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17871, 17871, -1000, 17871,
+	17871, 168, 6135, -1000, -1000, -1000, -1000, -1000, -1000, 13561,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15627, 15627, -1000, 15627, 15627,
-	172, 4670, -1000, -1000, -1000, -1000, -1000, -1000, 11447, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 765,
-	-1000, -1000, -1000, 901, 739, 1182, -1000, -1000, -1000, 763,
-	-1000, 762, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	755, -1000, -1000, 750, -1000, -1000, -1000, 739, -1000, -1000,
-	-1000, 4670, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -136,
-	-289, 749, -1000, 887, -90, -1000, -1000, 1480, 150, 17041,
-	-1000, 407, 407, 483, 407, 407, 407, 407, 113, 106,
-	407, 407, 407, 407, 407, 407, 407, 407, 407, 407,
-	407, 407, 407, 407, 1274, -1000, -1000, 919, -1000, -1000,
-	572, 5098, -1000, -1000, 886, 625, 302, 401, 1271, -1000,
-	72, 563, 555, -1000, 15627, -1000, -40, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 883, 883, -1000, -1000, 743, -1000,
-	-1000, 1270, 1290, 38, 1268, -1000, 1267, 1263, 15627, 836,
-	1151, -1000, 1262, 4670, 11, -1000, -1000, 1009, 996, 1139,
-	1135, 786, -105, -97, -1000, 1261, -1000, -1000, 1527, 154,
-	-1000, 1526, 17104, -1000, 740, 737, 407, 407, 724, 882,
-	877, 876, 407, 407, 723, 871, 16389, 718, 717, 708,
-	830, 863, 424, 782, 741, 679, 15627, 1259, 847, -1000,
-	-1000, 848, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 702, 1258, -1000, -1000, 1257, -1000, -1000,
-	1132, -1000, 1130, 977, 11447, 26, 26, 11447, 11447, 11447,
-	1256, 239, -1000, 11447, 1466, 756, -1000, -1000, -1000, -1000,
-	695, -1000, 677, -1000, 179, -96, -97, -1000, 1523, -92,
-	1520, 1518, 15627, 627, -1000, 79, -1000, -1000, -1000, 590,
-	590, -1000, -1000, -1000, -1000, 862, 859, -1000, -1000, -1000,
+	804, -1000, -1000, -1000, 885, 609, 102, -1000, 609, 1429,
+	1415, 1381, -1000, -1000, -1000, 800, -1000, 782, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 781, -1000, -1000, 776,
+	-1000, -1000, 995, 884, -1000, 609, -1000, -1000, -1000, 6135,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -134, -297, 773,
+	-1000, 882, -89, -1000, -1000, 1668, 146, 19407, -1000, 379,
+	379, 523, 379, 379, 379, 379, 112, 110, 379, 379,
+	379, 379, 379, 379, 379, 379, 379, 379, 379, 379,
+	379, 379, 1744, 1407, -1000, -1000, 1148, -1000, -1000, 610,
+	7017, -1000, -1000, 881, 746, 322, 320, 379, 1405, -1000,
+	73, 559, 554, -1000, 17871, -1000, -40, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 869, 869, -1000, -1000, 772, -1000,
+	-1000, 1404, 1285, 31, 1403, -1000, 1402, 1399, 17871, 1056,
+	1294, -1000, 1398, 6135, 13, -1000, -1000, 1413, 4812, 6135,
+	6135, 1397, 993, 986, 1261, 1250, -1000, -1000, 1017, -109,
+	-97, -1000, 1396, -1000, -1000, 1737, 174, -1000, 1728, 19477,
+	-1000, 771, 758, 379, 379, 754, 855, 854, 852, 379,
+	379, 751, 850, 18657, 745, 737, 734, 735, 849, 409,
+	699, 688, 686, 17871, 1395, 1394, 834, -1000, -1000, 1006,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 128,
-	15627, 1128, -1000, 409, 970, 4670, -211, 11447, -1000, 850,
-	-1000, -1000, 1126, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1123, 1121, 1119, 11447, -1000, -1000, -1000, 65, 100, -1000,
-	-1000, 1466, 958, 783, 1254, 667, -87, 1516, -1000, 627,
-	1513, 627, 627, 1115, -1000, -1000, 52, 164, 145, -1000,
-	204, -1000, -1000, -1000, -1000, -1000, -1000, 125, 1113, -1000,
-	847, 831, -1000, 660, 1401, -1000, -39, 1111, -1000, -1000,
-	-1000, -1000, -1000, 1108, -1000, -1000, 407, 673, 34, -1000,
-	-1000, -1000, -1000, -1000, 1453, 9775, -106, -1000, 665, -1000,
-	627, -1000, -1000, -1000, 15627, 47, 657, 5098, 1253, 5098,
-	1252, 56, 1251, -1000, -1000, -1000, -1000, -1000, 239, -1000,
-	-1000, 1400, 1396, 1576, -1000, -1000, -1000, -1000, 100, 100,
-	100, 100, -18, 656, -1000, 954, -1000, 15627, -1000, 1101,
-	-1000, -1000, -1000, 298, -1000, -1000, -1000, -1000, 1248, 1512,
-	-1000, 1893, 15627, 1586, 15627, 1245, 393, 5098, -1000, -1000,
-	1578, -1000, 1579, 304, 304, -1000, -1000, -1000, 1061, -1000,
-	374, -1000, 11029, 15627, -1000, 148, 54, -1000, 1099, -1000,
-	1097, 15627, 653, 1551, -1000, -1000, -1000, 635, 76, -1000,
-	15627, 3386, -1000, 297, 1093, -1000, 851, 43, -1000, -1000,
-	1075, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 739, 15627,
-	-1000, 148, 1447, -1000, 638, -1000, -1000, -1000, 16991, 144,
-	-1000, -1000, 16991, 41, -1000, 142, -1000, -1000, 1069, -1000,
-	839, 1242, -1000, 41, 17104, 4670, -1000, 17104, 1041, -1000,
+	842, -1000, 706, 1391, -1000, -1000, 1379, -1000, -1000, 1242,
+	-1000, 1213, 983, 13561, 29, 29, 13561, 13561, 13561, 1369,
+	240, -1000, 13561, 1654, 918, -1000, -1000, -1000, 1208, 1205,
+	6576, -1000, -1000, -1000, 698, -1000, 690, -1000, 175, -95,
+	-97, -1000, 1727, -91, 1723, 1718, 17871, 674, -1000, 80,
+	-1000, -1000, -1000, 625, 625, -1000, -1000, -1000, -1000, 841,
+	840, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 107, 17871, 17871, 1203, -1000, 384, -1000,
+	966, 6135, -221, 13561, -1000, 838, -1000, -1000, 1200, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1192, 1171, 1161, 13561,
+	-1000, -1000, -1000, 69, 101, -1000, -1000, 1654, -1000, -1000,
+	1158, -1000, 2599, 609, 926, 755, 1352, 684, -93, 1715,
+	-1000, 674, 1706, 674, 674, 1149, -1000, -1000, 50, 179,
+	176, -1000, 221, -1000, -1000, -1000, -1000, -1000, -1000, 120,
+	1144, 1142, -1000, 834, 827, -1000, 826, 1539, -1000, -33,
+	1082, -1000, -1000, -1000, -1000, -1000, 1072, -1000, -1000, 379,
+	721, 26, -1000, -1000, -1000, -1000, 6576, -1000, -1000, -1000,
+	1611, 11837, -112, -1000, 701, -1000, 674, -1000, -1000, -1000,
+	17871, 48, 679, 7017, 1338, 7017, 1327, 53, 1318, -1000,
+	-1000, -1000, -1000, -1000, -1000, 240, -1000, -1000, 1538, 1534,
+	1765, -1000, -1000, -1000, -1000, 101, 101, 101, 101, -18,
+	659, -1000, 832, -1000, -1000, 17871, -1000, 1067, -1000, -1000,
+	-1000, 293, -1000, -1000, -1000, -1000, 1291, 1700, -1000, 1424,
+	17871, 1075, 17871, 1270, 364, 7017, -1000, -1000, 1767, -1000,
+	1771, 304, 304, -1000, -1000, -1000, 1173, -1000, 352, -1000,
+	13130, 17871, -1000, 145, 57, -1000, 1045, -1000, 1043, 17871,
+	639, 683, -1000, -1000, -1000, 677, 81, -1000, 17871, 4371,
+	-1000, 292, 1004, -1000, 880, 30, -1000, -1000, 1002, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 609, 17871, -1000, 145,
+	1606, -1000, 630, -1000, -1000, -1000, 19326, 142, -1000, -1000,
+	19326, 41, -1000, 140, -1000, -1000, 992, -1000, 835, 1267,
+	-1000, 41, 19477, 6135, -1000, 19477, 972, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 106, 1938, 1937, 104, 102, 1936, 1935, 1934, 1932,
-	1931, 1930, 1929, 1928, 1927, 1926, 1925, 1924, 1922, 1921,
-	1920, 1919, 1918, 1916, 1915, 1914, 1913, 1911, 1910, 1909,
-	1908, 1907, 1906, 100, 1905, 1903, 1887, 1886, 1885, 1882,
-	137, 1880, 1879, 1878, 1877, 1876, 1875, 1874, 1873, 1872,
-	117, 45, 99, 697, 53, 180, 1871, 116, 1870, 82,
-	145, 1867, 1865, 28, 111, 1864, 118, 115, 89, 139,
-	90, 84, 62, 1863, 1862, 1861, 132, 1860, 1859, 1857,
-	1856, 55, 1855, 74, 42, 31, 1854, 76, 1851, 1850,
-	1846, 1845, 1842, 77, 1841, 67, 60, 1840, 1839, 1838,
-	1837, 1836, 33, 1835, 48, 1834, 1830, 1829, 1828, 1825,
-	1823, 1822, 16, 18, 21, 1821, 1817, 17, 2, 1812,
-	1811, 93, 1810, 1809, 1808, 161, 1807, 1806, 1804, 144,
-	1803, 112, 1802, 1801, 1799, 1798, 9, 1796, 38, 1795,
-	1793, 1792, 43, 1791, 1790, 1789, 91, 37, 59, 86,
-	1786, 1785, 1784, 133, 20, 108, 0, 131, 39, 1783,
-	130, 123, 1782, 87, 230, 124, 44, 1781, 58, 68,
-	1780, 1779, 1778, 65, 11, 1777, 88, 1776, 15, 78,
-	1774, 97, 1773, 119, 1, 94, 1772, 136, 1771, 1770,
-	110, 1769, 1768, 47, 107, 1767, 1766, 1763, 29, 1762,
-	34, 30, 1761, 148, 147, 1760, 1755, 1738, 113, 85,
-	75, 1733, 1730, 69, 1729, 109, 70, 120, 1728, 674,
-	1727, 98, 63, 19, 1725, 140, 1724, 219, 143, 121,
-	1723, 1722, 141, 1466, 138, 1721, 127, 10, 1720, 1719,
-	12, 1718, 25, 1716, 1714, 1712, 1711, 6, 1710, 1709,
-	1708, 3, 5, 1707, 4, 96, 1706, 57, 56, 52,
-	1705, 64, 1701, 1700, 1699, 1681, 1680, 177, 1679, 1678,
-	1677, 1676, 1675, 1674, 1673, 81, 1671, 1670, 1669, 1666,
-	61, 1665, 1664, 1649, 1647, 1644, 32, 1643, 1641, 22,
-	1640, 26, 1639, 1638, 1637, 13, 1636, 1631, 14, 1630,
-	1627, 7, 8, 1624, 1623, 51, 36, 35, 72, 71,
-	1622, 23, 1621, 92, 1620, 1616, 114, 1613, 95, 1608,
-	1605, 142, 156, 1604, 135, 1603, 1601, 1598, 1595, 1593,
-	1592, 1591, 122, 1590,
-}
-
-//line mysql_sql.y:6320
+	0, 100, 2119, 2118, 107, 105, 2117, 2115, 2114, 2112,
+	2111, 2110, 2107, 2106, 2104, 2103, 2102, 2101, 2100, 2099,
+	2098, 2097, 2096, 2095, 2094, 2092, 2090, 2088, 2087, 2086,
+	2085, 2084, 2083, 2082, 103, 2081, 2079, 2078, 2077, 2076,
+	2075, 135, 2073, 2066, 2065, 2064, 2063, 2061, 2060, 2058,
+	2057, 2056, 2055, 2054, 126, 63, 32, 684, 28, 162,
+	2053, 124, 2052, 88, 152, 2051, 2050, 34, 109, 2049,
+	120, 85, 89, 148, 95, 87, 65, 2048, 2045, 2044,
+	132, 2043, 2042, 2041, 2039, 61, 2038, 77, 33, 36,
+	2033, 79, 2030, 2029, 2028, 2027, 2026, 83, 2024, 70,
+	2023, 52, 2022, 2021, 2020, 2019, 2017, 45, 2016, 49,
+	2015, 2014, 2013, 2012, 2011, 2010, 2008, 14, 18, 20,
+	2007, 2006, 16, 2, 2005, 2003, 76, 2002, 2000, 1999,
+	164, 1997, 1996, 1995, 146, 1994, 121, 1993, 1992, 1991,
+	1989, 9, 1988, 48, 1986, 1985, 1984, 51, 1983, 1982,
+	1981, 94, 41, 127, 92, 1973, 1970, 1969, 138, 21,
+	134, 0, 129, 42, 1968, 139, 131, 143, 101, 210,
+	133, 53, 1967, 50, 72, 1964, 1963, 1962, 67, 17,
+	1961, 82, 1960, 15, 84, 1959, 99, 1957, 112, 1956,
+	23, 47, 1955, 1, 96, 1954, 140, 1953, 1952, 1951,
+	117, 1949, 1948, 59, 114, 1947, 1946, 1945, 35, 1944,
+	38, 24, 1943, 136, 150, 1942, 1940, 1939, 118, 93,
+	78, 1937, 1936, 71, 1931, 113, 73, 119, 1930, 742,
+	1928, 102, 66, 19, 1906, 142, 1904, 155, 149, 128,
+	1902, 1901, 145, 1618, 144, 1893, 130, 10, 1892, 1891,
+	11, 1890, 26, 1874, 1869, 1863, 1862, 6, 1861, 1860,
+	1858, 3, 5, 1857, 4, 98, 1856, 46, 57, 62,
+	1855, 69, 1854, 1853, 1852, 1851, 1850, 235, 1848, 1847,
+	1846, 1845, 1843, 1841, 1840, 81, 1839, 1838, 1837, 1836,
+	68, 1835, 1833, 1832, 1831, 1830, 37, 1828, 1827, 22,
+	1826, 31, 1825, 1823, 1820, 12, 1816, 1815, 13, 1814,
+	1813, 7, 8, 1811, 1810, 54, 40, 39, 75, 74,
+	1803, 25, 1802, 91, 1801, 1799, 122, 1797, 97, 1795,
+	1794, 141, 161, 1792, 137, 1791, 1790, 1789, 1787, 1786,
+	1784, 1783, 125, 1782,
+}
+
+//line mysql_sql.y:6551
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3619,222 +3900,229 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 330, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 340, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 304, 304, 303, 303, 302, 302, 301, 301,
-	301, 300, 300, 300, 299, 299, 298, 298, 296, 296,
-	297, 295, 294, 294, 292, 292, 290, 290, 291, 291,
-	285, 285, 288, 288, 286, 286, 286, 286, 289, 284,
-	284, 284, 283, 283, 47, 47, 47, 222, 222, 46,
-	46, 236, 236, 236, 236, 236, 234, 234, 234, 234,
-	233, 233, 232, 232, 237, 237, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
+	1, 1, 1, 1, 52, 314, 314, 313, 313, 312,
+	312, 311, 311, 311, 310, 310, 310, 309, 309, 308,
+	308, 306, 306, 307, 305, 304, 304, 302, 302, 300,
+	300, 301, 301, 295, 295, 298, 298, 296, 296, 296,
+	296, 299, 294, 294, 294, 293, 293, 51, 51, 51,
+	232, 232, 50, 50, 246, 246, 246, 246, 246, 244,
+	244, 244, 244, 243, 243, 242, 242, 247, 247, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 42, 42, 42, 42, 47, 47, 48, 49,
+	45, 46, 240, 240, 240, 240, 240, 241, 241, 241,
+	43, 44, 44, 231, 231, 236, 236, 235, 235, 235,
 	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 41,
-	41, 41, 41, 44, 45, 230, 230, 230, 230, 230,
-	231, 231, 231, 42, 43, 43, 221, 221, 226, 226,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225, 220, 220, 229, 229, 229, 228, 228, 227, 227,
-	35, 35, 35, 38, 37, 219, 219, 219, 219, 219,
-	219, 219, 219, 36, 36, 36, 36, 36, 36, 34,
-	34, 33, 218, 218, 217, 40, 40, 40, 40, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 159, 159,
-	159, 323, 323, 324, 325, 326, 326, 326, 49, 7,
-	32, 32, 267, 267, 170, 170, 171, 171, 169, 169,
-	169, 169, 169, 169, 270, 271, 166, 21, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 31, 331, 331,
-	331, 29, 30, 266, 266, 266, 28, 27, 26, 25,
-	25, 24, 23, 23, 163, 163, 165, 165, 161, 332,
-	332, 242, 242, 164, 164, 22, 22, 162, 162, 143,
-	160, 160, 160, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 274, 274, 274, 274,
-	274, 274, 312, 312, 312, 313, 75, 75, 70, 70,
-	275, 275, 185, 314, 314, 282, 282, 281, 281, 280,
-	280, 73, 73, 74, 74, 62, 62, 50, 50, 287,
-	287, 287, 287, 293, 293, 264, 264, 109, 109, 139,
-	139, 140, 140, 51, 51, 52, 52, 52, 52, 52,
-	52, 320, 320, 322, 322, 321, 72, 72, 68, 68,
-	69, 69, 69, 67, 67, 66, 65, 65, 64, 63,
-	63, 63, 54, 54, 53, 53, 53, 53, 53, 125,
-	125, 125, 55, 268, 268, 268, 273, 273, 122, 122,
-	123, 123, 121, 121, 56, 56, 57, 57, 57, 57,
-	120, 120, 119, 58, 58, 59, 59, 61, 61, 61,
-	61, 130, 130, 129, 129, 129, 129, 78, 78, 128,
-	127, 127, 127, 77, 77, 76, 76, 71, 71, 60,
-	60, 126, 333, 333, 124, 152, 152, 152, 158, 158,
-	151, 151, 151, 157, 157, 153, 153, 154, 154, 154,
-	3, 3, 3, 16, 16, 16, 16, 20, 20, 329,
-	329, 14, 215, 215, 214, 214, 216, 216, 216, 216,
-	210, 210, 211, 211, 211, 211, 212, 212, 212, 213,
-	213, 213, 213, 209, 209, 208, 206, 206, 206, 207,
-	207, 207, 207, 207, 207, 155, 155, 15, 203, 203,
-	204, 204, 204, 205, 205, 197, 197, 197, 197, 19,
-	201, 201, 202, 202, 202, 202, 202, 198, 198, 200,
-	200, 196, 196, 196, 196, 196, 18, 195, 195, 193,
-	193, 191, 191, 192, 192, 190, 190, 190, 194, 194,
-	17, 269, 269, 238, 238, 241, 241, 248, 248, 249,
-	249, 247, 247, 254, 254, 253, 253, 252, 252, 251,
-	251, 250, 250, 245, 245, 244, 244, 239, 239, 239,
-	239, 239, 240, 240, 243, 243, 246, 246, 100, 100,
-	101, 101, 101, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 310, 310, 311, 103, 103, 103, 107, 107,
-	107, 107, 107, 107, 102, 102, 102, 104, 104, 104,
-	85, 85, 84, 84, 79, 79, 80, 80, 81, 81,
-	82, 82, 83, 83, 83, 83, 83, 83, 224, 224,
-	308, 308, 309, 309, 305, 305, 305, 307, 307, 307,
-	307, 307, 306, 306, 86, 137, 137, 137, 156, 156,
-	156, 136, 136, 136, 99, 99, 98, 98, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
-	96, 223, 223, 167, 167, 168, 168, 117, 115, 115,
-	116, 116, 116, 116, 113, 114, 112, 112, 112, 112,
-	112, 111, 111, 110, 110, 110, 199, 199, 108, 108,
-	106, 106, 106, 105, 105, 105, 255, 174, 174, 174,
-	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
-	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
-	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
-	177, 177, 182, 182, 319, 319, 318, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 95, 95, 95, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 279, 279, 279, 132, 132, 132,
-	132, 132, 132, 315, 315, 316, 316, 316, 316, 316,
-	316, 316, 316, 316, 316, 316, 316, 317, 317, 317,
-	317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
-	317, 317, 317, 317, 134, 134, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 186, 186,
-	187, 187, 276, 276, 276, 276, 276, 276, 277, 277,
-	278, 278, 278, 278, 272, 272, 272, 272, 272, 272,
-	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
-	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
-	272, 272, 175, 175, 131, 131, 131, 188, 183, 183,
-	184, 184, 178, 178, 178, 178, 178, 180, 180, 180,
-	180, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	179, 179, 181, 181, 189, 189, 189, 189, 189, 189,
-	97, 97, 97, 97, 256, 172, 172, 172, 172, 172,
-	172, 172, 88, 88, 88, 88, 92, 92, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 94, 93, 93, 93, 93, 91, 91, 91, 91,
-	91, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 89, 90, 138, 138, 257,
-	257, 260, 260, 258, 258, 259, 261, 261, 261, 262,
-	262, 262, 263, 263, 263, 265, 265, 142, 142, 142,
-	148, 148, 141, 141, 149, 149, 150, 150, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 145,
-	145, 145, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 327, 327,
-	327, 328, 328,
+	230, 230, 239, 239, 239, 238, 238, 237, 237, 36,
+	36, 36, 39, 38, 229, 229, 229, 229, 229, 229,
+	229, 229, 37, 37, 37, 37, 37, 37, 35, 35,
+	34, 228, 228, 227, 41, 41, 41, 41, 40, 40,
+	40, 40, 40, 40, 40, 40, 40, 164, 164, 164,
+	333, 333, 334, 335, 336, 336, 336, 53, 7, 33,
+	33, 277, 277, 175, 175, 176, 176, 174, 174, 174,
+	174, 174, 174, 280, 281, 171, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 32, 341, 341, 341,
+	30, 31, 276, 276, 276, 29, 28, 27, 26, 26,
+	25, 24, 24, 168, 168, 170, 170, 166, 342, 342,
+	252, 252, 169, 169, 23, 23, 23, 167, 167, 148,
+	165, 165, 165, 6, 8, 8, 8, 8, 8, 8,
+	14, 13, 11, 10, 12, 9, 5, 4, 284, 284,
+	284, 284, 284, 284, 322, 322, 322, 323, 79, 79,
+	74, 74, 285, 285, 194, 324, 324, 292, 292, 291,
+	291, 290, 290, 77, 77, 78, 78, 66, 66, 54,
+	54, 297, 297, 297, 297, 303, 303, 274, 274, 114,
+	114, 144, 144, 145, 145, 55, 55, 56, 56, 56,
+	56, 56, 56, 330, 330, 332, 332, 331, 76, 76,
+	72, 72, 73, 73, 73, 71, 71, 70, 69, 69,
+	68, 67, 67, 67, 58, 58, 57, 57, 57, 57,
+	57, 130, 130, 130, 59, 278, 278, 278, 283, 283,
+	127, 127, 128, 128, 128, 189, 189, 191, 191, 191,
+	191, 192, 192, 190, 190, 126, 126, 60, 60, 61,
+	61, 61, 61, 125, 125, 124, 62, 62, 63, 63,
+	65, 65, 65, 65, 135, 135, 134, 134, 134, 134,
+	82, 82, 133, 132, 132, 132, 81, 81, 80, 80,
+	75, 75, 64, 64, 131, 343, 343, 129, 157, 157,
+	157, 163, 163, 156, 156, 156, 162, 162, 158, 158,
+	159, 159, 159, 3, 3, 3, 17, 17, 17, 17,
+	21, 21, 339, 339, 15, 225, 225, 224, 224, 226,
+	226, 226, 226, 220, 220, 221, 221, 221, 221, 222,
+	222, 222, 223, 223, 223, 223, 219, 219, 218, 216,
+	216, 216, 217, 217, 217, 217, 217, 217, 160, 160,
+	16, 213, 213, 214, 214, 214, 215, 215, 207, 207,
+	207, 207, 20, 211, 211, 212, 212, 212, 212, 212,
+	208, 208, 210, 210, 206, 206, 206, 206, 206, 19,
+	205, 205, 203, 203, 201, 201, 202, 202, 200, 200,
+	200, 204, 204, 18, 279, 279, 248, 248, 251, 251,
+	258, 258, 259, 259, 257, 257, 264, 264, 263, 263,
+	262, 262, 261, 261, 260, 260, 255, 255, 254, 254,
+	249, 249, 249, 249, 249, 250, 250, 253, 253, 256,
+	256, 105, 105, 106, 106, 106, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 320, 320, 321, 108,
+	108, 108, 112, 112, 112, 112, 112, 112, 107, 107,
+	107, 109, 109, 109, 89, 89, 88, 88, 83, 83,
+	84, 84, 85, 85, 86, 86, 87, 87, 87, 87,
+	87, 87, 234, 234, 318, 318, 319, 319, 315, 315,
+	315, 317, 317, 317, 317, 317, 316, 316, 90, 142,
+	142, 142, 161, 161, 161, 141, 141, 141, 104, 104,
+	103, 103, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 233, 233, 172, 172,
+	173, 173, 122, 120, 120, 121, 121, 121, 121, 118,
+	119, 117, 117, 117, 117, 117, 116, 116, 115, 115,
+	115, 209, 209, 113, 113, 111, 111, 111, 110, 110,
+	110, 265, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 182, 182, 187,
+	187, 329, 329, 328, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 99, 99, 99, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 289, 289, 289, 137, 137, 137,
+	137, 137, 137, 325, 325, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 327, 327, 327,
+	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 327, 139, 139, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 195, 195,
+	196, 196, 286, 286, 286, 286, 286, 286, 287, 287,
+	288, 288, 288, 288, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 180, 180, 100, 100, 136, 136, 136, 197,
+	188, 188, 193, 193, 183, 183, 183, 183, 183, 185,
+	185, 185, 185, 185, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 184, 184, 186, 186, 198, 198, 198,
+	198, 198, 198, 199, 199, 199, 102, 102, 102, 102,
+	266, 177, 177, 177, 177, 177, 177, 177, 92, 92,
+	92, 92, 96, 96, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 97, 97,
+	97, 97, 95, 95, 95, 95, 95, 93, 93, 93,
+	93, 93, 93, 93, 93, 93, 93, 93, 93, 93,
+	93, 93, 94, 143, 143, 267, 267, 270, 270, 268,
+	268, 269, 271, 271, 271, 272, 272, 272, 273, 273,
+	273, 275, 275, 147, 147, 147, 153, 153, 146, 146,
+	154, 154, 155, 155, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 150, 150, 150, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 337, 337, 337, 338, 338,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
-	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 8, 11, 0,
-	1, 6, 0, 2, 1, 2, 2, 2, 2, 2,
-	0, 1, 2, 2, 2, 2, 1, 3, 2, 2,
-	2, 2, 2, 1, 3, 2, 1, 3, 2, 0,
-	3, 3, 5, 5, 4, 1, 1, 4, 1, 3,
-	1, 3, 2, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 5, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	10, 0, 1, 0, 6, 0, 4, 0, 3, 1,
-	3, 4, 5, 0, 3, 1, 3, 2, 3, 1,
-	2, 0, 6, 0, 2, 0, 2, 4, 5, 4,
-	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
-	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
-	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	5, 4, 1, 3, 3, 0, 2, 2, 1, 1,
+	1, 1, 1, 0, 14, 0, 2, 1, 3, 3,
+	3, 1, 3, 5, 0, 2, 3, 1, 3, 1,
+	1, 1, 1, 1, 1, 0, 3, 0, 3, 0,
+	3, 0, 3, 0, 2, 1, 2, 3, 4, 3,
+	3, 1, 0, 1, 1, 0, 1, 9, 4, 7,
+	0, 3, 7, 4, 1, 3, 3, 3, 1, 0,
+	1, 1, 1, 1, 3, 1, 4, 1, 3, 1,
+	2, 1, 1, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 2, 1, 2, 2, 1,
+	1, 1, 3, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 2, 4, 4, 3,
+	3, 6, 3, 1, 1, 1, 1, 1, 1, 1,
+	2, 4, 6, 1, 4, 1, 3, 3, 4, 4,
+	4, 4, 4, 3, 2, 4, 4, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 2, 0, 4, 2, 4, 1, 5,
+	3, 2, 1, 2, 2, 4, 4, 5, 2, 1,
+	7, 1, 3, 3, 1, 1, 1, 1, 2, 3,
+	4, 7, 2, 3, 3, 4, 5, 1, 1, 1,
+	1, 3, 2, 1, 1, 1, 1, 6, 1, 7,
+	9, 0, 2, 0, 1, 1, 2, 2, 2, 1,
+	4, 2, 2, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 5, 1, 1, 1,
+	5, 5, 0, 1, 1, 2, 2, 3, 6, 7,
+	4, 7, 8, 0, 2, 0, 2, 2, 1, 1,
+	1, 1, 0, 1, 4, 4, 5, 1, 3, 1,
+	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
+	4, 4, 6, 4, 4, 4, 6, 4, 2, 1,
+	5, 4, 4, 2, 0, 1, 3, 3, 1, 3,
+	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
+	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
+	8, 0, 4, 7, 4, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
+	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
+	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
+	2, 0, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 1, 2, 2, 7, 0, 1, 1, 1, 1,
+	0, 2, 0, 3, 5, 1, 3, 1, 4, 4,
+	5, 1, 3, 2, 1, 0, 2, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 3, 1, 1,
+	4, 4, 4, 3, 2, 2, 2, 3, 2, 3,
+	0, 2, 1, 1, 2, 2, 0, 1, 2, 4,
+	1, 3, 1, 4, 3, 0, 1, 2, 0, 1,
+	2, 1, 1, 0, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 3, 5, 0, 1, 1, 2, 1, 2,
-	2, 1, 1, 2, 2, 2, 2, 2, 1, 5,
-	6, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
-	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 5, 2, 2, 2, 2, 1,
-	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
+	8, 11, 0, 1, 6, 0, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 2, 2, 2, 2, 1,
+	3, 2, 2, 2, 2, 2, 1, 3, 2, 1,
+	3, 2, 0, 3, 3, 5, 5, 4, 1, 1,
+	4, 1, 3, 1, 3, 2, 1, 1, 0, 1,
+	1, 1, 11, 0, 2, 3, 2, 3, 1, 1,
+	1, 3, 3, 4, 0, 2, 2, 2, 2, 5,
+	1, 1, 0, 3, 0, 1, 1, 2, 4, 4,
+	4, 0, 1, 10, 0, 1, 0, 6, 0, 4,
+	0, 3, 1, 3, 4, 5, 0, 3, 1, 3,
+	2, 3, 1, 2, 0, 6, 0, 2, 0, 2,
+	4, 5, 4, 5, 1, 6, 5, 0, 3, 0,
+	1, 0, 1, 1, 3, 2, 3, 3, 4, 4,
+	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 4, 5, 5, 4, 1, 3, 3, 0,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 0, 1,
+	1, 3, 1, 1, 2, 1, 7, 7, 7, 7,
+	8, 5, 0, 1, 0, 1, 1, 1, 1, 3,
+	3, 1, 1, 1, 1, 1, 0, 1, 3, 1,
+	3, 5, 1, 1, 1, 1, 3, 5, 0, 1,
+	1, 2, 1, 2, 2, 1, 1, 2, 2, 2,
+	2, 3, 2, 1, 5, 6, 1, 2, 0, 1,
+	1, 2, 5, 0, 1, 1, 1, 2, 2, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 2, 2,
+	2, 0, 3, 0, 3, 1, 1, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 1, 1, 3, 3, 1, 1,
+	3, 5, 2, 2, 2, 2, 1, 1, 2, 5,
+	6, 6, 6, 1, 1, 1, 1, 0, 2, 0,
+	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 1, 5, 4, 4, 7,
 	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
 	5, 5, 5, 5, 1, 1, 1, 4, 4, 6,
 	8, 6, 4, 1, 1, 1, 1, 1, 1, 1,
@@ -3846,19 +4134,21 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 3, 0, 1, 1, 3, 0, 1,
-	1, 3, 3, 3, 3, 2, 1, 3, 4, 3,
-	1, 3, 4, 4, 5, 3, 4, 5, 6, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 2, 3, 0, 2, 0, 1, 1, 3,
+	0, 1, 1, 3, 3, 3, 3, 2, 1, 3,
+	4, 3, 4, 1, 3, 4, 4, 5, 3, 4,
+	5, 6, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	2, 2, 1, 2, 2, 2, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 4, 1, 1, 3, 0, 1, 0, 3, 0,
+	3, 3, 0, 3, 5, 0, 3, 5, 0, 1,
+	1, 0, 1, 1, 2, 2, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 4, 4, 1, 1, 3, 0,
-	1, 0, 3, 0, 3, 3, 0, 3, 5, 0,
-	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3893,451 +4183,470 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -330, -2, -1, -3, -4, -5, -6, -39, -21,
-	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -159, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 181, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 283, 289,
-	326, -52, -54, -17, -18, -19, -20, 177, -9, -10,
-	-11, -12, -13, 199, 198, 26, 197, 178, 120, 121,
-	123, 124, 30, -53, -320, 54, 179, -55, 397, 6,
-	442, -62, 27, -84, -156, 57, -144, -147, 400, 401,
-	402, 403, 404, 405, 406, 407, 408, 409, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 394, 216, 240, 243, 250, 120,
-	137, 131, 158, 150, 151, 128, 220, 221, 64, 123,
-	354, 340, 325, 126, 235, 237, 239, 242, 222, 146,
-	142, 234, 218, 140, 223, 28, 224, 163, 225, 226,
-	399, 336, 265, 358, 342, 247, 141, 337, 238, 339,
-	164, 168, 346, 289, 135, 136, 344, 348, 162, 196,
-	32, 396, 34, 208, 349, 166, 161, 157, 357, 251,
-	249, 160, 134, 156, 219, 38, 170, 169, 171, 319,
-	320, 321, 322, 227, 228, 277, 345, 153, 143, 269,
-	129, 18, 352, 203, 341, 290, 229, 244, 206, 252,
-	165, 167, 210, 215, 398, 248, 350, 139, 207, 236,
-	202, 353, 130, 283, 292, 230, 124, 241, 347, 356,
-	37, 305, 133, 127, 193, 121, 212, 217, 231, 232,
-	233, 254, 253, 245, 154, 209, 159, 132, 155, 122,
-	211, 355, 306, 441, 267, 308, 152, 149, 213, 186,
-	351, 343, 125, 312, 307, 147, 255, 438, 439, 440,
-	278, 11, -160, 19, 323, -40, 324, 181, 54, -156,
-	-5, -4, -33, -51, 184, -59, -60, -61, -124, -126,
-	-84, 54, -156, -233, -203, -232, -204, -235, -205, -155,
-	20, 178, 177, 211, 10, 179, 287, 185, 8, 6,
-	288, 197, 9, 289, 291, 292, 295, 296, 297, 31,
-	300, 301, 57, 60, -156, -233, -203, 215, 222, -66,
-	-67, -125, 15, 5, -269, 308, 214, -197, -195, 298,
-	194, 193, 76, 360, 183, -331, -266, 343, 342, -164,
-	341, 334, 336, 177, 185, 344, 32, 346, 347, 337,
-	308, 125, 122, -219, 80, 130, 129, -219, 214, 29,
-	-226, 318, -225, -227, 346, 347, 357, -220, 345, -142,
-	-156, 58, 59, 75, 151, 148, -67, -125, -66, -53,
-	-54, -52, -54, 308, 214, 185, 184, 360, -322, 392,
-	-321, -156, -268, 20, -273, 21, 22, -1, -73, 206,
-	-84, 119, -59, -136, -156, 325, 89, -40, -40, 324,
-	-323, -324, -325, -327, 181, 324, 323, 119, -84, 30,
-	-127, -128, -129, -130, 41, 45, 47, 42, 43, 44,
-	48, -333, 23, -152, -158, 23, -153, 60, -154, -147,
-	57, 58, 59, -52, -54, 51, 55, 11, 55, 54,
-	443, 58, 285, 299, 308, 286, 298, 186, 214, 299,
-	214, 334, 186, 290, 293, 294, 335, 51, 187, 51,
-	-283, 357, -50, 27, -69, 17, -55, -54, 16, 20,
-	21, -329, 184, 392, -193, 189, -193, 185, -193, -332,
-	11, 99, 213, 212, 338, 335, -242, 339, 340, -164,
-	-163, 97, -164, 184, 360, -267, 189, 350, 397, 128,
-	129, 130, -230, 20, 29, 317, -203, 214, 55, 89,
-	19, -228, 89, 100, -227, -227, -227, -228, -102, 29,
-	-154, 60, 116, -102, 29, 119, 30, 30, -68, -69,
-	-55, -54, -67, -66, -67, 56, 56, -267, -267, -267,
-	-267, -267, 55, -322, -72, 54, -56, -57, 107, -178,
-	-156, 81, -180, 57, -173, 401, 402, 403, 404, 405,
-	406, 407, 409, 410, 411, 412, 414, 416, 417, 420,
-	421, 422, 423, 425, 426, 427, 428, 433, 434, 435,
-	277, 308, 147, 278, -174, -176, -301, -296, -172, 54,
-	105, 106, 113, 82, -175, -255, 24, 84, 368, -132,
-	-133, -134, -135, -297, -295, 60, 65, 69, 71, 72,
-	70, 67, 118, -54, -315, -145, -272, -278, -276, 148,
-	200, 144, 145, 8, 111, 318, 116, -279, 59, 58,
-	271, 75, 272, 273, 360, 268, 274, 189, 323, 43,
-	275, 276, 279, 367, 280, 44, 281, 270, 204, 282,
-	371, 370, 372, 364, 361, 359, 362, 363, 365, 366,
-	-274, 33, -51, 54, 30, 54, -156, -121, 12, 119,
-	65, 60, -40, 56, 55, -326, 71, 72, -328, 162,
-	154, -156, 54, -218, -217, -136, -60, -60, -60, -60,
-	41, 41, 41, 46, 41, 46, 41, -129, -156, -158,
-	56, -234, 184, 284, 210, -232, 211, 289, 292, -209,
-	-208, -206, -155, 60, -204, -237, -136, -155, 335, -234,
-	-209, -208, 327, 437, -50, -178, -156, -65, -64, -178,
-	186, -193, -209, 81, -203, -154, -156, -84, -163, -163,
-	-165, -332, -161, -332, 335, -121, -176, -242, -162, -156,
-	-193, -209, 308, 24, 351, 352, 126, 129, 128, 358,
-	-231, 317, 20, -203, -225, -221, 60, 318, -208, -229,
-	51, 116, -280, -178, 29, -228, -228, -228, -229, 115,
-	-156, -50, -68, -50, -69, -209, -203, -156, -85, -84,
-	-157, -154, -147, -321, 23, -71, -156, -120, 55, -119,
-	11, -151, 80, 78, 79, -156, 23, 119, -178, 96,
-	-189, 89, 90, 91, 92, 93, 94, 54, 54, 54,
-	54, 54, 54, 54, 54, -187, 54, 54, 54, 54,
-	54, 54, -187, 54, 54, 54, 102, 101, 112, 105,
-	106, 107, 108, 109, 110, 111, 103, 104, 99, 81,
-	97, 98, 83, -54, -178, -184, -176, -176, -176, -176,
-	-255, -182, -178, 54, 60, 65, 54, 54, 54, -277,
-	54, -186, -187, 54, 60, 60, 60, 54, 54, 54,
-	-176, 54, -275, -185, -314, 436, -75, 56, -70, -156,
-	-312, -313, -70, -74, -156, -67, -178, -149, -150, -141,
-	-146, -153, -154, -147, 266, 182, 20, 80, 23, 25,
-	271, 303, 83, 116, 16, 84, 148, 115, 273, 368,
-	272, 177, 47, 75, 370, 372, 371, 361, 359, 310,
-	314, 316, 313, 360, 334, 29, 10, 26, 198, 21,
-	22, 109, 179, 200, 87, 88, 201, 24, 199, 72,
-	19, 50, 11, 323, 13, 14, 274, 309, 189, 188,
-	99, 327, 185, 45, 8, 118, 27, 96, 311, 41,
-	77, 43, 97, 17, 362, 363, 31, 326, 393, 205,
-	111, 275, 276, 48, 81, 317, 70, 51, 78, 15,
-	46, 98, 180, 367, 44, 214, 315, 279, 281, 392,
-	280, 183, 6, 270, 369, 30, 197, 42, 184, 335,
-	86, 187, 71, 204, 144, 145, 5, 76, 9, 49,
-	52, 364, 365, 366, 33, 85, 12, 282, 397, 318,
-	328, 329, 330, 331, 332, 333, 172, 173, 174, 175,
-	176, 246, 192, 190, 194, 195, 436, 437, 19, -40,
-	-324, 119, -71, -121, 55, 89, -77, -76, 51, 52,
-	-78, 51, -76, 41, 41, -72, -236, 107, 57, 55,
-	-207, 309, 443, 58, 56, 55, -236, 187, 60, 60,
-	55, 18, 119, 55, -63, 25, 26, -84, 189, -84,
-	-210, -211, 315, 24, -196, 52, -191, -192, -190, -194,
-	29, -121, -121, -121, -163, -157, -165, -160, -165, -161,
-	119, -143, -156, -210, 54, 127, 130, 130, 129, -203,
-	187, 54, 89, -229, -229, -229, 29, -155, -50, -50,
-	51, 55, 54, 56, 55, -121, -57, -58, -59, -178,
-	-178, -178, -156, -156, 107, 70, 81, -173, -183, -184,
-	-178, -131, 21, 20, -131, -131, -178, -131, 107, -184,
-	-184, 56, -256, 65, -316, -317, 373, 374, 375, 376,
-	377, 378, 379, 380, 381, 382, 383, 275, 270, 276,
-	274, 268, 282, 277, 278, 147, 390, 391, 384, 385,
-	386, 387, 388, 389, -131, -131, -131, -131, -131, -131,
-	-131, -174, -174, -174, -174, -174, -174, -174, -174, -174,
-	-174, -174, -174, -181, -188, -255, 54, 99, 97, 98,
-	83, -176, -174, -174, 56, 55, -319, -318, 85, -178,
-	-316, -183, -178, -183, -183, 56, -184, -183, -174, -183,
-	-131, 55, 54, 56, 55, 33, 119, 55, 89, 56,
-	55, -68, 119, 325, -156, 56, -67, -217, -178, -178,
-	54, -178, 11, 119, 119, -208, 16, 397, -155, -136,
-	187, -209, -284, 188, 367, -287, 339, -178, -178, -156,
-	-64, -72, 81, 54, -215, 397, 317, 316, 312, -212,
-	-213, 311, 313, 310, 314, 51, 260, 261, 262, 263,
-	-190, -142, 115, 225, 151, -121, -163, -163, -165, -156,
-	-215, 56, 130, -209, -166, 60, -221, -84, -84, -1,
-	-156, -123, 13, 55, 119, 70, 56, 55, -178, -178,
-	-178, 23, -184, 56, 56, 56, 56, 11, -178, -178,
-	-178, -178, -178, -178, -178, -184, -181, -176, -174, -174,
-	-179, 201, 80, -178, -177, -318, 87, -178, 55, 52,
-	56, 11, 56, 56, 56, 52, 56, 55, 56, -178,
-	-185, -282, -281, -280, 33, -51, -70, -275, -156, -313,
-	-280, -156, -149, -146, -154, -147, 65, -68, -71, -209,
-	107, 107, 57, -155, 318, -155, -209, -222, 397, 27,
-	-293, 333, 328, 330, 119, 23, 24, -79, -80, -81,
-	-86, -82, -136, -168, -83, 192, 190, 194, -309, 76,
-	195, 246, 77, 185, -214, -216, 319, 320, 321, 322,
-	80, -213, 60, 60, 60, 60, -84, -148, 89, -148,
-	-148, -121, -121, -163, -170, -171, -169, 266, -270, 318,
-	309, 56, 56, -122, 14, 16, -59, -156, 107, -178,
-	56, 56, 56, -87, -93, 116, 148, 200, 147, 146,
-	144, 305, 306, 140, 141, 142, 139, 56, -178, 56,
-	56, 56, 56, 56, 56, 56, 56, -179, 80, -176,
-	-173, 56, 88, -178, 86, -87, -102, -178, -102, -174,
-	56, 56, 55, -275, 56, -155, 16, 23, -210, 289,
-	184, -264, 438, -291, 328, 16, 16, -51, -84, 56,
-	55, -88, -92, -89, -91, -90, -94, -93, 148, 149,
-	116, 152, 154, 155, 156, 157, 158, 159, 160, 161,
-	162, 163, 30, 200, 144, 145, 146, 147, 164, 131,
-	150, 395, 172, 132, 173, 133, 174, 134, 175, 135,
-	136, 176, 137, -83, -156, 77, -308, -309, -193, -308,
-	77, 54, -216, 65, 65, 65, 65, -213, 54, -102,
-	-104, -154, 60, 116, 60, -121, -169, 267, 31, 118,
-	269, 29, 265, 16, -178, -184, 56, -257, -259, 54,
-	-258, 54, -257, -257, -257, -95, 136, 135, -95, -261,
-	54, -261, -262, 54, -261, 56, -173, -178, 56, 56,
-	56, 19, 56, 56, -280, -155, -155, -222, 290, -84,
-	-109, 439, 60, 16, 60, -289, 60, -72, -100, -101,
-	-118, 303, 216, -194, 220, 64, 221, 325, 222, 185,
-	224, 225, 226, 196, 227, 228, 229, 318, 230, 231,
-	232, 233, 286, 5, 256, -81, -99, -98, -96, 70,
-	81, 29, 303, -97, 64, 115, 239, 217, 240, -117,
-	-167, 190, 76, 77, 291, -168, -263, 306, 305, -257,
-	-258, -259, -257, -257, 54, 54, -257, -260, 54, -257,
-	-257, -305, -306, -156, -306, -156, -305, -305, -193, -178,
-	-198, -200, -136, 54, 65, -271, -166, 65, 65, 65,
-	65, -178, -285, -242, -139, 440, 65, 60, 330, 23,
-	-238, 206, 55, -118, -148, -148, -142, 115, -148, -148,
-	-148, -148, 223, 223, -148, -148, -148, -148, -148, -148,
-	-148, -148, -148, -148, -148, -148, -148, -148, 54, -96,
-	70, -174, 60, -104, -105, 29, 238, 234, -106, 29,
-	218, 219, -108, 54, 246, 77, 77, -84, -265, 307,
-	-138, 60, -138, 65, 54, 52, 255, 54, 54, 54,
-	-306, 56, 56, 55, -257, -178, 268, 56, 56, 56,
-	55, 56, 55, 56, -292, 333, -288, -286, 328, 329,
-	330, 331, 54, 16, -51, 16, -118, 65, 65, -148,
-	-148, 65, 60, 60, 60, -148, -148, 65, 60, -158,
-	65, 65, 65, 65, 29, 60, -107, 29, 234, 238,
-	235, 236, 237, 65, 29, 65, 29, 65, 29, -156,
-	54, -310, -311, 60, 65, 54, -199, 54, 56, 55,
-	56, 56, -198, -307, 260, 261, 262, 264, 263, -307,
-	-198, -198, -198, 54, -224, -223, 247, 81, -201, -200,
-	-63, 56, 65, 65, -294, 188, -290, 332, -286, 16,
-	330, 16, 16, -140, -156, -289, -239, 248, 249, -240,
-	-246, 251, -102, -102, 60, 60, -103, 217, -85, 56,
-	55, 89, 56, -178, -111, -110, 393, -198, 60, 56,
-	56, 56, 56, -198, 247, -202, 196, 64, 397, 258,
-	259, -63, 56, 56, -300, 54, 65, -291, 16, -289,
-	16, -289, -289, 56, 55, -244, 252, 54, -242, 54,
-	-242, 77, 261, 218, 219, 56, -311, 60, 56, -115,
-	-116, -113, -114, 51, 337, 244, 245, 56, -201, -201,
-	-201, -201, 56, -148, 60, 257, -304, 30, 56, -299,
-	-298, -137, -295, -156, 333, 60, -289, -156, -241, 253,
-	65, -174, 54, -174, 54, -243, 250, 54, -223, -114,
-	51, -113, 51, 10, 9, -117, 65, -154, -303, -302,
-	-301, 56, 55, 119, -248, 54, 16, 56, -237, 56,
-	-237, 54, 89, -174, -112, 241, 242, 30, 129, -112,
-	55, 89, -298, -156, -249, -247, 206, -240, 56, 56,
-	-237, 65, 56, 70, 29, 243, -302, 29, -178, 119,
-	56, 55, 57, -245, 254, 56, -156, -247, -250, 33,
-	65, -254, -251, 54, -118, 208, -254, -118, -253, -252,
-	253, 209, 56, 55, 57, 54, -252, -251, -184, 56,
+	-1000, -340, -2, -1, -3, -4, -5, -6, -40, -22,
+	-7, -53, -34, -35, -36, -42, -47, -48, -49, -50,
+	-51, -52, -55, -17, -16, -15, 8, 10, -8, -164,
+	-23, -24, -25, -26, -27, -28, -29, -30, -31, -32,
+	-33, 189, 9, 55, -37, -38, -39, -43, -44, -45,
+	-46, 370, 371, 245, 292, 298, 335, -56, -58, -18,
+	-19, -20, -21, 185, -9, -10, -12, -11, -13, -14,
+	207, 206, 26, 205, 186, 128, 129, 131, 132, 30,
+	-57, -330, 60, 187, -59, 410, 6, 455, -66, 27,
+	-88, -161, 63, -149, -152, 413, 414, 415, 416, 417,
+	418, 419, 420, 421, 422, 423, 424, 425, 426, 427,
+	428, 429, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 439, 440, 441, 442, 443, 444, 445, 446, 447,
+	448, 407, 368, 369, 370, 371, 39, 42, 224, 248,
+	252, 259, 128, 145, 139, 166, 158, 159, 136, 228,
+	229, 70, 131, 363, 349, 334, 134, 243, 245, 249,
+	247, 251, 230, 154, 150, 242, 226, 148, 231, 28,
+	232, 171, 233, 234, 412, 345, 274, 367, 351, 256,
+	149, 346, 246, 348, 172, 176, 355, 298, 143, 144,
+	353, 357, 170, 204, 32, 409, 34, 216, 358, 174,
+	169, 165, 366, 260, 258, 168, 142, 164, 227, 38,
+	178, 177, 179, 328, 329, 330, 331, 235, 236, 286,
+	354, 161, 151, 278, 137, 18, 361, 211, 350, 299,
+	237, 253, 214, 261, 173, 175, 218, 223, 411, 257,
+	359, 147, 215, 244, 210, 362, 138, 292, 301, 238,
+	132, 250, 356, 365, 37, 314, 141, 135, 201, 129,
+	220, 225, 239, 240, 241, 263, 262, 254, 162, 217,
+	167, 140, 163, 130, 219, 364, 315, 454, 276, 317,
+	160, 157, 221, 194, 360, 352, 133, 321, 316, 155,
+	264, 451, 452, 453, 287, 11, -165, 19, 332, -41,
+	333, 189, 60, -161, -5, -4, -34, -55, 192, -63,
+	-64, -65, -129, -131, -88, 60, -161, 368, 373, 192,
+	-243, -213, -242, -214, -245, -215, -160, 20, 186, 185,
+	219, 10, 187, 296, 193, 8, 6, 297, 205, 9,
+	298, 300, 301, 304, 305, 306, 31, 309, 310, 63,
+	66, -161, -243, -213, 223, 230, -70, -71, -130, 15,
+	5, -279, 317, 222, -207, -205, 307, 202, 201, 82,
+	373, 191, -341, -276, 352, 351, -169, 350, 343, 345,
+	185, 193, 353, 32, 355, 356, 346, 317, 133, 130,
+	-229, 86, 138, 137, -229, 222, 29, -236, 327, -235,
+	-237, 355, 356, 366, 368, 369, -230, 354, -147, -161,
+	64, 65, 81, 159, 156, -71, -130, -70, -57, -58,
+	-56, -58, 317, 222, 193, 192, 194, 373, -332, 405,
+	-331, -161, -278, 20, -283, 21, 22, -1, -77, 214,
+	-88, 127, -63, -141, -161, 334, 95, -41, -41, 333,
+	-333, -334, -335, -337, 189, 333, 332, 127, -88, 30,
+	-132, -133, -134, -135, 47, 51, 53, 48, 49, 50,
+	54, -343, 23, -157, -163, 23, -158, 66, -159, -152,
+	63, 64, 65, -56, -58, -277, 197, 195, -88, 57,
+	61, 11, 61, 60, 456, 64, 294, 308, 317, 295,
+	307, 194, 222, 308, 222, 343, 194, 299, 302, 303,
+	344, 57, 195, 57, -293, 366, -54, 27, -73, 17,
+	-59, -58, 16, 20, 21, -339, 192, 405, -203, 197,
+	-203, 193, -203, -342, 11, 105, 221, 220, 347, 344,
+	-252, 348, 349, -169, -168, 103, -169, 192, 194, 373,
+	-277, 359, 410, 136, 137, 138, -240, 20, 29, 326,
+	-213, 222, 61, 95, 19, -238, 95, 106, -237, -237,
+	-237, -237, -237, -238, -107, 29, -159, 66, 124, -107,
+	29, 127, 30, 30, -72, -73, -59, -58, -71, -70,
+	-71, 62, 62, -277, -277, -277, -277, -277, -277, 61,
+	-332, -76, 60, -60, -61, 115, -183, -161, 87, -185,
+	63, -178, 414, 415, 416, 417, 418, 419, 420, 422,
+	423, 424, 425, 426, 427, 429, 430, 433, 434, 435,
+	436, 438, 439, 440, 441, 446, 447, 448, 38, 286,
+	317, 155, 287, -179, -181, -311, -306, -177, 60, 113,
+	114, 121, 88, -180, -265, 24, 90, 381, -137, -138,
+	-139, -140, -307, -305, 66, 71, 75, 77, 78, 76,
+	73, 126, -58, -325, -150, -282, -288, -286, 156, 208,
+	152, 153, 8, 119, 327, 124, 41, -289, 65, 64,
+	280, 81, 281, 282, 373, 277, 283, 197, 332, 49,
+	284, 285, 288, 380, 289, 50, 290, 279, 212, 291,
+	384, 383, 385, 377, 374, 372, 375, 376, 378, 379,
+	-284, 33, -55, 60, 30, 60, -161, -126, 12, 127,
+	71, 66, -41, 62, 61, -336, 77, 78, -338, 170,
+	162, -161, 60, -228, -227, -141, -64, -64, -64, -64,
+	47, 47, 47, 52, 47, 52, 47, -134, -161, -163,
+	62, -237, 24, 66, -244, 192, 293, 218, -242, 219,
+	298, 301, -219, -218, -216, -160, 66, -214, -247, -141,
+	-160, 344, -244, -219, -218, 336, 450, -54, -183, -161,
+	-69, -68, -183, 194, -203, -219, 87, -213, -159, -161,
+	-88, -168, -168, -170, -342, -166, -342, 344, -126, -181,
+	-252, -167, -161, -167, -203, -219, 317, 360, 361, 134,
+	137, 136, 367, -241, 326, 20, -213, -235, -231, 66,
+	327, -218, -239, 57, 124, -290, -183, 29, -238, -238,
+	-238, -238, -238, -239, 123, -161, -54, -72, -54, -73,
+	-219, -213, -161, -89, -88, -89, -162, -159, -152, -331,
+	23, -75, -161, -125, 61, -124, 11, -156, 86, 84,
+	85, -161, 23, 127, -183, 102, -198, 95, 96, 97,
+	98, 99, 100, 60, 60, 60, 60, 60, 60, 60,
+	60, -196, 60, 60, 60, 60, 60, 60, 60, -196,
+	60, 60, 60, 60, 108, 107, 120, 113, 114, 115,
+	116, 117, 118, 119, 109, 110, 105, 87, 103, 104,
+	89, 111, 112, -58, -183, -193, -181, -181, -181, -181,
+	-265, -187, -183, 60, 66, 71, 60, 60, 60, -287,
+	60, -195, -196, 60, 66, 66, 66, 60, 60, 60,
+	-181, 60, 60, -285, -194, -324, 449, -79, 62, -74,
+	-161, -322, -323, -74, -78, -161, -71, -183, -154, -155,
+	-146, -151, -158, -159, -152, 275, 40, 41, 43, 44,
+	190, 20, 86, 23, 25, 280, 312, 89, 124, 16,
+	90, 156, 123, 282, 381, 281, 185, 53, 81, 383,
+	385, 384, 374, 372, 319, 323, 325, 322, 373, 343,
+	29, 10, 26, 206, 21, 22, 117, 187, 208, 93,
+	94, 209, 24, 207, 78, 19, 56, 11, 332, 13,
+	14, 283, 318, 197, 196, 105, 336, 193, 51, 8,
+	126, 27, 102, 320, 47, 83, 49, 103, 17, 375,
+	376, 31, 335, 406, 213, 119, 284, 285, 54, 87,
+	326, 76, 57, 84, 15, 52, 104, 188, 380, 50,
+	222, 324, 288, 290, 405, 289, 191, 6, 279, 382,
+	30, 205, 48, 192, 344, 92, 195, 77, 212, 152,
+	153, 5, 82, 9, 55, 58, 377, 378, 379, 33,
+	91, 12, 291, 410, 327, 337, 338, 339, 340, 341,
+	342, 180, 181, 182, 183, 184, 255, 200, 198, 202,
+	203, 449, 450, 19, -41, -334, 127, -75, -126, 61,
+	95, -81, -80, 57, 58, -82, 57, -80, 47, 47,
+	-76, -246, 115, 63, 61, -217, 318, 456, 64, 62,
+	61, -246, 195, 66, 66, 61, 18, 127, 61, -67,
+	25, 26, -88, 197, -88, -220, -221, 324, 24, -206,
+	58, -201, -202, -200, -204, 29, -126, -126, -126, -168,
+	-162, -170, -165, -170, -166, 127, -148, -161, -220, 60,
+	135, 138, 138, 137, -213, 195, 60, 95, -239, -239,
+	-239, -239, -239, 29, -160, -54, -54, 57, 61, 60,
+	62, 61, -126, -61, -62, -63, -183, -183, -183, -161,
+	-161, 115, 76, 87, -178, -199, 20, 43, 44, -188,
+	-193, -183, -136, 21, 20, -136, -136, -183, -136, 115,
+	-193, -193, 62, -266, 71, -326, -327, 386, 387, 388,
+	389, 390, 391, 392, 393, 394, 395, 396, 284, 279,
+	285, 283, 277, 291, 286, 287, 155, 403, 404, 397,
+	398, 399, 400, 401, 402, -136, -136, -136, -136, -136,
+	-136, -136, -136, -183, -179, -179, -179, -179, -179, -179,
+	-179, -179, -179, -179, -179, -179, -186, -197, -265, 60,
+	105, 103, 104, 89, -181, -179, -179, 66, 66, 62,
+	61, -329, -328, 91, -183, -326, -188, -183, -188, -188,
+	62, -193, -188, -179, -188, -193, -136, 61, 60, 62,
+	61, 33, 127, 61, 95, 62, 61, -72, 127, 334,
+	-161, 62, -71, -227, -183, -183, 60, -183, 11, 127,
+	127, -218, 16, 410, -160, -141, 195, -219, -294, 196,
+	380, -297, 348, -183, -183, -161, -68, -76, 87, 60,
+	-225, 410, 326, 325, 321, -222, -223, 320, 322, 319,
+	323, 57, 269, 270, 271, 272, -200, -147, 123, 233,
+	159, -126, -168, -168, -170, -161, -225, 62, 138, -219,
+	-171, 66, -231, -88, -88, -1, -161, -128, 13, 61,
+	127, 76, -265, 62, 61, -183, -183, -183, 23, -193,
+	62, 62, 62, 62, 11, -193, -183, -183, -183, -183,
+	-183, -183, -183, 62, -193, -186, -181, -179, -179, -184,
+	209, 86, -183, -182, -328, 93, -183, 61, 58, 62,
+	11, 62, 62, 62, 58, 62, 61, 62, 62, -183,
+	-194, -292, -291, -290, 33, -55, -74, -285, -161, -323,
+	-290, -161, -154, -151, -159, -152, 71, -72, -75, -219,
+	115, 115, 63, -160, 327, -160, -219, -232, 410, 27,
+	-303, 342, 337, 339, 127, 23, 24, -83, -84, -85,
+	-90, -86, -141, -173, -87, 200, 198, 202, -319, 82,
+	203, 255, 83, 193, -224, -226, 328, 329, 330, 331,
+	86, -223, 66, 66, 66, 66, -88, -153, 95, -153,
+	-153, -126, -126, -168, -175, -176, -174, 275, -280, 327,
+	318, 62, 62, -127, 14, 16, -63, -161, 115, -183,
+	62, 62, 62, -91, -97, 124, 156, 208, 155, 154,
+	152, 314, 315, 148, 149, 150, 147, 62, -183, -71,
+	62, 62, 62, 62, 62, 62, 62, 62, -184, 86,
+	-181, -178, 62, 94, -183, 92, -91, -107, -183, -107,
+	-179, 62, 62, 61, -285, 62, -160, 16, 23, -220,
+	298, 192, -274, 451, -301, 337, 16, 16, -55, -88,
+	62, 61, -92, -96, -93, -95, -94, -98, -97, 156,
+	157, 124, 160, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 30, 208, 152, 153, 154, 155, 172,
+	139, 158, 408, 180, 140, 181, 141, 182, 142, 183,
+	143, 144, 184, 145, -87, -161, 83, -318, -319, -203,
+	-318, 83, 60, -226, 71, 71, 71, 71, -223, 60,
+	-107, -109, -159, 66, 124, 66, -126, -174, 276, 31,
+	126, 278, 29, 274, 16, -183, -189, -191, -183, 39,
+	40, 41, 62, -267, -269, 60, -268, 60, -267, -267,
+	-267, -99, 144, 143, -99, -271, 60, -271, -272, 60,
+	-271, 62, -100, 382, -178, -183, 62, 62, 62, 19,
+	62, 62, -290, -160, -160, -232, 299, -88, -114, 452,
+	66, 16, 66, -299, 66, -76, -105, -106, -123, 312,
+	224, -204, 228, 70, 229, 334, 230, 193, 232, 233,
+	234, 204, 235, 236, 237, 327, 238, 239, 240, 241,
+	295, 5, 249, 265, -85, -104, -103, -101, 76, 87,
+	29, 312, -102, 70, 123, 247, 225, 229, 248, -122,
+	-172, 198, 82, 83, 300, -173, -273, 315, 314, -267,
+	-268, -269, -267, -267, 60, 60, -267, -270, 60, -267,
+	-267, -315, -316, -161, -316, -161, -315, -315, -203, -183,
+	-208, -210, -141, 60, 71, -281, -171, 410, 61, 60,
+	60, 42, 71, 71, 71, 71, 62, 66, -183, -295,
+	-252, -144, 453, 71, 66, 339, 23, -248, 214, 61,
+	-123, -153, -153, -147, 123, -153, -153, -153, -153, 231,
+	231, -153, -153, -153, -153, -153, -153, -153, -153, -153,
+	-153, -153, -153, -153, -153, 16, 60, -101, 76, -179,
+	66, -109, -110, 29, 246, 242, -111, 29, 226, 227,
+	-153, -113, 60, 255, 83, 83, -88, -275, 316, -143,
+	66, -143, 71, 60, 58, 264, 60, 60, 60, -316,
+	62, 62, 61, -267, -183, 277, 39, -191, -193, -193,
+	60, 62, 62, 62, 61, 62, 61, 62, -302, 342,
+	-298, -296, 337, 338, 339, 340, 60, 16, -55, 16,
+	-123, 71, 71, -153, -153, 71, 66, 66, 66, -153,
+	-153, 71, 66, -163, 71, 71, 71, 71, 29, 66,
+	-112, 29, 242, 246, 243, 244, 245, 71, 29, 71,
+	29, 71, 29, -161, 60, 60, -320, -321, 66, 66,
+	71, 60, -209, 60, 62, 61, 62, 62, -208, -317,
+	269, 270, 271, 273, 272, -317, -208, -208, -208, 60,
+	-234, -233, 256, 87, -211, -210, -67, 62, 62, 62,
+	-192, -190, 60, -183, 71, 71, -304, 196, -300, 341,
+	-296, 16, 339, 16, 16, -145, -161, -299, -249, 257,
+	258, -250, -256, 260, -107, -107, 66, 66, -108, 225,
+	-89, -247, 62, 61, 95, 62, -183, -116, -115, 406,
+	-208, 66, 62, 62, 62, 62, -208, 256, -212, 204,
+	70, 410, 267, 268, -67, 62, 61, 62, 62, 62,
+	-310, 60, 71, -301, 16, -299, 16, -299, -299, 62,
+	61, -254, 261, 60, -252, 60, -252, 83, 270, 226,
+	227, 62, 62, -321, 66, 62, -120, -121, -118, -119,
+	57, 346, 253, 254, 62, -211, -211, -211, -211, 62,
+	-153, 66, 266, -190, -314, 30, 62, -309, -308, -142,
+	-305, -161, 342, 66, -299, -161, -251, 262, 71, -179,
+	60, -179, 60, -253, 259, 60, -233, -119, 57, -118,
+	57, 10, 9, -122, 71, -159, -313, -312, -311, 62,
+	61, 127, -258, 60, 16, 62, -247, 62, -247, 60,
+	95, -179, -117, 250, 251, 30, 137, -117, 61, 95,
+	-308, -161, -259, -257, 214, -250, 62, 62, -247, 71,
+	62, 76, 29, 252, -312, 29, -183, 127, 62, 61,
+	63, -255, 263, 62, -161, -257, -260, 33, 71, -264,
+	-261, 60, -123, 216, -264, -123, -263, -262, 262, 217,
+	62, 61, 63, 60, -262, -261, -193, 62,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, 446, -2, 274, 275,
-	276, 277, 278, 198, 199, 200, -2, 0, 173, 0,
-	165, 165, 0, 353, 0, 0, 0, 364, 0, 373,
-	20, 311, 0, 316, 622, 658, 659, 660, 1312, 1313,
-	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
-	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 1346, 1347, 1152, 1153, 1154, 1155, 1156, 1157,
-	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
-	1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
-	1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
-	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
-	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
-	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
-	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
-	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
-	1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257,
-	1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267,
-	1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277,
-	1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287,
-	1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297,
-	1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
-	1308, 0, 189, 0, 0, 193, 0, 0, 0, 270,
-	185, 186, 187, 188, 0, 0, 395, 396, 419, 422,
-	425, 0, 179, 0, 0, 80, 488, 82, 490, 0,
-	86, 88, 89, -2, 93, 94, 95, 96, 97, 98,
-	99, 0, 101, 1201, 103, 1262, 106, 107, 108, 0,
-	117, 118, -2, -2, 485, 0, 0, 1251, 62, -2,
-	0, 0, 0, 369, 449, 519, 519, 0, 519, 532,
-	496, 497, 498, 517, 518, 0, 0, 246, 247, 0,
-	263, 254, 263, 0, 238, 239, 240, 244, 245, 264,
-	212, 174, 175, 164, 0, 169, 0, 163, 0, 0,
-	133, 0, 138, 0, 1200, 1266, 1216, 0, 1234, 0,
-	158, 151, 152, 997, 1162, 0, 348, 0, 354, 353,
-	353, 0, 353, 212, 212, 212, 212, 212, 341, 0,
-	343, 346, 0, 374, 375, 376, 377, 3, 0, 0,
-	315, 0, 382, 190, 661, 0, 0, 194, 195, 0,
-	0, 201, 0, 204, 1348, 1349, 1350, 0, 0, 0,
-	0, 0, 0, 0, 410, 0, 0, 409, 0, 0,
-	0, 0, 423, 424, 426, 0, 428, 429, 435, 436,
-	437, 438, 439, 0, 353, 76, 0, 0, 0, 0,
-	0, 492, 87, 116, 90, 91, 0, 111, 113, 115,
-	114, 100, 112, 102, 104, 105, 110, 76, 0, 0,
-	0, 63, 336, 0, 317, 0, 366, 368, 0, 370,
-	371, 0, 519, 450, 0, 0, 0, 0, 0, 0,
-	259, 260, 254, 254, 248, 256, 0, 261, 262, 0,
-	382, 0, 0, 0, 519, 0, 0, 0, 0, 167,
-	0, 172, 123, 128, 126, 127, 129, 0, 0, 0,
-	0, 0, 156, 157, 0, 0, 0, 0, 145, 148,
-	614, 615, 616, 149, 150, 0, 998, 999, 317, 349,
-	365, 367, 348, -2, 0, 362, 363, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 390, 384, 386, 430,
-	28, 0, 896, 658, 900, 1313, 1314, 1315, 1316, 1317,
-	1318, 1319, 1321, -2, -2, 1324, 1326, 1328, -2, -2,
-	-2, -2, 1335, -2, -2, 1339, 1340, 1345, 1346, 1347,
-	-2, -2, -2, -2, 909, 729, 730, 731, 732, 0,
-	0, 0, 0, 0, 739, 740, 0, 752, 0, 746,
-	747, 748, 749, 38, 39, 925, 926, 927, 928, 929,
-	930, 931, 863, 716, 0, 0, 0, 848, 838, 0,
-	858, 876, 877, 0, 0, 0, 0, 0, 40, 41,
-	854, 855, 856, 857, 859, 860, 861, 862, 864, 865,
-	866, 867, 870, 871, 872, 873, 874, 875, 878, 880,
-	850, 851, 852, 853, 842, 843, 844, 845, 846, 847,
-	285, 303, 287, 0, 292, 0, 623, 353, 0, 0,
-	191, 0, 196, 0, 0, 203, 205, 206, 207, 1351,
-	1352, 271, 0, 382, 182, 0, 413, 407, 0, 400,
-	411, 412, 403, 0, 405, 0, 401, 402, 346, 427,
-	421, 0, 77, 78, 79, 81, 92, 0, 0, 70,
-	473, 479, 476, 486, 489, 0, 84, 491, 109, 0,
-	65, 0, 0, 0, 337, 350, 28, 355, 356, 359,
-	0, 0, 460, 0, 487, 511, -2, 382, 382, 382,
-	254, 0, 256, 0, 256, 251, 255, 0, 265, 267,
-	0, 460, 1293, 213, 176, 177, 0, 0, 171, 0,
-	0, 130, 131, 132, 139, 134, 136, 0, 0, 140,
-	153, 154, 155, 309, 310, 0, 0, 0, 144, 0,
-	159, 335, 317, 339, 317, 279, 280, 0, 282, 620,
-	283, 433, 434, 344, 0, 0, 417, 382, 0, 391,
-	0, 387, 0, 0, 0, 431, 0, 0, 895, 0,
-	0, 914, 915, 916, 917, 918, 919, 888, 884, 884,
-	884, 0, 884, 0, 0, 824, 0, 0, 884, 884,
-	884, 884, 825, 884, 884, 884, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, -2, 890, 0, 735, 736, 737, 738,
-	741, 0, 753, 0, 882, 0, 888, 888, 888, 827,
-	0, 828, 839, 0, 831, 832, 833, 888, 0, 888,
-	837, 884, 286, 300, 0, 304, 0, 0, 296, 298,
-	291, 293, 0, 0, 313, 348, 383, 662, 0, 1004,
-	-2, 1006, -2, -2, 1008, 1009, 1010, 1011, 1012, 1013,
-	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023,
-	1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
-	1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
-	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
-	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
-	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 0, 197,
-	202, 0, 0, 353, 0, 0, 397, 414, 0, 0,
-	398, 0, 399, 404, 406, 420, 0, 71, 75, 0,
-	475, 0, 0, 478, 83, 0, 0, 0, 59, 319,
-	0, 0, 0, 0, 358, 360, 361, 346, 0, 0,
-	452, 461, 0, 520, 0, 0, 516, -2, 523, 0,
-	529, 237, 241, 242, 382, 257, 254, 258, 254, 256,
-	0, 266, 269, 452, 0, 178, 166, 168, 0, 125,
-	0, 0, 0, 141, 142, 143, 146, 147, 338, 340,
-	0, 0, 20, 347, 0, 380, 385, 392, 393, 892,
-	893, 894, 432, 29, 388, 897, 0, 899, 0, 889,
-	890, 0, 885, 886, 0, 0, 0, 0, 0, 0,
-	0, 840, 0, 924, 0, 795, 796, 797, 798, 799,
-	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
-	810, 811, 812, 813, 814, 815, 816, 817, 818, 819,
-	820, 821, 822, 823, 0, 0, 0, 0, 0, 0,
-	0, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 901, 912, 913, 0, 0, 0, 0,
-	0, 910, 905, 0, 733, 0, 750, 754, 0, 0,
-	883, 0, 890, 0, 0, 849, 0, 0, 0, 0,
-	0, 303, 305, 0, 0, 303, 0, 0, 0, 312,
-	0, 284, 0, 0, 272, 208, 348, 183, 184, 415,
-	0, 408, 0, 0, 0, 474, 0, 0, 477, 85,
-	0, 67, 0, 60, 61, 323, 0, 351, 352, 29,
-	357, 0, 0, 624, 451, 0, 462, 463, 464, 465,
-	466, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	524, 1000, 1000, 1000, 0, 249, 382, 382, 254, 268,
-	214, 0, 170, 124, 0, 226, 135, 281, 621, 0,
-	418, 378, 0, 0, 0, 898, 787, 0, 0, 0,
-	0, 0, 0, 776, 770, 771, 841, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 902, 910, 906, 0,
-	903, 0, 0, 891, 0, 755, 0, 0, 0, 0,
-	788, 0, 792, 826, 829, 0, 834, 0, 836, 0,
-	301, 0, 306, 307, 303, 290, 297, 289, 299, 294,
-	295, 314, 663, 1005, 1002, 1003, 192, 181, 0, 69,
-	72, 73, 74, 480, 0, 481, 460, 66, 0, 0,
-	325, 48, 0, 0, 0, 0, 0, 0, 625, 626,
-	628, 629, 0, 0, 631, 685, 0, 640, 519, 640,
-	0, 0, 642, 643, 453, 454, 0, 0, 0, 0,
-	0, 468, 469, 470, 471, 472, 0, 0, 1001, 0,
-	0, 252, 250, 382, 210, 215, 216, 0, 220, 0,
-	0, 137, 345, 372, 0, 0, 394, 30, 389, 891,
-	772, 773, 774, 0, 757, 979, 983, 760, 979, 979,
-	979, 766, 766, 986, 986, 989, 986, 775, 0, 777,
-	778, 781, 779, 782, 783, 769, 887, 904, 0, 911,
-	907, 734, 742, 751, 0, 0, 0, 0, 0, 0,
-	780, 302, 0, 288, 416, 484, 0, 0, 67, 0,
-	0, 327, 0, 324, 0, 0, 0, 447, 346, -2,
-	0, -2, 992, 933, 934, 935, 979, 937, 983, 0,
-	979, 979, 965, 966, 967, 968, 969, 970, 971, 972,
-	973, 0, 0, 956, 979, 981, 979, 979, 976, 938,
-	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
-	949, 950, 951, 630, 686, 652, 652, 641, 652, 652,
-	519, 0, 455, 456, 457, 458, 459, 467, 0, 525,
-	526, 617, 618, 619, 527, 253, 217, 218, 219, 0,
-	222, 223, 225, 0, 379, 381, 743, 758, 980, 0,
-	759, 0, 761, 762, 763, 764, 767, 768, 765, 952,
-	0, 953, 954, 0, 955, 791, 908, 756, 744, 745,
-	789, 0, 830, 835, 308, 482, 483, 64, 68, 50,
-	329, 0, 326, 0, 320, 322, 58, 0, 533, -2,
-	570, 1000, 1000, 0, 1000, 1000, 1000, 1000, 0, 0,
-	1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000,
-	1000, 1000, 1000, 1000, 0, 627, 654, -2, 666, 668,
-	0, 0, 671, 672, 0, 0, 0, 0, 708, 678,
-	0, 0, 922, 923, 0, 684, 995, 993, 994, 936,
-	961, 962, 963, 964, 0, 0, 957, 958, 0, 959,
-	960, 0, 644, 653, 0, 653, 0, 0, 652, 0,
-	0, 507, 979, 0, 0, 224, 211, 0, 0, 0,
-	0, 0, 44, 0, 318, 0, 328, 49, 0, 0,
-	530, 0, 528, 572, 0, 0, 1000, 1000, 0, 0,
-	0, 0, 1000, 1000, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 667,
-	669, 670, 673, 674, 675, 713, 714, 715, 676, 710,
-	711, 712, 677, 0, 0, 920, 921, 706, 932, 996,
-	0, 977, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 638, 500, 0, 359, 0, 221, 985, 984, 987,
-	0, 990, 0, 790, 42, 46, 51, 52, 0, 0,
-	0, 0, 0, 0, 448, 566, 571, 573, 574, 0,
-	0, 577, 578, 579, 580, 0, 0, 583, 584, 585,
-	586, 587, 588, 589, 590, 591, 592, 608, 609, 610,
-	611, 612, 613, 593, 594, 595, 596, 597, 598, 605,
-	0, 0, 602, 0, 0, 0, 701, 0, 974, 0,
-	975, 982, 0, 645, 647, 648, 649, 650, 651, 646,
-	0, 0, 0, 0, 637, 639, 681, 0, 499, 508,
-	509, 359, 0, 0, 31, 0, 48, 0, 53, 0,
-	0, 0, 0, 0, 331, 321, 555, 0, 0, 561,
-	0, 567, 575, 576, 581, 582, 599, 0, 0, 601,
-	0, 0, 709, 0, 688, 702, 0, 0, 978, 500,
-	500, 500, 500, 0, 682, 501, 1000, 0, 0, 505,
-	506, 510, 988, 991, 22, 0, 0, 45, 0, 54,
-	0, 56, 57, 330, 0, 535, 0, 0, 0, 0,
-	0, 564, 0, 606, 607, 600, 603, 604, 679, 687,
-	689, 690, 691, 0, 703, 704, 705, 707, 632, 633,
-	634, 635, 0, 0, 503, 0, 21, 0, 32, 0,
-	34, 36, 37, 655, 43, 47, 55, 332, 537, 0,
-	556, 0, 0, 0, 0, 0, 0, 0, 680, 692,
-	0, 693, 0, 0, 0, 636, 502, 504, 23, 24,
-	0, 33, 0, 0, 534, 0, 566, 557, 0, 559,
-	0, 0, 0, 0, 694, 696, 697, 0, 0, 695,
-	0, 0, 35, 656, 0, 539, 0, 553, 558, 560,
-	0, 565, 563, 698, 700, 699, 25, 26, 27, 0,
-	538, 0, 551, 536, 0, 562, 657, 540, -2, 0,
-	554, 541, -2, 0, 549, 0, 542, 550, 0, 545,
-	0, 0, 544, 0, -2, 0, 546, -2, 0, 552,
+	20, 21, 22, 463, 464, 465, 0, 0, 283, 0,
+	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	218, 0, 0, 189, 169, 170, 171, 122, 123, 124,
+	125, 0, 0, 0, 0, 0, 0, 345, -2, 466,
+	467, 468, 469, -2, 284, 285, 286, 287, 288, 289,
+	207, 208, 209, -2, 0, 182, 0, 174, 174, 0,
+	365, 0, 0, 0, 376, 0, 385, 23, 323, 0,
+	328, 646, 682, 683, 684, 1359, 1360, 1361, 1362, 1363,
+	1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373,
+	1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383,
+	1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393,
+	1394, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
+	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
+	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
+	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
+	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
+	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
+	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
+	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
+	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
+	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
+	1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330,
+	1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340,
+	1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
+	1351, 1352, 1353, 1354, 1355, 0, 198, 0, 0, 202,
+	0, 0, 0, 280, 194, 195, 196, 197, 0, 0,
+	418, 419, 442, 445, 448, 0, 188, -2, 0, 0,
+	0, 0, 83, 511, 85, 513, 0, 89, 91, 92,
+	-2, 96, 97, 98, 99, 100, 101, 102, 0, 104,
+	1248, 106, 1309, 109, 110, 111, 0, 120, 121, -2,
+	-2, 508, 0, 0, 1298, 65, -2, 0, 0, 0,
+	381, 472, 542, 542, 0, 542, 555, 519, 520, 521,
+	540, 541, 0, 0, 255, 256, 0, 272, 263, 272,
+	0, 247, 248, 249, 253, 254, 273, 221, 183, 184,
+	173, 0, 178, 0, 172, 0, 0, 140, 0, 145,
+	0, 1247, 1313, 1263, 1193, 1194, 0, 1281, 0, 167,
+	160, 161, 1033, 1208, 0, 360, 0, 366, 365, 365,
+	0, 365, 221, 221, 221, 221, 221, 221, 353, 0,
+	355, 358, 0, 386, 387, 388, 389, 3, 0, 0,
+	327, 0, 405, 199, 685, 0, 0, 203, 204, 0,
+	0, 210, 0, 213, 1395, 1396, 1397, 0, 0, 0,
+	0, 0, 0, 0, 433, 0, 0, 432, 0, 0,
+	0, 0, 446, 447, 449, 0, 451, 452, 458, 459,
+	460, 461, 462, 0, 365, 0, 0, 0, 129, 79,
+	0, 0, 0, 0, 0, 515, 90, 119, 93, 94,
+	0, 114, 116, 118, 117, 103, 115, 105, 107, 108,
+	113, 79, 0, 0, 0, 66, 348, 0, 329, 0,
+	378, 380, 0, 382, 383, 0, 542, 473, 0, 0,
+	0, 0, 0, 0, 268, 269, 263, 263, 257, 265,
+	0, 270, 271, 0, 405, 0, 0, 0, 0, 542,
+	0, 0, 0, 176, 0, 181, 130, 135, 133, 134,
+	136, 0, 0, 0, 0, 0, 165, 166, 0, 0,
+	0, 0, 0, 0, 154, 157, 638, 639, 640, 158,
+	159, 0, 1034, 1035, 329, 361, 377, 379, 360, -2,
+	0, 374, 375, 0, 0, 0, 0, 0, 0, 0,
+	354, 0, 0, 413, 407, 409, 453, 31, 0, 928,
+	682, 933, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1368,
+	-2, -2, 1371, 1372, 1373, 1375, -2, -2, -2, -2,
+	1382, -2, -2, 1386, 1387, 1392, 1393, 1394, 1270, -2,
+	-2, -2, -2, 942, 754, 755, 758, 759, 0, 0,
+	0, 0, 0, 766, 767, 0, 779, 0, 773, 774,
+	775, 776, 41, 42, 961, 962, 963, 964, 965, 966,
+	967, 893, 741, 0, 0, 0, 878, 868, 0, 888,
+	906, 907, 0, 0, 0, 0, 0, 0, 43, 44,
+	884, 885, 886, 887, 889, 890, 891, 892, 894, 895,
+	896, 897, 900, 901, 902, 903, 904, 905, 908, 910,
+	880, 881, 882, 883, 872, 873, 874, 875, 876, 877,
+	297, 315, 299, 0, 304, 0, 647, 365, 0, 0,
+	200, 0, 205, 0, 0, 212, 214, 215, 216, 1398,
+	1399, 281, 0, 405, 191, 0, 436, 430, 0, 423,
+	434, 435, 426, 0, 428, 0, 424, 425, 358, 450,
+	444, 127, 222, 128, 0, 80, 81, 82, 84, 95,
+	0, 0, 73, 496, 502, 499, 509, 512, 0, 87,
+	514, 112, 0, 68, 0, 0, 0, 349, 362, 31,
+	367, 368, 371, 0, 0, 483, 0, 510, 534, -2,
+	405, 405, 405, 263, 0, 265, 0, 265, 260, 264,
+	0, 274, 277, 275, 0, 483, 1340, 185, 186, 0,
+	0, 180, 0, 0, 137, 138, 139, 146, 141, 143,
+	0, 0, 147, 162, 163, 164, 321, 322, 0, 0,
+	0, 0, 0, 153, 0, 168, 347, 329, 351, 329,
+	290, 291, 0, 293, 644, 294, 295, 456, 457, 356,
+	0, 0, 440, 405, 0, 414, 0, 410, 0, 0,
+	0, 454, 0, 0, 927, 0, 0, 947, 948, 949,
+	950, 951, 952, 920, 916, 916, 916, 0, 916, 0,
+	0, 854, 0, 0, 916, 916, 916, 916, 916, 855,
+	916, 916, 916, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, -2, 922, 0, 762, 763, 764, 765,
+	768, 0, 780, 0, 912, 0, 920, 920, 920, 857,
+	0, 858, 869, 0, 861, 862, 863, 920, 0, 920,
+	867, 0, 916, 298, 312, 0, 316, 0, 0, 308,
+	310, 303, 305, 0, 0, 325, 360, 406, 686, 0,
+	1040, -2, 1042, -2, -2, 1044, 1045, 1046, 1047, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
+	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
+	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
+	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
+	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
+	1189, 1190, 1191, 0, 206, 211, 0, 0, 365, 0,
+	0, 420, 437, 0, 0, 421, 0, 422, 427, 429,
+	443, 0, 74, 78, 0, 498, 0, 0, 501, 86,
+	0, 0, 0, 62, 331, 0, 0, 0, 0, 370,
+	372, 373, 358, 0, 0, 475, 484, 0, 543, 0,
+	0, 539, -2, 546, 0, 552, 246, 250, 251, 405,
+	266, 263, 267, 263, 265, 0, 276, 279, 475, 0,
+	187, 175, 177, 0, 132, 0, 0, 0, 148, 149,
+	150, 151, 152, 155, 156, 350, 352, 0, 0, 23,
+	359, 0, 392, 408, 415, 416, 924, 925, 926, 455,
+	32, 411, 929, 0, 931, 0, 953, 954, 955, 0,
+	921, 922, 0, 917, 918, 0, 0, 0, 0, 0,
+	0, 0, 870, 0, 960, 0, 825, 826, 827, 828,
+	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
+	849, 850, 851, 852, 853, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 742, 743, 744, 745, 746, 747,
+	748, 749, 750, 751, 752, 753, 934, 945, 946, 0,
+	0, 0, 0, 0, 943, 938, 0, 756, 757, 760,
+	0, 777, 781, 0, 0, 913, 0, 922, 0, 0,
+	879, 0, 0, 0, 0, 0, 0, 315, 317, 0,
+	0, 315, 0, 0, 0, 324, 0, 296, 0, 0,
+	282, 217, 360, 192, 193, 438, 0, 431, 0, 0,
+	0, 497, 0, 0, 500, 88, 0, 70, 0, 63,
+	64, 335, 0, 363, 364, 32, 369, 0, 0, 648,
+	474, 0, 485, 486, 487, 488, 489, 0, 0, 0,
+	0, 0, 535, 536, 537, 538, 547, 1036, 1036, 1036,
+	0, 258, 405, 405, 263, 278, 223, 0, 179, 131,
+	0, 235, 142, 292, 645, 0, 441, 390, 0, 0,
+	0, 930, 932, 817, 0, 0, 0, 0, 0, 0,
+	806, 797, 798, 871, 0, 365, 0, 0, 0, 0,
+	0, 0, 0, 801, 0, 935, 943, 939, 0, 936,
+	0, 0, 923, 0, 782, 0, 0, 0, 0, 818,
+	0, 822, 856, 859, 0, 864, 0, 866, 800, 0,
+	313, 0, 318, 319, 315, 302, 309, 301, 311, 306,
+	307, 326, 687, 1041, 1038, 1039, 201, 190, 0, 72,
+	75, 76, 77, 503, 0, 504, 483, 69, 0, 0,
+	337, 51, 0, 0, 0, 0, 0, 0, 649, 650,
+	652, 653, 0, 0, 655, 710, 0, 664, 542, 664,
+	0, 0, 666, 667, 476, 477, 0, 0, 0, 0,
+	0, 491, 492, 493, 494, 495, 0, 0, 1037, 0,
+	0, 261, 259, 405, 219, 224, 225, 0, 229, 0,
+	0, 144, 357, 384, 0, 0, 417, 33, 412, 923,
+	802, 803, 804, 0, 784, 1015, 1019, 787, 1015, 1015,
+	1015, 793, 793, 1022, 1022, 1025, 1022, 805, 0, 914,
+	807, 808, 811, 809, 812, 813, 796, 919, 937, 0,
+	944, 940, 761, 769, 778, 0, 0, 0, 0, 0,
+	0, 810, 314, 0, 300, 439, 507, 0, 0, 70,
+	0, 0, 339, 0, 336, 0, 0, 0, 470, 358,
+	-2, 0, -2, 1028, 969, 970, 971, 1015, 973, 1019,
+	0, 1015, 1015, 1001, 1002, 1003, 1004, 1005, 1006, 1007,
+	1008, 1009, 0, 0, 992, 1015, 1017, 1015, 1015, 1012,
+	974, 975, 976, 977, 978, 979, 980, 981, 982, 983,
+	984, 985, 986, 987, 654, 711, 676, 676, 665, 676,
+	676, 542, 0, 478, 479, 480, 481, 482, 490, 0,
+	548, 549, 641, 642, 643, 550, 262, 226, 227, 228,
+	0, 231, 232, 234, 0, 391, 393, 395, 397, 1197,
+	0, 0, 770, 785, 1016, 0, 786, 0, 788, 789,
+	790, 791, 794, 795, 792, 988, 0, 989, 990, 0,
+	991, 821, 0, 0, 941, 783, 771, 772, 819, 0,
+	860, 865, 320, 505, 506, 67, 71, 53, 341, 0,
+	338, 0, 332, 334, 61, 0, 556, -2, 593, 1036,
+	1036, 0, 1036, 1036, 1036, 1036, 0, 0, 1036, 1036,
+	1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036,
+	1036, 1036, 0, 0, 651, 678, -2, 690, 692, 0,
+	0, 695, 696, 0, 0, 0, 0, 1036, 733, 703,
+	0, 0, 958, 959, 0, 709, 1031, 1029, 1030, 972,
+	997, 998, 999, 1000, 0, 0, 993, 994, 0, 995,
+	996, 0, 668, 677, 0, 677, 0, 0, 676, 0,
+	0, 530, 1015, 0, 0, 233, 220, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 799, 915, 0, 47,
+	0, 330, 0, 340, 52, 0, 0, 553, 0, 551,
+	595, 0, 0, 1036, 1036, 0, 0, 0, 0, 1036,
+	1036, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 691, 693, 694,
+	697, 698, 699, 738, 739, 740, 700, 735, 736, 737,
+	0, 702, 0, 0, 956, 957, 731, 968, 1032, 0,
+	1013, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	662, 523, 0, 371, 0, 230, 394, 396, 0, 0,
+	0, 1021, 1020, 1023, 0, 1026, 0, 820, 45, 49,
+	54, 55, 0, 0, 0, 0, 0, 0, 471, 589,
+	594, 596, 597, 0, 0, 600, 601, 602, 603, 0,
+	0, 606, 607, 608, 609, 610, 611, 612, 613, 614,
+	615, 632, 633, 634, 635, 636, 637, 616, 617, 618,
+	619, 620, 621, 629, 0, 0, 0, 626, 0, 701,
+	0, 0, 726, 0, 1010, 0, 1011, 1018, 0, 669,
+	671, 672, 673, 674, 675, 670, 0, 0, 0, 0,
+	661, 663, 706, 0, 522, 531, 532, 371, 398, 399,
+	0, 401, 0, 404, 0, 0, 34, 0, 51, 0,
+	56, 0, 0, 0, 0, 0, 343, 333, 578, 0,
+	0, 584, 0, 590, 598, 599, 604, 605, 622, 0,
+	0, 0, 625, 0, 0, 734, 0, 713, 727, 0,
+	0, 1014, 523, 523, 523, 523, 0, 707, 524, 1036,
+	0, 0, 528, 529, 533, 400, 0, 403, 1024, 1027,
+	25, 0, 0, 48, 0, 57, 0, 59, 60, 342,
+	0, 558, 0, 0, 0, 0, 0, 587, 0, 630,
+	631, 623, 624, 627, 628, 704, 712, 714, 715, 716,
+	0, 728, 729, 730, 732, 656, 657, 658, 659, 0,
+	0, 526, 0, 402, 24, 0, 35, 0, 37, 39,
+	40, 679, 46, 50, 58, 344, 560, 0, 579, 0,
+	0, 0, 0, 0, 0, 0, 705, 717, 0, 718,
+	0, 0, 0, 660, 525, 527, 26, 27, 0, 36,
+	0, 0, 557, 0, 589, 580, 0, 582, 0, 0,
+	0, 0, 719, 721, 722, 0, 0, 720, 0, 0,
+	38, 680, 0, 562, 0, 576, 581, 583, 0, 588,
+	586, 723, 725, 724, 28, 29, 30, 0, 561, 0,
+	574, 559, 0, 585, 681, 563, -2, 0, 577, 564,
+	-2, 0, 572, 0, 565, 573, 0, 568, 0, 0,
+	567, 0, -2, 0, 569, -2, 0, 575,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 88, 3, 3, 3, 118, 108, 3,
+	60, 62, 115, 113, 61, 114, 127, 116, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 455,
+	96, 95, 97, 3, 456, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 120, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 107, 3, 121,
 }
 
 var yyTok2 = [...]int{
@@ -4346,12 +4655,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 57, 58, 59, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 89, 90, 91, 92, 93, 94, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 109, 110,
+	111, 112, 117, 119, 122, 123, 124, 125, 126, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4403,7 +4712,10 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4753,7 +5065,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:515
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4761,32 +5073,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:521
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:547
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:551
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 24:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:557
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4801,42 +5113,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:572
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:576
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:582
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:586
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:592
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4844,10 +5156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:599
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4855,58 +5167,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:608
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:612
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:616
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:621
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 35:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:625
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:629
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:635
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4916,10 +5228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:644
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4929,42 +5241,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:655
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:659
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:665
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:669
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:675
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4987,10 +5299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:699
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5009,34 +5321,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:718
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:722
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:727
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:731
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5044,42 +5356,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:739
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:743
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:748
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:752
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:757
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:761
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5102,36 +5414,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:785
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:789
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:795
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:801
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5150,10 +5462,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:819
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5171,10 +5483,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:836
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5192,50 +5504,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:859
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:863
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:867
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:872
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:876
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:882
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5246,10 +5558,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:892
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5258,10 +5570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:900
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5271,26 +5583,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:910
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:914
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:924
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5301,10 +5613,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:934
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5313,30 +5625,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:944
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:950
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:956
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5344,10 +5656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:963
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5356,10 +5668,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:971
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5367,58 +5679,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:979
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:983
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:987
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:991
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:997
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1007
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5426,10 +5738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1014
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5437,418 +5749,450 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1023
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1045
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1049
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1053
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1057
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1061
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1065
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1069
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1073
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1077
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1085
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1089
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1093
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1097
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1101
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1105
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1109
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1113
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1117
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1121
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1125
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1129
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1133
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1137
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1141
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1145
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1149
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1153
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1157
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1161
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1173
+		{
+			yyLOCAL = &tree.ResetPersist{}
+		}
+		yyVAL.union = yyLOCAL
+	case 127:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1177
+		{
+			yyLOCAL = &tree.ResetPersist{IfExists: yyDollar[3].boolValUnion(), Name: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 128:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1183
+		{
+			yyLOCAL = &tree.BackupDatabase{Dir: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1189
+		{
+			yyLOCAL = &tree.CompactTable{Table: yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1201
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1209
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1221
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1225
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1231
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1235
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1239
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1245
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1251
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1255
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1262
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1268
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1272
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5857,10 +6201,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1286
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5870,10 +6214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1295
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5882,10 +6226,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1303
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5894,15 +6238,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 151:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1311
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System:  true,
+				Persist: true,
+				Name:    yyDollar[2].str,
+				Value:   yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 152:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1320
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System:      true,
+				PersistOnly: true,
+				Name:        yyDollar[2].str,
+				Value:       yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1329
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
-			var isGlobal bool
-			if strings.ToLower(vs[0]) == "global" {
+			var isGlobal, isPersist, isPersistOnly bool
+			switch strings.ToLower(vs[0]) {
+			case "global":
 				isGlobal = true
+			case "persist":
+				isPersist = true
+			case "persist_only":
+				isPersistOnly = true
 			}
 			var r string
 			if len(vs) == 2 {
@@ -5914,17 +6289,19 @@ yydefault:
 				return 1
 			}
 			yyLOCAL = &tree.VarAssignmentExpr{
-				System: true,
-				Global: isGlobal,
-				Name:   r,
-				Value:  yyDollar[3].exprUnion(),
+				System:      true,
+				Global:      isGlobal,
+				Persist:     isPersist,
+				PersistOnly: isPersistOnly,
+				Name:        r,
+				Value:       yyDollar[3].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1359
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5932,10 +6309,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5943,10 +6320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1373
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5955,10 +6332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1381
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5966,10 +6343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1388
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5977,10 +6354,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1395
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5988,198 +6365,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1408
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1412
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1416
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1422
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1426
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1433
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1444
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1450
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1455
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1459
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1463
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1467
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1471
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1475
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1479
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1483
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1497
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1501
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1506
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 187:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1511
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 190:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
+//line mysql_sql.y:1527
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6190,84 +6567,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1544
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1550
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1559
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1565
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1570
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 200:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1575
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 201:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1579
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1583
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1587
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6277,10 +6654,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1596
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6289,10 +6666,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1604
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6303,76 +6680,76 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 206:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1614
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1627
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1631
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1637
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1643
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1648
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1649
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1650
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 217:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1655
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 219:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6384,10 +6761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 220:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1676
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6400,118 +6777,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1698
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1702
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1708
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1712
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1718
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1722
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1726
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1730
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1734
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1738
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1744
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1749
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1770
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6519,10 +6896,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 250:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1784
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6531,10 +6908,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 251:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1794
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6543,58 +6920,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 252:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1803
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1807
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1811
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1823
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1829
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 258:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1835
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6605,10 +6982,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 259:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1845
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6619,18 +6996,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1857
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 261:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1863
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6643,10 +7020,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 262:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1875
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6659,118 +7036,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1888
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1892
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 265:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1897
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1901
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1907
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 272:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:1920
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:1924
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:1930
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 275:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1934
+		{
+			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 276:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:1938
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:1944
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:1948
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:1957
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:1961
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 282:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:1965
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6778,10 +7163,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:1991
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6789,10 +7174,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 292:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:2000
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6801,26 +7186,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:2016
+		{
+			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 295:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2022
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 296:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2028
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6830,10 +7223,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:2043
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6841,10 +7234,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2052
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6852,20 +7245,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2059
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 300:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2065
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6874,10 +7267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2073
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6885,10 +7278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2080
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6896,10 +7289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2087
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6918,34 +7311,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2106
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2110
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2114
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2120
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6953,155 +7346,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2129
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2133
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2139
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2143
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2149
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2153
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2164
 		{
 		}
-	case 305:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2175
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2179
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2186
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2191
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 324:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2195
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2201
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2205
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2211
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2215
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2220
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 330:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2224
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7114,10 +7507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2237
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7125,10 +7518,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 332:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2244
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7136,10 +7529,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 333:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2251
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7158,10 +7551,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 334:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2269
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7180,38 +7573,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2288
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2294
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2301
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2305
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7224,115 +7617,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2318
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2322
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2327
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2331
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2338
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2343
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2350
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2356
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2360
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2364
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2368
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 351:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2372
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 352:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2376
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2382
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7340,10 +7733,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7351,26 +7744,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2398
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2402
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2408
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7378,162 +7771,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2416
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2420
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2425
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2435
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2439
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2443
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2448
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2458
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2464
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2468
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2474
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2479
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2483
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2487
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2494
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2498
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2504
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2508
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7544,10 +7937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2518
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7558,10 +7951,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2528
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7572,10 +7965,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7586,10 +7979,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2550
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7598,10 +7991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2558
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7610,10 +8003,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2566
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7622,10 +8015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 384:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2576
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7637,130 +8030,217 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2588
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2592
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2522
+//line mysql_sql.y:2596
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+//line mysql_sql.y:2605
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+//line mysql_sql.y:2609
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+//line mysql_sql.y:2614
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+//line mysql_sql.y:2618
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 394:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2622
+		{
+			yyLOCAL = tree.GroupBy{&tree.Rollup{Exprs: yyDollar[3].exprsUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2628
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 396:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2632
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 398:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2639
+		{
+			yyLOCAL = &tree.Rollup{Exprs: yyDollar[3].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 399:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2643
+		{
+			yyLOCAL = &tree.Cube{Exprs: yyDollar[3].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 400:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2647
+		{
+			yyLOCAL = &tree.GroupingSets{Sets: yyDollar[4].rowsExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line mysql_sql.y:2653
+		{
+			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 402:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line mysql_sql.y:2657
+		{
+			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 403:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2663
+		{
+			yyLOCAL = tree.Exprs{}
+		}
+		yyVAL.union = yyLOCAL
+	case 404:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2667
+		{
+			switch e := yyDollar[1].exprUnion().(type) {
+			case *tree.Tuple:
+				yyLOCAL = e.Exprs
+			case *tree.ParenExpr:
+				yyLOCAL = tree.Exprs{e.Expr}
+			default:
+				yyLOCAL = tree.Exprs{e}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 405:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2679
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2683
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2689
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2693
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2699
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2703
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2707
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2711
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2717
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7769,44 +8249,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2725
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2731
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2739
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2743
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2753
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7816,10 +8296,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2762
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7829,10 +8309,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2772
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7842,10 +8322,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2781
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7854,15 +8334,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2791
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2795
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7870,130 +8350,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2805
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2809
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2813
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2817
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2823
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2827
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2833
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2839
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2843
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2847
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2853
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2857
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2863
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2867
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2873
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2877
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2883
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2887
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -8004,28 +8484,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2900
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 445:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2905
 		{
 		}
-	case 423:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2906
 		{
 		}
-	case 424:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2910
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -8035,46 +8515,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 448:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+//line mysql_sql.y:2922
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:2926
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:2930
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:2939
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:2943
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:2947
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 447:
+	case 470:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2847
+//line mysql_sql.y:2977
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8085,10 +8565,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 471:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:2987
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8099,15 +8579,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2868
+//line mysql_sql.y:2998
 		{
 		}
-	case 451:
+	case 474:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2873
+//line mysql_sql.y:3003
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8117,189 +8597,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2883
+//line mysql_sql.y:3013
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2887
+//line mysql_sql.y:3017
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2893
+//line mysql_sql.y:3023
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2897
+//line mysql_sql.y:3027
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2903
+//line mysql_sql.y:3033
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2907
+//line mysql_sql.y:3037
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3041
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2915
+//line mysql_sql.y:3045
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2921
+//line mysql_sql.y:3051
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2928
+//line mysql_sql.y:3058
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:3063
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2938
+//line mysql_sql.y:3068
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3073
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2949
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2953
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3087
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2963
+//line mysql_sql.y:3093
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2967
+//line mysql_sql.y:3097
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2971
+//line mysql_sql.y:3101
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2975
+//line mysql_sql.y:3105
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2981
+//line mysql_sql.y:3111
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2985
+//line mysql_sql.y:3115
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2991
+//line mysql_sql.y:3121
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8311,42 +8791,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3004
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3008
+//line mysql_sql.y:3138
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3012
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 502:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3017
+//line mysql_sql.y:3147
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3021
+//line mysql_sql.y:3151
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8354,20 +8834,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3028
+//line mysql_sql.y:3158
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3034
+//line mysql_sql.y:3164
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8376,10 +8856,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 506:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3042
+//line mysql_sql.y:3172
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8387,20 +8867,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 507:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3049
+//line mysql_sql.y:3179
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3061
+//line mysql_sql.y:3191
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8408,82 +8888,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3070
+//line mysql_sql.y:3200
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3074
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3080
+//line mysql_sql.y:3210
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3084
+//line mysql_sql.y:3214
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3088
+//line mysql_sql.y:3218
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3097
+//line mysql_sql.y:3227
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3101
+//line mysql_sql.y:3231
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3105
+//line mysql_sql.y:3235
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3109
+//line mysql_sql.y:3239
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 522:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3115
+//line mysql_sql.y:3245
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8502,18 +8982,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3134
+//line mysql_sql.y:3264
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3138
+//line mysql_sql.y:3268
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8534,123 +9014,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3290
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3294
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3298
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3172
+//line mysql_sql.y:3302
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3176
+//line mysql_sql.y:3306
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3182
+//line mysql_sql.y:3312
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3186
+//line mysql_sql.y:3316
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3192
+//line mysql_sql.y:3322
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3197
+//line mysql_sql.y:3327
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3202
+//line mysql_sql.y:3332
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3206
+//line mysql_sql.y:3336
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3210
+//line mysql_sql.y:3340
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3214
+//line mysql_sql.y:3344
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3218
+//line mysql_sql.y:3348
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 539:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3224
+//line mysql_sql.y:3354
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8659,98 +9139,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3238
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3242
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3247
+//line mysql_sql.y:3377
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3251
+//line mysql_sql.y:3381
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3257
+//line mysql_sql.y:3387
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3261
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3267
+//line mysql_sql.y:3397
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 549:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3271
+//line mysql_sql.y:3401
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3275
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3280
+//line mysql_sql.y:3410
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3284
+//line mysql_sql.y:3414
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 553:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3290
+//line mysql_sql.y:3420
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8762,34 +9242,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3302
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3306
+//line mysql_sql.y:3436
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3441
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 557:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3315
+//line mysql_sql.y:3445
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8799,18 +9279,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3325
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3329
+//line mysql_sql.y:3459
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8819,42 +9299,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3338
+//line mysql_sql.y:3468
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3342
+//line mysql_sql.y:3472
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3348
+//line mysql_sql.y:3478
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3352
+//line mysql_sql.y:3482
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 564:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3358
+//line mysql_sql.y:3488
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8864,10 +9344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 565:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3367
+//line mysql_sql.y:3497
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8877,42 +9357,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3381
+//line mysql_sql.y:3511
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3387
+//line mysql_sql.y:3517
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3391
+//line mysql_sql.y:3521
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3397
+//line mysql_sql.y:3527
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8920,10 +9400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3404
+//line mysql_sql.y:3534
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8931,50 +9411,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3413
+//line mysql_sql.y:3543
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3417
+//line mysql_sql.y:3547
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3422
+//line mysql_sql.y:3552
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 575:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3426
+//line mysql_sql.y:3556
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3431
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3435
+//line mysql_sql.y:3565
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8984,18 +9464,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 578:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3445
+//line mysql_sql.y:3575
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3449
+//line mysql_sql.y:3579
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9005,10 +9485,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3460
+//line mysql_sql.y:3590
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9017,10 +9497,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3598
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9029,10 +9509,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3476
+//line mysql_sql.y:3606
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9041,10 +9521,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 583:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3484
+//line mysql_sql.y:3614
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9053,10 +9533,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 585:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3495
+//line mysql_sql.y:3625
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9067,10 +9547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 586:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3505
+//line mysql_sql.y:3635
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9080,478 +9560,486 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3515
+//line mysql_sql.y:3645
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3519
+//line mysql_sql.y:3649
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3524
+//line mysql_sql.y:3654
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3528
+//line mysql_sql.y:3658
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3533
+//line mysql_sql.y:3663
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3537
+//line mysql_sql.y:3667
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3543
+//line mysql_sql.y:3673
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3547
+//line mysql_sql.y:3677
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3551
+//line mysql_sql.y:3681
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3557
+//line mysql_sql.y:3687
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3561
+//line mysql_sql.y:3691
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3565
+//line mysql_sql.y:3695
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3699
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3703
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3577
+//line mysql_sql.y:3707
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3581
+//line mysql_sql.y:3711
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3585
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3589
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3723
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3727
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3731
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3735
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3739
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3747
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3751
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3755
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3759
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3763
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3767
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3771
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3775
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3779
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3791
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 623:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3795
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 624:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:3799
+		{
+			yyLOCAL = tree.NewTableOptionClusterBy(yyDollar[4].unresolveNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3803
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3676
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3680
+//line mysql_sql.y:3814
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3686
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3691
+//line mysql_sql.y:3825
 		{
 			yyVAL.str = ""
 		}
-	case 606:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3695
+//line mysql_sql.y:3829
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 607:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3699
+//line mysql_sql.y:3833
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 608:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3705
+//line mysql_sql.y:3839
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3709
+//line mysql_sql.y:3843
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3713
+//line mysql_sql.y:3847
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3717
+//line mysql_sql.y:3851
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3721
+//line mysql_sql.y:3855
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3725
+//line mysql_sql.y:3859
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3741
+//line mysql_sql.y:3875
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3745
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3754
+//line mysql_sql.y:3888
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3759
+//line mysql_sql.y:3893
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3765
+//line mysql_sql.y:3899
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3772
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3776
+//line mysql_sql.y:3910
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3782
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3786
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3792
+//line mysql_sql.y:3926
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9562,18 +10050,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3802
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 656:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3808
+//line mysql_sql.y:3942
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9583,10 +10071,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 657:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3817
+//line mysql_sql.y:3951
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9596,10 +10084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 658:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3826
+//line mysql_sql.y:3960
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9623,10 +10111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 659:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3849
+//line mysql_sql.y:3983
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9636,10 +10124,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 660:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3858
+//line mysql_sql.y:3992
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9650,10 +10138,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 661:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3868
+//line mysql_sql.y:4002
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9661,298 +10149,306 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 662:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3876
+//line mysql_sql.y:4010
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3882
+//line mysql_sql.y:4016
 		{
 			yyVAL.str = ""
 		}
-	case 641:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3886
+//line mysql_sql.y:4020
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 644:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3896
+//line mysql_sql.y:4030
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3902
+//line mysql_sql.y:4036
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3908
+//line mysql_sql.y:4042
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:4056
 		{
 			yyVAL.str = ""
 		}
-	case 654:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3929
+//line mysql_sql.y:4063
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4069
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3939
+//line mysql_sql.y:4073
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 681:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3943
+//line mysql_sql.y:4077
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3954
+//line mysql_sql.y:4088
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3958
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 687:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3962
+//line mysql_sql.y:4096
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3967
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3971
+//line mysql_sql.y:4105
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3977
+//line mysql_sql.y:4111
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3981
+//line mysql_sql.y:4115
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3987
+//line mysql_sql.y:4121
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3991
+//line mysql_sql.y:4125
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3995
+//line mysql_sql.y:4129
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3999
+//line mysql_sql.y:4133
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4137
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4141
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4011
+//line mysql_sql.y:4145
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4015
+//line mysql_sql.y:4149
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4019
+//line mysql_sql.y:4153
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 701:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4157
+		{
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4161
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4165
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 704:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4169
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 705:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4173
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4045
+//line mysql_sql.y:4183
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4049
+//line mysql_sql.y:4187
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 708:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4054
+//line mysql_sql.y:4192
 		{
 			yyVAL.str = ""
 		}
-	case 684:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4058
+//line mysql_sql.y:4196
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 685:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4064
+//line mysql_sql.y:4202
 		{
 			yyVAL.str = ""
 		}
-	case 686:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4068
+//line mysql_sql.y:4206
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 687:
+	case 712:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4074
+//line mysql_sql.y:4212
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9963,10 +10459,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 713:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4085
+//line mysql_sql.y:4223
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9974,10 +10470,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4095
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9985,10 +10481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4102
+//line mysql_sql.y:4240
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9996,10 +10492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4109
+//line mysql_sql.y:4247
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10007,10 +10503,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4116
+//line mysql_sql.y:4254
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10018,339 +10514,368 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4125
+//line mysql_sql.y:4263
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 720:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4269
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4137
+//line mysql_sql.y:4275
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4141
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4145
+//line mysql_sql.y:4283
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4149
+//line mysql_sql.y:4287
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4153
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 726:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4158
+//line mysql_sql.y:4296
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4165
+//line mysql_sql.y:4303
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4169
+//line mysql_sql.y:4307
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4311
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 731:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4178
+//line mysql_sql.y:4316
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4182
+//line mysql_sql.y:4320
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 733:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4187
+//line mysql_sql.y:4325
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4191
+//line mysql_sql.y:4329
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4207
+//line mysql_sql.y:4345
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4213
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4217
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4221
+//line mysql_sql.y:4359
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4225
+//line mysql_sql.y:4363
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4229
+//line mysql_sql.y:4367
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4233
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4237
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4241
+//line mysql_sql.y:4379
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4245
+//line mysql_sql.y:4383
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4387
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4391
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4395
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4399
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4267
+//line mysql_sql.y:4405
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 756:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4409
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 757:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4417
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4271
+//line mysql_sql.y:4430
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 759:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4275
+//line mysql_sql.y:4434
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4279
+//line mysql_sql.y:4438
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 761:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4283
+//line mysql_sql.y:4442
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4287
+//line mysql_sql.y:4446
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4291
+//line mysql_sql.y:4450
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4295
+//line mysql_sql.y:4454
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4299
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4470
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 769:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4316
+//line mysql_sql.y:4475
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10359,26 +10884,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 770:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4324
+//line mysql_sql.y:4483
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 771:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4487
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 772:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4332
+//line mysql_sql.y:4491
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10388,90 +10913,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4341
+//line mysql_sql.y:4500
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4345
+//line mysql_sql.y:4504
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4349
+//line mysql_sql.y:4508
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4353
+//line mysql_sql.y:4512
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 777:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4358
+//line mysql_sql.y:4517
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4362
+//line mysql_sql.y:4521
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 779:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4367
+//line mysql_sql.y:4526
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4371
+//line mysql_sql.y:4530
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4377
+//line mysql_sql.y:4536
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4381
+//line mysql_sql.y:4540
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 783:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4387
+//line mysql_sql.y:4546
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10479,10 +11004,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4397
+//line mysql_sql.y:4556
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10490,16 +11015,17 @@ yydefault:
 					Family:       tree.StringFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
+					Binary:       true,
 					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4410
+//line mysql_sql.y:4570
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10513,10 +11039,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4423
+//line mysql_sql.y:4583
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10529,10 +11055,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4435
+//line mysql_sql.y:4595
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10547,10 +11073,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4449
+//line mysql_sql.y:4609
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10566,10 +11092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4464
+//line mysql_sql.y:4624
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10585,10 +11111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4479
+//line mysql_sql.y:4639
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10602,10 +11128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4492
+//line mysql_sql.y:4652
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10620,28 +11146,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 793:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4507
+//line mysql_sql.y:4667
+		{
+		}
+	case 796:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4673
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4682
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4690
 		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
 		}
-	case 769:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyVAL.union = yyLOCAL
+	case 799:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4513
+//line mysql_sql.y:4698
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Func:      tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:     yyDollar[4].exprsUnion(),
+				Type:      yyDollar[3].funcTypeUnion(),
+				OrderBy:   yyDollar[5].orderByUnion(),
+				Separator: yyDollar[6].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4709
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10650,22 +11215,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4717
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: yyDollar[3].exprsUnion(),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4725
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10675,10 +11240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 803:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4734
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10688,10 +11253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 804:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4743
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10701,10 +11266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4752
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10714,10 +11279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 806:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4761
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10727,10 +11292,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 807:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4770
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10740,10 +11305,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 808:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4779
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10753,10 +11318,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 809:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4788
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10766,10 +11331,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4797
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10779,10 +11344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 811:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4806
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10792,10 +11357,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4815
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10805,10 +11370,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 813:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4824
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10818,10 +11383,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 817:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4653
+//line mysql_sql.y:4840
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10830,10 +11395,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 818:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4661
+//line mysql_sql.y:4848
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10842,10 +11407,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 819:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4669
+//line mysql_sql.y:4856
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10854,10 +11419,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 820:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4677
+//line mysql_sql.y:4864
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10866,10 +11431,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 821:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4685
+//line mysql_sql.y:4872
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -10879,10 +11444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 822:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4694
+//line mysql_sql.y:4881
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10891,16 +11456,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 825:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4708
+//line mysql_sql.y:4895
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 824:
+	case 854:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4744
+//line mysql_sql.y:4931
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10913,10 +11478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4756
+//line mysql_sql.y:4943
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10929,10 +11494,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 856:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:4957
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10941,10 +11506,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4778
+//line mysql_sql.y:4965
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10952,10 +11517,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4785
+//line mysql_sql.y:4972
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10968,10 +11533,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 859:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4797
+//line mysql_sql.y:4984
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10980,10 +11545,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 860:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4805
+//line mysql_sql.y:4992
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10995,10 +11560,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4816
+//line mysql_sql.y:5003
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -11008,10 +11573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4825
+//line mysql_sql.y:5012
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -11021,10 +11586,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4834
+//line mysql_sql.y:5021
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11034,10 +11599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 864:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5030
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11046,10 +11611,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 865:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4851
+//line mysql_sql.y:5038
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11060,10 +11625,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 866:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4861
+//line mysql_sql.y:5048
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11072,10 +11637,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4869
+//line mysql_sql.y:5056
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11084,52 +11649,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4878
+//line mysql_sql.y:5065
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4882
+//line mysql_sql.y:5069
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4888
+//line mysql_sql.y:5075
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 871:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4892
+//line mysql_sql.y:5079
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 878:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4905
+//line mysql_sql.y:5092
 		{
 		}
-	case 849:
+	case 879:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4907
+//line mysql_sql.y:5094
 		{
 		}
-	case 882:
+	case 912:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4947
+//line mysql_sql.y:5134
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11139,10 +11704,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4956
+//line mysql_sql.y:5143
 		{
 			//        name := tree.SetUnresolvedName("interval")
 			//        ival := util.GetUint64($2)
@@ -11163,314 +11728,358 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 914:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:5164
+		{
+			yyVAL.str = ","
+		}
+	case 915:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5168
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4977
+//line mysql_sql.y:5173
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4981
+//line mysql_sql.y:5177
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4985
+//line mysql_sql.y:5181
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4991
+//line mysql_sql.y:5187
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4996
+//line mysql_sql.y:5192
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5000
+//line mysql_sql.y:5196
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5006
+//line mysql_sql.y:5202
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5010
+//line mysql_sql.y:5206
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5017
+//line mysql_sql.y:5213
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5021
+//line mysql_sql.y:5217
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5025
+//line mysql_sql.y:5221
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5029
+//line mysql_sql.y:5225
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5033
+//line mysql_sql.y:5229
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5039
+//line mysql_sql.y:5235
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 930:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5043
+//line mysql_sql.y:5239
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5047
+//line mysql_sql.y:5243
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 932:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5247
+		{
+			yyLOCAL = tree.NewComparisonExprWithSubop(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5054
+//line mysql_sql.y:5254
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 935:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5058
+//line mysql_sql.y:5258
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 936:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5062
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5066
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5070
+//line mysql_sql.y:5270
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 939:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5074
+//line mysql_sql.y:5274
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 940:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5078
+//line mysql_sql.y:5278
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 941:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5082
+//line mysql_sql.y:5282
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5088
+//line mysql_sql.y:5288
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 944:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5092
+//line mysql_sql.y:5292
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5098
+//line mysql_sql.y:5298
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5102
+//line mysql_sql.y:5302
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5109
+//line mysql_sql.y:5309
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5113
+//line mysql_sql.y:5313
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5117
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5121
+//line mysql_sql.y:5321
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5125
+//line mysql_sql.y:5325
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5129
+//line mysql_sql.y:5329
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 953:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line mysql_sql.y:5336
+		{
+			yyLOCAL = tree.ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 954:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line mysql_sql.y:5340
+		{
+			yyLOCAL = tree.ANY
+		}
+		yyVAL.union = yyLOCAL
+	case 955:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line mysql_sql.y:5344
+		{
+			yyLOCAL = tree.SOME
+		}
+		yyVAL.union = yyLOCAL
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5136
+//line mysql_sql.y:5350
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5140
+//line mysql_sql.y:5354
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5144
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5148
+//line mysql_sql.y:5362
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5154
+//line mysql_sql.y:5368
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11484,96 +12093,96 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5169
+//line mysql_sql.y:5383
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5173
+//line mysql_sql.y:5387
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5178
+//line mysql_sql.y:5392
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5183
+//line mysql_sql.y:5397
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5187
+//line mysql_sql.y:5401
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5191
+//line mysql_sql.y:5405
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5195
+//line mysql_sql.y:5409
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5205
+//line mysql_sql.y:5419
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 972:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5216
+//line mysql_sql.y:5430
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5221
+//line mysql_sql.y:5435
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5227
+//line mysql_sql.y:5441
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11586,10 +12195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5239
+//line mysql_sql.y:5453
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11602,10 +12211,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5251
+//line mysql_sql.y:5465
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11618,10 +12227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5263
+//line mysql_sql.y:5477
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11635,10 +12244,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5276
+//line mysql_sql.y:5490
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11652,10 +12261,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5289
+//line mysql_sql.y:5503
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11669,10 +12278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5302
+//line mysql_sql.y:5516
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11686,10 +12295,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5315
+//line mysql_sql.y:5529
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11703,10 +12312,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5328
+//line mysql_sql.y:5542
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11720,10 +12329,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5341
+//line mysql_sql.y:5555
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11737,10 +12346,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5354
+//line mysql_sql.y:5568
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11754,10 +12363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5367
+//line mysql_sql.y:5581
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11771,10 +12380,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5380
+//line mysql_sql.y:5594
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11788,10 +12397,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5393
+//line mysql_sql.y:5607
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11805,10 +12414,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5408
+//line mysql_sql.y:5622
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11832,10 +12441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5431
+//line mysql_sql.y:5645
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11872,10 +12481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5468
+//line mysql_sql.y:5682
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11912,10 +12521,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5516
+//line mysql_sql.y:5730
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11931,10 +12540,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5533
+//line mysql_sql.y:5747
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11947,10 +12556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5545
+//line mysql_sql.y:5759
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11966,10 +12575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5560
+//line mysql_sql.y:5774
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11990,10 +12599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5580
+//line mysql_sql.y:5794
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12009,10 +12618,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5595
+//line mysql_sql.y:5809
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12027,10 +12636,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5611
+//line mysql_sql.y:5825
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12044,10 +12653,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5624
+//line mysql_sql.y:5838
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12061,10 +12670,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5637
+//line mysql_sql.y:5851
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12072,22 +12681,24 @@ yydefault:
 					Family:       tree.StringFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
-					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					Binary:       true,
+					Oid:          uint32(defines.MYSQL_TYPE_STRING),
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5650
+//line mysql_sql.y:5865
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
 				InternalType: tree.InternalType{
 					Family:       tree.StringFamily,
 					Locale:       &locale,
+					Binary:       true,
 					FamilyString: yyDollar[1].str,
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
 					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
@@ -12095,10 +12706,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5663
+//line mysql_sql.y:5879
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12111,10 +12722,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5675
+//line mysql_sql.y:5891
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12127,10 +12738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5687
+//line mysql_sql.y:5903
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12143,10 +12754,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5699
+//line mysql_sql.y:5915
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12159,10 +12770,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5711
+//line mysql_sql.y:5927
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12170,15 +12781,16 @@ yydefault:
 					Family:       tree.BlobFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
+					Binary:       true,
 					Oid:          uint32(defines.MYSQL_TYPE_BLOB),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5723
+//line mysql_sql.y:5940
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12186,15 +12798,16 @@ yydefault:
 					Family:       tree.BlobFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
+					Binary:       true,
 					Oid:          uint32(defines.MYSQL_TYPE_TINY_BLOB),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5735
+//line mysql_sql.y:5953
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12202,15 +12815,16 @@ yydefault:
 					Family:       tree.BlobFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
+					Binary:       true,
 					Oid:          uint32(defines.MYSQL_TYPE_MEDIUM_BLOB),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5747
+//line mysql_sql.y:5966
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12218,15 +12832,16 @@ yydefault:
 					Family:       tree.BlobFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
+					Binary:       true,
 					Oid:          uint32(defines.MYSQL_TYPE_LONG_BLOB),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5759
+//line mysql_sql.y:5979
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12239,10 +12854,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1010:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5771
+//line mysql_sql.y:5991
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12256,10 +12871,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1011:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5784
+//line mysql_sql.y:6004
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12273,10 +12888,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5799
+//line mysql_sql.y:6019
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12289,75 +12904,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5822
+//line mysql_sql.y:6042
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5827
+//line mysql_sql.y:6047
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5833
+//line mysql_sql.y:6053
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5840
+//line mysql_sql.y:6060
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5844
+//line mysql_sql.y:6064
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1019:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5849
+//line mysql_sql.y:6069
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5853
+//line mysql_sql.y:6073
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5859
+//line mysql_sql.y:6079
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5865
+//line mysql_sql.y:6085
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12365,10 +12980,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5872
+//line mysql_sql.y:6092
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12376,10 +12991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1024:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5879
+//line mysql_sql.y:6099
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12387,10 +13002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5888
+//line mysql_sql.y:6108
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12398,10 +13013,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1026:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5895
+//line mysql_sql.y:6115
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12409,10 +13024,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1027:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5902
+//line mysql_sql.y:6122
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12420,52 +13035,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5911
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5915
+//line mysql_sql.y:6135
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5919
+//line mysql_sql.y:6139
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1031:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5925
+//line mysql_sql.y:6145
 		{
 		}
-	case 996:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5927
+//line mysql_sql.y:6147
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5937
+//line mysql_sql.y:6157
 		{
 			yyVAL.str = ""
 		}
-	case 1001:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5941
+//line mysql_sql.y:6161
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
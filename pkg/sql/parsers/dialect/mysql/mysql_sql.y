@@ -170,6 +170,9 @@ import (
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
+
+// Grouping sets
+%token <str> ROLLUP CUBE GROUPING SETS ANY SOME
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING
@@ -191,6 +194,7 @@ import (
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %left <str> '+' '-'
 %left <str> '*' '/' DIV '%' MOD
 %left <str> '^'
@@ -222,7 +226,7 @@ import (
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
-%token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
+%token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM CLUSTER
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS
 %token <str> TYPE
@@ -263,7 +267,10 @@ import (
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL EXCEPT PERSIST PERSIST_ONLY RESET
+
+// Backup
+%token <str> BACKUP
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -300,7 +307,7 @@ import (
 %type <statement> stmt
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
-%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
+%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_view_stmt
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
@@ -311,6 +318,9 @@ import (
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
+%type <statement> reset_stmt
+%type <statement> backup_stmt
+%type <statement> compact_stmt
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
@@ -337,7 +347,7 @@ import (
 %type <columnTableDef> column_def
 %type <columnType> cast_type
 %type <columnType> column_type char_type spatial_type time_type numeric_type decimal_type int_type
-%type <str> integer_opt
+%type <str> integer_opt separator_opt
 %type <columnAttribute> column_attribute_elem keys
 %type <columnAttributes> column_attribute_list column_attribute_list_opt
 %type <tableOptions> table_option_list_opt table_option_list
@@ -385,10 +395,13 @@ import (
 %type <expr> simple_expr else_opt
 %type <expr> expression like_escape_opt boolean_primary col_tuple expression_opt
 %type <exprs> expression_list_opt
+%type <exprs> group_by_list grouping_set
+%type <expr> group_by_item
+%type <rowsExprs> grouping_set_list
 %type <exprs> expression_list row_value
 %type <expr> datatime_precision_opt datatime_precision
 %type <tuple> tuple_expression
-%type <comparisonOp> comparison_operator
+%type <comparisonOp> comparison_operator and_or_some
 %type <createOption> create_option
 %type <createOptions> create_option_list_opt create_option_list
 %type <ifNotExists> not_exists_opt
@@ -524,6 +537,9 @@ stmt:
 |   use_stmt
 |   transaction_stmt
 |   set_stmt
+|   reset_stmt
+|   backup_stmt
+|   compact_stmt
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
@@ -1152,6 +1168,28 @@ set_stmt:
 |   set_role_stmt
 |   set_default_role_stmt
 
+reset_stmt:
+    RESET PERSIST
+    {
+        $$ = &tree.ResetPersist{}
+    }
+|   RESET PERSIST exists_opt var_name
+    {
+        $$ = &tree.ResetPersist{IfExists: $3, Name: $4}
+    }
+
+backup_stmt:
+    BACKUP DATABASE TO STRING
+    {
+        $$ = &tree.BackupDatabase{Dir: $4}
+    }
+
+compact_stmt:
+    COMPACT TABLE table_name
+    {
+        $$ = &tree.CompactTable{Table: $3}
+    }
+
 set_role_stmt:
     SET ROLE set_role_opt
     {
@@ -1269,12 +1307,35 @@ var_assignment:
             Value: $4,
         }
     }
+|   PERSIST var_name equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            System: true,
+            Persist: true,
+            Name: $2,
+            Value: $4,
+        }
+    }
+|   PERSIST_ONLY var_name equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            System: true,
+            PersistOnly: true,
+            Name: $2,
+            Value: $4,
+        }
+    }
 |   id_prefix_at equal_or_assignment set_expr
     {
     	vs := strings.Split($1, ".")
-        var isGlobal bool
-        if strings.ToLower(vs[0]) == "global" {
+        var isGlobal, isPersist, isPersistOnly bool
+        switch strings.ToLower(vs[0]) {
+        case "global":
             isGlobal = true
+        case "persist":
+            isPersist = true
+        case "persist_only":
+            isPersistOnly = true
         }
         var r string
         if len(vs) == 2 {
@@ -1288,6 +1349,8 @@ var_assignment:
         $$ = &tree.VarAssignmentExpr{
             System: true,
             Global: isGlobal,
+            Persist: isPersist,
+            PersistOnly: isPersistOnly,
             Name: r,
             Value: $3,
         }
@@ -1867,6 +1930,10 @@ show_create_stmt:
     {
         $$ = &tree.ShowCreateTable{Name: $4}
     }
+|   SHOW CREATE VIEW table_name_unresolved
+    {
+        $$ = &tree.ShowCreateView{Name: $4}
+    }
 |   SHOW CREATE DATABASE not_exists_opt db_name
     {
         $$ = &tree.ShowCreateDatabase{IfNotExists: $4, Name: $5}
@@ -1905,6 +1972,7 @@ drop_stmt:
 drop_ddl_stmt:
     drop_database_stmt
 |   drop_table_stmt
+|   drop_view_stmt
 |   drop_index_stmt
 |   drop_role_stmt
 |   drop_user_stmt
@@ -1943,6 +2011,12 @@ drop_table_stmt:
         $$ = &tree.DropTable{IfExists: $3, Names: $4}
     }
 
+drop_view_stmt:
+    DROP VIEW exists_opt table_name_list
+    {
+        $$ = &tree.DropView{IfExists: $3, Names: $4}
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt database_id
     {
@@ -2540,10 +2614,66 @@ group_by_opt:
     {
         $$ = nil
     }
-|   GROUP BY expression_list
+|   GROUP BY group_by_list
     {
         $$ = tree.GroupBy($3)
     }
+|   GROUP BY group_by_list WITH ROLLUP
+    {
+        $$ = tree.GroupBy{&tree.Rollup{Exprs: $3}}
+    }
+
+group_by_list:
+    group_by_item
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   group_by_list ',' group_by_item
+    {
+        $$ = append($1, $3)
+    }
+
+group_by_item:
+    expression
+|   ROLLUP '(' expression_list ')'
+    {
+        $$ = &tree.Rollup{Exprs: $3}
+    }
+|   CUBE '(' expression_list ')'
+    {
+        $$ = &tree.Cube{Exprs: $3}
+    }
+|   GROUPING SETS '(' grouping_set_list ')'
+    {
+        $$ = &tree.GroupingSets{Sets: $4}
+    }
+
+grouping_set_list:
+    grouping_set
+    {
+        $$ = []tree.Exprs{$1}
+    }
+|   grouping_set_list ',' grouping_set
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_set:
+    '(' ')'
+    {
+        $$ = tree.Exprs{}
+    }
+|   expression
+    {
+        switch e := $1.(type) {
+        case *tree.Tuple:
+            $$ = e.Exprs
+        case *tree.ParenExpr:
+            $$ = tree.Exprs{e.Expr}
+        default:
+            $$ = tree.Exprs{e}
+        }
+    }
 
 where_expression_opt:
     {
@@ -3665,6 +3795,10 @@ table_option:
     {
         $$= tree.NewTableOptionUnion($4)
     }
+|   CLUSTER BY '(' column_name_list ')'
+    {
+        $$ = tree.NewTableOptionClusterBy($4)
+    }
 |	PROPERTIES '(' properties_list ')'
 	{
 		$$ = &tree.TableOptionProperties{Preperties: $3}
@@ -4019,6 +4153,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION equal_opt STRING
+    {
+        $$ = tree.NewAttributeCompression($3)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -4267,6 +4405,27 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -4401,6 +4560,7 @@ cast_type:
 		        Family: tree.StringFamily,
                 FamilyString: $1,
 		        Locale: &locale,
+		        Binary: true,
 		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
                 DisplayWith: $2,
 	        },
@@ -4534,6 +4694,33 @@ function_call_aggregate:
             Exprs: $3,
         }
     }
+|   GROUP_CONCAT '(' func_type_opt expression_list order_by_opt separator_opt ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $4,
+            Type: $3,
+            OrderBy: $5,
+            Separator: $6,
+        }
+    }
+|   GROUPING '(' expression_list ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+        }
+    }
+|   MODE '(' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3},
+        }
+    }
 |   BIT_AND '(' func_type_opt expression ')'
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -4973,6 +5160,15 @@ interval_expr:
         }
     }
 
+separator_opt:
+    {
+        $$ = ","
+    }
+|   SEPARATOR STRING
+    {
+        $$ = $2
+    }
+
 func_type_opt:
     {
         $$ = tree.FUNC_TYPE_DEFAULT
@@ -5047,6 +5243,10 @@ boolean_primary:
     {
         $$ = tree.NewComparisonExpr($2, $1, $3)
     }
+|   boolean_primary comparison_operator and_or_some subquery %prec '='
+    {
+        $$ = tree.NewComparisonExprWithSubop($2, $3, $1, $4)
+    }
 |   predicate
 
 predicate:
@@ -5131,6 +5331,20 @@ comparison_operator:
     }
 // |    NULL_SAFE_EQUAL
 
+and_or_some:
+    ALL
+    {
+        $$ = tree.ALL
+    }
+|   ANY
+    {
+        $$ = tree.ANY
+    }
+|   SOME
+    {
+        $$ = tree.SOME
+    }
+
 keys:
     PRIMARY KEY
     {
@@ -5641,7 +5855,8 @@ char_type:
 		        Family: tree.StringFamily,
                 FamilyString: $1,
 		        Locale: &locale,
-		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
+		        Binary: true,
+		        Oid:    uint32(defines.MYSQL_TYPE_STRING),
                 DisplayWith: $2,
 	        },
         }
@@ -5653,6 +5868,7 @@ char_type:
             InternalType: tree.InternalType{
 		        Family: tree.StringFamily,
 		        Locale: &locale,
+		        Binary: true,
                 FamilyString: $1,
                 DisplayWith: $2,
 		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
@@ -5715,6 +5931,7 @@ char_type:
 		        Family: tree.BlobFamily,
                 FamilyString: $1,
 		        Locale: &locale,
+		        Binary: true,
 		        Oid:    uint32(defines.MYSQL_TYPE_BLOB),
             },
         }
@@ -5727,6 +5944,7 @@ char_type:
 		        Family: tree.BlobFamily,
                 FamilyString: $1,
 		        Locale: &locale,
+		        Binary: true,
 		        Oid:    uint32(defines.MYSQL_TYPE_TINY_BLOB),
             },
         }
@@ -5739,6 +5957,7 @@ char_type:
 		        Family: tree.BlobFamily,
                 FamilyString: $1,
 		        Locale: &locale,
+		        Binary: true,
 		        Oid:    uint32(defines.MYSQL_TYPE_MEDIUM_BLOB),
 	        },
         }
@@ -5751,6 +5970,7 @@ char_type:
 		        Family: tree.BlobFamily,
                 FamilyString: $1,
 		        Locale: &locale,
+		        Binary: true,
 		        Oid:    uint32(defines.MYSQL_TYPE_LONG_BLOB),
 	        },
         }
@@ -5956,6 +6176,10 @@ reserved_table_id:
 
 reserved_keyword:
     ACCOUNT
+|   CUBE
+|   GROUPING
+|   ANY
+|   SOME
 |   ADD
 |   ALL
 |   AND
@@ -6102,6 +6326,12 @@ reserved_keyword:
 
 non_reserved_keyword:
     AGAINST
+|   PERSIST
+|   PERSIST_ONLY
+|   RESET
+|   BACKUP
+|   ROLLUP
+|   SETS
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
@@ -6123,6 +6353,7 @@ non_reserved_keyword:
 |   CONSISTENT
 |   COMPRESSED
 |   COMPACT
+|   CLUSTER
 |   COLUMN_FORMAT
 |   CASCADE
 |   DATA
@@ -58,6 +58,30 @@ var (
 	}{{
 		input:  "select date_sub(now(), interval 1 day) from t1;",
 		output: "select date_sub(now(), interval(1 day)) from t1",
+	}, {
+		input:  "select a->'$.b', t.a->>'$[0]' from t where a->'$.c' = 1",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(t.a, $[0])) from t where json_extract(a, $.c) = 1",
+	}, {
+		input:  "select json_set(a, '$.b', json_array(1, 'x'), '$.c', json_object('k', 2)) from t",
+		output: "select json_set(a, $.b, json_array(1, x), $.c, json_object(k, 2)) from t",
+	}, {
+		input:  "select group_concat(distinct a, b order by b desc, a SEPARATOR ';'), group_concat(c) from t",
+		output: "select group_concat(distinct a, b order by b desc, a separator ';'), group_concat(c) from t",
+	}, {
+		input: "select mode(a), median(a), percentile_cont(a, 0.5) from t",
+	}, {
+		input:  "select a, b, sum(c) from t group by a, b with rollup",
+		output: "select a, b, sum(c) from t group by rollup(a, b)",
+	}, {
+		input: "select a, b, grouping(a, b), sum(c) from t group by a, cube(b, c)",
+	}, {
+		input:  "select a, b, sum(c) from t group by grouping sets ((a, b), a, (b), ())",
+		output: "select a, b, sum(c) from t group by grouping sets((a, b), (a), (b), ())",
+	}, {
+		input: "select rollup, sets from t group by rollup(rollup)",
+	}, {
+		input:  "select a from t where a > all (select b from s) and a = any (select b from s) or a <> some (select b from s)",
+		output: "select a from t where a > all (select b from s) and a = any (select b from s) or a != some (select b from s)",
 	}, {
 		input:  "select date_sub(now(), interval '1 day') from t1;",
 		output: "select date_sub(now(), interval(1 day)) from t1",
@@ -78,6 +102,13 @@ var (
 		output: "create view v as select qty, price, qty * price as value from t",
 	}, {
 		input: "create view v_today (today) as select current_day from t",
+	}, {
+		input:  "DROP VIEW IF EXISTS v1, db.v2",
+		output: "drop view if exists v1, db.v2",
+	}, {
+		input: "drop view v1",
+	}, {
+		input: "show create view db.v1",
 	}, {
 		input: "explain (analyze true,verbose false) select * from emp",
 	}, {
@@ -376,6 +407,14 @@ var (
 	}, {
 		input:  "create table a (a int) engine = 'innodb' row_format = dynamic comment = 'table A' compression = 'lz4' data directory = '/data' index directory = '/index' max_rows = 1000 min_rows = 100",
 		output: "create table a (a int) engine = innodb row_format = dynamic comment = table A compression = lz4 data directory = /data index directory = /index max_rows = 1000 min_rows = 100",
+	}, {
+		input:  "create table a (a int compression = 'zstd', b varchar(10) compression 'snappy')",
+		output: "create table a (a int compression zstd, b varchar(10) compression snappy)",
+	}, {
+		input:  "create table a (a int primary key, b date, c varchar(10)) cluster by (b, a)",
+		output: "create table a (a int primary key, b date, c varchar(10)) cluster by (b, a)",
+	}, {
+		input: "create table cluster (cluster int)",
 	}, {
 		input: "create table a (a int) partition by linear key algorithm = 3221 (a, b, db.t.c) (partition xx values less than (1, 2, 323), partition yy)",
 	}, {
@@ -467,6 +506,29 @@ var (
 		input: "set global a = 1",
 	}, {
 		input: "set a = 1",
+	}, {
+		input: "set persist a = 1",
+	}, {
+		input: "set persist_only a = 1",
+	}, {
+		input:  "set @@persist.a = 1, @@persist_only.b = 'x'",
+		output: "set persist a = 1, persist_only b = x",
+	}, {
+		input: "reset persist",
+	}, {
+		input: "reset persist a",
+	}, {
+		input: "reset persist if exists a",
+	}, {
+		input: "backup database to '/tmp/backup'",
+	}, {
+		input: "backup database to 'it\\'s'",
+	}, {
+		input: "compact table t1",
+	}, {
+		input: "compact table db.t1",
+	}, {
+		input: "set persist = 1",
 	}, {
 		input: "rollback",
 	}, {
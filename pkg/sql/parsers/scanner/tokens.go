@@ -143,6 +143,7 @@ func initTokens(dialectType dialect.DialectType) {
 		FIXED = MYSQL_FIXED
 		REDUNDANT = MYSQL_REDUNDANT
 		COMPACT = MYSQL_COMPACT
+		CLUSTER = MYSQL_CLUSTER
 		STORAGE = MYSQL_STORAGE
 		DISK = MYSQL_DISK
 		MEMORY = MYSQL_MEMORY
@@ -295,6 +296,8 @@ func initTokens(dialectType dialect.DialectType) {
 		IN = MYSQL_IN
 		SHIFT_LEFT = MYSQL_SHIFT_LEFT
 		SHIFT_RIGHT = MYSQL_SHIFT_RIGHT
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
 		DIV = MYSQL_DIV
 		MOD = MYSQL_MOD
 		UNARY = MYSQL_UNARY
@@ -434,6 +437,16 @@ func initTokens(dialectType dialect.DialectType) {
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
 		UNUSED = MYSQL_UNUSED
+		ROLLUP = MYSQL_ROLLUP
+		CUBE = MYSQL_CUBE
+		GROUPING = MYSQL_GROUPING
+		SETS = MYSQL_SETS
+		ANY = MYSQL_ANY
+		SOME = MYSQL_SOME
+		PERSIST = MYSQL_PERSIST
+		PERSIST_ONLY = MYSQL_PERSIST_ONLY
+		RESET = MYSQL_RESET
+		BACKUP = MYSQL_BACKUP
 
 	case dialect.POSTGRESQL:
 		USE = POSTGRESQL_USE
@@ -464,6 +477,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"avg_row_length":           AVG_ROW_LENGTH,
 		"avg":                      AVG,
 		"bsi":                      BSI,
+		"backup":                   BACKUP,
 		"before":                   UNUSED,
 		"begin":                    BEGIN,
 		"between":                  BETWEEN,
@@ -499,6 +513,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"committed":                COMMITTED,
 		"commit":                   COMMIT,
 		"compact":                  COMPACT,
+		"cluster":                  CLUSTER,
 		"condition":                UNUSED,
 		"constraint":               CONSTRAINT,
 		"consistent":               CONSISTENT,
@@ -518,6 +533,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"cube":                     CUBE,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -593,6 +609,9 @@ func initTokens(dialectType dialect.DialectType) {
 		"global":                   GLOBAL,
 		"grant":                    GRANT,
 		"group":                    GROUP,
+		"grouping":                 GROUPING,
+		"any":                      ANY,
+		"some":                     SOME,
 		"group_concat":             GROUP_CONCAT,
 		"having":                   HAVING,
 		"hash":                     HASH,
@@ -709,6 +728,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"partial":                  PARTIAL,
 		"password":                 PASSWORD,
 		"pack_keys":                PACK_KEYS,
+		"persist":                  PERSIST,
+		"persist_only":             PERSIST_ONLY,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
 		"precision":                UNUSED,
@@ -737,6 +758,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"replace":                  REPLACE,
 		"replication":              REPLICATION,
 		"require":                  REQUIRE,
+		"reset":                    RESET,
 		"resignal":                 UNUSED,
 		"restrict":                 RESTRICT,
 		"return":                   UNUSED,
@@ -745,6 +767,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"reload":                   RELOAD,
 		"right":                    RIGHT,
 		"rlike":                    REGEXP,
+		"rollup":                   ROLLUP,
 		"rollback":                 ROLLBACK,
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
@@ -759,6 +782,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"sensitive":                UNUSED,
 		"separator":                SEPARATOR,
 		"serializable":             SERIALIZABLE,
+		"sets":                     SETS,
 		"session":                  SESSION,
 		"set":                      SET,
 		"share":                    SHARE,
@@ -1000,6 +1024,7 @@ var (
 	COMPRESSED               int
 	REDUNDANT                int
 	COMPACT                  int
+	CLUSTER                  int
 	STORAGE                  int
 	DISK                     int
 	MEMORY                   int
@@ -1152,6 +1177,8 @@ var (
 	IN                       int
 	SHIFT_LEFT               int
 	SHIFT_RIGHT              int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
 	DIV                      int
 	MOD                      int
 	UNARY                    int
@@ -1283,6 +1310,16 @@ var (
 	SUBSTRING                int
 	GROUP_CONCAT             int
 	SEPARATOR                int
+	ROLLUP                   int
+	CUBE                     int
+	GROUPING                 int
+	SETS                     int
+	ANY                      int
+	SOME                     int
+	PERSIST                  int
+	PERSIST_ONLY             int
+	RESET                    int
+	BACKUP                   int
 	MATCH                    int
 	AGAINST                  int
 	BOOLEAN                  int
@@ -0,0 +1,29 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// BackupDatabase copies an online snapshot of the storage into Dir
+type BackupDatabase struct {
+	statementImpl
+	Dir string
+}
+
+func (node *BackupDatabase) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup database to '")
+	ctx.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(node.Dir))
+	ctx.WriteByte('\'')
+}
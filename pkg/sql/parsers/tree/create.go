@@ -374,6 +374,22 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+}
+
+func NewAttributeCompression(c string) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: c,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -1410,6 +1426,26 @@ func NewTableOptionUnion(n TableNames) *TableOptionUnion {
 	return &TableOptionUnion{Names: n}
 }
 
+// TableOptionClusterBy keeps the rows of the table sorted on the columns
+type TableOptionClusterBy struct {
+	tableOptionImpl
+	ColumnList []*UnresolvedName
+}
+
+func (node *TableOptionClusterBy) Format(ctx *FmtCtx) {
+	prefix := "cluster by ("
+	for _, c := range node.ColumnList {
+		ctx.WriteString(prefix)
+		c.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteByte(')')
+}
+
+func NewTableOptionClusterBy(c []*UnresolvedName) *TableOptionClusterBy {
+	return &TableOptionClusterBy{ColumnList: c}
+}
+
 type TableOptionEncryption struct {
 	tableOptionImpl
 	Encryption string
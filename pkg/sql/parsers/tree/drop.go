@@ -63,6 +63,22 @@ func NewDropTable(i bool, n TableNames) *DropTable {
 	}
 }
 
+//DROP View statement
+type DropView struct {
+	statementImpl
+	IfExists bool
+	Names    TableNames
+}
+
+func (node *DropView) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop view")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Names.Format(ctx)
+}
+
 type DropIndex struct {
 	statementImpl
 	Name       Identifier
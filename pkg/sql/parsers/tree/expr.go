@@ -263,6 +263,10 @@ func (node *ComparisonExpr) Format(ctx *FmtCtx) {
 	}
 	ctx.WriteString(node.Op.ToString())
 	ctx.WriteByte(' ')
+	if node.SubOp >= ANY {
+		ctx.WriteString(node.SubOp.ToString())
+		ctx.WriteByte(' ')
+	}
 	ctx.PrintExpr(node, node.Right, false)
 	if node.Escape != nil {
 		ctx.WriteString(" escape ")
@@ -528,6 +532,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the separator of group_concat.
+	Separator string
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -539,6 +546,13 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.OrderBy) > 0 {
+		ctx.WriteByte(' ')
+		node.OrderBy.Format(ctx)
+	}
+	if node.Separator != "" && node.Separator != "," {
+		ctx.WriteString(fmt.Sprintf(" separator '%s'", node.Separator))
+	}
 	ctx.WriteByte(')')
 }
 
@@ -255,6 +255,51 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+//the ROLLUP in a GROUP BY clause, it groups the rows by all the prefixes
+//of the expressions. GROUP BY ... WITH ROLLUP is parsed as ROLLUP too.
+type Rollup struct {
+	exprImpl
+	Exprs Exprs
+}
+
+func (node *Rollup) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollup(")
+	node.Exprs.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+//the CUBE in a GROUP BY clause, it groups the rows by all the subsets
+//of the expressions.
+type Cube struct {
+	exprImpl
+	Exprs Exprs
+}
+
+func (node *Cube) Format(ctx *FmtCtx) {
+	ctx.WriteString("cube(")
+	node.Exprs.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+//the GROUPING SETS in a GROUP BY clause.
+type GroupingSets struct {
+	exprImpl
+	Sets []Exprs
+}
+
+func (node *GroupingSets) Format(ctx *FmtCtx) {
+	ctx.WriteString("grouping sets(")
+	for i, set := range node.Sets {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteByte('(')
+		set.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteByte(')')
+}
+
 const (
 	JOIN_TYPE_FULL          = "FULL"
 	JOIN_TYPE_LEFT          = "LEFT"
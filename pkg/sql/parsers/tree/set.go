@@ -40,16 +40,23 @@ func NewSetVar(a []*VarAssignmentExpr) *SetVar {
 //for variable = expr
 type VarAssignmentExpr struct {
 	NodeFormatter
-	System   bool
-	Global   bool
-	Name     string
-	Value    Expr
-	Reserved Expr
+	System      bool
+	Global      bool
+	Persist     bool
+	PersistOnly bool
+	Name        string
+	Value       Expr
+	Reserved    Expr
 }
 
 func (node *VarAssignmentExpr) Format(ctx *FmtCtx) {
-	if node.Global {
+	switch {
+	case node.Global:
 		ctx.WriteString("global ")
+	case node.Persist:
+		ctx.WriteString("persist ")
+	case node.PersistOnly:
+		ctx.WriteString("persist_only ")
 	}
 	ctx.WriteString(node.Name)
 	ctx.WriteString(" =")
@@ -73,6 +80,25 @@ func NewVarAssignmentExpr(s bool, g bool, n string, v Expr, r Expr) *VarAssignme
 	}
 }
 
+// ResetPersist removes the persisted value of the system variable Name,
+// or of all the system variables when Name is empty.
+type ResetPersist struct {
+	statementImpl
+	IfExists bool
+	Name     string
+}
+
+func (node *ResetPersist) Format(ctx *FmtCtx) {
+	ctx.WriteString("reset persist")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	if node.Name != "" {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Name)
+	}
+}
+
 type SetDefaultRoleType int
 
 const (
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -32,6 +33,13 @@ func New(db string, sql string, e engine.Engine) *build {
 	}
 }
 
+// SetTimeZone sets the time zone the TIMESTAMP constants are parsed in,
+// the server's local time zone is used if it is not set.
+func (b *build) SetTimeZone(loc *time.Location) *build {
+	b.loc = loc
+	return b
+}
+
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
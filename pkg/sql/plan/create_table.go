@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"go/constant"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -94,6 +96,15 @@ func (b *build) getOptionDef(option tree.TableOption) (engine.TableDef, error) {
 				Value: property.Value}
 		}
 		return &engine.PropertiesDef{Properties: properties}, nil
+	case *tree.TableOptionClusterBy:
+		names := make([]string, len(n.ColumnList))
+		for i, col := range n.ColumnList {
+			names[i] = col.Parts[0]
+		}
+		return &engine.PropertiesDef{Properties: []engine.Property{{
+			Key:   engine.ClusterByProperty,
+			Value: strings.Join(names, ","),
+		}}}, nil
 
 	}
 	return nil, nil
@@ -120,7 +131,7 @@ func (b *build) getTableDef(def tree.TableDef) (engine.TableDef, []string, error
 			return nil, nil, err
 		}
 
-		defaultExpr, err := getDefaultExprFromColumnDef(n, typ)
+		defaultExpr, err := getDefaultExprFromColumnDef(n, typ, b.loc)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -250,7 +261,7 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 // For example:
 // 		create table testTb1 (first int default 15.6) ==> create table testTb1 (first int default 16)
 //		create table testTb2 (first int default 'abc') ==> error(Invalid default value for 'first')
-func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (engine.DefaultExpr, error) {
+func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type, loc *time.Location) (engine.DefaultExpr, error) {
 	allowNull := true // be false when column has not null constraint
 
 	{
@@ -275,7 +286,12 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 			// check value and its type, only support constant value for default expression now.
 			var value interface{}
 			var err error
-			if value, err = buildConstant(*typ, defaultExpr); err != nil { // build constant failed
+			if typ.Oid == types.T_timestamp {
+				value, err = buildTimestampConstant(*typ, defaultExpr, loc)
+			} else {
+				value, err = buildConstant(*typ, defaultExpr)
+			}
+			if err != nil { // build constant failed
 				return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", column.Name.Parts[0]))
 			}
 			if _, err = rangeCheck(value, *typ, "", 0); err != nil { // value out of range
@@ -20,6 +20,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -820,6 +821,23 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", val))
 }
 
+// buildTimestampConstant parses the string constant n as a TIMESTAMP value
+// in the time zone loc, the server's local time zone is used if loc is nil.
+func buildTimestampConstant(typ types.Type, n tree.Expr, loc *time.Location) (interface{}, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	v, err := buildConstant(types.Type{Oid: types.T_varchar}, n)
+	if err != nil || v == nil {
+		return v, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", v))
+	}
+	return types.ParseTimestampInLocation(s, typ.Precision, loc)
+}
+
 func buildFunctionExtend(fe *extend.FuncExtend) (extend.Extend, error) {
 	op, ok := extend.FunctionRegistry[fe.Name]
 	if !ok {
@@ -425,7 +425,7 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vs := make([]types.Timestamp, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildTimestampConstant(vec.Typ, row[i], b.loc)
 					if err != nil {
 						return err
 					}
@@ -17,6 +17,7 @@ package plan
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -307,6 +308,7 @@ type build struct {
 	db       string // name of schema
 	sql      string
 	e        engine.Engine
+	loc      *time.Location // time zone of the TIMESTAMP constants
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -29,6 +29,9 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		query, binderCtx := newQueryAndSelectCtx(plan.Query_SELECT)
 		nodeId, err := buildSelect(stmt, ctx, query, binderCtx)
 		query.Steps = append(query.Steps, nodeId)
+		if err == nil {
+			pushdownClusterOrder(query)
+		}
 		return &Plan{
 			Plan: &plan.Plan_Query{
 				Query: query,
@@ -61,6 +64,10 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildCreateTable(stmt, ctx)
 	case *tree.DropTable:
 		return buildDropTable(stmt, ctx)
+	case *tree.CreateView:
+		return buildCreateView(stmt, ctx)
+	case *tree.DropView:
+		return buildDropView(stmt, ctx)
 	case *tree.CreateIndex:
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
@@ -69,6 +76,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildShowCreateDatabase(stmt, ctx)
 	case *tree.ShowCreateTable:
 		return buildShowCreateTable(stmt, ctx)
+	case *tree.ShowCreateView:
+		return buildShowCreateView(stmt, ctx)
 	case *tree.ShowDatabases:
 		return buildShowDatabases(stmt, ctx)
 	case *tree.ShowTables:
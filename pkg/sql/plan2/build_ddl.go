@@ -16,12 +16,14 @@ package plan2
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error) {
@@ -48,8 +50,19 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		}
 	}
 
+	// the compression of the table is the default compression of its columns
+	alg := plan.CompressType_Lz4
+	for _, option := range stmt.Options {
+		if opt, ok := option.(*tree.TableOptionCompression); ok {
+			var err error
+			if alg, err = getCompressType(opt.Compression); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef, alg)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +85,26 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionClusterBy:
+			names := make([]string, len(opt.ColumnList))
+			for idx, col := range opt.ColumnList {
+				names[idx] = col.Parts[0]
+				if !hasColumn(createTable.TableDef, names[idx]) {
+					return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown cluster by column '%s'", names[idx]))
+				}
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   engine.ClusterByProperty,
+								Value: strings.Join(names, ","),
+							},
+						},
+					},
+				},
+			})
 		// todo confirm: option data store like this?
 		case *tree.TableOptionComment:
 			properties := []*plan.Property{
@@ -87,10 +120,12 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCompression:
+			// set to the columns
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
-		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum, *tree.TableOptionCompression,
+		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum,
 		// 	*tree.TableOptionConnection, *tree.TableOptionPassword, *tree.TableOptionKeyBlockSize,
 		// 	*tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionDelayKeyWrite,
 		// 	*tree.TableOptionRowFormat, *tree.TableOptionStatsPersistent, *tree.TableOptionStatsAutoRecalc,
@@ -120,7 +155,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}, nil
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef, alg plan.CompressType) error {
 	var primaryKeys []string
 	for _, item := range defs {
 		switch def := item.(type) {
@@ -129,13 +164,13 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 			if err != nil {
 				return err
 			}
-			defultValue, err := getDefaultExprFromColumn(def, colType)
+			defultValue, err := getDefaultExprFromColumn(def, colType, ctx.TimeZone())
 			if err != nil {
 				return err
 			}
 			col := &ColDef{
 				Name:    def.Name.Parts[0],
-				Alg:     plan.CompressType_Lz4,
+				Alg:     alg,
 				Typ:     colType,
 				Default: defultValue,
 			}
@@ -143,8 +178,13 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 
 			var pks []string
 			for _, attr := range def.Attributes {
-				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+				switch attr := attr.(type) {
+				case *tree.AttributePrimaryKey:
 					pks = append(pks, def.Name.Parts[0])
+				case *tree.AttributeCompression:
+					if col.Alg, err = getCompressType(attr.Compression); err != nil {
+						return err
+					}
 				}
 			}
 			if len(pks) > 0 {
@@ -221,6 +261,123 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 	return nil
 }
 
+// buildCreateView binds the definition of the view in its database to check
+// its columns. The privileges on the base tables are NOT checked, neither
+// here nor when the view is expanded, as there is no privilege model to
+// check them against yet.
+func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error) {
+	if stmt.Temporary {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("temporary view unsupport now; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+	}
+	createTable := &plan.CreateTable{
+		IfNotExists: stmt.IfNotExists,
+		Database:    string(stmt.Name.SchemaName),
+		TableDef: &TableDef{
+			Name: string(stmt.Name.ObjectName),
+		},
+	}
+
+	dbName := createTable.Database
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	if !stmt.IfNotExists {
+		_, def := ctx.Resolve(dbName, createTable.TableDef.Name)
+		if def != nil {
+			return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("table '%v' exist", createTable.TableDef.Name))
+		}
+	}
+
+	// bind the definition in the database of the view, as it will be expanded
+	query, binderCtx := newQueryAndSelectCtx(plan.Query_SELECT)
+	viewCtx := &viewCompilerContext{
+		CompilerContext: ctx,
+		dbName:          dbName,
+	}
+	nodeId, err := buildSelect(stmt.AsSource, viewCtx, query, binderCtx)
+	if err != nil {
+		return nil, err
+	}
+	projectList := query.Nodes[nodeId].ProjectList
+	if len(stmt.ColNames) > 0 && len(stmt.ColNames) != len(projectList) {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("view '%v' has %v columns in its select list but %v columns specified", createTable.TableDef.Name, len(projectList), len(stmt.ColNames)))
+	}
+
+	names := make(map[string]bool)
+	createTable.TableDef.Cols = make([]*ColDef, len(projectList))
+	for idx, expr := range projectList {
+		name := expr.ColName
+		if len(stmt.ColNames) > 0 {
+			name = string(stmt.ColNames[idx])
+		}
+		if names[name] {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("duplicate column name '%v' in view '%v'", name, createTable.TableDef.Name))
+		}
+		names[name] = true
+		createTable.TableDef.Cols[idx] = &ColDef{
+			Name: name,
+			Typ:  expr.Typ,
+		}
+	}
+	view := *stmt
+	view.IfNotExists = false
+	createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_View{
+			View: &plan.ViewDef{
+				View: tree.String(&view, dialect.MYSQL),
+			},
+		},
+	})
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_VIEW,
+				Definition: &plan.DataDefinition_CreateTable{
+					CreateTable: createTable,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildDropView(stmt *tree.DropView, ctx CompilerContext) (*Plan, error) {
+	dropTable := &plan.DropTable{
+		IfExists: stmt.IfExists,
+	}
+	if len(stmt.Names) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "support drop one view now")
+	}
+	dropTable.Database = string(stmt.Names[0].SchemaName)
+	if dropTable.Database == "" {
+		dropTable.Database = ctx.DefaultDatabase()
+	}
+	dropTable.Table = string(stmt.Names[0].ObjectName)
+	if !stmt.IfExists {
+		if !ctx.DatabaseExists(dropTable.Database) {
+			return nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("database '%v' doesn't exist", dropTable.Database))
+		}
+		_, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table)
+		if tableDef == nil {
+			return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("view '%v' doesn't exist", dropTable.Table))
+		}
+		if getViewSql(tableDef) == "" {
+			return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not VIEW", dropTable.Database, dropTable.Table))
+		}
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_VIEW,
+				Definition: &plan.DataDefinition_DropTable{
+					DropTable: dropTable,
+				},
+			},
+		},
+	}, nil
+}
+
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	dropTable := &plan.DropTable{
 		IfExists: stmt.IfExists,
@@ -241,6 +398,9 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 		if tableDef == nil {
 			return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", dropTable.Table))
 		}
+		if getViewSql(tableDef) != "" {
+			return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is a view, use drop view", dropTable.Database, dropTable.Table))
+		}
 	}
 
 	return &Plan{
@@ -336,3 +496,26 @@ func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
 	// 	},
 	// }, nil
 }
+
+func hasColumn(tableDef *TableDef, name string) bool {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func getCompressType(name string) (plan.CompressType, error) {
+	switch strings.ToLower(name) {
+	case "none":
+		return plan.CompressType_None, nil
+	case "lz4":
+		return plan.CompressType_Lz4, nil
+	case "zstd":
+		return plan.CompressType_Zstd, nil
+	case "snappy":
+		return plan.CompressType_Snappy, nil
+	}
+	return plan.CompressType_None, errors.New(errno.InvalidOptionValue, fmt.Sprintf("unsupported compression '%s'", name))
+}
@@ -0,0 +1,693 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	existsSubquery = iota // EXISTS, any number of columns
+	columnSubquery        // IN, ANY and ALL, a single column
+	scalarSubquery        // a single value
+)
+
+// decorrelator rewrites the WHERE conjuncts of a node that contain subqueries
+// correlated with it into a chain of joins on top of the node:
+//
+//	EXISTS and NOT EXISTS conjuncts become SEMI and ANTI joins
+//	IN conjuncts whose left side is a column become SEMI joins
+//	other EXISTS, IN, ANY and ALL predicates become MARK joins, the predicate
+//	is replaced by the mark column of the join
+//	scalar subqueries become SINGLE joins, the subquery is replaced by the
+//	value column of the join
+//
+// The `inner = outer` predicates of a subquery become the keys of its join.
+type decorrelator struct {
+	query   *Query
+	node    *Node
+	root    int32   // top of the join chain
+	columns []*Expr // output columns of the top of the join chain
+}
+
+// correlatedSubquery is a subquery whose correlation with the node can be
+// pulled up into join keys.
+type correlatedSubquery struct {
+	root  *Node
+	node  *Node   // the node holding the correlated predicates, root or its child
+	where []*Expr // the predicates of node which are not pulled up
+	inner []*Expr
+	outer []*Expr
+	keys  []*Expr // inner = outer on the outputs of the join children
+}
+
+type pendingJoin struct {
+	joinType plan.Node_JoinFlag
+	sub      *correlatedSubquery
+	onList   []*Expr // conditions besides the keys
+	extra    *Expr   // column appended to the output, nil for SEMI and ANTI
+}
+
+// rewriter rewrites one conjunct, nothing is changed in the query until the
+// whole conjunct has been rewritten.
+type rewriter struct {
+	d     *decorrelator
+	width int32
+	joins []*pendingJoin
+}
+
+// decorrelateWhere returns the node the rest of the SELECT is built on, which
+// is a PROJECT node filtering the rewritten conjuncts and restoring the
+// columns of the node if any subquery was decorrelated. Conjuncts that cannot
+// be decorrelated are left unchanged.
+func decorrelateWhere(query *Query, nodeId int32) int32 {
+	node := query.Nodes[nodeId]
+	d := &decorrelator{
+		query:   query,
+		node:    node,
+		root:    nodeId,
+		columns: node.ProjectList,
+	}
+	var where, conds []*Expr
+	for _, cond := range node.WhereList {
+		r := &rewriter{d: d, width: int32(len(d.columns))}
+		if r.semiJoin(cond) {
+			d.commit(r.joins)
+			continue
+		}
+		expr, ok := r.rewrite(cond)
+		if !ok || len(r.joins) == 0 {
+			where = append(where, cond)
+			continue
+		}
+		d.commit(r.joins)
+		conds = append(conds, expr)
+	}
+	if d.root == nodeId {
+		return nodeId
+	}
+	node.WhereList = where
+	projectList := make([]*Expr, len(node.ProjectList))
+	for i, expr := range node.ProjectList {
+		projectList[i] = &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+	return appendQueryNode(query, &Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{d.root},
+		WhereList:   conds,
+		ProjectList: projectList,
+	})
+}
+
+// commit pulls the correlated predicates up from the subqueries and appends
+// the joins to the chain.
+func (d *decorrelator) commit(joins []*pendingJoin) {
+	for _, j := range joins {
+		sub := j.sub
+		sub.node.WhereList = sub.where
+		if sub.node != sub.root {
+			// the group operator emits the aggregates after the group by
+			// columns, which grow by the pulled up inner columns
+			shift := int32(len(sub.inner))
+			for _, expr := range sub.root.ProjectList {
+				shiftAggRefs(expr, shift)
+			}
+			for _, expr := range sub.root.WhereList {
+				shiftAggRefs(expr, shift)
+			}
+		}
+		for _, inner := range sub.inner {
+			sub.node.ProjectList = append(sub.node.ProjectList, &Expr{
+				Typ:  inner.Typ,
+				Expr: inner.Expr,
+			})
+			if sub.node != sub.root {
+				sub.root.GroupBy = append(sub.root.GroupBy, &Expr{
+					Typ: inner.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &ColRef{
+							RelPos: 0,
+							ColPos: int32(len(sub.node.ProjectList) - 1),
+						},
+					},
+				})
+				sub.root.ProjectList = append(sub.root.ProjectList, &Expr{
+					Typ: inner.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &ColRef{
+							RelPos: -1,
+							ColPos: int32(len(sub.root.GroupBy) - 1),
+						},
+					},
+				})
+			}
+		}
+		projectList := make([]*Expr, len(d.columns), len(d.columns)+1)
+		for i, col := range d.columns {
+			projectList[i] = &Expr{
+				Typ:       col.Typ,
+				TableName: col.TableName,
+				ColName:   col.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+					},
+				},
+			}
+		}
+		if j.extra != nil {
+			projectList = append(projectList, j.extra)
+		}
+		d.columns = projectList
+		d.root = appendQueryNode(d.query, &Node{
+			NodeType:    plan.Node_JOIN,
+			JoinType:    j.joinType,
+			Children:    []int32{d.root, sub.root.NodeId},
+			OnList:      append(sub.keys, j.onList...),
+			ProjectList: projectList,
+		})
+	}
+}
+
+// shiftAggRefs moves the aggregate references of expr by shift columns.
+func shiftAggRefs(expr *Expr, shift int32) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos == -2 {
+			e.Col.ColPos += shift
+		}
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			shiftAggRefs(arg, shift)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			shiftAggRefs(arg, shift)
+		}
+	}
+}
+
+// semiJoin rewrites a conjunct which is [NOT] EXISTS or IN on a column.
+func (r *rewriter) semiJoin(cond *Expr) bool {
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	joinType := plan.Node_SEMI
+	if funcName(f) == "not" {
+		if f, ok = f.F.Args[0].Expr.(*plan.Expr_F); !ok || funcName(f) != "exists" {
+			return false
+		}
+		joinType = plan.Node_ANTI
+	}
+	switch funcName(f) {
+	case "exists":
+		sub, _ := r.d.subquery(f.F.Args[0], existsSubquery)
+		if sub == nil {
+			return false
+		}
+		r.joins = append(r.joins, &pendingJoin{joinType: joinType, sub: sub})
+		return true
+	case "in":
+		x, ok := r.remap(f.F.Args[0])
+		if !ok {
+			return false
+		}
+		sub, _ := r.d.subquery(f.F.Args[1], columnSubquery)
+		if sub == nil || x.Typ.Id != sub.root.ProjectList[0].Typ.Id {
+			return false
+		}
+		key, _, err := getFunctionExprByNameAndPlanExprs("=", []*Expr{x, subqueryColumn(sub)})
+		if err != nil {
+			return false
+		}
+		r.joins = append(r.joins, &pendingJoin{joinType: joinType, sub: sub, onList: []*Expr{key}})
+		return true
+	}
+	return false
+}
+
+// rewrite returns a copy of expr with the correlated subqueries replaced by
+// the columns of their joins, and the columns of the node replaced by the
+// columns of the join chain.
+func (r *rewriter) rewrite(expr *Expr) (*Expr, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return r.remap(expr)
+	case *plan.Expr_Sub:
+		sub, correlated := r.d.subquery(expr, scalarSubquery)
+		if !correlated {
+			return expr, true
+		}
+		if sub == nil {
+			return nil, false
+		}
+		return r.single(expr, sub), true
+	case *plan.Expr_List:
+		list := make([]*Expr, len(e.List.List))
+		for i, arg := range e.List.List {
+			var ok bool
+			if list[i], ok = r.rewrite(arg); !ok {
+				return nil, false
+			}
+		}
+		return &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{List: list},
+			},
+		}, true
+	case *plan.Expr_F:
+		switch funcName(e) {
+		case "exists":
+			sub, correlated := r.d.subquery(e.F.Args[0], existsSubquery)
+			if !correlated {
+				return expr, true
+			}
+			if sub == nil {
+				return nil, false
+			}
+			return r.mark(expr, sub, nil), true
+		case "in":
+			if _, ok := e.F.Args[1].Expr.(*plan.Expr_Sub); !ok {
+				break
+			}
+			return r.quantified(expr, "any", "=", e.F.Args[0], e.F.Args[1])
+		case "any", "all":
+			cmp, ok := e.F.Args[0].Expr.(*plan.Expr_F)
+			if !ok || len(cmp.F.Args) != 2 {
+				return nil, false
+			}
+			return r.quantified(expr, funcName(e), funcName(cmp), cmp.F.Args[0], cmp.F.Args[1])
+		case "cast":
+			if _, ok := e.F.Args[0].Expr.(*plan.Expr_Sub); !ok {
+				break
+			}
+			sub, correlated := r.d.subquery(e.F.Args[0], scalarSubquery)
+			if !correlated {
+				return expr, true
+			}
+			if sub == nil {
+				return nil, false
+			}
+			// the cast depends on the type of its argument
+			castExpr, err := appendCastExpr(r.single(e.F.Args[0], sub), expr.Typ)
+			if err != nil {
+				return nil, false
+			}
+			castExpr.ColName = expr.ColName
+			return castExpr, true
+		}
+		args := make([]*Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			var ok bool
+			if args[i], ok = r.rewrite(arg); !ok {
+				return nil, false
+			}
+		}
+		return &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}, true
+	}
+	return expr, true
+}
+
+// quantified rewrites `x op ANY|ALL (subquery)`, IN being `= ANY`.
+func (r *rewriter) quantified(expr *Expr, quantifier, op string, left, right *Expr) (*Expr, bool) {
+	x, ok := r.rewrite(left)
+	if !ok {
+		return nil, false
+	}
+	arg := right
+	if f, ok := arg.Expr.(*plan.Expr_F); ok && funcName(f) == "cast" {
+		arg = f.F.Args[0]
+	}
+	sub, correlated := r.d.subquery(arg, columnSubquery)
+	if !correlated {
+		// rebuild the expression on the new left side
+		if funcName(expr.Expr.(*plan.Expr_F)) == "in" {
+			return copyFunction(expr, x, right), true
+		}
+		cmp := expr.Expr.(*plan.Expr_F).F.Args[0]
+		return copyFunction(expr, copyFunction(cmp, x, right)), true
+	}
+	if sub == nil {
+		return nil, false
+	}
+	// the mark join compares a column of each side
+	if col, ok := stripCast(x).Expr.(*plan.Expr_Col); !ok || col.Col.RelPos != 0 {
+		return nil, false
+	}
+	cond, _, err := getFunctionExprByNameAndPlanExprs(op, []*Expr{x, subqueryColumn(sub)})
+	if err != nil {
+		return nil, false
+	}
+	if cond, _, err = getFunctionExprByNameAndPlanExprs(quantifier, []*Expr{cond}); err != nil {
+		return nil, false
+	}
+	return r.mark(expr, sub, cond), true
+}
+
+func (r *rewriter) mark(expr *Expr, sub *correlatedSubquery, cond *Expr) *Expr {
+	j := &pendingJoin{
+		joinType: plan.Node_MARK,
+		sub:      sub,
+		extra: &Expr{
+			Typ: &plan.Type{Id: plan.Type_BOOL},
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: -1,
+					ColPos: 0,
+				},
+			},
+		},
+	}
+	if cond != nil {
+		j.onList = []*Expr{cond}
+	}
+	return r.appendJoin(expr, j)
+}
+
+func (r *rewriter) single(expr *Expr, sub *correlatedSubquery) *Expr {
+	return r.appendJoin(expr, &pendingJoin{
+		joinType: plan.Node_SINGLE,
+		sub:      sub,
+		extra:    subqueryColumn(sub),
+	})
+}
+
+// appendJoin returns the column of the join which replaces expr
+func (r *rewriter) appendJoin(expr *Expr, j *pendingJoin) *Expr {
+	r.joins = append(r.joins, j)
+	r.width++
+	return &Expr{
+		Typ:     j.extra.Typ,
+		ColName: expr.ColName,
+		Expr: &plan.Expr_Col{
+			Col: &ColRef{
+				RelPos: 0,
+				ColPos: r.width - 1,
+			},
+		},
+	}
+}
+
+// remap maps a column of the input of the node to its output
+func (r *rewriter) remap(expr *Expr) (*Expr, bool) {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return nil, false
+	}
+	for i, e := range r.d.node.ProjectList {
+		if c, ok := e.Expr.(*plan.Expr_Col); ok && c.Col.RelPos == col.Col.RelPos && c.Col.ColPos == col.Col.ColPos {
+			return &Expr{
+				Typ:       expr.Typ,
+				TableName: expr.TableName,
+				ColName:   expr.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+					},
+				},
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// subquery checks whether the subquery of expr is correlated with the node,
+// and if so whether its correlation can be pulled up. It returns a nil
+// subquery when it is not correlated or cannot be decorrelated.
+func (d *decorrelator) subquery(expr *Expr, kind int) (*correlatedSubquery, bool) {
+	e, ok := expr.Expr.(*plan.Expr_Sub)
+	if !ok {
+		return nil, false
+	}
+	sub := &correlatedSubquery{root: d.query.Nodes[e.Sub.NodeId]}
+	sub.node = sub.root
+	if sub.root.NodeType == plan.Node_AGG {
+		sub.node = d.query.Nodes[sub.root.Children[0]]
+	}
+	pulled := make(map[*Expr]bool)
+	for _, w := range sub.node.WhereList {
+		if inner, outer, ok := d.correlatedPredicate(w); ok {
+			pulled[w] = true
+			sub.inner = append(sub.inner, inner)
+			sub.outer = append(sub.outer, outer)
+			continue
+		}
+		sub.where = append(sub.where, w)
+	}
+
+	ids := make(map[int32]bool)
+	var refs []*plan.CorrColRef
+	d.collect(sub.root.NodeId, ids, &refs, pulled)
+	correlated := len(pulled) > 0
+	external := false
+	for _, ref := range refs {
+		if ref.NodeId == d.node.NodeId {
+			correlated = true
+		}
+		if !ids[ref.NodeId] {
+			external = true
+		}
+	}
+	if !correlated {
+		return nil, false
+	}
+	if external || len(pulled) == 0 || !d.decorrelatable(sub, kind) {
+		return nil, true
+	}
+
+	// the keys are built on the outputs of the join children
+	width := int32(len(sub.root.ProjectList))
+	for i, inner := range sub.inner {
+		outer := sub.outer[i]
+		key, _, err := getFunctionExprByNameAndPlanExprs("=", []*Expr{
+			{
+				Typ:     outer.Typ,
+				ColName: outer.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: outer.Expr.(*plan.Expr_Corr).Corr.ColPos,
+					},
+				},
+			},
+			{
+				Typ:     inner.Typ,
+				ColName: inner.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 1,
+						ColPos: width + int32(i),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, true
+		}
+		sub.keys = append(sub.keys, key)
+	}
+	return sub, true
+}
+
+// correlatedPredicate matches `inner = outer` where outer is a column of the
+// node and inner does not depend on any outer query.
+func (d *decorrelator) correlatedPredicate(expr *Expr) (*Expr, *Expr, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || funcName(f) != "=" || len(f.F.Args) != 2 {
+		return nil, nil, false
+	}
+	inner, outer := f.F.Args[0], f.F.Args[1]
+	if _, ok := inner.Expr.(*plan.Expr_Corr); ok {
+		inner, outer = outer, inner
+	}
+	corr, ok := outer.Expr.(*plan.Expr_Corr)
+	if !ok || corr.Corr.NodeId != d.node.NodeId {
+		return nil, nil, false
+	}
+	if inner.Typ.Id != outer.Typ.Id || !isLocalExpr(inner) {
+		return nil, nil, false
+	}
+	return inner, outer, true
+}
+
+func (d *decorrelator) decorrelatable(sub *correlatedSubquery, kind int) bool {
+	root := sub.root
+	if root.Limit != nil || root.Offset != nil || sub.node.Limit != nil || sub.node.Offset != nil {
+		return false
+	}
+	switch root.NodeType {
+	case plan.Node_TABLE_SCAN, plan.Node_JOIN, plan.Node_PROJECT:
+		if sub.node.NodeType == plan.Node_JOIN && len(sub.where) > 0 {
+			// the predicates of a join node are its join conditions
+			return false
+		}
+	case plan.Node_AGG:
+		if len(root.GroupingSet) > 0 {
+			return false
+		}
+		if len(root.GroupBy) == 0 {
+			// a scalar aggregate returns a row even if nothing matches,
+			// which only a single join preserves when the aggregates of
+			// no rows are null
+			if kind != scalarSubquery || len(root.WhereList) > 0 {
+				return false
+			}
+			for _, agg := range root.AggList {
+				if f, ok := agg.Expr.(*plan.Expr_F); !ok || strings.Contains(funcName(f), "count") {
+					return false
+				}
+			}
+		}
+	default:
+		return false
+	}
+	if kind != existsSubquery && len(root.ProjectList) != 1 {
+		return false
+	}
+	return true
+}
+
+// collect records the nodes of the subtree of nodeId in ids and the
+// correlated references of their expressions in refs, skipping the
+// expressions in skip.
+func (d *decorrelator) collect(nodeId int32, ids map[int32]bool, refs *[]*plan.CorrColRef, skip map[*Expr]bool) {
+	if ids[nodeId] {
+		return
+	}
+	ids[nodeId] = true
+	node := d.query.Nodes[nodeId]
+	var walk func(expr *Expr)
+	walk = func(expr *Expr) {
+		if expr == nil || skip[expr] {
+			return
+		}
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Corr:
+			*refs = append(*refs, e.Corr)
+		case *plan.Expr_Sub:
+			d.collect(e.Sub.NodeId, ids, refs, skip)
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				walk(arg)
+			}
+		case *plan.Expr_List:
+			for _, arg := range e.List.List {
+				walk(arg)
+			}
+		}
+	}
+	for _, exprs := range [][]*Expr{node.ProjectList, node.WhereList, node.OnList, node.GroupBy, node.AggList} {
+		for _, expr := range exprs {
+			walk(expr)
+		}
+	}
+	for _, orderBy := range node.OrderBy {
+		walk(orderBy.Expr)
+	}
+	walk(node.Limit)
+	walk(node.Offset)
+	for _, child := range node.Children {
+		d.collect(child, ids, refs, skip)
+	}
+}
+
+// isLocalExpr reports whether expr depends on neither an outer query nor a subquery
+func isLocalExpr(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Corr, *plan.Expr_Sub:
+		return false
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if !isLocalExpr(arg) {
+				return false
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			if !isLocalExpr(arg) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func subqueryColumn(sub *correlatedSubquery) *Expr {
+	return &Expr{
+		Typ: sub.root.ProjectList[0].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &ColRef{
+				RelPos: 1,
+				ColPos: 0,
+			},
+		},
+	}
+}
+
+func copyFunction(expr *Expr, args ...*Expr) *Expr {
+	return &Expr{
+		Typ:       expr.Typ,
+		TableName: expr.TableName,
+		ColName:   expr.ColName,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: expr.Expr.(*plan.Expr_F).F.Func,
+				Args: args,
+			},
+		},
+	}
+}
+
+func stripCast(expr *Expr) *Expr {
+	for {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || funcName(f) != "cast" {
+			return expr
+		}
+		expr = f.F.Args[0]
+	}
+}
+
+func funcName(f *plan.Expr_F) string {
+	return f.F.Func.GetObjName()
+}
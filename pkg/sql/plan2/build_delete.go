@@ -22,6 +22,9 @@ import (
 )
 
 func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
+	if err := checkUpdatableTable(stmt.Table, ctx, "delete from"); err != nil {
+		return nil, err
+	}
 	selectStmt := &tree.Select{
 		Select: &tree.SelectClause{
 			// todo confirm how to set row_id
@@ -392,10 +392,147 @@ func buildFunctionExpr(astExpr *tree.FuncExpr, ctx CompilerContext, query *Query
 	// 	}, nil
 	// }
 
-	return getFunctionExprByNameAndAstExprs(funcName, astExpr.Exprs, ctx, query, node, binderCtx, needAgg)
+	exprs := astExpr.Exprs
+	switch funcName {
+	case "GROUPING":
+		return buildGroupingExpr(astExpr, node, needAgg)
+	case "PERCENTILE_CONT":
+		if exprs, err = buildPercentileArgs(astExpr); err != nil {
+			return nil, false, err
+		}
+	case "GROUP_CONCAT":
+		if len(exprs) != 1 {
+			return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", astExpr))
+		}
+		// the separator is passed to group_concat as its second argument
+		exprs = []tree.Expr{exprs[0], tree.NewNumVal(constant.MakeString(astExpr.Separator), astExpr.Separator, false)}
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		switch funcName {
+		case "COUNT", "SUM", "AVG":
+		default:
+			return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", astExpr))
+		}
+	}
+
+	var orderBy []*plan.OrderBySpec
+	if len(astExpr.OrderBy) > 0 {
+		if orderBy, err = buildAggregateOrderBy(astExpr, ctx, query, node, binderCtx, needAgg); err != nil {
+			return nil, false, err
+		}
+	}
+	expr, isAgg, aggIdx, err := buildFunctionExprWithAggIdx(funcName, exprs, ctx, query, node, binderCtx, needAgg)
+	if err != nil {
+		return nil, false, err
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT || orderBy != nil {
+		if aggIdx < 0 {
+			return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not an aggregate function", funcName))
+		}
+		f := node.AggList[aggIdx].Expr.(*plan.Expr_F).F
+		f.Distinct = astExpr.Type == tree.FUNC_TYPE_DISTINCT
+		f.OrderBy = orderBy
+	}
+	return expr, isAgg, nil
+}
+
+// buildPercentileArgs returns the arguments of percentile_cont, the percentile
+// must be a number between 0 and 1 and is always passed as a float.
+func buildPercentileArgs(astExpr *tree.FuncExpr) ([]tree.Expr, error) {
+	if len(astExpr.Exprs) != 2 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' needs 2 arguments", astExpr))
+	}
+	if v, ok := astExpr.Exprs[1].(*tree.NumVal); ok {
+		switch v.Value.Kind() {
+		case constant.Int, constant.Float:
+			if f, _ := constant.Float64Val(v.Value); f >= 0 && f <= 1 {
+				return []tree.Expr{astExpr.Exprs[0], tree.NewNumVal(constant.MakeFloat64(f), v.String(), false)}, nil
+			}
+		}
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("the percentile of '%v' must be a number between 0 and 1", astExpr))
+}
+
+// buildGroupingExpr builds GROUPING(a, b, ...) as grouping_id(gid, pos_a, pos_b, ...), gid is
+// the grouping set id column that follows the group by columns of the AGG node.
+func buildGroupingExpr(astExpr *tree.FuncExpr, node *Node, needAgg bool) (*Expr, bool, error) {
+	if !needAgg || len(node.GroupingSet) == 0 {
+		return nil, false, errors.New(errno.GroupingError, fmt.Sprintf("'%v' is only allowed with GROUPING SETS, ROLLUP or CUBE", astExpr))
+	}
+	if len(astExpr.Exprs) > maxGroupingColumns {
+		return nil, false, errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many arguments in '%v'", astExpr))
+	}
+	int64Typ := &plan.Type{Id: plan.Type_INT64}
+	args := make([]*Expr, 0, len(astExpr.Exprs)+1)
+	args = append(args, &Expr{
+		Typ: int64Typ,
+		Expr: &plan.Expr_Col{
+			Col: &ColRef{
+				RelPos: -1,
+				ColPos: int32(len(node.GroupBy)),
+			},
+		},
+	})
+	for _, arg := range astExpr.Exprs {
+		colName := tree.String(arg, dialect.MYSQL)
+		pos := -1
+		for i, col := range node.GroupBy {
+			if colName == col.ColName {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			return nil, false, errors.New(errno.GroupingError, fmt.Sprintf("argument '%s' of '%v' must be a GROUP BY expression", colName, astExpr))
+		}
+		args = append(args, &Expr{
+			Typ: int64Typ,
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Value: &plan.Const_Ival{
+						Ival: int64(pos),
+					},
+				},
+			},
+		})
+	}
+	expr, _, err := getFunctionExprByNameAndPlanExprs("grouping_id", args)
+	if err != nil {
+		return nil, false, err
+	}
+	return expr, true, nil
+}
+
+// buildAggregateOrderBy builds the order by expressions of an aggregate such
+// as group_concat, they are evaluated on the same rows as the arguments.
+func buildAggregateOrderBy(astExpr *tree.FuncExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) ([]*plan.OrderBySpec, error) {
+	aggCnt := len(node.AggList)
+	orderBy := make([]*plan.OrderBySpec, len(astExpr.OrderBy))
+	for i, order := range astExpr.OrderBy {
+		expr, _, err := buildExpr(order.Expr, ctx, query, node, binderCtx, needAgg)
+		if err != nil {
+			return nil, err
+		}
+		if len(node.AggList) != aggCnt {
+			return nil, errors.New(errno.GroupingError, fmt.Sprintf("aggregate function in the order by of '%v' is not allowed", astExpr))
+		}
+		orderBy[i] = &plan.OrderBySpec{Expr: expr}
+		switch order.Direction {
+		case tree.DefaultDirection:
+			orderBy[i].Flag = plan.OrderBySpec_INTERNAL
+		case tree.Ascending:
+			orderBy[i].Flag = plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy[i].Flag = plan.OrderBySpec_DESC
+		}
+	}
+	return orderBy, nil
 }
 
 func buildComparisonExpr(astExpr *tree.ComparisonExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (resultExpr *Expr, isAgg bool, err error) {
+	if astExpr.SubOp >= tree.ANY {
+		return buildQuantifiedComparisonExpr(astExpr, ctx, query, node, binderCtx, needAgg)
+	}
 	switch astExpr.Op {
 	case tree.EQUAL:
 		return getFunctionExprByNameAndAstExprs("=", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
@@ -418,6 +555,15 @@ func buildComparisonExpr(astExpr *tree.ComparisonExpr, ctx CompilerContext, quer
 		}
 		resultExpr, _, err = getFunctionExprByNameAndPlanExprs("NOT", []*Expr{resultExpr})
 		return
+	case tree.REG_MATCH:
+		return getFunctionExprByNameAndAstExprs("REGEXP", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
+	case tree.NOT_REG_MATCH:
+		resultExpr, isAgg, err = getFunctionExprByNameAndAstExprs("REGEXP", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
+		if err != nil {
+			return
+		}
+		resultExpr, _, err = getFunctionExprByNameAndPlanExprs("NOT", []*Expr{resultExpr})
+		return
 	case tree.IN:
 		return getFunctionExprByNameAndAstExprs("IN", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
 	case tree.NOT_IN:
@@ -431,6 +577,40 @@ func buildComparisonExpr(astExpr *tree.ComparisonExpr, ctx CompilerContext, quer
 	return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", astExpr))
 }
 
+// buildQuantifiedComparisonExpr builds `a op ANY|SOME|ALL (subquery)` as any(op(a, subquery)) or all(op(a, subquery))
+func buildQuantifiedComparisonExpr(astExpr *tree.ComparisonExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (resultExpr *Expr, isAgg bool, err error) {
+	var name string
+	switch astExpr.Op {
+	case tree.EQUAL:
+		name = "="
+	case tree.LESS_THAN:
+		name = "<"
+	case tree.LESS_THAN_EQUAL:
+		name = "<="
+	case tree.GREAT_THAN:
+		name = ">"
+	case tree.GREAT_THAN_EQUAL:
+		name = ">="
+	case tree.NOT_EQUAL:
+		name = "<>"
+	default:
+		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", astExpr))
+	}
+	if _, ok := astExpr.Right.(*tree.Subquery); !ok {
+		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' needs a subquery", astExpr))
+	}
+	resultExpr, isAgg, err = getFunctionExprByNameAndAstExprs(name, []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
+	if err != nil {
+		return
+	}
+	quantifier := "ANY"
+	if astExpr.SubOp == tree.ALL {
+		quantifier = "ALL"
+	}
+	resultExpr, _, err = getFunctionExprByNameAndPlanExprs(quantifier, []*Expr{resultExpr})
+	return
+}
+
 func buildUnaryExpr(astExpr *tree.UnaryExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (expr *Expr, isAgg bool, err error) {
 	switch astExpr.Op {
 	case tree.UNARY_MINUS:
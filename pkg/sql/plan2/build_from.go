@@ -21,6 +21,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -84,6 +86,9 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 			if tableDef == nil {
 				return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("table '%v' does not exist", tblName))
 			}
+			if viewSql := getViewSql(tableDef); viewSql != "" {
+				return buildView(viewSql, obj, tableDef, ctx, query)
+			}
 			node := &Node{
 				ObjRef:   obj,
 				TableDef: tableDef,
@@ -114,7 +119,12 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 		if err != nil {
 			return
 		}
-		nodeId, err = fillTableProjectList(query, nodeId, tbl.As)
+		// a table or a view without alias is referred to by its name
+		alias := tbl.As
+		if name, ok := tbl.Expr.(*tree.TableName); ok && alias.Alias == "" {
+			alias.Alias = name.ObjectName
+		}
+		nodeId, err = fillTableProjectList(query, nodeId, alias)
 
 		return
 
@@ -126,6 +136,75 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 	return 0, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table expr: %T", stmt))
 }
 
+// viewCompilerContext binds the definition of a view in the database of the view
+type viewCompilerContext struct {
+	CompilerContext
+	dbName string
+	// the views being expanded, to reject a view that refers to itself
+	views []string
+}
+
+func (vc *viewCompilerContext) DefaultDatabase() string {
+	return vc.dbName
+}
+
+func (vc *viewCompilerContext) Resolve(dbName string, tableName string) (*ObjectRef, *TableDef) {
+	if dbName == "" {
+		dbName = vc.dbName
+	}
+	return vc.CompilerContext.Resolve(dbName, tableName)
+}
+
+// buildView expands a view into a derived table named after the view
+func buildView(viewSql string, obj *ObjectRef, tableDef *TableDef, ctx CompilerContext, query *Query) (int32, error) {
+	stmt, err := parsers.ParseOne(dialect.MYSQL, viewSql)
+	if err != nil {
+		return 0, err
+	}
+	view, ok := stmt.(*tree.CreateView)
+	if !ok {
+		return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("view '%v' has an invalid definition", tableDef.Name))
+	}
+
+	viewCtx := &viewCompilerContext{
+		CompilerContext: ctx,
+		dbName:          ctx.DefaultDatabase(),
+	}
+	if obj != nil && obj.SchemaName != "" {
+		viewCtx.dbName = obj.SchemaName
+	}
+	if parent, ok := ctx.(*viewCompilerContext); ok {
+		viewCtx.CompilerContext = parent.CompilerContext
+		viewCtx.views = append(viewCtx.views, parent.views...)
+	}
+	name := viewCtx.dbName + "." + tableDef.Name
+	for _, v := range viewCtx.views {
+		if v == name {
+			return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("view '%v' refers to itself", name))
+		}
+	}
+	viewCtx.views = append(viewCtx.views, name)
+
+	binderCtx := &BinderContext{
+		columnAlias: make(map[string]*Expr),
+		cteTables:   make(map[string]*TableDef),
+	}
+	nodeId, err := buildSelect(view.AsSource, viewCtx, query, binderCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	// the tables under the view may have changed since it was created
+	node := query.Nodes[nodeId]
+	if len(node.ProjectList) != len(tableDef.Cols) {
+		return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("view '%v' references invalid table(s) or column(s)", name))
+	}
+	for idx, col := range tableDef.Cols {
+		node.ProjectList[idx].ColName = col.Name
+	}
+	return nodeId, nil
+}
+
 func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query, binderCtx *BinderContext) (nodeId int32, err error) {
 	var leftJoinType plan.Node_JoinFlag
 	var rightJoinType plan.Node_JoinFlag
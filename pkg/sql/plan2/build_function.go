@@ -101,6 +101,14 @@ func getFunctionExprByNameAndPlanExprs(name string, exprs []*Expr) (resultExpr *
 }
 
 func getFunctionExprByNameAndAstExprs(name string, astExprs []tree.Expr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (resultExpr *Expr, isAgg bool, err error) {
+	resultExpr, isAgg, _, err = buildFunctionExprWithAggIdx(name, astExprs, ctx, query, node, binderCtx, needAgg)
+	return
+}
+
+// buildFunctionExprWithAggIdx builds the function like getFunctionExprByNameAndAstExprs,
+// and also returns the position in node.AggList of the aggregate it appended, or -1 if none.
+func buildFunctionExprWithAggIdx(name string, astExprs []tree.Expr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (resultExpr *Expr, isAgg bool, aggIdx int, err error) {
+	aggIdx = -1
 	name = strings.ToLower(name)
 	args := make([]*Expr, len(astExprs))
 	// deal with special function
@@ -138,12 +146,13 @@ func getFunctionExprByNameAndAstExprs(name string, astExprs []tree.Expr, ctx Com
 		resultExpr, paramIsAgg, err = getFunctionExprByNameAndPlanExprs(name, args)
 		if paramIsAgg {
 			node.AggList = append(node.AggList, resultExpr)
+			aggIdx = len(node.AggList) - 1
 			resultExpr = &Expr{
 				Typ: resultExpr.Typ,
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: -2,
-						ColPos: int32(len(node.AggList) - 1),
+						ColPos: int32(aggIdx),
 					},
 				},
 			}
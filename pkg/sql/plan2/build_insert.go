@@ -203,6 +203,9 @@ func getInsertTable(stmt tree.TableExpr, ctx CompilerContext, query *Query) (*Ob
 		if tableDef == nil {
 			return nil, nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("table '%v' is not exist", tblName))
 		}
+		if getViewSql(tableDef) != "" {
+			return nil, nil, errors.New(errno.WrongObjectType, fmt.Sprintf("insert into view '%v' unsupport now", tblName))
+		}
 		return objRef, tableDef, nil
 	case *tree.ParenTableExpr:
 		return getInsertTable(tbl.Expr, ctx, query)
@@ -0,0 +1,179 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// pushdownClusterOrder makes use of the order the rows of the clustered
+// tables are kept in. The order by of a table scan, which is not used
+// otherwise, lists the output columns every batch of the scan is sorted on:
+//   - a sort on a prefix of the cluster key of the table it scans only has
+//     to merge the sorted batches
+//   - an inner join on a prefix of the cluster keys of both tables is run as
+//     a merge join
+func pushdownClusterOrder(query *Query) {
+	for _, node := range query.Nodes {
+		switch node.NodeType {
+		case plan.Node_SORT:
+			pushdownSort(node, query)
+		case plan.Node_JOIN:
+			pushdownMergeJoin(node, query)
+		}
+	}
+}
+
+func pushdownSort(node *Node, query *Query) {
+	if len(node.OrderBy) == 0 {
+		return
+	}
+	scan := query.Nodes[node.Children[0]]
+	keys := getScanClusterBy(scan)
+	if len(node.OrderBy) > len(keys) {
+		return
+	}
+	orderBy := make([]*plan.OrderBySpec, len(node.OrderBy))
+	for i, spec := range node.OrderBy {
+		if spec.Flag&plan.OrderBySpec_DESC != 0 {
+			return
+		}
+		col, ok := spec.Expr.Expr.(*plan.Expr_Col)
+		if !ok || col.Col.RelPos != 0 || getClusterKeyPos(scan, keys, col.Col.ColPos) != i {
+			return
+		}
+		orderBy[i] = getScanOrderBy(scan, col.Col.ColPos)
+	}
+	scan.OrderBy = orderBy
+}
+
+func pushdownMergeJoin(node *Node, query *Query) {
+	left, right := query.Nodes[node.Children[0]], query.Nodes[node.Children[1]]
+	// the outer side of a join is marked on its child
+	if node.JoinType != plan.Node_INNER || left.JoinType != plan.Node_INNER || right.JoinType != plan.Node_INNER {
+		return
+	}
+	if len(node.OnList) == 0 {
+		return
+	}
+	lkeys, rkeys := getScanClusterBy(left), getScanClusterBy(right)
+	if len(node.OnList) > len(lkeys) || len(node.OnList) > len(rkeys) {
+		return
+	}
+	lorderBy := make([]*plan.OrderBySpec, len(node.OnList))
+	rorderBy := make([]*plan.OrderBySpec, len(node.OnList))
+	for i, expr := range node.OnList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+			return
+		}
+		larg, rarg := f.F.Args[0], f.F.Args[1]
+		lcol, lok := larg.Expr.(*plan.Expr_Col)
+		rcol, rok := rarg.Expr.(*plan.Expr_Col)
+		if !lok || !rok {
+			return
+		}
+		if lcol.Col.RelPos == 1 {
+			lcol, rcol = rcol, lcol
+		}
+		if lcol.Col.RelPos != 0 || rcol.Col.RelPos != 1 {
+			return
+		}
+		// the keys are compared as they are stored
+		if larg.Typ.Id != rarg.Typ.Id || larg.Typ.Scale != rarg.Typ.Scale {
+			return
+		}
+		if getClusterKeyPos(left, lkeys, lcol.Col.ColPos) != i || getClusterKeyPos(right, rkeys, rcol.Col.ColPos) != i {
+			return
+		}
+		lorderBy[i] = getScanOrderBy(left, lcol.Col.ColPos)
+		rorderBy[i] = getScanOrderBy(right, rcol.Col.ColPos)
+	}
+	left.OrderBy, right.OrderBy = lorderBy, rorderBy
+}
+
+// getClusterBy returns the cluster by columns of a table
+func getClusterBy(tableDef *TableDef) []string {
+	for _, def := range tableDef.Defs {
+		if properties, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+			for _, property := range properties.Properties.Properties {
+				if property.Key == engine.ClusterByProperty {
+					keys := strings.Split(property.Value, ",")
+					for i := range keys {
+						keys[i] = strings.TrimSpace(keys[i])
+					}
+					return keys
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getScanClusterBy returns the cluster by columns of the table read by a
+// table scan, if the order of the scan is not used yet
+func getScanClusterBy(node *Node) []string {
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || len(node.OrderBy) > 0 {
+		return nil
+	}
+	return getClusterBy(node.TableDef)
+}
+
+// getClusterKeyPos returns the position in the cluster key of the column
+// output by a table scan at pos, or -1 if it is not a cluster by column
+func getClusterKeyPos(scan *Node, keys []string, pos int32) int {
+	if pos < 0 || int(pos) >= len(scan.ProjectList) {
+		return -1
+	}
+	expr := scan.ProjectList[pos]
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || col.Col.RelPos != 0 || int(col.Col.ColPos) >= len(scan.TableDef.Cols) {
+		return -1
+	}
+	// the sorted batches are merged by the comparators of the column type
+	if compare.New(types.T(expr.Typ.Id), false) == nil {
+		return -1
+	}
+	name := scan.TableDef.Cols[col.Col.ColPos].Name
+	for i, key := range keys {
+		if key == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func getScanOrderBy(scan *Node, pos int32) *plan.OrderBySpec {
+	expr := scan.ProjectList[pos]
+	return &plan.OrderBySpec{
+		Expr: &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: pos,
+				},
+			},
+		},
+		Flag: plan.OrderBySpec_ASC,
+	}
+}
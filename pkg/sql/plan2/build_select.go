@@ -341,6 +341,8 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		if err != nil {
 			return
 		}
+		nodeId = decorrelateWhere(query, nodeId)
+		node = query.Nodes[nodeId]
 	}
 
 	// build GROUP BY and HAVING clause and DISTINCT
@@ -356,12 +358,9 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 				return
 			}
 
-			aggNode.GroupBy = make([]*Expr, len(stmt.GroupBy))
-			for i, groupByExpr := range stmt.GroupBy {
-				aggNode.GroupBy[i], _, err = buildExpr(groupByExpr, ctx, query, aggNode, binderCtx, false)
-				if err != nil {
-					return
-				}
+			err = buildGroupBy(stmt.GroupBy, ctx, query, aggNode, binderCtx)
+			if err != nil {
+				return
 			}
 		} else if stmt.Distinct {
 			aggNode.GroupBy = make([]*Expr, len(stmt.Exprs))
@@ -386,7 +385,7 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		}
 
 		aggNode.ProjectList = make([]*Expr, len(aggNode.GroupBy)+len(aggNode.AggList))
-		var idx int32
+		var idx, colPos int32
 
 		for _, expr := range aggNode.GroupBy {
 			aggNode.ProjectList[idx] = &Expr{
@@ -396,11 +395,16 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: -1,
-						ColPos: idx,
+						ColPos: colPos,
 					},
 				},
 			}
 			idx++
+			colPos++
+		}
+		// the group operator emits the grouping set id after the group by columns
+		if len(aggNode.GroupingSet) > 0 {
+			colPos++
 		}
 
 		for _, expr := range aggNode.AggList {
@@ -411,11 +415,12 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: -2,
-						ColPos: idx,
+						ColPos: colPos,
 					},
 				},
 			}
 			idx++
+			colPos++
 		}
 
 		nodeId = appendQueryNode(query, aggNode)
@@ -426,3 +431,139 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 
 	return
 }
+
+const (
+	// the grouping set id has one bit per group by column
+	maxGroupingColumns = 64
+	maxGroupingSets    = 4096
+)
+
+// buildGroupBy builds the distinct group by expressions of the AGG node, and expands
+// ROLLUP, CUBE and GROUPING SETS into node.GroupingSet, each set being a list of
+// references to the group by columns it contains
+func buildGroupBy(groupBy tree.GroupBy, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext) error {
+	names := make(map[string]int32)
+	position := func(expr tree.Expr) (int32, error) {
+		name := tree.String(expr, dialect.MYSQL)
+		if pos, ok := names[name]; ok {
+			return pos, nil
+		}
+		e, _, err := buildExpr(expr, ctx, query, node, binderCtx, false)
+		if err != nil {
+			return 0, err
+		}
+		names[name] = int32(len(node.GroupBy))
+		node.GroupBy = append(node.GroupBy, e)
+		return names[name], nil
+	}
+
+	hasGroupingSets := false
+	sets := [][]int32{{}}
+	for _, item := range groupBy {
+		var itemSets []tree.Exprs
+		switch e := item.(type) {
+		case *tree.Rollup:
+			for i := len(e.Exprs); i >= 0; i-- {
+				itemSets = append(itemSets, e.Exprs[:i])
+			}
+			hasGroupingSets = true
+		case *tree.Cube:
+			if 1<<len(e.Exprs) > maxGroupingSets {
+				return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many grouping sets in '%s'", tree.String(e, dialect.MYSQL)))
+			}
+			itemSets = cubeSets(e.Exprs)
+			hasGroupingSets = true
+		case *tree.GroupingSets:
+			itemSets = e.Sets
+			hasGroupingSets = true
+		default:
+			itemSets = []tree.Exprs{{item}}
+		}
+
+		itemPoss := make([][]int32, len(itemSets))
+		for i, set := range itemSets {
+			for _, expr := range set {
+				pos, err := position(expr)
+				if err != nil {
+					return err
+				}
+				itemPoss[i] = append(itemPoss[i], pos)
+			}
+		}
+
+		// the grouping sets of several items are the cross product of their sets
+		if len(sets)*len(itemPoss) > maxGroupingSets {
+			return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many grouping sets in '%s'", tree.String(&groupBy, dialect.MYSQL)))
+		}
+		product := make([][]int32, 0, len(sets)*len(itemPoss))
+		for _, set := range sets {
+			for _, poss := range itemPoss {
+				product = append(product, mergePositions(set, poss))
+			}
+		}
+		sets = product
+	}
+
+	if !hasGroupingSets || len(node.GroupBy) == 0 {
+		return nil
+	}
+	if len(node.GroupBy) > maxGroupingColumns {
+		return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many columns in grouping sets '%s'", tree.String(&groupBy, dialect.MYSQL)))
+	}
+	node.GroupingSet = make([]*Expr, len(sets))
+	for i, set := range sets {
+		list := &plan.ExprList{List: make([]*Expr, len(set))}
+		for j, pos := range set {
+			list.List[j] = &Expr{
+				Typ:     node.GroupBy[pos].Typ,
+				ColName: node.GroupBy[pos].ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: -1,
+						ColPos: pos,
+					},
+				},
+			}
+		}
+		node.GroupingSet[i] = &Expr{
+			Expr: &plan.Expr_List{
+				List: list,
+			},
+		}
+	}
+	return nil
+}
+
+// cubeSets returns all the subsets of exprs, starting from the whole one
+func cubeSets(exprs tree.Exprs) []tree.Exprs {
+	n := len(exprs)
+	sets := make([]tree.Exprs, 0, 1<<n)
+	for mask := (1 << n) - 1; mask >= 0; mask-- {
+		var set tree.Exprs
+		for i := 0; i < n; i++ {
+			if mask&(1<<(n-1-i)) != 0 {
+				set = append(set, exprs[i])
+			}
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// mergePositions returns the union of xs and ys, keeping the order of first appearance
+func mergePositions(xs, ys []int32) []int32 {
+	rs := append(make([]int32, 0, len(xs)+len(ys)), xs...)
+	for _, y := range ys {
+		found := false
+		for _, x := range xs {
+			if x == y {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rs = append(rs, y)
+		}
+	}
+	return rs
+}
@@ -60,6 +60,26 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 	return returnByRewriteSql(ctx, sql, plan.DataDefinition_SHOW_CREATETABLE)
 }
 
+func buildShowCreateView(stmt *tree.ShowCreateView, ctx CompilerContext) (*Plan, error) {
+	sql := "SELECT mt.relname as `View`, mt.rel_createsql as `Create View` FROM %s.mo_tables mt WHERE mt.reldatabase = '%s' AND mt.relname = '%s'"
+	tblName := stmt.Name.Parts[0]
+	dbName := ctx.DefaultDatabase()
+	if stmt.Name.NumParts == 2 {
+		dbName = stmt.Name.Parts[1]
+	}
+
+	_, tableDef := ctx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("view '%v' doesn't exist", tblName))
+	}
+	if getViewSql(tableDef) == "" {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not VIEW", dbName, tblName))
+	}
+
+	sql = fmt.Sprintf(sql, MO_CATALOG_DB_NAME, dbName, tblName)
+	return returnByRewriteSql(ctx, sql, plan.DataDefinition_SHOW_CREATEVIEW)
+}
+
 func buildShowDatabases(stmt *tree.ShowDatabases, ctx CompilerContext) (*Plan, error) {
 	if stmt.Like != nil && stmt.Where != nil {
 		return nil, errors.New(errno.SyntaxError, "like clause and where clause cannot exist at the same time")
@@ -21,7 +21,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 )
@@ -379,6 +381,13 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10", //test agg
 		"SELECT DISTINCT N_NAME FROM NATION", //test distinct
 		"select sum(n_nationkey) as s from nation order by s",
+		"SELECT N_REGIONKEY, count(distinct N_NAME), sum(distinct N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY", //test distinct agg
+		"SELECT N_REGIONKEY, group_concat(N_NAME order by N_NATIONKEY desc separator ';'), median(N_NATIONKEY), percentile_cont(N_NATIONKEY, 0.9), mode(N_NAME) FROM NATION GROUP BY N_REGIONKEY", //test order-sensitive agg
+		"SELECT N_REGIONKEY, N_NAME, SUM(N_NATIONKEY), GROUPING(N_REGIONKEY, N_NAME) FROM NATION GROUP BY N_REGIONKEY, N_NAME WITH ROLLUP",                                                        //test grouping sets
+		"SELECT N_REGIONKEY, N_NAME, SUM(N_NATIONKEY) FROM NATION GROUP BY CUBE(N_REGIONKEY, N_NAME) HAVING GROUPING(N_NAME) = 0",
+		"SELECT N_REGIONKEY, N_NAME, COUNT(*) FROM NATION GROUP BY GROUPING SETS((N_REGIONKEY, N_NAME), (N_NAME), ())",
+		"SELECT N_NAME FROM NATION WHERE N_NAME REGEXP '^A' AND N_COMMENT NOT REGEXP 'x+'", //test regular expression
+		"SELECT REGEXP_LIKE(N_NAME, 'a', 'i'), REGEXP_INSTR(N_NAME, 'a', 1, 2, 1), REGEXP_SUBSTR(N_NAME, '[a-z]+'), REGEXP_REPLACE(N_NAME, 'a', 'b', 1, 0, 'c') FROM NATION",
 
 		"SELECT N_REGIONKEY + 2 as a, N_REGIONKEY/2, N_REGIONKEY* N_NATIONKEY, N_REGIONKEY % N_NATIONKEY, N_REGIONKEY - N_NATIONKEY FROM NATION WHERE -N_NATIONKEY < -20", //test more expr
 		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY >= N_NATIONKEY or (N_NAME like '%ddd' and N_REGIONKEY >0.5)",                                                    //test more expr
@@ -400,6 +409,14 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"select n_nationkey, sum(n_nationkey) from nation",
 
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
+		"SELECT max(distinct N_REGIONKEY) FROM NATION",            //distinct is only for count, sum and avg
+		"SELECT percentile_cont(N_NATIONKEY, 2) FROM NATION",      //percentile is out of range
+		"SELECT percentile_cont(N_NATIONKEY, N_REGIONKEY) FROM NATION",
+		"SELECT group_concat(N_NAME, N_COMMENT) FROM NATION",
+		"SELECT group_concat(N_NAME order by max(N_NATIONKEY)) FROM NATION",
+		"SELECT N_NAME, GROUPING(N_NAME) FROM NATION GROUP BY N_NAME",              //grouping() without grouping sets
+		"SELECT N_NAME, GROUPING(N_REGIONKEY) FROM NATION GROUP BY ROLLUP(N_NAME)", //grouping() of a non group by column
+		"SELECT COUNT(*) FROM NATION GROUP BY CUBE(N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME)", //too many grouping sets
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -546,6 +563,11 @@ func TestSubQuery(t *testing.T) {
 			where
 				l_partkey = p_partkey
 		);`, //tpch q17
+		"SELECT N_NAME FROM NATION where N_NATIONKEY > all (select R_REGIONKEY from REGION)",
+		"SELECT N_NAME FROM NATION where N_NATIONKEY = any (select R_REGIONKEY from REGION where R_NAME = N_NAME)",
+		"SELECT N_NAME FROM NATION where N_NATIONKEY <> some (select R_REGIONKEY from REGION where R_NAME = N_NAME) or N_NATIONKEY = 1",
+		"SELECT N_NAME FROM NATION where N_NATIONKEY not in (select R_REGIONKEY from REGION where R_NAME = N_NAME) order by N_NAME",
+		"SELECT * FROM NATION where not exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -557,6 +579,80 @@ func TestSubQuery(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestDecorrelation(t *testing.T) {
+	mock := NewMockOptimizer()
+	checks := map[string][]plan.Node_JoinFlag{
+		"SELECT N_NAME FROM NATION where exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)":                                                                          {plan.Node_SEMI},
+		"SELECT N_NAME FROM NATION where not exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)":                                                                      {plan.Node_ANTI},
+		"SELECT N_NAME FROM NATION where N_NATIONKEY in (select R_REGIONKEY from REGION where R_NAME = N_NAME)":                                                                  {plan.Node_SEMI},
+		"SELECT N_NAME FROM NATION where N_NATIONKEY not in (select R_REGIONKEY from REGION where R_NAME = N_NAME)":                                                              {plan.Node_MARK},
+		"SELECT N_NAME FROM NATION where N_NATIONKEY > all (select R_REGIONKEY from REGION where R_NAME = N_NAME)":                                                               {plan.Node_MARK},
+		"SELECT N_NAME FROM NATION where exists (select * from REGION where R_REGIONKEY = N_REGIONKEY) or N_NATIONKEY = 1":                                                       {plan.Node_MARK},
+		"SELECT N_NAME FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_NAME = N_NAME)":                                                              {plan.Node_SINGLE},
+		"SELECT N_NAME FROM NATION where N_REGIONKEY = (select R_REGIONKEY from REGION where R_NAME = N_NAME) and exists (select * from REGION where R_REGIONKEY = N_NATIONKEY)": {plan.Node_SINGLE, plan.Node_SEMI},
+		// not decorrelated
+		"SELECT N_NAME FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY)": nil,
+		"SELECT N_NAME FROM NATION where exists (select count(*) from REGION where R_REGIONKEY = N_REGIONKEY)":                nil,
+		"SELECT N_NAME FROM NATION where N_REGIONKEY > (select count(*) from REGION where R_NAME = N_NAME)":                   nil,
+		"SELECT N_NAME FROM NATION where exists (select * from REGION where R_REGIONKEY = N_REGIONKEY limit 1)":               nil,
+	}
+	for sql, joinTypes := range checks {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%s: %+v", sql, err)
+		}
+		var built []plan.Node_JoinFlag
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN {
+				built = append(built, node.JoinType)
+			}
+		}
+		if !reflect.DeepEqual(built, joinTypes) {
+			t.Fatalf("%s: joins %v are built, %v are expected", sql, built, joinTypes)
+		}
+	}
+}
+
+func TestDecorrelatedAggregate(t *testing.T) {
+	mock := NewMockOptimizer()
+	sql := "SELECT N_NAME FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_NAME = N_NAME)"
+	logicPlan, err := runOneStmt(mock, t, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	nodes := logicPlan.GetQuery().Nodes
+	colRef := func(expr *Expr) *ColRef {
+		return expr.Expr.(*plan.Expr_Col).Col
+	}
+	for _, node := range nodes {
+		if node.NodeType != plan.Node_JOIN {
+			continue
+		}
+		agg := nodes[node.Children[1]]
+		if agg.NodeType != plan.Node_AGG || len(agg.GroupBy) != 1 {
+			t.Fatalf("the inner column is not grouped by: %v", agg)
+		}
+		// max() follows the pulled up group by column
+		if ref := colRef(agg.ProjectList[0]); ref.RelPos != -2 || ref.ColPos != 1 {
+			t.Fatalf("max() refers to %v", ref)
+		}
+		if ref := colRef(agg.ProjectList[1]); ref.RelPos != -1 || ref.ColPos != 0 {
+			t.Fatalf("the group by column refers to %v", ref)
+		}
+		// the join key is the group by column, the value is max()
+		key := node.OnList[0].Expr.(*plan.Expr_F).F.Args[1]
+		if ref := colRef(key); ref.RelPos != 1 || ref.ColPos != 1 {
+			t.Fatalf("the join key refers to %v", ref)
+		}
+		value := node.ProjectList[len(node.ProjectList)-1]
+		if ref := colRef(value); ref.RelPos != 1 || ref.ColPos != 0 {
+			t.Fatalf("the join value refers to %v", ref)
+		}
+		return
+	}
+	t.Fatalf("%s is not decorrelated", sql)
+}
+
 func TestTcl(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -588,6 +684,7 @@ func TestDdl(t *testing.T) {
 		"create table tbl_name (t bool(20), b int unsigned, c char(20), d varchar(20), primary key(b), index idx_t(c)) comment 'test comment'",
 		"create table if not exists tbl_name (b int default 20 primary key, c char(20) default 'ss', d varchar(20) default 'kkk')",
 		"create table if not exists nation (t bool(20), b int, c char(20), d varchar(20))",
+		"create table tbl_name (a int primary key, b date, c varchar(20)) cluster by (b, a)",
 		"drop table if exists tbl_name",
 		"drop table if exists nation",
 		"drop table nation",
@@ -609,10 +706,218 @@ func TestDdl(t *testing.T) {
 
 		"create index idx1 using bsi on a(a)", //unsupport now
 		"drop index idx1 on tbl",              //unsupport now
+
+		"create table tbl_name (a int) compression = 'gzip'", //unsupport compression
+		"create table tbl_name (a int) cluster by (b)",      //unknown cluster by column
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestView(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"create view v2 as select n_name, r_name from nation join region on n_regionkey = r_regionkey",
+		"create view v2 (a, b) as select n_nationkey, count(*) from nation group by n_nationkey",
+		"create view if not exists v1 as select n_name from nation",
+		"select * from v1",
+		"select n_name from v1 where r_name = 'ASIA'",
+		"select v.n_name from v1 v",
+		"select v1.r_name, count(*) from v1 join nation on v1.n_name = nation.n_name group by v1.r_name",
+		"select n_name from nation where n_name in (select n_name from v1)",
+		"drop view v1",
+		"drop view tpch.v1",
+		"drop view if exists v_not_exist",
+		"show create view v1",
+		"show create view tpch.v1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create view v1 as select n_name from nation",                  //view exists
+		"create view v2 (a) as select n_nationkey, n_name from nation", //column length not match
+		"create view v2 as select n_name, n_name from nation",          //duplicate column
+		"create view v2 as select n_comment from v1",                   //column not exist
+		"create temporary view v2 as select n_name from nation",        //unsupport now
+		"select n_comment from v1",                                     //column not exist
+		"select * from v_loop",                                         //view refers to itself
+		"select * from v_stale",                                        //column length not match
+		"drop view nation",                                             //not a view
+		"drop view v_not_exist",                                        //view not exist
+		"drop table v1",                                                //not a table
+		"show create view nation",                                      //not a view
+		"show create view v_not_exist",                                 //view not exist
+		"insert into v1 values ('a', 'b')",                             //unsupport now
+		"delete from v1",                                               //unsupport now
+		"delete from v1 where n_name = 'a'",                            //unsupport now
+		"update v1 set n_name = 'a'",                                   //unsupport now
+		"update tpch.v1 v set v.n_name = 'a' where v.r_name = 'b'",     //unsupport now
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create view v2 (a, b) as select n_nationkey, n_name from nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tableDef := logicPlan.GetDdl().GetCreateTable().TableDef
+	if len(tableDef.Cols) != 2 || tableDef.Cols[0].Name != "a" || tableDef.Cols[1].Typ.Id != plan.Type_VARCHAR {
+		t.Fatalf("unexpected columns of view: %v", tableDef.Cols)
+	}
+	if sql := getViewSql(tableDef); sql != "create view v2 (a, b) as select n_nationkey, n_name from nation" {
+		t.Fatalf("unexpected definition of view: %s", sql)
+	}
+}
+
+func TestCreateTableCompression(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table tbl_name (a int, b varchar(20) compression = 'snappy', c int compression 'none') compression = 'zstd'")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cols := logicPlan.GetDdl().GetCreateTable().TableDef.Cols
+	expected := []plan.CompressType{plan.CompressType_Zstd, plan.CompressType_Snappy, plan.CompressType_None}
+	for i, col := range cols {
+		if col.Alg != expected[i] {
+			t.Fatalf("column %s is compressed with %v, %v is expected", col.Name, col.Alg, expected[i])
+		}
+	}
+}
+
+func TestTimestampDefault(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table tbl_name (a timestamp default '2022-05-01 11:11:11')")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// the default value is the wall clock in the time zone of the session, +08:00
+	expected, _ := types.ParseTimestampInLocation("2022-05-01 03:11:11", 0, time.UTC)
+	value := logicPlan.GetDdl().GetCreateTable().TableDef.Cols[0].Default.Value
+	if value.GetTimeStampV() != int64(expected) {
+		t.Fatalf("default value is %v, %v is expected", value.GetTimeStampV(), int64(expected))
+	}
+}
+
+func TestDistinctAggregate(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "select sum(n_regionkey), count(distinct n_name), avg(n_nationkey) from nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var aggs []*Expr
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_AGG {
+			aggs = append(aggs, node.AggList...)
+		}
+	}
+	expected := []bool{false, true, false}
+	if len(aggs) != len(expected) {
+		t.Fatalf("%d aggregates are built, %d are expected", len(aggs), len(expected))
+	}
+	for i, agg := range aggs {
+		if agg.Expr.(*plan.Expr_F).F.Distinct != expected[i] {
+			t.Fatalf("distinct of aggregate %d should be %v", i, expected[i])
+		}
+	}
+}
+
+func TestGroupConcatOrderBy(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "select group_concat(n_name order by n_regionkey, n_nationkey desc separator '-') from nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_AGG {
+			continue
+		}
+		f := node.AggList[0].Expr.(*plan.Expr_F).F
+		if sep := f.Args[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval; sep != "-" {
+			t.Fatalf("the separator is '%s', '-' is expected", sep)
+		}
+		expected := []plan.OrderBySpec_OrderByFlag{plan.OrderBySpec_INTERNAL, plan.OrderBySpec_DESC}
+		if len(f.OrderBy) != len(expected) {
+			t.Fatalf("%d order by expressions are built, %d are expected", len(f.OrderBy), len(expected))
+		}
+		for i, o := range f.OrderBy {
+			if o.Flag != expected[i] {
+				t.Fatalf("flag of order by %d should be %v", i, expected[i])
+			}
+		}
+		return
+	}
+	t.Fatalf("aggregate node is not built")
+}
+
+func TestClusterOrder(t *testing.T) {
+	mock := NewMockOptimizer()
+	// sql -> the columns each table scan is sorted on
+	sqls := map[string][][]string{
+		"select so_orderkey from sorted_orders order by so_custkey":                                             {{"so_custkey"}},
+		"select * from sorted_orders where so_totalprice > 1 order by so_custkey, so_orderkey limit 2":          {{"so_custkey", "so_orderkey"}},
+		"select so_orderkey from sorted_orders order by so_orderkey":                                            {nil},
+		"select so_orderkey from sorted_orders order by so_custkey desc":                                        {nil},
+		"select n_name from nation order by n_nationkey":                                                        {nil},
+		"select sc_name, so_totalprice from sorted_customer join sorted_orders on sc_custkey = so_custkey":      {{"sc_custkey"}, {"so_custkey"}},
+		"select sc_name, so_totalprice from sorted_customer join sorted_orders on so_custkey = sc_custkey":      {{"sc_custkey"}, {"so_custkey"}},
+		"select sc_name, so_totalprice from sorted_customer join sorted_orders on sc_custkey = so_orderkey":     {nil, nil},
+		"select sc_name, so_totalprice from sorted_customer left join sorted_orders on sc_custkey = so_custkey": {nil, nil},
+	}
+	for sql, expected := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		var orders [][]string
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType != plan.Node_TABLE_SCAN {
+				continue
+			}
+			var cols []string
+			for _, spec := range node.OrderBy {
+				cols = append(cols, spec.Expr.ColName)
+			}
+			orders = append(orders, cols)
+		}
+		if !reflect.DeepEqual(orders, expected) {
+			t.Fatalf("%s: the table scans are sorted on %v, %v is expected", sql, orders, expected)
+		}
+	}
+}
+
+func TestGroupingSets(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "select n_regionkey, n_name, count(*) from nation group by n_nationkey, rollup(n_regionkey, n_name)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_AGG {
+			continue
+		}
+		if len(node.GroupBy) != 3 {
+			t.Fatalf("%d group by expressions are built, 3 are expected", len(node.GroupBy))
+		}
+		expected := [][]int32{{0, 1, 2}, {0, 1}, {0}}
+		if len(node.GroupingSet) != len(expected) {
+			t.Fatalf("%d grouping sets are built, %d are expected", len(node.GroupingSet), len(expected))
+		}
+		for i, set := range node.GroupingSet {
+			list := set.Expr.(*plan.Expr_List).List.List
+			if len(list) != len(expected[i]) {
+				t.Fatalf("grouping set %d has %d columns, %d are expected", i, len(list), len(expected[i]))
+			}
+			for j, e := range list {
+				if pos := e.Expr.(*plan.Expr_Col).Col.ColPos; pos != expected[i][j] {
+					t.Fatalf("column %d of grouping set %d is %d, %d is expected", j, i, pos, expected[i][j])
+				}
+			}
+		}
+		return
+	}
+	t.Fatalf("aggregate node is not built")
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -677,6 +982,8 @@ func TestResultColumns(t *testing.T) {
 		"drop database tpch",
 		"create table tbl_name (b int unsigned, c char(20))",
 		"drop table nation",
+		"create view v2 as select n_name from nation",
+		"drop view v1",
 	}
 	for _, sql := range returnNilSql {
 		columns := getColumns(sql)
@@ -693,6 +1000,8 @@ func TestResultColumns(t *testing.T) {
 		"show databases":            "Database",
 		"show tables":               "Tables_in_tpch",
 		"show columns from nation":  "Field,Type,Null,Key,Default,Comment",
+		"show create view v1":       "View,Create View",
+		"select * from v1":          "n_name,r_name",
 	}
 	for sql, colsStr := range returnColumnsSql {
 		cols := strings.Split(colsStr, ",")
@@ -24,6 +24,9 @@ import (
 )
 
 func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
+	if err := checkUpdatableTable(stmt.Table, ctx, "update"); err != nil {
+		return nil, err
+	}
 	query, _ := newQueryAndSelectCtx(plan.Query_UPDATE)
 
 	// build select
@@ -20,6 +20,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
@@ -328,6 +329,7 @@ func buildUnresolvedName(query *Query, node *Node, colName string, tableName str
 			}
 		}
 		if corrRef.ColPos != -1 {
+			binderCtx.subqueryIsCorrelated = true
 			return corrExpr, nil
 		}
 	}
@@ -365,6 +367,47 @@ func getResolveTable(dbName string, tableName string, ctx CompilerContext, binde
 	return nil, nil, false
 }
 
+//getViewSql returns the stored definition of a view, or "" for a table
+func getViewSql(tableDef *TableDef) string {
+	for _, def := range tableDef.Defs {
+		if view, ok := def.Def.(*plan.TableDef_DefType_View); ok {
+			return view.View.View
+		}
+	}
+	return ""
+}
+
+// checkUpdatableTable resolves the tables updated or deleted by the statement,
+// the views can't be the target of them now.
+func checkUpdatableTable(stmt tree.TableExpr, ctx CompilerContext, op string) error {
+	switch tbl := stmt.(type) {
+	case *tree.TableName:
+		tblName := string(tbl.ObjectName)
+		_, tableDef := ctx.Resolve(string(tbl.SchemaName), tblName)
+		if tableDef == nil {
+			return errors.New(errno.InvalidSchemaName, fmt.Sprintf("table '%v' is not exist", tblName))
+		}
+		if getViewSql(tableDef) != "" {
+			return errors.New(errno.WrongObjectType, fmt.Sprintf("%s view '%v' unsupport now", op, tblName))
+		}
+		return nil
+	case *tree.ParenTableExpr:
+		return checkUpdatableTable(tbl.Expr, ctx, op)
+	case *tree.AliasedTableExpr:
+		return checkUpdatableTable(tbl.Expr, ctx, op)
+	case *tree.JoinTableExpr:
+		if err := checkUpdatableTable(tbl.Left, ctx, op); err != nil {
+			return err
+		}
+		if tbl.Right == nil {
+			return nil
+		}
+		return checkUpdatableTable(tbl.Right, ctx, op)
+	default:
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table expr: %T", stmt))
+	}
+}
+
 //getLastTableDef get insert/update/delete tableDef
 // FIXME
 func getLastTableDef(query *Query) (*ObjectRef, *TableDef) {
@@ -420,17 +463,35 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 		case defines.MYSQL_TYPE_DOUBLE:
 			return &plan.Type{Id: plan.Type_FLOAT64, Width: n.InternalType.Width, Size: 8, Precision: n.InternalType.Precision}, nil
 		case defines.MYSQL_TYPE_STRING:
+			if n.InternalType.Binary {
+				if n.InternalType.DisplayWith <= 0 { // type binary
+					return &plan.Type{Id: plan.Type_BINARY, Size: 24, Width: 1}, nil
+				}
+				return &plan.Type{Id: plan.Type_BINARY, Size: 24, Width: n.InternalType.DisplayWith}, nil
+			}
 			if n.InternalType.DisplayWith == -1 { // type char
 				return &plan.Type{Id: plan.Type_CHAR, Size: 24, Width: 1}, nil
 			}
 			return &plan.Type{Id: plan.Type_VARCHAR, Size: 24, Width: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_VARCHAR:
+			if n.InternalType.Binary {
+				return &plan.Type{Id: plan.Type_VARBINARY, Size: 24, Width: n.InternalType.DisplayWith}, nil
+			}
 			if n.InternalType.DisplayWith == -1 { // type char
 				return &plan.Type{Id: plan.Type_CHAR, Size: 24, Width: 1}, nil
 			}
 			return &plan.Type{Id: plan.Type_VARCHAR, Size: 24, Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+			if n.InternalType.Binary {
+				return &plan.Type{Id: plan.Type_BLOB, Size: 24}, nil
+			}
+			return &plan.Type{Id: plan.Type_TEXT, Size: 24}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &plan.Type{Id: plan.Type_JSON, Size: 24}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: plan.Type_DATE, Size: 4}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &plan.Type{Id: plan.Type_TIME, Size: 8, Precision: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &plan.Type{Id: plan.Type_DATETIME, Size: 8}, nil
 		case defines.MYSQL_TYPE_TIMESTAMP:
@@ -447,7 +508,7 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
 }
 
-func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*plan.DefaultExpr, error) {
+func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type, loc *time.Location) (*plan.DefaultExpr, error) {
 	allowNull := true // be false when column has not null constraint
 	isNullExpr := func(expr tree.Expr) bool {
 		v, ok := expr.(*tree.NumVal)
@@ -478,7 +539,13 @@ func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*pla
 				}, nil
 			}
 
-			value, err := buildConstant(typ, d.Expr)
+			var value interface{}
+			var err error
+			if typ.Id == plan.Type_TIMESTAMP {
+				value, err = buildTimestampConstant(typ, d.Expr, loc)
+			} else {
+				value, err = buildConstant(typ, d.Expr)
+			}
 			if err != nil {
 				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", column.Name.Parts[0]))
 			}
@@ -823,6 +890,23 @@ func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
 
+// buildTimestampConstant parses the string constant n as a TIMESTAMP value
+// in the time zone loc, the server's local time zone is used if loc is nil.
+func buildTimestampConstant(typ *plan.Type, n tree.Expr, loc *time.Location) (interface{}, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	v, err := buildConstant(&plan.Type{Id: plan.Type_VARCHAR}, n)
+	if err != nil || v == nil {
+		return v, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", v))
+	}
+	return types.ParseTimestampInLocation(s, typ.Precision, loc)
+}
+
 func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 	val := num.Value
 	str := num.String()
@@ -93,6 +93,9 @@ func funcExprExplain(funcExpr *plan.Expr_F, Typ *plan.Type, options *ExplainOpti
 	switch funcProtoType.Layout {
 	case function.STANDARD_FUNCTION:
 		result += funcExpr.F.Func.GetObjName() + "("
+		if funcExpr.F.Distinct {
+			result += "DISTINCT "
+		}
 		if len(funcExpr.F.Args) > 0 {
 			var first = true
 			for _, v := range funcExpr.F.Args {
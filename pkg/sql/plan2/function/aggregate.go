@@ -616,4 +616,84 @@ var aggregates = map[int][]Function{
 			AggregateInfo: aggregate.ApproxCountDistinct,
 		},
 	},
+	GROUP_CONCAT: {
+		{
+			Index:     0,
+			Flag:      plan.Function_AGG,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_varchar,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				// the second argument is the separator
+				if len(inputTypes) == 2 {
+					return inputTypes[1] == types.T_char || inputTypes[1] == types.T_varchar
+				}
+				return false
+			},
+			AggregateInfo: aggregate.GroupConcat,
+		},
+	},
+	MEDIAN: {
+		{
+			Index:     0,
+			Flag:      plan.Function_AGG,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_float64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) == 1 {
+					_, err := aggregate.NewPercentile(types.Type{Oid: inputTypes[0]}, 0.5)
+					if err == nil {
+						return true
+					}
+				}
+				return false
+			},
+			AggregateInfo: aggregate.Median,
+		},
+	},
+	PERCENTILE_CONT: {
+		{
+			Index:     0,
+			Flag:      plan.Function_AGG,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_float64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				// the second argument is the percentile
+				if len(inputTypes) == 2 && inputTypes[1] == types.T_float64 {
+					_, err := aggregate.NewPercentile(types.Type{Oid: inputTypes[0]}, 0)
+					if err == nil {
+						return true
+					}
+				}
+				return false
+			},
+			AggregateInfo: aggregate.PercentileCont,
+		},
+	},
+	MODE: sameTypeAggregates(aggregate.Mode, []types.T{
+		types.T_bool,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_float32, types.T_float64,
+		types.T_char, types.T_varchar,
+		types.T_date, types.T_datetime, types.T_timestamp,
+		types.T_decimal64, types.T_decimal128,
+	}),
+}
+
+// sameTypeAggregates returns the overloads of an aggregate whose result has
+// the same type as its argument.
+func sameTypeAggregates(op int, typs []types.T) []Function {
+	fs := make([]Function, len(typs))
+	for i, typ := range typs {
+		fs[i] = Function{
+			Index:         int32(i),
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			Args:          []types.T{typ},
+			TypeCheckFn:   strictTypeCheck,
+			ReturnTyp:     typ,
+			AggregateInfo: op,
+		}
+	}
+	return fs
 }
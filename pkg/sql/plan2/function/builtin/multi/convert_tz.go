@@ -0,0 +1,83 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/converttz"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var datetimeType = types.Type{Oid: types.T_datetime, Size: 8}
+
+// ConvertTz is CONVERT_TZ(dt, from_tz, to_tz), the result is null if
+// the datetime or a time zone is invalid
+func ConvertTz(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(datetimeType, vs), nil
+	}
+	rv := newResult(datetimeType, vs)
+	nulls.Or(vs[0].Nsp, vs[1].Nsp, rv.Nsp)
+	nulls.Or(rv.Nsp, vs[2].Nsp, rv.Nsp)
+
+	n := rowCount(vs)
+	xs := toDatetimes(vs[0], n, rv.Nsp)
+	froms, tos := toLocations(vs[1]), toLocations(vs[2])
+	vector.SetCol(rv, converttz.ConvertTz(xs, froms, tos, make([]types.Datetime, n), rv.Nsp))
+	return rv, nil
+}
+
+// toDatetimes returns n datetimes of v, the invalid strings are added to nsp
+func toDatetimes(v *vector.Vector, n int, nsp *nulls.Nulls) []types.Datetime {
+	xs := make([]types.Datetime, n)
+	switch col := v.Col.(type) {
+	case []types.Datetime:
+		for i := range xs {
+			xs[i] = col[i%len(col)]
+		}
+	case *types.Bytes:
+		for i := range xs {
+			if nulls.Contains(nsp, uint64(i)) {
+				continue
+			}
+			dt, err := types.ParseDatetime(string(col.Get(int64(i % len(col.Offsets)))))
+			if err != nil {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			xs[i] = dt
+		}
+	}
+	return xs
+}
+
+// toLocations returns the time zones of v, nil for the invalid ones
+func toLocations(v *vector.Vector) []*time.Location {
+	col := v.Col.(*types.Bytes)
+	locs := make([]*time.Location, len(col.Offsets))
+	for i := range locs {
+		if nulls.Contains(v.Nsp, uint64(i)) {
+			continue
+		}
+		if loc, err := types.ParseTimeZone(string(col.Get(int64(i)))); err == nil {
+			locs[i] = loc
+		}
+	}
+	return locs
+}
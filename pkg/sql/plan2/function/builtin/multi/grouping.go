@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// GroupingId is GROUPING_ID(gid, pos[, pos] ...), gid is the grouping set id emitted by
+// the group operator whose bit i is set if the i-th group by column is not in the set.
+// The result has one bit per pos, the last pos being the lowest bit, just like GROUPING(a, b, ...)
+func GroupingId(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(int64Type, vs), nil
+	}
+	poss := make([]int64, len(vs)-1)
+	for i, v := range vs[1:] {
+		poss[i] = v.Col.([]int64)[0]
+	}
+	gids := vs[0].Col.([]int64)
+	rv := newInt64Result(vs)
+	rs := rv.Col.([]int64)
+	for i := range rs {
+		gid := gids[i%len(gids)]
+		for _, pos := range poss {
+			rs[i] = rs[i]<<1 | (gid>>pos)&1
+		}
+	}
+	return rv, nil
+}
@@ -0,0 +1,390 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	gojson "encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/json"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	jsonType  = types.Type{Oid: types.T_json, Size: 24}
+	int64Type = types.Type{Oid: types.T_int64, Size: 8}
+)
+
+// JsonExtract is JSON_EXTRACT(json_doc, path[, path] ...)
+func JsonExtract(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(jsonType, vs), nil
+	}
+	paths, err := ConstPaths(vs[1:])
+	if err != nil {
+		return nil, err
+	}
+	xs, err := ToJsonColumn(vs[0])
+	if err != nil {
+		return nil, err
+	}
+	rv := newResult(jsonType, vs)
+	nulls.Or(rv.Nsp, vs[0].Nsp, rv.Nsp)
+	rs, err := json.Extract(xs, paths, &types.Bytes{}, rv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonContains is JSON_CONTAINS(target, candidate[, path])
+func JsonContains(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(int64Type, vs), nil
+	}
+	var path *bytejson.Path
+	if len(vs) == 3 {
+		paths, err := ConstPaths(vs[2:])
+		if err != nil {
+			return nil, err
+		}
+		path = paths[0]
+	}
+	xs, err := ToJsonColumn(vs[0])
+	if err != nil {
+		return nil, err
+	}
+	ys, err := ToJsonColumn(vs[1])
+	if err != nil {
+		return nil, err
+	}
+	rv := newInt64Result(vs)
+	nulls.Or(vs[0].Nsp, vs[1].Nsp, rv.Nsp)
+	rs, err := json.Contains(xs, ys, path, rv.Col.([]int64), rv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonLength is JSON_LENGTH(json_doc[, path])
+func JsonLength(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(int64Type, vs), nil
+	}
+	var path *bytejson.Path
+	if len(vs) == 2 {
+		paths, err := ConstPaths(vs[1:])
+		if err != nil {
+			return nil, err
+		}
+		path = paths[0]
+	}
+	xs, err := ToJsonColumn(vs[0])
+	if err != nil {
+		return nil, err
+	}
+	rv := newInt64Result(vs)
+	nulls.Or(rv.Nsp, vs[0].Nsp, rv.Nsp)
+	rs, err := json.Length(xs, path, rv.Col.([]int64), rv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonKeys is JSON_KEYS(json_doc[, path])
+func JsonKeys(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(jsonType, vs), nil
+	}
+	var path *bytejson.Path
+	if len(vs) == 2 {
+		paths, err := ConstPaths(vs[1:])
+		if err != nil {
+			return nil, err
+		}
+		path = paths[0]
+	}
+	xs, err := ToJsonColumn(vs[0])
+	if err != nil {
+		return nil, err
+	}
+	rv := newResult(jsonType, vs)
+	nulls.Or(rv.Nsp, vs[0].Nsp, rv.Nsp)
+	rs, err := json.Keys(xs, path, &types.Bytes{}, rv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonSet is JSON_SET(json_doc, path, val[, path, val] ...)
+func JsonSet(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if isConstNull(vs[0]) {
+		return constNull(jsonType, vs), nil
+	}
+	n := (len(vs) - 1) / 2
+	pvs := make([]*vector.Vector, n)
+	vals := make([][]bytejson.ByteJson, n)
+	for i := 0; i < n; i++ {
+		pvs[i] = vs[2*i+1]
+		if isConstNull(pvs[i]) {
+			return constNull(jsonType, vs), nil
+		}
+		val, err := ToByteJsons(vs[2*i+2])
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	paths, err := ConstPaths(pvs)
+	if err != nil {
+		return nil, err
+	}
+	xs, err := ToJsonColumn(vs[0])
+	if err != nil {
+		return nil, err
+	}
+	rv := newResult(jsonType, vs)
+	nulls.Or(rv.Nsp, vs[0].Nsp, rv.Nsp)
+	rs, err := json.Set(xs, paths, vals, &types.Bytes{}, rv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonArray is JSON_ARRAY([val[, val] ...])
+func JsonArray(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vals := make([][]bytejson.ByteJson, len(vs))
+	for i, v := range vs {
+		val, err := ToByteJsons(v)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	rv := newResult(jsonType, vs)
+	rs, err := json.Array(vals, rowCount(vs), &types.Bytes{})
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonObject is JSON_OBJECT([key, val[, key, val] ...])
+func JsonObject(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	n := len(vs) / 2
+	keys := make([][]string, n)
+	vals := make([][]bytejson.ByteJson, n)
+	for i := 0; i < n; i++ {
+		kv := vs[2*i]
+		if nulls.Any(kv.Nsp) {
+			return nil, errors.New(errno.DataException, "JSON documents may not contain NULL member names")
+		}
+		kbs := kv.Col.(*types.Bytes)
+		keys[i] = make([]string, len(kbs.Offsets))
+		for j := range keys[i] {
+			keys[i][j] = string(kbs.Get(int64(j)))
+		}
+		val, err := ToByteJsons(vs[2*i+1])
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	rv := newResult(jsonType, vs)
+	rs, err := json.Object(keys, vals, rowCount(vs), &types.Bytes{})
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// ConstPaths parses the path arguments, which must be string constants
+func ConstPaths(vs []*vector.Vector) ([]*bytejson.Path, error) {
+	paths := make([]*bytejson.Path, len(vs))
+	for i, v := range vs {
+		if !v.IsConst || !isString(v.Typ.Oid) {
+			return nil, errors.New(errno.DataException, "json path must be a constant string")
+		}
+		p, err := bytejson.ParsePath(string(v.Col.(*types.Bytes).Get(0)))
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = p
+	}
+	return paths, nil
+}
+
+// ToJsonColumn returns the binary json of a json document argument,
+// the strings are parsed as json texts
+func ToJsonColumn(v *vector.Vector) (*types.Bytes, error) {
+	switch {
+	case v.Typ.Oid == types.T_json:
+		return v.Col.(*types.Bytes), nil
+	case isString(v.Typ.Oid):
+		return json.Parse(v.Col.(*types.Bytes), &types.Bytes{}, v.Nsp)
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("invalid data type %s for json document", v.Typ))
+}
+
+// ToByteJsons converts a value argument to be json values, the null
+// values are converted to be json null
+func ToByteJsons(v *vector.Vector) ([]bytejson.ByteJson, error) {
+	n := vector.Length(v)
+	if v.IsConst {
+		n = 1
+	}
+	rs := make([]bytejson.ByteJson, n)
+	for i := range rs {
+		if nulls.Contains(v.Nsp, uint64(i)) {
+			rs[i] = bytejson.Null
+			continue
+		}
+		var val any
+		switch col := v.Col.(type) {
+		case []bool:
+			val = col[i]
+		case []int8:
+			val = int64(col[i])
+		case []int16:
+			val = int64(col[i])
+		case []int32:
+			val = int64(col[i])
+		case []int64:
+			val = col[i]
+		case []uint8:
+			val = uint64(col[i])
+		case []uint16:
+			val = uint64(col[i])
+		case []uint32:
+			val = uint64(col[i])
+		case []uint64:
+			val = col[i]
+		case []float32:
+			val = float64(col[i])
+		case []float64:
+			val = col[i]
+		case []types.Decimal64:
+			val = gojson.Number(col[i].Decimal64ToString(v.Typ.Scale))
+		case []types.Decimal128:
+			val = gojson.Number(col[i].Decimal128ToString(v.Typ.Scale))
+		case []types.Date:
+			val = col[i].String()
+		case []types.Datetime:
+			val = col[i].String()
+		case []types.Time:
+			val = col[i].String()
+		case *types.Bytes:
+			if v.Typ.Oid == types.T_json {
+				bj, err := bytejson.Unmarshal(col.Get(int64(i)))
+				if err != nil {
+					return nil, err
+				}
+				rs[i] = bj
+				continue
+			}
+			val = string(col.Get(int64(i)))
+		default:
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("invalid data type %s for json value", v.Typ))
+		}
+		bj, err := bytejson.CreateByteJson(val)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = bj
+	}
+	return rs, nil
+}
+
+func isString(oid types.T) bool {
+	return oid == types.T_char || oid == types.T_varchar || oid == types.T_text
+}
+
+func isConstNull(v *vector.Vector) bool {
+	return v.IsConst && nulls.Contains(v.Nsp, 0)
+}
+
+func anyConstNull(vs []*vector.Vector) bool {
+	for _, v := range vs {
+		if isConstNull(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// newResult returns an empty result vector, which is a constant if all the arguments are constants
+func newResult(typ types.Type, vs []*vector.Vector) *vector.Vector {
+	for _, v := range vs {
+		if !v.IsConst {
+			return vector.New(typ)
+		}
+	}
+	rv := vector.NewConst(typ)
+	rv.Length = 1
+	if len(vs) > 0 {
+		rv.Length = vs[0].Length
+	}
+	return rv
+}
+
+func newInt64Result(vs []*vector.Vector) *vector.Vector {
+	rv := newResult(int64Type, vs)
+	vector.SetCol(rv, make([]int64, rowCount(vs)))
+	return rv
+}
+
+// rowCount returns the count of the values to compute, 1 if all the arguments are constants
+func rowCount(vs []*vector.Vector) int {
+	for _, v := range vs {
+		if !v.IsConst {
+			return vector.Length(v)
+		}
+	}
+	return 1
+}
+
+func constNull(typ types.Type, vs []*vector.Vector) *vector.Vector {
+	rv := vector.NewConst(typ)
+	rv.Length = vs[0].Length
+	switch typ.Oid {
+	case types.T_int64:
+		rv.Col = []int64{0}
+	case types.T_bool:
+		rv.Col = []bool{false}
+	case types.T_datetime:
+		rv.Col = []types.Datetime{0}
+	default:
+		rv.Col = &types.Bytes{Data: []byte{}, Offsets: []uint32{0}, Lengths: []uint32{0}}
+	}
+	nulls.Add(rv.Nsp, 0)
+	return rv
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/regexp"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	boolType    = types.Type{Oid: types.T_bool, Size: 1}
+	varcharType = types.Type{Oid: types.T_varchar, Size: 24}
+)
+
+// RegexpLike is REGEXP_LIKE(expr, pat[, match_type]), which is also expr REGEXP pat
+func RegexpLike(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(boolType, vs), nil
+	}
+	rv := newRegexpResult(boolType, vs)
+	rs, err := regexp.Like(bytesArg(vs, 0), bytesArg(vs, 1), bytesArg(vs, 2), isBinary(vs), rv.Nsp, make([]bool, rowCount(vs)))
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// RegexpInstr is REGEXP_INSTR(expr, pat[, pos[, occurrence[, return_option[, match_type]]]])
+func RegexpInstr(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(int64Type, vs), nil
+	}
+	rv := newRegexpResult(int64Type, vs)
+	rs, err := regexp.Instr(bytesArg(vs, 0), bytesArg(vs, 1), intArg(vs, 2), intArg(vs, 3), intArg(vs, 4), bytesArg(vs, 5), isBinary(vs),
+		rv.Nsp, make([]int64, rowCount(vs)))
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// RegexpSubstr is REGEXP_SUBSTR(expr, pat[, pos[, occurrence[, match_type]]]),
+// the result is null if there is no match
+func RegexpSubstr(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(varcharType, vs), nil
+	}
+	rv := newRegexpResult(varcharType, vs)
+	rs, err := regexp.Substr(bytesArg(vs, 0), bytesArg(vs, 1), intArg(vs, 2), intArg(vs, 3), bytesArg(vs, 4), isBinary(vs),
+		rowCount(vs), rv.Nsp, &types.Bytes{})
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// RegexpReplace is REGEXP_REPLACE(expr, pat, repl[, pos[, occurrence[, match_type]]]),
+// all the matches are replaced if the occurrence is omitted or 0
+func RegexpReplace(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if anyConstNull(vs) {
+		return constNull(varcharType, vs), nil
+	}
+	rv := newRegexpResult(varcharType, vs)
+	rs, err := regexp.Replace(bytesArg(vs, 0), bytesArg(vs, 1), bytesArg(vs, 2), intArg(vs, 3), intArg(vs, 4), bytesArg(vs, 5), isBinary(vs),
+		rowCount(vs), rv.Nsp, &types.Bytes{})
+	if err != nil {
+		return nil, err
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// newRegexpResult returns an empty result whose nulls are the ones of the arguments
+func newRegexpResult(typ types.Type, vs []*vector.Vector) *vector.Vector {
+	rv := newResult(typ, vs)
+	for _, v := range vs {
+		if !v.IsConst {
+			nulls.Or(rv.Nsp, v.Nsp, rv.Nsp)
+		}
+	}
+	return rv
+}
+
+// isBinary returns true if the string or the pattern is a binary string,
+// which is matched case sensitively without a match type
+func isBinary(vs []*vector.Vector) bool {
+	for _, v := range vs[:2] {
+		switch v.Typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return true
+		}
+	}
+	return false
+}
+
+// bytesArg returns the i-th string argument, nil if it is omitted
+func bytesArg(vs []*vector.Vector, i int) *types.Bytes {
+	if i >= len(vs) {
+		return nil
+	}
+	return vs[i].Col.(*types.Bytes)
+}
+
+// intArg returns the i-th integer argument as int64s, nil if it is omitted
+func intArg(vs []*vector.Vector, i int) []int64 {
+	if i >= len(vs) {
+		return nil
+	}
+	switch col := vs[i].Col.(type) {
+	case []int8:
+		return toInt64s(col)
+	case []int16:
+		return toInt64s(col)
+	case []int32:
+		return toInt64s(col)
+	case []int64:
+		return col
+	case []uint8:
+		return toInt64s(col)
+	case []uint16:
+		return toInt64s(col)
+	case []uint32:
+		return toInt64s(col)
+	case []uint64:
+		return toInt64s(col)
+	}
+	return nil
+}
+
+func toInt64s[T int8 | int16 | int32 | uint8 | uint16 | uint32 | uint64](xs []T) []int64 {
+	rs := make([]int64, len(xs))
+	for i, x := range xs {
+		rs[i] = int64(x)
+	}
+	return rs
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/json"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// JsonUnquote is JSON_UNQUOTE(json_val), a string argument is unquoted
+// only if it is a quoted json string, and returned as it is otherwise
+func JsonUnquote(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	v := vs[0]
+	rv := newResult(types.Type{Oid: types.T_varchar, Size: 24}, v)
+	nulls.Or(rv.Nsp, v.Nsp, rv.Nsp)
+	xs := v.Col.(*types.Bytes)
+	if v.Typ.Oid == types.T_json {
+		rs, err := json.Unquote(xs, &types.Bytes{}, rv.Nsp)
+		if err != nil {
+			return nil, err
+		}
+		vector.SetCol(rv, rs)
+		return rv, nil
+	}
+	rs := &types.Bytes{}
+	for i := range xs.Offsets {
+		s := xs.Get(int64(i))
+		if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+			bj, err := bytejson.ParseFromByteSlice(s)
+			if err != nil {
+				return nil, err
+			}
+			s = []byte(bj.Unquote())
+		}
+		if err := rs.Append([][]byte{s}); err != nil {
+			return nil, err
+		}
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+// JsonValid is JSON_VALID(val), a json argument is always valid
+func JsonValid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	v := vs[0]
+	rv := newResult(types.Type{Oid: types.T_int64, Size: 8}, v)
+	nulls.Or(rv.Nsp, v.Nsp, rv.Nsp)
+	xs := v.Col.(*types.Bytes)
+	rs := make([]int64, len(xs.Offsets))
+	if v.Typ.Oid == types.T_json {
+		for i := range rs {
+			rs[i] = 1
+		}
+	} else {
+		json.Valid(xs, rs)
+	}
+	vector.SetCol(rv, rs)
+	return rv, nil
+}
+
+func newResult(typ types.Type, v *vector.Vector) *vector.Vector {
+	if v.IsConst {
+		rv := vector.NewConst(typ)
+		rv.Length = v.Length
+		return rv
+	}
+	return vector.New(typ)
+}
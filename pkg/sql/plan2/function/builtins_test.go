@@ -1 +1,185 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func makeStrVector(t *testing.T, typ types.T, isConst bool, strs ...string) *vector.Vector {
+	v := vector.New(types.Type{Oid: typ})
+	bs := make([][]byte, len(strs))
+	for i, s := range strs {
+		if typ == types.T_json {
+			bj, err := bytejson.ParseFromString(s)
+			require.NoError(t, err)
+			bs[i] = bj.Marshal()
+		} else {
+			bs[i] = []byte(s)
+		}
+	}
+	require.NoError(t, vector.Append(v, bs))
+	if isConst {
+		v.IsConst = true
+		v.Length = 2
+	}
+	return v
+}
+
+func evalFunction(t *testing.T, name string, vs ...*vector.Vector) *vector.Vector {
+	args := make([]types.T, len(vs))
+	for i, v := range vs {
+		args[i] = v.Typ.Oid
+	}
+	f, _, _, err := GetFunctionByName(name, args)
+	require.NoError(t, err)
+	rv, err := f.VecFn(vs, nil)
+	require.NoError(t, err)
+	return rv
+}
+
+func jsonStrings(t *testing.T, v *vector.Vector) []string {
+	xs := v.Col.(*types.Bytes)
+	rs := make([]string, len(xs.Offsets))
+	for i := range rs {
+		if nulls.Contains(v.Nsp, uint64(i)) {
+			rs[i] = "NULL"
+			continue
+		}
+		bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+		require.NoError(t, err)
+		rs[i] = bj.String()
+	}
+	return rs
+}
+
+func TestJsonFunctions(t *testing.T) {
+	doc := makeStrVector(t, types.T_json, false, `{"a": [1, 2], "b": "x"}`, `{"a": 3}`)
+
+	rv := evalFunction(t, "json_extract", doc, makeStrVector(t, types.T_varchar, true, `$.b`))
+	require.Equal(t, []string{`"x"`, "NULL"}, jsonStrings(t, rv))
+
+	rv = evalFunction(t, "json_unquote", rv)
+	require.Equal(t, "x", string(rv.Col.(*types.Bytes).Get(0)))
+
+	rv = evalFunction(t, "json_length", doc, makeStrVector(t, types.T_varchar, true, `$.a`))
+	require.Equal(t, []int64{2, 1}, rv.Col.([]int64))
+
+	rv = evalFunction(t, "json_contains", doc, makeStrVector(t, types.T_varchar, true, `3`), makeStrVector(t, types.T_varchar, true, `$.a`))
+	require.Equal(t, []int64{0, 1}, rv.Col.([]int64))
+
+	rv = evalFunction(t, "json_keys", makeStrVector(t, types.T_varchar, false, `{"k": 1}`, `[]`))
+	require.Equal(t, []string{`["k"]`, "NULL"}, jsonStrings(t, rv))
+
+	rv = evalFunction(t, "json_valid", makeStrVector(t, types.T_varchar, false, `{"k": 1}`, `{`))
+	require.Equal(t, []int64{1, 0}, rv.Col.([]int64))
+
+	rv = evalFunction(t, "json_set", doc, makeStrVector(t, types.T_varchar, true, `$.b`), makeStrVector(t, types.T_varchar, false, `y`, `z`))
+	require.Equal(t, []string{`{"a": [1, 2], "b": "y"}`, `{"a": 3, "b": "z"}`}, jsonStrings(t, rv))
+
+	rv = evalFunction(t, "json_object", makeStrVector(t, types.T_varchar, true, `k`), doc)
+	require.Equal(t, []string{`{"k": {"a": [1, 2], "b": "x"}}`, `{"k": {"a": 3}}`}, jsonStrings(t, rv))
+
+	rv = evalFunction(t, "json_array", makeStrVector(t, types.T_varchar, false, `p`, `q`), makeStrVector(t, types.T_varchar, true, `c`))
+	require.Equal(t, []string{`["p", "c"]`, `["q", "c"]`}, jsonStrings(t, rv))
+
+	_, _, _, err := GetFunctionByName("json_object", []types.T{types.T_varchar})
+	require.Error(t, err)
+}
+
+func TestConvertTz(t *testing.T) {
+	dts := vector.New(types.Type{Oid: types.T_datetime})
+	for _, s := range []string{"2022-01-01 00:00:00", "2022-07-01 12:00:00"} {
+		dt, err := types.ParseDatetime(s)
+		require.NoError(t, err)
+		require.NoError(t, vector.Append(dts, []types.Datetime{dt}))
+	}
+	rv := evalFunction(t, "convert_tz", dts, makeStrVector(t, types.T_varchar, true, `+00:00`), makeStrVector(t, types.T_varchar, true, `Asia/Shanghai`))
+	vs := rv.Col.([]types.Datetime)
+	require.Equal(t, "2022-01-01 08:00:00", vs[0].String())
+	require.Equal(t, "2022-07-01 20:00:00", vs[1].String())
+
+	rv = evalFunction(t, "convert_tz", makeStrVector(t, types.T_varchar, false, `2022-01-01 00:00:00`, `bad`),
+		makeStrVector(t, types.T_varchar, true, `SYSTEM`), makeStrVector(t, types.T_varchar, false, `-05:00`, `+01:00`))
+	require.True(t, nulls.Contains(rv.Nsp, 1))
+
+	rv = evalFunction(t, "convert_tz", dts, makeStrVector(t, types.T_varchar, true, `+00:00`), makeStrVector(t, types.T_varchar, true, `Mars/Olympus`))
+	require.True(t, nulls.Contains(rv.Nsp, 0))
+	require.True(t, nulls.Contains(rv.Nsp, 1))
+}
+
+func TestGroupingId(t *testing.T) {
+	gids := vector.New(types.Type{Oid: types.T_int64})
+	require.NoError(t, vector.Append(gids, []int64{0, 1, 2, 3}))
+	pos := func(p int64) *vector.Vector {
+		v := vector.NewConst(types.Type{Oid: types.T_int64})
+		v.Col = []int64{p}
+		v.Length = 4
+		return v
+	}
+	rv := evalFunction(t, "grouping_id", gids, pos(0), pos(1))
+	require.Equal(t, []int64{0, 2, 1, 3}, rv.Col.([]int64))
+
+	rv = evalFunction(t, "grouping_id", gids, pos(1))
+	require.Equal(t, []int64{0, 0, 1, 1}, rv.Col.([]int64))
+}
+
+func TestRegexpFunctions(t *testing.T) {
+	strs := vector.New(types.Type{Oid: types.T_varchar})
+	require.NoError(t, vector.Append(strs, [][]byte{[]byte("Apple pie"), []byte("banana"), nil}))
+	nulls.Add(strs.Nsp, 2)
+
+	rv := evalFunction(t, "regexp", strs, makeStrVector(t, types.T_varchar, true, `^a`))
+	require.Equal(t, []bool{true, false, false}, rv.Col.([]bool))
+	require.True(t, nulls.Contains(rv.Nsp, 2))
+
+	rv = evalFunction(t, "regexp_like", strs, makeStrVector(t, types.T_varchar, true, `^a`), makeStrVector(t, types.T_varchar, true, `c`))
+	require.Equal(t, []bool{false, false, false}, rv.Col.([]bool))
+
+	pos := vector.NewConst(types.Type{Oid: types.T_int64})
+	pos.Col = []int64{2}
+	pos.Length = 3
+	rv = evalFunction(t, "regexp_instr", strs, makeStrVector(t, types.T_varchar, true, `an`), pos)
+	require.Equal(t, int64(0), rv.Col.([]int64)[0])
+	require.Equal(t, int64(2), rv.Col.([]int64)[1])
+
+	rv = evalFunction(t, "regexp_substr", strs, makeStrVector(t, types.T_varchar, true, `p+`))
+	require.Equal(t, "pp", string(rv.Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(rv.Nsp, 1))
+	require.True(t, nulls.Contains(rv.Nsp, 2))
+
+	rv = evalFunction(t, "regexp_replace", strs, makeStrVector(t, types.T_varchar, true, `a`), makeStrVector(t, types.T_varchar, true, `o`))
+	require.Equal(t, "bonono", string(rv.Col.(*types.Bytes).Get(1)))
+
+	null := makeStrVector(t, types.T_varchar, true, ``)
+	nulls.Add(null.Nsp, 0)
+	rv = evalFunction(t, "regexp_like", strs, null)
+	require.True(t, rv.IsConst && nulls.Contains(rv.Nsp, 0))
+
+	_, _, _, err := GetFunctionByName("regexp_instr", []types.T{types.T_varchar, types.T_varchar, types.T_varchar})
+	require.Error(t, err)
+	_, _, _, err = GetFunctionByName("regexp_replace", []types.T{types.T_varchar, types.T_varchar, ScalarNull, types.T_int32})
+	require.NoError(t, err)
+	f, _, _, err := GetFunctionByName("regexp_like", []types.T{types.T_varchar, types.T_varchar})
+	require.NoError(t, err)
+	_, err = f.VecFn([]*vector.Vector{strs, makeStrVector(t, types.T_varchar, true, `(`)}, nil)
+	require.Error(t, err)
+}
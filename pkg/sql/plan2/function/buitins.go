@@ -15,8 +15,12 @@
 package function
 
 import (
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/builtin/unary"
 )
 
 func initBuiltIns() {
@@ -116,4 +120,345 @@ var builtins = map[int][]Function{
 			Fn:          nil,
 		},
 	},
+	JSON_EXTRACT: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_json,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 2 || !isJsonDocType(inputTypes[0]) {
+					return false
+				}
+				return allStringType(inputTypes[1:])
+			},
+			Fn: multi.JsonExtract,
+		},
+	},
+	JSON_UNQUOTE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_json},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonUnquote,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonUnquote,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonUnquote,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_text},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonUnquote,
+		},
+	},
+	JSON_CONTAINS: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_int64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 2 || len(inputTypes) > 3 {
+					return false
+				}
+				return isJsonDocType(inputTypes[0]) && isJsonDocType(inputTypes[1]) && allStringType(inputTypes[2:])
+			},
+			Fn: multi.JsonContains,
+		},
+	},
+	JSON_ARRAY: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_json,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				return true
+			},
+			Fn: multi.JsonArray,
+		},
+	},
+	JSON_OBJECT: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_json,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes)%2 != 0 {
+					return false
+				}
+				for i := 0; i < len(inputTypes); i += 2 {
+					if !isStringType(inputTypes[i]) {
+						return false
+					}
+				}
+				return true
+			},
+			Fn: multi.JsonObject,
+		},
+	},
+	JSON_LENGTH: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_int64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 1 || len(inputTypes) > 2 {
+					return false
+				}
+				return isJsonDocType(inputTypes[0]) && allStringType(inputTypes[1:])
+			},
+			Fn: multi.JsonLength,
+		},
+	},
+	JSON_KEYS: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_json,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 1 || len(inputTypes) > 2 {
+					return false
+				}
+				return isJsonDocType(inputTypes[0]) && allStringType(inputTypes[1:])
+			},
+			Fn: multi.JsonKeys,
+		},
+	},
+	JSON_VALID: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_json},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonValid,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonValid,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonValid,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_text},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.JsonValid,
+		},
+	},
+	JSON_SET: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_json,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 3 || len(inputTypes)%2 != 1 || !isJsonDocType(inputTypes[0]) {
+					return false
+				}
+				for i := 1; i < len(inputTypes); i += 2 {
+					if !isStringType(inputTypes[i]) {
+						return false
+					}
+				}
+				return true
+			},
+			Fn: multi.JsonSet,
+		},
+	},
+	CONVERT_TZ: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.ConvertTz,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.ConvertTz,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.ConvertTz,
+		},
+	},
+	GROUPING_ID: {
+		{
+			Index:     0,
+			Flag:      plan.Function_STRICT,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_int64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) < 2 {
+					return false
+				}
+				for _, t := range inputTypes {
+					if t != types.T_int64 {
+						return false
+					}
+				}
+				return true
+			},
+			Fn: multi.GroupingId,
+		},
+	},
+	REGEXP: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: regexpTypeCheck("ss"),
+			Fn:          multi.RegexpLike,
+		},
+	},
+	REGEXP_LIKE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: regexpTypeCheck("ss[s]"),
+			Fn:          multi.RegexpLike,
+		},
+	},
+	REGEXP_INSTR: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: regexpTypeCheck("ss[iiis]"),
+			Fn:          multi.RegexpInstr,
+		},
+	},
+	REGEXP_SUBSTR: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: regexpTypeCheck("ss[iis]"),
+			Fn:          multi.RegexpSubstr,
+		},
+	},
+	REGEXP_REPLACE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: regexpTypeCheck("sss[iis]"),
+			Fn:          multi.RegexpReplace,
+		},
+	},
+}
+
+// regexpTypeCheck returns the type check of a regular expression function
+// whose arguments are described by sig, s is a string and i is an integer.
+// The arguments in the brackets are optional, and only the trailing ones
+// can be omitted.
+func regexpTypeCheck(sig string) func([]types.T, []types.T) bool {
+	required := strings.IndexByte(sig, '[')
+	if required < 0 {
+		required = len(sig)
+	}
+	kinds := strings.Trim(strings.Replace(sig, "[", "", 1), "]")
+	return func(inputTypes []types.T, _ []types.T) bool {
+		if len(inputTypes) < required || len(inputTypes) > len(kinds) {
+			return false
+		}
+		for i, t := range inputTypes {
+			if kinds[i] == 's' && !isStringType(t) {
+				return false
+			}
+			if kinds[i] == 'i' && !isIntegerType(t) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func isIntegerType(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, ScalarNull:
+		return true
+	}
+	return false
+}
+
+// isJsonDocType returns true if t can be a json document argument,
+// the strings are parsed as json texts.
+func isJsonDocType(t types.T) bool {
+	return t == types.T_json || isStringType(t)
+}
+
+func isStringType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar || t == types.T_text || t == ScalarNull
+}
+
+func allStringType(ts []types.T) bool {
+	for _, t := range ts {
+		if !isStringType(t) {
+			return false
+		}
+	}
+	return true
 }
@@ -77,8 +77,12 @@ var (
 		types.T_int64:     {types.T_float64, types.T_decimal64, types.T_decimal128},
 		types.T_float32:   {types.T_float64},
 		types.T_decimal64: {types.T_decimal128},
-		types.T_char:      {types.T_varchar},
-		types.T_varchar:   {types.T_char},
+		types.T_char:      {types.T_varchar, types.T_text},
+		types.T_varchar:   {types.T_char, types.T_text},
+		types.T_text:      {types.T_varchar},
+		types.T_binary:    {types.T_varbinary, types.T_blob},
+		types.T_varbinary: {types.T_blob},
+		types.T_time:      {types.T_datetime},
 
 		types.T_tuple: {types.T_float64},
 	}
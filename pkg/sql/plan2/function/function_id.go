@@ -173,6 +173,24 @@ const (
 	DATE_SUB              // DATE_SUB
 	APPROX_COUNT_DISTINCT // APPROX_COUNT_DISTINCT, special aggregate
 
+	JSON_EXTRACT  // JSON_EXTRACT
+	JSON_UNQUOTE  // JSON_UNQUOTE
+	JSON_CONTAINS // JSON_CONTAINS
+	JSON_ARRAY    // JSON_ARRAY
+	JSON_OBJECT   // JSON_OBJECT
+	JSON_LENGTH   // JSON_LENGTH
+	JSON_KEYS     // JSON_KEYS
+	JSON_VALID    // JSON_VALID
+	JSON_SET      // JSON_SET
+
+	CONVERT_TZ // CONVERT_TZ
+
+	GROUP_CONCAT    // GROUP_CONCAT
+	PERCENTILE_CONT // PERCENTILE_CONT
+
+	REGEXP_LIKE  // REGEXP_LIKE
+	REGEXP_INSTR // REGEXP_INSTR
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -195,6 +213,8 @@ var functionIdRegister = map[string]int32{
 	"between":     BETWEEN,
 	"in":          IN,
 	"exists":      EXISTS,
+	"any":         ANY,
+	"all":         ALL,
 	"+":           PLUS,
 	"-":           MINUS,
 	"*":           MULTI,
@@ -219,6 +239,10 @@ var functionIdRegister = map[string]int32{
 	"stddev_pop":            STDDEV_POP,
 	"variance":              VAR_POP,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
+	"group_concat":          GROUP_CONCAT,
+	"median":                MEDIAN,
+	"percentile_cont":       PERCENTILE_CONT,
+	"mode":                  MODE,
 	// builtin
 	"extract":   EXTRACT,
 	"year":      YEAR,
@@ -227,4 +251,24 @@ var functionIdRegister = map[string]int32{
 	"iff":       IFF,
 	"date_add":  DATE_ADD,
 	"date_sub":  DATE_SUB,
+	// json
+	"json_extract":  JSON_EXTRACT,
+	"json_unquote":  JSON_UNQUOTE,
+	"json_contains": JSON_CONTAINS,
+	"json_array":    JSON_ARRAY,
+	"json_object":   JSON_OBJECT,
+	"json_length":   JSON_LENGTH,
+	"json_keys":     JSON_KEYS,
+	"json_valid":    JSON_VALID,
+	"json_set":      JSON_SET,
+	// time zone
+	"convert_tz": CONVERT_TZ,
+	// grouping sets
+	"grouping_id": GROUPING_ID,
+	// regular expression
+	"regexp":         REGEXP,
+	"regexp_like":    REGEXP_LIKE,
+	"regexp_instr":   REGEXP_INSTR,
+	"regexp_substr":  REGEXP_SUBSTR,
+	"regexp_replace": REGEXP_REPLACE,
 }
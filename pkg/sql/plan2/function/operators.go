@@ -1399,6 +1399,26 @@ var operators = map[int][]Function{
 			},
 		},
 	},
+	ANY: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      EXISTS_ANY_PREDICATE,
+			Args:        []types.T{types.T_bool},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+		},
+	},
+	ALL: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      EXISTS_ANY_PREDICATE,
+			Args:        []types.T{types.T_bool},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+		},
+	},
 	// logic operator
 	AND: {
 		{
@@ -3599,6 +3619,339 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:       159,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       160,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_text},
+			ReturnTyp:   types.T_text,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       161,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_binary, types.T_binary},
+			ReturnTyp:   types.T_binary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       162,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varbinary, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       163,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_blob, types.T_blob},
+			ReturnTyp:   types.T_blob,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       164,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       165,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       166,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_datetime, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       167,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       168,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       169,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       170,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_text},
+			ReturnTyp:   types.T_text,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       171,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_text},
+			ReturnTyp:   types.T_text,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       172,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       173,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       174,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_binary},
+			ReturnTyp:   types.T_binary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       175,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_binary},
+			ReturnTyp:   types.T_binary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       176,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varbinary, types.T_binary},
+			ReturnTyp:   types.T_binary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       177,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       178,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       179,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_binary, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       180,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_blob, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       181,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_varbinary},
+			ReturnTyp:   types.T_varbinary,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       182,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_blob},
+			ReturnTyp:   types.T_blob,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       183,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_blob},
+			ReturnTyp:   types.T_blob,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       184,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varbinary, types.T_blob},
+			ReturnTyp:   types.T_blob,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       185,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_blob},
+			ReturnTyp:   types.T_blob,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       186,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_binary, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       187,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varbinary, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       188,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_blob, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       189,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_blob, types.T_text},
+			ReturnTyp:   types.T_text,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       190,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_json},
+			ReturnTyp:   types.T_json,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       191,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_json},
+			ReturnTyp:   types.T_json,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       192,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_text, types.T_json},
+			ReturnTyp:   types.T_json,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       193,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_json, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       194,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_json, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       195,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_json, types.T_text},
+			ReturnTyp:   types.T_text,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	CASE: {
 		{
@@ -16,9 +16,11 @@ package plan2
 
 import (
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 type MockCompilerContext struct {
@@ -135,12 +137,28 @@ func NewMockCompilerContext() *MockCompilerContext {
 		{"l_comment", plan.Type_VARCHAR, false, 44, 0},
 	}
 
+	tpchSchema["sorted_orders"] = []col{ //not exist in tpch, create for test the use of the cluster by order
+		{"so_orderkey", plan.Type_INT64, false, 0, 0},
+		{"so_custkey", plan.Type_INT32, false, 0, 0},
+		{"so_totalprice", plan.Type_FLOAT64, false, 15, 2},
+	}
+	tpchSchema["sorted_customer"] = []col{ //not exist in tpch, create for test the use of the cluster by order
+		{"sc_custkey", plan.Type_INT32, false, 0, 0},
+		{"sc_name", plan.Type_VARCHAR, false, 25, 0},
+	}
+	clusterBy := map[string]string{
+		"sorted_orders":   "so_custkey,so_orderkey",
+		"sorted_customer": "sc_custkey",
+	}
+
 	moSchema["mo_database"] = []col{
 		{"datname", plan.Type_VARCHAR, false, 50, 0},
 	}
 	moSchema["mo_tables"] = []col{
 		{"reldatabase", plan.Type_VARCHAR, false, 50, 0},
 		{"relname", plan.Type_VARCHAR, false, 50, 0},
+		{"relkind", plan.Type_VARCHAR, false, 50, 0},
+		{"rel_createsql", plan.Type_VARCHAR, false, 1024, 0},
 	}
 	moSchema["mo_columns"] = []col{
 		{"att_database", plan.Type_VARCHAR, false, 50, 0},
@@ -191,10 +209,74 @@ func NewMockCompilerContext() *MockCompilerContext {
 				Name: tableName,
 				Cols: colDefs,
 			}
+			if keys, ok := clusterBy[tableName]; ok {
+				tables[tableName].Defs = []*plan.TableDef_DefType{
+					{
+						Def: &plan.TableDef_DefType_Properties{
+							Properties: &plan.PropertiesDef{
+								Properties: []*plan.Property{
+									{
+										Key:   engine.ClusterByProperty,
+										Value: keys,
+									},
+								},
+							},
+						},
+					},
+				}
+			}
 			tableIdx++
 		}
 	}
 
+	// views of tpch, the names and types of their columns are stored with the definition
+	views := map[string]struct {
+		sql  string
+		cols []col
+	}{
+		"v1": {"create view v1 as select n_name, r_name from nation join region on n_regionkey = r_regionkey", []col{
+			{"n_name", plan.Type_VARCHAR, false, 25, 0},
+			{"r_name", plan.Type_VARCHAR, false, 25, 0},
+		}},
+		"v_loop": {"create view v_loop as select * from v_loop", []col{ //refers to itself
+			{"n_name", plan.Type_VARCHAR, false, 25, 0},
+		}},
+		"v_stale": {"create view v_stale as select * from region", []col{ //region has changed since created
+			{"r_regionkey", plan.Type_INT32, false, 0, 0},
+		}},
+	}
+	for viewName, view := range views {
+		colDefs := make([]*ColDef, 0, len(view.cols))
+		for _, col := range view.cols {
+			colDefs = append(colDefs, &ColDef{
+				Typ: &plan.Type{
+					Id:        col.Id,
+					Nullable:  col.Nullable,
+					Width:     col.Width,
+					Precision: col.Precision,
+				},
+				Name: col.Name,
+			})
+		}
+		objects[viewName] = &ObjectRef{
+			SchemaName: "tpch",
+			ObjName:    viewName,
+		}
+		tables[viewName] = &TableDef{
+			Name: viewName,
+			Cols: colDefs,
+			Defs: []*plan.TableDef_DefType{
+				{
+					Def: &plan.TableDef_DefType_View{
+						View: &plan.ViewDef{
+							View: view.sql,
+						},
+					},
+				},
+			},
+		}
+	}
+
 	return &MockCompilerContext{
 		objects: objects,
 		tables:  tables,
@@ -209,6 +291,10 @@ func (m *MockCompilerContext) DefaultDatabase() string {
 	return "tpch"
 }
 
+func (m *MockCompilerContext) TimeZone() *time.Location {
+	return time.FixedZone("+08:00", 8*3600)
+}
+
 func (m *MockCompilerContext) Resolve(dbName string, tableName string) (*ObjectRef, *TableDef) {
 	name := strings.ToLower(tableName)
 	return m.objects[name], m.tables[name]
@@ -15,6 +15,8 @@
 package plan2
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -41,6 +43,8 @@ type CompilerContext interface {
 	Resolve(schemaName string, tableName string) (*ObjectRef, *TableDef)
 	// get estimated cost by table & expr
 	Cost(obj *ObjectRef, e *Expr) *Cost
+	// time zone of the session, the TIMESTAMP constants are parsed in it
+	TimeZone() *time.Location
 }
 
 type Optimizer interface {
@@ -0,0 +1,477 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeorder"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergetop"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
+	"google.golang.org/protobuf/proto"
+)
+
+// EncodePipeline encodes a scope of compile2 and its execution context,
+// the instructions of the scope are the operators of pkg/sql/colexec2.
+func EncodePipeline(p Pipeline, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeInt64(p.UnixTime))
+	buf.Write(encoding.EncodeUint32(uint32(len(p.Snapshot))))
+	buf.Write(p.Snapshot)
+	return EncodePipelineScope(p.Scope, buf)
+}
+
+func DecodePipeline(data []byte) (Pipeline, []byte, error) {
+	var p Pipeline
+	var err error
+
+	p.UnixTime = encoding.DecodeInt64(data[:8])
+	data = data[8:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		p.Snapshot = append([]byte{}, data[:n]...)
+		data = data[n:]
+	}
+	if p.Scope, data, err = DecodePipelineScope(data); err != nil {
+		return p, nil, err
+	}
+	return p, data, nil
+}
+
+func EncodePipelineScope(s Scope, buf *bytes.Buffer) error {
+	// Magic
+	buf.Write(encoding.EncodeUint32(uint32(s.Magic)))
+	// DataSource
+	data, err := encoding.Encode(s.DataSource)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	// PreScopes
+	buf.Write(encoding.EncodeUint32(uint32(len(s.PreScopes))))
+	for i := range s.PreScopes {
+		if err := EncodePipelineScope(s.PreScopes[i], buf); err != nil {
+			return err
+		}
+	}
+	// Node
+	data, err = encoding.Encode(s.NodeInfo)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	// Ins
+	return EncodePipelineInstructions(s.Ins, buf)
+}
+
+func DecodePipelineScope(data []byte) (Scope, []byte, error) {
+	var s Scope
+	var err error
+	// Magic
+	s.Magic = int(encoding.DecodeUint32(data[:4]))
+	data = data[4:]
+	// DataSource
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if err = encoding.Decode(data[:n], &s.DataSource); err != nil {
+		return s, nil, err
+	}
+	data = data[n:]
+	// PreScopes
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	s.PreScopes = make([]Scope, n)
+	for i := uint32(0); i < n; i++ {
+		if s.PreScopes[i], data, err = DecodePipelineScope(data); err != nil {
+			return s, nil, err
+		}
+	}
+	// Node
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if err = encoding.Decode(data[:n], &s.NodeInfo); err != nil {
+		return s, nil, err
+	}
+	data = data[n:]
+	// Ins
+	if s.Ins, data, err = DecodePipelineInstructions(data); err != nil {
+		return s, nil, err
+	}
+	return s, data, nil
+}
+
+func EncodePipelineInstructions(ins vm.Instructions, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeUint32(uint32(len(ins))))
+	for _, in := range ins {
+		if err := EncodePipelineInstruction(in, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DecodePipelineInstructions(data []byte) (vm.Instructions, []byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	ins := make(vm.Instructions, n)
+	for i := uint32(0); i < n; i++ {
+		in, d, err := DecodePipelineInstruction(data)
+		if err != nil {
+			return ins, nil, err
+		}
+		ins[i] = in
+		data = d
+	}
+	return ins, data, nil
+}
+
+// IsPipelineInstructionEncodable returns true if the operator can be encoded
+// by EncodePipelineInstruction and so run on a remote node.
+func IsPipelineInstructionEncodable(in vm.Instruction) bool {
+	switch in.Op {
+	case overload.Top, overload.MergeTop, overload.Order, overload.MergeOrder,
+		overload.Limit, overload.MergeLimit, overload.Offset, overload.MergeOffset,
+		overload.Group, overload.MergeGroup, overload.Restrict, overload.Projection,
+		overload.Merge, overload.Connector:
+		return true
+	}
+	return false
+}
+
+// EncodePipelineInstruction encodes an operator of pkg/sql/colexec2. The connector
+// carries nothing and has to be rebuilt by the receiver, the operators bound to the
+// local node, such as dispatch, output and the joins, can not be encoded.
+func EncodePipelineInstruction(in vm.Instruction, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeUint32(uint32(in.Op)))
+	switch in.Op {
+	case overload.Top:
+		arg := in.Arg.(*top.Argument)
+		buf.Write(encoding.EncodeInt64(arg.Limit))
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Fs))))
+		for _, f := range arg.Fs {
+			buf.WriteByte(byte(f.Type))
+			if err := EncodePlanExpr(f.E, buf); err != nil {
+				return err
+			}
+		}
+	case overload.MergeTop:
+		arg := in.Arg.(*mergetop.Argument)
+		buf.Write(encoding.EncodeInt64(arg.Limit))
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Fs))))
+		for _, f := range arg.Fs {
+			buf.WriteByte(byte(f.Type))
+			if err := EncodePlanExpr(f.E, buf); err != nil {
+				return err
+			}
+		}
+	case overload.Order:
+		arg := in.Arg.(*order.Argument)
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Fs))))
+		for _, f := range arg.Fs {
+			buf.WriteByte(byte(f.Type))
+			if err := EncodePlanExpr(f.E, buf); err != nil {
+				return err
+			}
+		}
+	case overload.MergeOrder:
+		arg := in.Arg.(*mergeorder.Argument)
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Fs))))
+		for _, f := range arg.Fs {
+			buf.WriteByte(byte(f.Type))
+			if err := EncodePlanExpr(f.E, buf); err != nil {
+				return err
+			}
+		}
+	case overload.Limit:
+		arg := in.Arg.(*limit.Argument)
+		buf.Write(encoding.EncodeUint64(arg.Seen))
+		buf.Write(encoding.EncodeUint64(arg.Limit))
+	case overload.MergeLimit:
+		arg := in.Arg.(*mergelimit.Argument)
+		buf.Write(encoding.EncodeUint64(arg.Limit))
+	case overload.Offset:
+		arg := in.Arg.(*offset.Argument)
+		buf.Write(encoding.EncodeUint64(arg.Seen))
+		buf.Write(encoding.EncodeUint64(arg.Offset))
+	case overload.MergeOffset:
+		arg := in.Arg.(*mergeoffset.Argument)
+		buf.Write(encoding.EncodeUint64(arg.Offset))
+	case overload.Group:
+		arg := in.Arg.(*group.Argument)
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Exprs))))
+		for _, e := range arg.Exprs {
+			if err := EncodePlanExpr(e, buf); err != nil {
+				return err
+			}
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Aggs))))
+		for _, agg := range arg.Aggs {
+			buf.Write(encoding.EncodeUint32(uint32(agg.Op)))
+			if agg.Dist {
+				buf.WriteByte(1)
+			} else {
+				buf.WriteByte(0)
+			}
+			if err := EncodePlanExpr(agg.E, buf); err != nil {
+				return err
+			}
+			buf.Write(encoding.EncodeUint32(uint32(len(agg.Orders))))
+			for _, o := range agg.Orders {
+				if err := EncodePlanExpr(o.Expr, buf); err != nil {
+					return err
+				}
+				buf.Write(encoding.EncodeUint32(uint32(o.Flag)))
+			}
+			encodeBytes([]byte(agg.Sep), buf)
+			buf.Write(encoding.EncodeFloat64(agg.Frac))
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.GroupingSets))))
+		for _, set := range arg.GroupingSets {
+			buf.Write(encoding.EncodeUint32(uint32(len(set))))
+			for _, pos := range set {
+				buf.Write(encoding.EncodeInt32(pos))
+			}
+		}
+	case overload.MergeGroup:
+		arg := in.Arg.(*mergegroup.Argument)
+		if arg.NeedEval {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case overload.Restrict:
+		arg := in.Arg.(*restrict.Argument)
+		return EncodePlanExpr(arg.E, buf)
+	case overload.Projection:
+		arg := in.Arg.(*projection.Argument)
+		buf.Write(encoding.EncodeUint32(uint32(len(arg.Es))))
+		for _, e := range arg.Es {
+			if err := EncodePlanExpr(e, buf); err != nil {
+				return err
+			}
+		}
+	case overload.Merge, overload.Connector:
+	default:
+		return fmt.Errorf("instruction '%v' can not be sent to the remote node", in.Op)
+	}
+	return nil
+}
+
+func DecodePipelineInstruction(data []byte) (vm.Instruction, []byte, error) {
+	var err error
+	var in vm.Instruction
+
+	in.Op = int(encoding.DecodeUint32(data[:4]))
+	data = data[4:]
+	switch in.Op {
+	case overload.Top:
+		arg := &top.Argument{Limit: encoding.DecodeInt64(data[:8])}
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Fs = make([]top.Field, n)
+		for i := range arg.Fs {
+			arg.Fs[i].Type = top.Direction(data[0])
+			if arg.Fs[i].E, data, err = DecodePlanExpr(data[1:]); err != nil {
+				return in, nil, err
+			}
+		}
+		in.Arg = arg
+	case overload.MergeTop:
+		arg := &mergetop.Argument{Limit: encoding.DecodeInt64(data[:8])}
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Fs = make([]top.Field, n)
+		for i := range arg.Fs {
+			arg.Fs[i].Type = top.Direction(data[0])
+			if arg.Fs[i].E, data, err = DecodePlanExpr(data[1:]); err != nil {
+				return in, nil, err
+			}
+		}
+		in.Arg = arg
+	case overload.Order:
+		arg := &order.Argument{}
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Fs = make([]order.Field, n)
+		for i := range arg.Fs {
+			arg.Fs[i].Type = order.Direction(data[0])
+			if arg.Fs[i].E, data, err = DecodePlanExpr(data[1:]); err != nil {
+				return in, nil, err
+			}
+		}
+		in.Arg = arg
+	case overload.MergeOrder:
+		arg := &mergeorder.Argument{}
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Fs = make([]order.Field, n)
+		for i := range arg.Fs {
+			arg.Fs[i].Type = order.Direction(data[0])
+			if arg.Fs[i].E, data, err = DecodePlanExpr(data[1:]); err != nil {
+				return in, nil, err
+			}
+		}
+		in.Arg = arg
+	case overload.Limit:
+		in.Arg = &limit.Argument{
+			Seen:  encoding.DecodeUint64(data[:8]),
+			Limit: encoding.DecodeUint64(data[8:16]),
+		}
+		data = data[16:]
+	case overload.MergeLimit:
+		in.Arg = &mergelimit.Argument{Limit: encoding.DecodeUint64(data[:8])}
+		data = data[8:]
+	case overload.Offset:
+		in.Arg = &offset.Argument{
+			Seen:   encoding.DecodeUint64(data[:8]),
+			Offset: encoding.DecodeUint64(data[8:16]),
+		}
+		data = data[16:]
+	case overload.MergeOffset:
+		in.Arg = &mergeoffset.Argument{Offset: encoding.DecodeUint64(data[:8])}
+		data = data[8:]
+	case overload.Group:
+		arg := &group.Argument{}
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Exprs = make([]*plan.Expr, n)
+		for i := range arg.Exprs {
+			if arg.Exprs[i], data, err = DecodePlanExpr(data); err != nil {
+				return in, nil, err
+			}
+		}
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Aggs = make([]aggregate.Aggregate, n)
+		for i := range arg.Aggs {
+			arg.Aggs[i].Op = int(encoding.DecodeUint32(data[:4]))
+			arg.Aggs[i].Dist = data[4] == 1
+			if arg.Aggs[i].E, data, err = DecodePlanExpr(data[5:]); err != nil {
+				return in, nil, err
+			}
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			if m > 0 {
+				arg.Aggs[i].Orders = make([]*plan.OrderBySpec, m)
+				for j := range arg.Aggs[i].Orders {
+					o := &plan.OrderBySpec{}
+					if o.Expr, data, err = DecodePlanExpr(data); err != nil {
+						return in, nil, err
+					}
+					o.Flag = plan.OrderBySpec_OrderByFlag(encoding.DecodeUint32(data[:4]))
+					data = data[4:]
+					arg.Aggs[i].Orders[j] = o
+				}
+			}
+			var sep []byte
+			sep, data = decodeBytes(data)
+			arg.Aggs[i].Sep = string(sep)
+			arg.Aggs[i].Frac = encoding.DecodeFloat64(data[:8])
+			data = data[8:]
+		}
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			arg.GroupingSets = make([][]int32, n)
+			for i := range arg.GroupingSets {
+				m := encoding.DecodeUint32(data[:4])
+				data = data[4:]
+				arg.GroupingSets[i] = make([]int32, m)
+				for j := range arg.GroupingSets[i] {
+					arg.GroupingSets[i][j] = encoding.DecodeInt32(data[:4])
+					data = data[4:]
+				}
+			}
+		}
+		in.Arg = arg
+	case overload.MergeGroup:
+		in.Arg = &mergegroup.Argument{NeedEval: data[0] == 1}
+		data = data[1:]
+	case overload.Restrict:
+		arg := &restrict.Argument{}
+		if arg.E, data, err = DecodePlanExpr(data); err != nil {
+			return in, nil, err
+		}
+		in.Arg = arg
+	case overload.Projection:
+		arg := &projection.Argument{}
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		arg.Es = make([]*plan.Expr, n)
+		for i := range arg.Es {
+			if arg.Es[i], data, err = DecodePlanExpr(data); err != nil {
+				return in, nil, err
+			}
+		}
+		in.Arg = arg
+	case overload.Merge:
+		in.Arg = &merge.Argument{}
+	case overload.Connector:
+		in.Arg = &connector.Argument{}
+	default:
+		return in, nil, fmt.Errorf("instruction '%v' can not be received from the remote node", in.Op)
+	}
+	return in, data, nil
+}
+
+// EncodePlanExpr encodes an expression of plan2 by protobuf, a nil expression
+// is encoded as an empty one.
+func EncodePlanExpr(e *plan.Expr, buf *bytes.Buffer) error {
+	if e == nil {
+		buf.Write(encoding.EncodeUint32(0))
+		return nil
+	}
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	return nil
+}
+
+func DecodePlanExpr(data []byte) (*plan.Expr, []byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n == 0 {
+		return nil, data, nil
+	}
+	e := new(plan.Expr)
+	if err := proto.Unmarshal(data[:n], e); err != nil {
+		return nil, nil, err
+	}
+	return e, data[n:], nil
+}
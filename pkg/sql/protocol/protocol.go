@@ -30,8 +30,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/mode"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -1909,6 +1913,72 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 		// Typ
 		buf.Write(encoding.EncodeType(v.Typ))
 		return nil
+	case *distinct.DistinctRing:
+		buf.WriteByte(DistinctRing)
+		if err := EncodeRing(v.R, buf); err != nil {
+			return err
+		}
+		if err := EncodeVector(v.Vs, buf); err != nil {
+			return err
+		}
+		// Rows
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Rows))))
+		for _, rows := range v.Rows {
+			buf.Write(encoding.EncodeUint32(uint32(len(rows))))
+			if len(rows) > 0 {
+				buf.Write(encoding.EncodeInt64Slice(rows))
+			}
+		}
+		return nil
+	case *groupconcat.GroupConcatRing:
+		buf.WriteByte(GroupConcatRing)
+		buf.Write(encoding.EncodeType(v.Typ))
+		encodeBytes([]byte(v.Sep), buf)
+		// Desc
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Desc))))
+		for _, desc := range v.Desc {
+			if desc {
+				buf.WriteByte(1)
+			} else {
+				buf.WriteByte(0)
+			}
+		}
+		// Vs and Ks
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Vs))))
+		for i := range v.Vs {
+			buf.Write(encoding.EncodeUint32(uint32(len(v.Vs[i]))))
+			for j := range v.Vs[i] {
+				encodeBytes(v.Vs[i][j], buf)
+				encodeBytes(v.Ks[i][j], buf)
+			}
+		}
+		return nil
+	case *percentile.PercentileRing:
+		buf.WriteByte(PercentileRing)
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeFloat64(v.P))
+		// Vs
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Vs))))
+		for _, vs := range v.Vs {
+			buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+			if len(vs) > 0 {
+				buf.Write(encoding.EncodeFloat64Slice(vs))
+			}
+		}
+		return nil
+	case *mode.ModeRing:
+		buf.WriteByte(ModeRing)
+		buf.Write(encoding.EncodeType(v.Typ))
+		// Vs
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Vs))))
+		for _, mp := range v.Vs {
+			buf.Write(encoding.EncodeUint32(uint32(len(mp))))
+			for k, n := range mp {
+				encodeBytes([]byte(k), buf)
+				buf.Write(encoding.EncodeInt64(n))
+			}
+		}
+		return nil
 	}
 	return fmt.Errorf("'%v' ring not yet support", r)
 }
@@ -3093,6 +3163,107 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		data = data[encoding.TypeSize:]
 		result.Typ = typ
 		return result, data, nil
+	case DistinctRing:
+		r, data, err := DecodeRing(data[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		vs, data, err := DecodeVector(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Rows
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		rows := make([][]int64, n)
+		for i := range rows {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			if m > 0 {
+				rows[i] = make([]int64, m)
+				copy(rows[i], encoding.DecodeInt64Slice(data[:m*8]))
+				data = data[m*8:]
+			}
+		}
+		return distinct.NewDistinctWithData(r, vs, rows, nil), data, nil
+	case GroupConcatRing:
+		data = data[1:]
+		r := new(groupconcat.GroupConcatRing)
+		r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		var sep []byte
+		sep, data = decodeBytes(data)
+		r.Sep = string(sep)
+		// Desc
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Desc = make([]bool, n)
+			for i := range r.Desc {
+				r.Desc[i] = data[i] == 1
+			}
+			data = data[n:]
+		}
+		// Vs and Ks
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		r.Vs = make([][][]byte, n)
+		r.Ks = make([][][]byte, n)
+		for i := range r.Vs {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			if m > 0 {
+				r.Vs[i] = make([][]byte, m)
+				r.Ks[i] = make([][]byte, m)
+				for j := range r.Vs[i] {
+					r.Vs[i][j], data = decodeBytes(data)
+					r.Ks[i][j], data = decodeBytes(data)
+				}
+			}
+		}
+		return r, data, nil
+	case PercentileRing:
+		data = data[1:]
+		r := new(percentile.PercentileRing)
+		r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.P = encoding.DecodeFloat64(data[:8])
+		data = data[8:]
+		// Vs
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		r.Vs = make([][]float64, n)
+		for i := range r.Vs {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			if m > 0 {
+				r.Vs[i] = make([]float64, m)
+				copy(r.Vs[i], encoding.DecodeFloat64Slice(data[:m*8]))
+				data = data[m*8:]
+			}
+		}
+		return r, data, nil
+	case ModeRing:
+		data = data[1:]
+		r := new(mode.ModeRing)
+		r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		// Vs
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		r.Vs = make([]map[string]int64, n)
+		for i := range r.Vs {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs[i] = make(map[string]int64, m)
+			for j := uint32(0); j < m; j++ {
+				var k []byte
+				k, data = decodeBytes(data)
+				r.Vs[i][string(k)] = encoding.DecodeInt64(data[:8])
+				data = data[8:]
+			}
+		}
+		return r, data, nil
 	}
 	return nil, nil, fmt.Errorf("type '%v' ring not yet support", data[0])
 }
@@ -4531,10 +4702,57 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 		data = data[encoding.TypeSize:]
 		result.Typ = typ
 		return result, data, nil
+	case DistinctRing:
+		r, data, err := DecodeRingWithProcess(data[1:], proc)
+		if err != nil {
+			return nil, nil, err
+		}
+		vs, data, err := DecodeVector(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if vs, err = vector.Dup(vs, proc.Mp); err != nil {
+			return nil, nil, err
+		}
+		// Rows
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		rows := make([][]int64, n)
+		for i := range rows {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			if m > 0 {
+				rows[i] = make([]int64, m)
+				copy(rows[i], encoding.DecodeInt64Slice(data[:m*8]))
+				data = data[m*8:]
+			}
+		}
+		return distinct.NewDistinctWithData(r, vs, rows, proc.Mp), data, nil
+	case GroupConcatRing, PercentileRing, ModeRing:
+		// these rings do not allocate memory from the process
+		return DecodeRing(data)
 	}
 	return nil, nil, fmt.Errorf("type '%v' ring not yet support", data[0])
 }
 
+// encodeBytes writes the length of v followed by v.
+func encodeBytes(v []byte, buf *bytes.Buffer) {
+	buf.Write(encoding.EncodeUint32(uint32(len(v))))
+	buf.Write(v)
+}
+
+// decodeBytes returns a copy of the bytes written by encodeBytes.
+func decodeBytes(data []byte) ([]byte, []byte) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n == 0 {
+		return nil, data
+	}
+	v := make([]byte, n)
+	copy(v, data[:n])
+	return v, data[n:]
+}
+
 func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 	switch v.Typ.Oid {
 	case types.T_int8:
@@ -4707,7 +4925,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -4793,6 +5011,23 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Time)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeTimeSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
 	case types.T_timestamp:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
@@ -5134,7 +5369,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -5264,6 +5499,34 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
+	case types.T_time:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeTimeSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
 	case types.T_timestamp:
 		v := vector.New(typ)
 		v.Or = true
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitand"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitor"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitxor"
@@ -31,8 +32,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/mode"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -56,9 +61,27 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
+func TestPipelineInstructionEncodable(t *testing.T) {
+	for op := overload.Top; op <= overload.MergeJoin; op++ {
+		in := vm.Instruction{Op: op}
+		if IsPipelineInstructionEncodable(in) {
+			continue
+		}
+		var buf bytes.Buffer
+		require.Error(t, EncodePipelineInstruction(in, &buf), "instruction %v", op)
+	}
+	require.True(t, IsPipelineInstructionEncodable(vm.Instruction{Op: overload.Projection}))
+	require.False(t, IsPipelineInstructionEncodable(vm.Instruction{Op: overload.Join}))
+}
+
 func TestTransform(t *testing.T) {
 	var buf bytes.Buffer
 	ins := vm.Instruction{
@@ -691,6 +714,64 @@ func TestVector(t *testing.T) {
 	}
 }
 
+func TestDistinctRing(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	typ := types.Type{Oid: types.T_varchar, Size: 24}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, [][]byte{[]byte("a"), []byte("b"), []byte("a"), []byte("c")}))
+	r := distinct.NewDistinct(count.NewCount(typ), typ)
+	require.NoError(t, r.Grows(2, proc.Mp))
+	r.BatchFill(0, make([]uint8, 3), []uint64{1, 1, 2}, []int64{1, 1, 1}, vec)
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeRing(r, &buf))
+	rr, _, err := DecodeRing(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, r.Rows, rr.(*distinct.DistinctRing).Rows)
+	rr, _, err = DecodeRingWithProcess(buf.Bytes(), proc)
+	require.NoError(t, err)
+
+	// the decoded ring still knows the values it has seen
+	rr.Fill(0, 0, 1, vec)
+	rr.Fill(1, 3, 1, vec)
+	require.Equal(t, []int64{2, 2}, rr.Eval(nil).Col)
+}
+
+func TestOrderedRings(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, []int64{3, 1, 2, 2}))
+	rs := []ring.Ring{
+		groupconcat.NewGroupConcat(typ, ";", []bool{true}),
+		percentile.NewPercentile(typ, 0.5),
+		mode.NewMode(typ),
+	}
+	rs[0].(ring.OrderedRing).SetOrderVecs([]*vector.Vector{vec})
+	expected := []interface{}{"3;2;2;1", 2.0, int64(2)}
+	for i, r := range rs {
+		require.NoError(t, r.Grows(2, proc.Mp))
+		r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+
+		var buf bytes.Buffer
+		require.NoError(t, EncodeRing(r, &buf))
+		rr, data, err := DecodeRingWithProcess(buf.Bytes(), proc)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(data))
+
+		res := rr.Eval(nil)
+		switch col := res.Col.(type) {
+		case *types.Bytes:
+			require.Equal(t, expected[i], string(col.Get(0)))
+		case []float64:
+			require.Equal(t, expected[i], col[0])
+		case []int64:
+			require.Equal(t, expected[i], col[0])
+		}
+		require.True(t, nulls.Contains(res.Nsp, 1))
+	}
+}
+
 func TestRing(t *testing.T) {
 	sk := hyperloglog.New()
 	sk.Insert([]byte{0, 0, 0, 1})
@@ -88,6 +88,14 @@ const (
 	BitXorRing
 	// StdDevPop
 	StdDevPopRing
+	// Distinct
+	DistinctRing
+	// GroupConcat
+	GroupConcatRing
+	// Median and PercentileCont
+	PercentileRing
+	// Mode
+	ModeRing
 )
 
 // colexec
@@ -279,3 +287,11 @@ type Scope struct {
 	NodeInfo   Node
 	Ins        vm.Instructions
 }
+
+// Pipeline is a scope of compile2 shipped to the node which holds its data,
+// it carries the execution context of the statement as well.
+type Pipeline struct {
+	UnixTime int64
+	Snapshot []byte
+	Scope    Scope
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converttz
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// ConvertTz converts xs from the time zones froms to the time zones tos,
+// froms or tos can be a constant of one location. The rows whose time
+// zone is nil are added to nsp.
+func ConvertTz(xs []types.Datetime, froms, tos []*time.Location, rs []types.Datetime, nsp *nulls.Nulls) []types.Datetime {
+	for i, x := range xs {
+		from, to := froms[0], tos[0]
+		if len(froms) > 1 {
+			from = froms[i]
+		}
+		if len(tos) > 1 {
+			to = tos[i]
+		}
+		if from == nil || to == nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = types.ConvertTimeZone(x, from, to)
+	}
+	return rs
+}
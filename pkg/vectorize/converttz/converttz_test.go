@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converttz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertTz(t *testing.T) {
+	xs := make([]types.Datetime, 2)
+	for i, s := range []string{"2022-01-01 00:00:00", "2022-07-01 00:00:00"} {
+		dt, err := types.ParseDatetime(s)
+		require.NoError(t, err)
+		xs[i] = dt
+	}
+	ny, err := types.ParseTimeZone("America/New_York")
+	require.NoError(t, err)
+
+	nsp := new(nulls.Nulls)
+	rs := ConvertTz(xs, []*time.Location{time.UTC}, []*time.Location{ny}, make([]types.Datetime, 2), nsp)
+	require.Equal(t, "2021-12-31 19:00:00", rs[0].String())
+	require.Equal(t, "2022-06-30 20:00:00", rs[1].String())
+	require.False(t, nulls.Any(nsp))
+
+	rs = ConvertTz(xs, []*time.Location{time.UTC}, []*time.Location{nil, ny}, make([]types.Datetime, 2), nsp)
+	require.True(t, nulls.Contains(nsp, 0))
+	require.Equal(t, "2022-06-30 20:00:00", rs[1].String())
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package json implements the json functions on columns of binary json,
+// the values are stored in the format of bytejson.ByteJson.Marshal
+package json
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+	rs.Data = append(rs.Data, v...)
+}
+
+// at returns the i-th value of a column, a column of one value is a constant
+func at[T any](col []T, i int) T {
+	if len(col) == 1 {
+		return col[0]
+	}
+	return col[i]
+}
+
+// Parse converts the json texts of xs to be binary json, the null rows in nsp are skipped
+func Parse(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		bj, err := bytejson.ParseFromByteSlice(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, bj.Marshal())
+	}
+	return rs, nil
+}
+
+// Valid checks whether the values of xs are valid json texts
+func Valid(xs *types.Bytes, rs []int64) []int64 {
+	for i := range xs.Offsets {
+		if bytejson.IsValid(xs.Get(int64(i))) {
+			rs[i] = 1
+		} else {
+			rs[i] = 0
+		}
+	}
+	return rs
+}
+
+// Extract evaluates the paths on xs, the rows without any match are added to nsp
+func Extract(xs *types.Bytes, paths []*bytejson.Path, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		res, ok := bj.Extract(paths)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			appendBytes(rs, nil)
+			continue
+		}
+		appendBytes(rs, res.Marshal())
+	}
+	return rs, nil
+}
+
+// Unquote returns the unquoted strings of xs
+func Unquote(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, []byte(bj.Unquote()))
+	}
+	return rs, nil
+}
+
+// locate returns the value at path of the i-th row, path can be nil
+func locate(xs *types.Bytes, i int, path *bytejson.Path) (bytejson.ByteJson, bool, error) {
+	bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+	if err != nil || path == nil {
+		return bj, err == nil, err
+	}
+	bj, ok := bj.Extract([]*bytejson.Path{path})
+	return bj, ok, nil
+}
+
+// Length returns the lengths of the values at path, rows without match are added to nsp
+func Length(xs *types.Bytes, path *bytejson.Path, rs []int64, nsp *nulls.Nulls) ([]int64, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		bj, ok, err := locate(xs, i, path)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = bj.Length()
+	}
+	return rs, nil
+}
+
+// Keys returns the keys of the objects at path, the other rows are added to nsp
+func Keys(xs *types.Bytes, path *bytejson.Path, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		bj, ok, err := locate(xs, i, path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			bj, ok = bj.Keys()
+		}
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			appendBytes(rs, nil)
+			continue
+		}
+		appendBytes(rs, bj.Marshal())
+	}
+	return rs, nil
+}
+
+// Contains checks whether the candidates ys are contained in the targets
+// xs at path. xs or ys can be a constant of one value.
+func Contains(xs, ys *types.Bytes, path *bytejson.Path, rs []int64, nsp *nulls.Nulls) ([]int64, error) {
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		target, ok, err := locate(xs, i%len(xs.Offsets), path)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		candidate, err := bytejson.Unmarshal(ys.Get(int64(i % len(ys.Offsets))))
+		if err != nil {
+			return nil, err
+		}
+		if target.Contains(candidate) {
+			rs[i] = 1
+		} else {
+			rs[i] = 0
+		}
+	}
+	return rs, nil
+}
+
+// Set sets the values of vals at paths, vals[j] is the column of the j-th path
+func Set(xs *types.Bytes, paths []*bytejson.Path, vals [][]bytejson.ByteJson, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	row := make([]bytejson.ByteJson, len(paths))
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		for j := range row {
+			row[j] = at(vals[j], i)
+		}
+		if bj, err = bj.Set(paths, row); err != nil {
+			return nil, err
+		}
+		appendBytes(rs, bj.Marshal())
+	}
+	return rs, nil
+}
+
+// Array builds json arrays of count rows, vals[j] is the column of the j-th element
+func Array(vals [][]bytejson.ByteJson, count int, rs *types.Bytes) (*types.Bytes, error) {
+	row := make([]any, len(vals))
+	for i := 0; i < count; i++ {
+		for j := range row {
+			row[j] = at(vals[j], i)
+		}
+		bj, err := bytejson.CreateByteJson(row)
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, bj.Marshal())
+	}
+	return rs, nil
+}
+
+// Object builds json objects of count rows, keys[j] and vals[j] are the
+// columns of the j-th member
+func Object(keys [][]string, vals [][]bytejson.ByteJson, count int, rs *types.Bytes) (*types.Bytes, error) {
+	for i := 0; i < count; i++ {
+		m := make(map[string]any, len(keys))
+		for j := range keys {
+			m[at(keys[j], i)] = at(vals[j], i)
+		}
+		bj, err := bytejson.CreateByteJson(m)
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, bj.Marshal())
+	}
+	return rs, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(strs ...string) *types.Bytes {
+	rs := &types.Bytes{}
+	for _, s := range strs {
+		appendBytes(rs, []byte(s))
+	}
+	return rs
+}
+
+func makeJson(t *testing.T, strs ...string) *types.Bytes {
+	rs, err := Parse(makeBytes(strs...), &types.Bytes{}, new(nulls.Nulls))
+	require.NoError(t, err)
+	return rs
+}
+
+func toStrings(t *testing.T, xs *types.Bytes, nsp *nulls.Nulls) []string {
+	rs := make([]string, len(xs.Offsets))
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs[i] = "NULL"
+			continue
+		}
+		bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+		require.NoError(t, err)
+		rs[i] = bj.String()
+	}
+	return rs
+}
+
+func mustPath(t *testing.T, s string) *bytejson.Path {
+	p, err := bytejson.ParsePath(s)
+	require.NoError(t, err)
+	return p
+}
+
+func TestParseValid(t *testing.T) {
+	_, err := Parse(makeBytes(`{"a": 1}`, `{`), &types.Bytes{}, new(nulls.Nulls))
+	require.Error(t, err)
+
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs, err := Parse(makeBytes(`{"b":1, "a":[true]}`, `{`), &types.Bytes{}, nsp)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a": [true], "b": 1}`, "NULL"}, toStrings(t, rs, nsp))
+
+	require.Equal(t, []int64{1, 0, 1}, Valid(makeBytes(`[1]`, `[1`, `"a"`), make([]int64, 3)))
+}
+
+func TestExtractUnquote(t *testing.T) {
+	xs := makeJson(t, `{"a": "x", "b": [1, 2]}`, `{"a": 1}`, `[]`)
+	nsp := new(nulls.Nulls)
+	rs, err := Extract(xs, []*bytejson.Path{mustPath(t, `$.a`)}, &types.Bytes{}, nsp)
+	require.NoError(t, err)
+	require.Equal(t, []string{`"x"`, `1`, "NULL"}, toStrings(t, rs, nsp))
+
+	us, err := Unquote(rs, &types.Bytes{}, nsp)
+	require.NoError(t, err)
+	require.Equal(t, "x", string(us.Get(0)))
+	require.Equal(t, "1", string(us.Get(1)))
+}
+
+func TestLengthKeys(t *testing.T) {
+	xs := makeJson(t, `{"a": [1, 2, 3], "b": 1}`, `[1]`)
+	nsp := new(nulls.Nulls)
+	rs, err := Length(xs, nil, make([]int64, 2), nsp)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 1}, rs)
+
+	rs, err = Length(xs, mustPath(t, `$.a`), make([]int64, 2), nsp)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), rs[0])
+	require.True(t, nulls.Contains(nsp, 1))
+
+	nsp = new(nulls.Nulls)
+	ks, err := Keys(xs, nil, &types.Bytes{}, nsp)
+	require.NoError(t, err)
+	require.Equal(t, []string{`["a", "b"]`, "NULL"}, toStrings(t, ks, nsp))
+}
+
+func TestContains(t *testing.T) {
+	xs := makeJson(t, `{"a": [1, 2]}`, `{"a": [3]}`)
+	ys := makeJson(t, `2`)
+	rs, err := Contains(xs, ys, mustPath(t, `$.a`), make([]int64, 2), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0}, rs)
+}
+
+func TestSetArrayObject(t *testing.T) {
+	xs := makeJson(t, `{"a": 1}`, `[1]`)
+	nsp := new(nulls.Nulls)
+	rs, err := Set(xs, []*bytejson.Path{mustPath(t, `$.b`)}, [][]bytejson.ByteJson{{bytejson.True}}, &types.Bytes{}, nsp)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a": 1, "b": true}`, `[1]`}, toStrings(t, rs, nsp))
+
+	vals := [][]bytejson.ByteJson{{bytejson.Null}, {bytejson.String("x"), bytejson.String("y")}}
+	rs, err = Array(vals, 2, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{`[null, "x"]`, `[null, "y"]`}, toStrings(t, rs, nsp))
+
+	rs, err = Object([][]string{{"k"}}, vals[1:], 2, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"k": "x"}`, `{"k": "y"}`}, toStrings(t, rs, nsp))
+}
@@ -0,0 +1,286 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package regexp implements the regular expression functions on string columns,
+// a column of one value is a constant of every row.
+package regexp
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// Compiler compiles the patterns of a batch, every distinct pair of
+// pattern and match type is compiled only once, so a constant pattern
+// is compiled once per batch
+type Compiler struct {
+	// binary is true if the strings are binary ones, which are compared
+	// case sensitively without a match type
+	binary bool
+	res    map[string]*regexp.Regexp
+}
+
+func NewCompiler(binary bool) *Compiler {
+	return &Compiler{
+		binary: binary,
+		res:    make(map[string]*regexp.Regexp),
+	}
+}
+
+// Compile returns the compiled pattern pat with the match type mt
+func (c *Compiler) Compile(pat, mt []byte) (*regexp.Regexp, error) {
+	key := string(mt) + "\x00" + string(pat)
+	if re, ok := c.res[key]; ok {
+		return re, nil
+	}
+	flags, err := Flags(mt, c.binary)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(flags + string(pat))
+	if err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid regular expression '%s': %v", pat, err))
+	}
+	c.res[key] = re
+	return re, nil
+}
+
+// Flags converts a MySQL match type to the flags of a go regular expression.
+// c is case sensitive, i is case insensitive, m is the multiple-line mode,
+// n lets . match the line terminators and u means unix-only line endings,
+// which go always does. The rightmost one of c and i wins. Without c or i,
+// the patterns are case insensitive unless the strings are binary ones.
+func Flags(mt []byte, binary bool) (string, error) {
+	var multi, dotAll bool
+
+	ci := !binary
+	for _, c := range mt {
+		switch c {
+		case 'c':
+			ci = false
+		case 'i':
+			ci = true
+		case 'm':
+			multi = true
+		case 'n':
+			dotAll = true
+		case 'u':
+		default:
+			return "", errors.New(errno.DataException, fmt.Sprintf("invalid match type '%s' of regular expression", mt))
+		}
+	}
+	flags := ""
+	if ci {
+		flags += "i"
+	}
+	if multi {
+		flags += "m"
+	}
+	if dotAll {
+		flags += "s"
+	}
+	if flags == "" {
+		return "", nil
+	}
+	return "(?" + flags + ")", nil
+}
+
+// Like sets rs[i] to whether xs[i] matches the pattern pats[i], mts is
+// nil without a match type and binary is true for binary strings. The
+// null rows in nsp are skipped.
+func Like(xs, pats, mts *types.Bytes, binary bool, nsp *nulls.Nulls, rs []bool) ([]bool, error) {
+	c := NewCompiler(binary)
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		re, err := c.Compile(at(pats, i), at(mts, i))
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = re.Match(at(xs, i))
+	}
+	return rs, nil
+}
+
+// Instr sets rs[i] to the character position of the occurs[i]-th match of
+// pats[i] in xs[i] searched from the character position poss[i], 0 if there
+// is no such match. The position is the beginning of the match if opts[i]
+// is 0 and the one following the match if it is 1. poss, occurs, opts and
+// mts are nil if they are omitted.
+func Instr(xs, pats *types.Bytes, poss, occurs, opts []int64, mts *types.Bytes, binary bool, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+	c := NewCompiler(binary)
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		opt := atInt(opts, i, 0)
+		if opt != 0 && opt != 1 {
+			return nil, errors.New(errno.DataException, "the return option of regexp_instr must be 0 or 1")
+		}
+		re, err := c.Compile(at(pats, i), at(mts, i))
+		if err != nil {
+			return nil, err
+		}
+		s := at(xs, i)
+		m, start, err := find(re, s, atInt(poss, i, 1), atInt(occurs, i, 1))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case m == nil:
+			rs[i] = 0
+		case opt == 0:
+			rs[i] = int64(utf8.RuneCount(s[:start+m[0]])) + 1
+		default:
+			rs[i] = int64(utf8.RuneCount(s[:start+m[1]])) + 1
+		}
+	}
+	return rs, nil
+}
+
+// Substr returns the occurs[i]-th match of pats[i] in xs[i] searched from the
+// character position poss[i] for n rows, the rows without such a match are
+// added to nsp. poss, occurs and mts are nil if they are omitted.
+func Substr(xs, pats *types.Bytes, poss, occurs []int64, mts *types.Bytes, binary bool, n int, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	c := NewCompiler(binary)
+	for i := 0; i < n; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		re, err := c.Compile(at(pats, i), at(mts, i))
+		if err != nil {
+			return nil, err
+		}
+		s := at(xs, i)
+		m, start, err := find(re, s, atInt(poss, i, 1), atInt(occurs, i, 1))
+		if err != nil {
+			return nil, err
+		}
+		if m == nil {
+			nulls.Add(nsp, uint64(i))
+			appendBytes(rs, nil)
+			continue
+		}
+		appendBytes(rs, s[start+m[0]:start+m[1]])
+	}
+	return rs, nil
+}
+
+// Replace replaces the occurs[i]-th match of pats[i] in xs[i] searched from
+// the character position poss[i] with repls[i] for n rows, all the matches
+// are replaced if the occurrence is 0. $1 to $9 of a replacement are the
+// groups of the match. poss, occurs and mts are nil if they are omitted.
+func Replace(xs, pats, repls *types.Bytes, poss, occurs []int64, mts *types.Bytes, binary bool, n int, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	c := NewCompiler(binary)
+	for i := 0; i < n; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendBytes(rs, nil)
+			continue
+		}
+		re, err := c.Compile(at(pats, i), at(mts, i))
+		if err != nil {
+			return nil, err
+		}
+		s := at(xs, i)
+		start, err := offset(s, atInt(poss, i, 1))
+		if err != nil {
+			return nil, err
+		}
+		occur := atInt(occurs, i, 0)
+		repl := at(repls, i)
+		r := append([]byte{}, s[:start]...)
+		last := start
+		for k, m := range re.FindAllSubmatchIndex(s[start:], -1) {
+			if occur > 0 && int64(k+1) != occur {
+				continue
+			}
+			r = append(r, s[last:start+m[0]]...)
+			r = re.Expand(r, repl, s[start:], m)
+			last = start + m[1]
+		}
+		appendBytes(rs, append(r, s[last:]...))
+	}
+	return rs, nil
+}
+
+// find returns the byte indexes of the occur-th match of re in s searched
+// from the character position pos, relative to the returned byte offset of
+// pos. The match is nil if there is no such match.
+func find(re *regexp.Regexp, s []byte, pos, occur int64) ([]int, int, error) {
+	start, err := offset(s, pos)
+	if err != nil {
+		return nil, 0, err
+	}
+	if occur < 1 {
+		occur = 1
+	}
+	ms := re.FindAllIndex(s[start:], int(occur))
+	if int64(len(ms)) < occur {
+		return nil, start, nil
+	}
+	return ms[occur-1], start, nil
+}
+
+// offset returns the byte offset of the character position pos of s,
+// which starts from 1 and can be one past the last character
+func offset(s []byte, pos int64) (int, error) {
+	if pos < 1 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("index %d out of bounds in regular expression search", pos))
+	}
+	off := 0
+	for k := int64(1); k < pos; k++ {
+		if off >= len(s) {
+			return 0, errors.New(errno.DataException, fmt.Sprintf("index %d out of bounds in regular expression search", pos))
+		}
+		_, size := utf8.DecodeRune(s[off:])
+		off += size
+	}
+	return off, nil
+}
+
+// at returns the i-th value of a column, nil if the column is omitted
+func at(col *types.Bytes, i int) []byte {
+	switch {
+	case col == nil:
+		return nil
+	case len(col.Offsets) == 1:
+		return col.Get(0)
+	}
+	return col.Get(int64(i))
+}
+
+// atInt returns the i-th value of an integer column, def if the column is omitted
+func atInt(col []int64, i int, def int64) int64 {
+	switch {
+	case col == nil:
+		return def
+	case len(col) == 1:
+		return col[0]
+	}
+	return col[i]
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+	rs.Data = append(rs.Data, v...)
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regexp
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(strs ...string) *types.Bytes {
+	rs := &types.Bytes{}
+	for _, s := range strs {
+		appendBytes(rs, []byte(s))
+	}
+	return rs
+}
+
+func toStrings(rs *types.Bytes, nsp *nulls.Nulls) []string {
+	ss := make([]string, len(rs.Offsets))
+	for i := range ss {
+		if nulls.Contains(nsp, uint64(i)) {
+			ss[i] = "NULL"
+			continue
+		}
+		ss[i] = string(rs.Get(int64(i)))
+	}
+	return ss
+}
+
+func TestFlags(t *testing.T) {
+	flags, err := Flags(nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "(?i)", flags)
+	flags, err = Flags(nil, true)
+	require.NoError(t, err)
+	require.Equal(t, "", flags)
+	flags, err = Flags([]byte("c"), false)
+	require.NoError(t, err)
+	require.Equal(t, "", flags)
+	flags, err = Flags([]byte("icmn"), false)
+	require.NoError(t, err)
+	require.Equal(t, "(?ms)", flags)
+	flags, err = Flags([]byte("ci"), true)
+	require.NoError(t, err)
+	require.Equal(t, "(?i)", flags)
+	_, err = Flags([]byte("x"), false)
+	require.Error(t, err)
+}
+
+func TestCompiler(t *testing.T) {
+	c := NewCompiler(false)
+	re, err := c.Compile([]byte("a+"), nil)
+	require.NoError(t, err)
+	re1, err := c.Compile([]byte("a+"), nil)
+	require.NoError(t, err)
+	require.Same(t, re, re1)
+	re1, err = c.Compile([]byte("a+"), []byte("i"))
+	require.NoError(t, err)
+	require.NotSame(t, re, re1)
+	_, err = c.Compile([]byte("a("), nil)
+	require.Error(t, err)
+}
+
+func TestLike(t *testing.T) {
+	xs := makeBytes("abc", "ABC", "xyz", "")
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 3)
+	rs, err := Like(xs, makeBytes("^a"), nil, false, nsp, make([]bool, 4))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false, false}, rs)
+
+	rs, err = Like(xs, makeBytes("^a"), makeBytes("c"), false, nsp, make([]bool, 4))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false}, rs)
+
+	rs, err = Like(xs, makeBytes("^a"), nil, true, nsp, make([]bool, 4))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false}, rs)
+
+	rs, err = Like(xs, makeBytes("^a"), makeBytes("i"), true, nsp, make([]bool, 4))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false, false}, rs)
+
+	rs, err = Like(xs, makeBytes("b", "B", "b", "b"), nil, true, nsp, make([]bool, 4))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false, false}, rs)
+
+	rs, err = Like(makeBytes("a\nb"), makeBytes("a.b", "^b$"), makeBytes("", "m"), false, new(nulls.Nulls), make([]bool, 2))
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, true}, rs)
+}
+
+func TestInstr(t *testing.T) {
+	xs := makeBytes("dog cat dog", "猫 dog")
+	rs, err := Instr(xs, makeBytes("dog"), nil, nil, nil, nil, false, new(nulls.Nulls), make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3}, rs)
+
+	rs, err = Instr(xs, makeBytes("dog"), []int64{2}, nil, []int64{1}, nil, false, new(nulls.Nulls), make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{12, 6}, rs)
+
+	rs, err = Instr(xs, makeBytes("dog"), nil, []int64{2}, nil, nil, false, new(nulls.Nulls), make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{9, 0}, rs)
+
+	_, err = Instr(xs, makeBytes("dog"), nil, nil, []int64{2}, nil, false, new(nulls.Nulls), make([]int64, 2))
+	require.Error(t, err)
+	_, err = Instr(xs, makeBytes("dog"), []int64{20}, nil, nil, nil, false, new(nulls.Nulls), make([]int64, 2))
+	require.Error(t, err)
+}
+
+func TestSubstr(t *testing.T) {
+	xs := makeBytes("abc def ghi", "abc")
+	nsp := new(nulls.Nulls)
+	rs, err := Substr(xs, makeBytes("[a-z]+"), []int64{2}, []int64{2}, nil, false, 2, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"def", "NULL"}, toStrings(rs, nsp))
+}
+
+func TestReplace(t *testing.T) {
+	xs := makeBytes("a b c", "abc")
+	rs, err := Replace(xs, makeBytes("[a-c]"), makeBytes("X"), nil, nil, nil, false, 2, new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"X X X", "XXX"}, toStrings(rs, new(nulls.Nulls)))
+
+	rs, err = Replace(xs, makeBytes("[a-c]"), makeBytes("X"), []int64{2}, []int64{2}, nil, false, 2, new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a b X", "abX"}, toStrings(rs, new(nulls.Nulls)))
+
+	rs, err = Replace(makeBytes("John Smith"), makeBytes(`(\w+) (\w+)`), makeBytes("${2}, $1"), nil, nil, nil, false, 1, new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Smith, John"}, toStrings(rs, new(nulls.Nulls)))
+}
@@ -37,6 +37,7 @@ var (
 	Decimal128Shuffle = fixedLengthShuffle[types.Decimal128]
 
 	DateShuffle      = fixedLengthShuffle[types.Date]
+	TimeShuffle      = fixedLengthShuffle[types.Time]
 	DatetimeShuffle  = fixedLengthShuffle[types.Datetime]
 	TimestampShuffle = fixedLengthShuffle[types.Timestamp]
 
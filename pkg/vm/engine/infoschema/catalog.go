@@ -0,0 +1,105 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	dbAttrs    = []string{"datname"}
+	tableAttrs = []string{"reldatabase", "relname", "relkind", "rel_comment", "rel_createsql"}
+	colAttrs   = []string{"att_database", "att_relname", "attname", "atttyp", "attnum", "attnotnull",
+		"att_constraint_type", "att_is_hidden", "att_is_unsigned", "att_is_auto_increment",
+		"atthasdef", "att_default", "att_comment"}
+)
+
+// loadCatalog reads mo_database, mo_tables and mo_columns in the transaction ctx
+func loadCatalog(e engine.Engine, ctx engine.Snapshot) (*catalog, error) {
+	db, err := e.Database(catalogDB, ctx)
+	if err != nil {
+		return nil, err
+	}
+	cat := new(catalog)
+	if err = scan(db, "mo_database", dbAttrs, ctx, func(bat *batch.Batch, i int64) {
+		cat.dbs = append(cat.dbs, dbRow{name: str(bat, 0, i)})
+	}); err != nil {
+		return nil, err
+	}
+	if err = scan(db, "mo_tables", tableAttrs, ctx, func(bat *batch.Batch, i int64) {
+		cat.tables = append(cat.tables, tableRow{
+			db:        str(bat, 0, i),
+			name:      str(bat, 1, i),
+			kind:      str(bat, 2, i),
+			comment:   str(bat, 3, i),
+			createSQL: str(bat, 4, i),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if err = scan(db, "mo_columns", colAttrs, ctx, func(bat *batch.Batch, i int64) {
+		cat.columns = append(cat.columns, columnRow{
+			db:         str(bat, 0, i),
+			table:      str(bat, 1, i),
+			name:       str(bat, 2, i),
+			typ:        types.T(bat.Vecs[3].Col.([]int32)[i]),
+			num:        bat.Vecs[4].Col.([]int32)[i],
+			notNull:    bat.Vecs[5].Col.([]int8)[i] != 0,
+			primary:    str(bat, 6, i) == "p",
+			hidden:     bat.Vecs[7].Col.([]int8)[i] != 0,
+			unsigned:   bat.Vecs[8].Col.([]int8)[i] != 0,
+			autoIncr:   bat.Vecs[9].Col.([]int8)[i] != 0,
+			hasDefault: bat.Vecs[10].Col.([]int8)[i] != 0,
+			defaultVal: str(bat, 11, i),
+			comment:    str(bat, 12, i),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// scan calls fn for every row of the attributes attrs of the table name
+func scan(db engine.Database, name string, attrs []string, ctx engine.Snapshot, fn func(*batch.Batch, int64)) error {
+	rel, err := db.Relation(name, ctx)
+	if err != nil {
+		return err
+	}
+	defer rel.Close(ctx)
+	cs := make([]uint64, len(attrs))
+	for _, rd := range rel.NewReader(1, nil, nil, ctx) {
+		for {
+			bat, err := rd.Read(cs, attrs)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			n := int64(vector.Length(bat.Vecs[0]))
+			for i := int64(0); i < n; i++ {
+				fn(bat, i)
+			}
+		}
+	}
+	return nil
+}
+
+func str(bat *batch.Batch, j int, i int64) string {
+	return string(bat.Vecs[j].Col.(*types.Bytes).Get(i))
+}
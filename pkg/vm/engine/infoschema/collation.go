@@ -0,0 +1,55 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+type collation struct {
+	name      string
+	charset   string
+	id        int64
+	isDefault bool
+	sortLen   int64
+	noPad     bool
+}
+
+type charset struct {
+	name             string
+	defaultCollation string
+	description      string
+	maxLen           int64
+}
+
+// charsets are the character sets accepted by the server
+var charsets = []charset{
+	{"ascii", "ascii_general_ci", "US ASCII", 1},
+	{"binary", "binary", "Binary pseudo charset", 1},
+	{"latin1", "latin1_swedish_ci", "cp1252 West European", 1},
+	{"utf8mb3", "utf8mb3_general_ci", "UTF-8 Unicode", 3},
+	{"utf8mb4", "utf8mb4_0900_ai_ci", "UTF-8 Unicode", 4},
+}
+
+// collations are the collations of charsets, with the ids of the MySQL protocol
+var collations = []collation{
+	{"ascii_general_ci", "ascii", 11, true, 1, false},
+	{"ascii_bin", "ascii", 65, false, 1, false},
+	{"binary", "binary", 63, true, 1, true},
+	{"latin1_swedish_ci", "latin1", 8, true, 1, false},
+	{"latin1_bin", "latin1", 47, false, 1, false},
+	{"utf8mb3_general_ci", "utf8mb3", 33, true, 1, false},
+	{"utf8mb3_bin", "utf8mb3", 83, false, 1, false},
+	{"utf8mb4_general_ci", "utf8mb4", 45, false, 1, false},
+	{"utf8mb4_bin", "utf8mb4", 46, false, 1, false},
+	{"utf8mb4_unicode_ci", "utf8mb4", 224, false, 8, false},
+	{"utf8mb4_0900_ai_ci", "utf8mb4", 255, true, 0, true},
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	_ engine.Database = (*database)(nil)
+	_ engine.Relation = (*relation)(nil)
+	_ engine.Reader   = (*reader)(nil)
+)
+
+var errReadOnly = errors.New("information_schema is read only")
+
+// New returns the information_schema database of e in the transaction ctx
+func New(e engine.Engine, ctx engine.Snapshot) engine.Database {
+	return &database{e: e, ctx: ctx}
+}
+
+func (db *database) Relations(_ engine.Snapshot) []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (db *database) Relation(name string, _ engine.Snapshot) (engine.Relation, error) {
+	tbl, ok := tables[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("table '%s' doesn't exist in %s", name, Name)
+	}
+	return &relation{db: db, tbl: tbl}, nil
+}
+
+func (db *database) Delete(_ uint64, _ string, _ engine.Snapshot) error {
+	return errReadOnly
+}
+
+func (db *database) Create(_ uint64, _ string, _ []engine.TableDef, _ engine.Snapshot) error {
+	return errReadOnly
+}
+
+func (r *relation) Close(_ engine.Snapshot) {}
+
+func (r *relation) ID(_ engine.Snapshot) string {
+	return r.tbl.name
+}
+
+// Nodes returns a local node, the rows are generated where they are read
+func (r *relation) Nodes(_ engine.Snapshot) engine.Nodes {
+	return engine.Nodes{{}}
+}
+
+func (r *relation) TableDefs(_ engine.Snapshot) []engine.TableDef {
+	defs := make([]engine.TableDef, len(r.tbl.cols))
+	for i, col := range r.tbl.cols {
+		defs[i] = &engine.AttributeDef{Attr: engine.Attribute{Name: col.name, Type: col.typ}}
+	}
+	return defs
+}
+
+func (r *relation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribute, bool) {
+	return nil, false
+}
+
+func (r *relation) Rows() int64 {
+	return 0
+}
+
+func (r *relation) Size(_ string) int64 {
+	return 0
+}
+
+func (r *relation) Write(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return errReadOnly
+}
+
+func (r *relation) AddTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return errReadOnly
+}
+
+func (r *relation) DelTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return errReadOnly
+}
+
+// NewReader returns num readers, all the rows are returned by the first one
+func (r *relation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) []engine.Reader {
+	rds := make([]engine.Reader, num)
+	for i := range rds {
+		rds[i] = &reader{rel: r, done: i > 0}
+	}
+	return rds
+}
+
+// Read generates the rows of the table from the catalog, and returns them in one batch
+func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if r.done {
+		return nil, nil
+	}
+	r.done = true
+	cat, err := loadCatalog(r.rel.db.e, r.rel.db.ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows := r.rel.tbl.rows(cat)
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		j := r.rel.tbl.colIdx(attr)
+		if j < 0 {
+			return nil, fmt.Errorf("column '%s' doesn't exist in %s.%s", attr, Name, r.rel.tbl.name)
+		}
+		if bat.Vecs[i], err = newVector(r.rel.tbl.cols[j].typ, rows, j); err != nil {
+			return nil, err
+		}
+		bat.Vecs[i].Ref = cs[i]
+	}
+	bat.Zs = make([]int64, len(rows))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat, nil
+}
+
+func (t *table) colIdx(name string) int {
+	for i, col := range t.cols {
+		if col.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// newVector returns the j-th column of rows, the nil values are nulls
+func newVector(typ types.Type, rows [][]interface{}, j int) (*vector.Vector, error) {
+	v := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int64:
+		vs := make([]int64, len(rows))
+		for i, row := range rows {
+			if row[j] == nil {
+				nulls.Add(v.Nsp, uint64(i))
+				continue
+			}
+			vs[i] = row[j].(int64)
+		}
+		if err := vector.Append(v, vs); err != nil {
+			return nil, err
+		}
+	default:
+		vs := make([][]byte, len(rows))
+		for i, row := range rows {
+			if row[j] == nil {
+				nulls.Add(v.Nsp, uint64(i))
+				continue
+			}
+			vs[i] = []byte(row[j].(string))
+		}
+		if err := vector.Append(v, vs); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
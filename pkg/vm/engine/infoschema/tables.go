@@ -0,0 +1,310 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// tables are the virtual tables of information_schema indexed by name
+var tables = map[string]*table{}
+
+func init() {
+	for _, tbl := range []*table{
+		{
+			name: "schemata",
+			cols: []column{varchar("catalog_name"), varchar("schema_name"), varchar("default_character_set_name"),
+				varchar("default_collation_name"), varchar("sql_path"), varchar("default_encryption")},
+			rows: schemataRows,
+		},
+		{
+			name: "tables",
+			cols: []column{varchar("table_catalog"), varchar("table_schema"), varchar("table_name"), varchar("table_type"),
+				varchar("engine"), bigint("version"), varchar("row_format"), bigint("table_rows"), bigint("avg_row_length"),
+				bigint("data_length"), bigint("index_length"), bigint("auto_increment"), varchar("create_time"),
+				varchar("table_collation"), varchar("create_options"), varchar("table_comment")},
+			rows: tablesRows,
+		},
+		{
+			name: "columns",
+			cols: []column{varchar("table_catalog"), varchar("table_schema"), varchar("table_name"), varchar("column_name"),
+				bigint("ordinal_position"), varchar("column_default"), varchar("is_nullable"), varchar("data_type"),
+				bigint("character_maximum_length"), bigint("numeric_precision"), bigint("numeric_scale"),
+				varchar("character_set_name"), varchar("collation_name"), varchar("column_type"), varchar("column_key"),
+				varchar("extra"), varchar("privileges"), varchar("column_comment")},
+			rows: columnsRows,
+		},
+		{
+			name: "statistics",
+			cols: []column{varchar("table_catalog"), varchar("table_schema"), varchar("table_name"), bigint("non_unique"),
+				varchar("index_schema"), varchar("index_name"), bigint("seq_in_index"), varchar("column_name"),
+				varchar("collation"), bigint("cardinality"), bigint("sub_part"), varchar("packed"), varchar("nullable"),
+				varchar("index_type"), varchar("comment"), varchar("index_comment"), varchar("is_visible"), varchar("expression")},
+			rows: statisticsRows,
+		},
+		{
+			name: "key_column_usage",
+			cols: []column{varchar("constraint_catalog"), varchar("constraint_schema"), varchar("constraint_name"),
+				varchar("table_catalog"), varchar("table_schema"), varchar("table_name"), varchar("column_name"),
+				bigint("ordinal_position"), bigint("position_in_unique_constraint"), varchar("referenced_table_schema"),
+				varchar("referenced_table_name"), varchar("referenced_column_name")},
+			rows: keyColumnUsageRows,
+		},
+		{
+			name: "views",
+			cols: []column{varchar("table_catalog"), varchar("table_schema"), varchar("table_name"), varchar("view_definition"),
+				varchar("check_option"), varchar("is_updatable"), varchar("definer"), varchar("security_type"),
+				varchar("character_set_client"), varchar("collation_connection")},
+			rows: viewsRows,
+		},
+		{
+			name: "collations",
+			cols: []column{varchar("collation_name"), varchar("character_set_name"), bigint("id"), varchar("is_default"),
+				varchar("is_compiled"), bigint("sortlen"), varchar("pad_attribute")},
+			rows: collationsRows,
+		},
+		{
+			name: "character_sets",
+			cols: []column{varchar("character_set_name"), varchar("default_collate_name"), varchar("description"), bigint("maxlen")},
+			rows: characterSetsRows,
+		},
+	} {
+		tables[tbl.name] = tbl
+	}
+}
+
+func varchar(name string) column {
+	return column{name: name, typ: types.Type{Oid: types.T_varchar, Size: 24, Width: 1024}}
+}
+
+func bigint(name string) column {
+	return column{name: name, typ: types.Type{Oid: types.T_int64, Size: 8, Width: 64}}
+}
+
+func schemataRows(cat *catalog) [][]interface{} {
+	rows := make([][]interface{}, 0, len(cat.dbs)+1)
+	hasSelf := false
+	for _, db := range cat.dbs {
+		hasSelf = hasSelf || db.name == Name
+		rows = append(rows, []interface{}{catalogName, db.name, defaultChset, defaultColl, nil, "NO"})
+	}
+	if !hasSelf {
+		rows = append(rows, []interface{}{catalogName, Name, defaultChset, defaultColl, nil, "NO"})
+	}
+	return rows
+}
+
+func tablesRows(cat *catalog) [][]interface{} {
+	rows := make([][]interface{}, 0, len(cat.tables)+len(tables))
+	for _, tbl := range cat.tables {
+		if tbl.kind == viewKind {
+			rows = append(rows, []interface{}{catalogName, tbl.db, tbl.name, "VIEW", nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, "VIEW"})
+			continue
+		}
+		rows = append(rows, []interface{}{catalogName, tbl.db, tbl.name, "BASE TABLE", engineName, int64(10), "Dynamic",
+			int64(0), int64(0), int64(0), int64(0), nil, nil, defaultColl, "", tbl.comment})
+	}
+	for _, name := range (&database{}).Relations(nil) {
+		rows = append(rows, []interface{}{catalogName, Name, name, "SYSTEM VIEW", nil, int64(10), nil, nil, nil,
+			nil, nil, nil, nil, nil, "", ""})
+	}
+	return rows
+}
+
+func columnsRows(cat *catalog) [][]interface{} {
+	var rows [][]interface{}
+	for _, col := range append(cat.columns, virtualColumns()...) {
+		if col.hidden {
+			continue
+		}
+		dataType, colType := typeNames(col)
+		var def, precision, scale, chset, coll interface{}
+		if col.hasDefault {
+			def = col.defaultVal
+		}
+		switch {
+		case col.typ.IsString() && !col.typ.IsBinary():
+			chset, coll = defaultChset, defaultColl
+		case col.typ == types.T_float32:
+			precision = int64(12)
+		case col.typ == types.T_float64:
+			precision = int64(22)
+		case col.typ == types.T_decimal64:
+			precision, scale = int64(18), int64(0)
+		case col.typ == types.T_decimal128:
+			precision, scale = int64(38), int64(0)
+		default:
+			if p, ok := intPrecisions[col.typ]; ok {
+				precision, scale = p, int64(0)
+			}
+		}
+		nullable := "YES"
+		if col.notNull || col.primary {
+			nullable = "NO"
+		}
+		key := ""
+		if col.primary {
+			key = "PRI"
+		}
+		extra := ""
+		if col.autoIncr {
+			extra = "auto_increment"
+		}
+		rows = append(rows, []interface{}{catalogName, col.db, col.table, col.name, int64(col.num), def, nullable, dataType,
+			nil, precision, scale, chset, coll, colType, key, extra, "select,insert,update,references", col.comment})
+	}
+	return rows
+}
+
+func statisticsRows(cat *catalog) [][]interface{} {
+	var rows [][]interface{}
+	for _, key := range primaryKeys(cat) {
+		for i, col := range key {
+			rows = append(rows, []interface{}{catalogName, col.db, col.table, int64(0), col.db, "PRIMARY", int64(i + 1),
+				col.name, "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil})
+		}
+	}
+	return rows
+}
+
+func keyColumnUsageRows(cat *catalog) [][]interface{} {
+	var rows [][]interface{}
+	for _, key := range primaryKeys(cat) {
+		for i, col := range key {
+			rows = append(rows, []interface{}{catalogName, col.db, "PRIMARY", catalogName, col.db, col.table, col.name,
+				int64(i + 1), nil, nil, nil, nil})
+		}
+	}
+	return rows
+}
+
+func viewsRows(cat *catalog) [][]interface{} {
+	var rows [][]interface{}
+	for _, tbl := range cat.tables {
+		if tbl.kind != viewKind {
+			continue
+		}
+		rows = append(rows, []interface{}{catalogName, tbl.db, tbl.name, viewDefinition(tbl.createSQL), "NONE", "NO",
+			"root@localhost", "DEFINER", defaultChset, defaultColl})
+	}
+	return rows
+}
+
+// viewDefinition returns the select statement of a stored create view statement
+func viewDefinition(createSQL string) string {
+	stmt, err := mysql.ParseOne(createSQL)
+	if err != nil {
+		return createSQL
+	}
+	if view, ok := stmt.(*tree.CreateView); ok {
+		return tree.String(view.AsSource, dialect.MYSQL)
+	}
+	return createSQL
+}
+
+func collationsRows(_ *catalog) [][]interface{} {
+	rows := make([][]interface{}, len(collations))
+	for i, c := range collations {
+		isDefault, pad := "", "PAD SPACE"
+		if c.isDefault {
+			isDefault = "Yes"
+		}
+		if c.noPad {
+			pad = "NO PAD"
+		}
+		rows[i] = []interface{}{c.name, c.charset, c.id, isDefault, "Yes", c.sortLen, pad}
+	}
+	return rows
+}
+
+func characterSetsRows(_ *catalog) [][]interface{} {
+	rows := make([][]interface{}, len(charsets))
+	for i, c := range charsets {
+		rows[i] = []interface{}{c.name, c.defaultCollation, c.description, c.maxLen}
+	}
+	return rows
+}
+
+// primaryKeys returns the visible primary key columns of every table in the order of attnum
+func primaryKeys(cat *catalog) [][]columnRow {
+	var keys [][]columnRow
+	idx := make(map[string]int)
+	for _, col := range cat.columns {
+		if !col.primary || col.hidden {
+			continue
+		}
+		name := col.db + "." + col.table
+		i, ok := idx[name]
+		if !ok {
+			i = len(keys)
+			idx[name] = i
+			keys = append(keys, nil)
+		}
+		keys[i] = append(keys[i], col)
+	}
+	for _, key := range keys {
+		for i := 1; i < len(key); i++ {
+			for j := i; j > 0 && key[j].num < key[j-1].num; j-- {
+				key[j], key[j-1] = key[j-1], key[j]
+			}
+		}
+	}
+	return keys
+}
+
+// virtualColumns returns the columns of the virtual tables
+func virtualColumns() []columnRow {
+	var cols []columnRow
+	for _, name := range (&database{}).Relations(nil) {
+		for i, col := range tables[name].cols {
+			cols = append(cols, columnRow{db: Name, table: name, name: col.name, typ: col.typ.Oid, num: int32(i + 1)})
+		}
+	}
+	return cols
+}
+
+// typeNames returns the data type and the column type of a column
+func typeNames(col columnRow) (string, string) {
+	var colType string
+	switch col.typ {
+	case types.T_decimal64, types.T_decimal128:
+		colType = "decimal"
+	default:
+		colType = strings.ToLower(col.typ.String())
+	}
+	dataType := strings.TrimSuffix(colType, " unsigned")
+	if col.unsigned && !strings.HasSuffix(colType, " unsigned") {
+		colType += " unsigned"
+	}
+	return dataType, colType
+}
+
+// intPrecisions are the numeric precisions of the integer types
+var intPrecisions = map[types.T]int64{
+	types.T_int8:   3,
+	types.T_int16:  5,
+	types.T_int32:  10,
+	types.T_int64:  19,
+	types.T_uint8:  3,
+	types.T_uint16: 5,
+	types.T_uint32: 10,
+	types.T_uint64: 20,
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package infoschema implements the information_schema database, whose tables
+// are virtual relations generated on read from the catalog tables of mo_catalog.
+package infoschema
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	// Name is the name of the information_schema database
+	Name = "information_schema"
+
+	catalogName  = "def"
+	catalogDB    = "mo_catalog"
+	engineName   = "TAE"
+	defaultChset = "utf8mb4"
+	defaultColl  = "utf8mb4_bin"
+	viewKind     = "v"
+)
+
+type database struct {
+	e   engine.Engine
+	ctx engine.Snapshot
+}
+
+// table is the definition of a virtual table, its rows are generated from the catalog
+type table struct {
+	name string
+	cols []column
+	rows func(*catalog) [][]interface{}
+}
+
+// column is a column of a virtual table, whose values are string, int64 or nil for null
+type column struct {
+	name string
+	typ  types.Type
+}
+
+type relation struct {
+	db  *database
+	tbl *table
+}
+
+type reader struct {
+	rel  *relation
+	done bool
+}
+
+// catalog is the content of mo_database, mo_tables and mo_columns
+type catalog struct {
+	dbs     []dbRow
+	tables  []tableRow
+	columns []columnRow
+}
+
+type dbRow struct {
+	name string
+}
+
+type tableRow struct {
+	db        string
+	name      string
+	kind      string
+	comment   string
+	createSQL string
+}
+
+type columnRow struct {
+	db         string
+	table      string
+	name       string
+	typ        types.T
+	num        int32
+	notNull    bool
+	primary    bool
+	hidden     bool
+	unsigned   bool
+	autoIncr   bool
+	hasDefault bool
+	defaultVal string
+	comment    string
+}
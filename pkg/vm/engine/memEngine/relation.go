@@ -8,8 +8,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-
-	"github.com/pierrec/lz4"
 )
 
 func (r *relation) Close(_ engine.Snapshot) {}
@@ -104,9 +102,9 @@ func (r *relation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
-			data := make([]byte, lz4.CompressBlockBound(len(v)))
-			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+		if alg := int(r.md.Attrs[i].Alg); alg != compress.None {
+			data := make([]byte, compress.CompressBound(len(v), alg))
+			if data, err = compress.Compress(v, data, alg); err != nil {
 				return err
 			}
 			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
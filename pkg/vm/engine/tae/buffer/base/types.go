@@ -68,6 +68,16 @@ type INode interface {
 	Expand(uint64, func() error) error
 }
 
+// NodeManagerStats are the counters of a node manager
+type NodeManagerStats struct {
+	// Hits are the pins of the loaded nodes
+	Hits uint64
+	// Misses are the pins that load the node
+	Misses uint64
+	// Evictions are the nodes unloaded to make room
+	Evictions uint64
+}
+
 type INodeManager interface {
 	ISizeLimiter
 	sync.Locker
@@ -81,6 +91,7 @@ type INodeManager interface {
 	TryPin(INode, time.Duration) (INodeHandle, error)
 	Unpin(INode)
 	MakeRoom(uint64) bool
+	Stats() NodeManagerStats
 }
 
 type ISizeLimiter interface {
@@ -106,3 +106,44 @@ func TestHandle(t *testing.T) {
 	assert.Equal(t, uint64(0), mgr.Total())
 	t.Log(mgr.String())
 }
+
+func TestEvictPolicy(t *testing.T) {
+	_, err := NewEvictHolder("mru")
+	assert.ErrorIs(t, err, ErrUnknownEvictPolicy)
+
+	// A scan of the cold nodes flushes out the hot nodes with fifo only
+	for _, policy := range []string{EvictPolicyFIFO, EvictPolicyLRUK, EvictPolicy2Q} {
+		evicter, err := NewEvictHolder(policy)
+		assert.Nil(t, err)
+		mgr := NewNodeManager(uint64(40), evicter)
+		baseId := common.ID{}
+		hot := make([]*testNodeHandle, 2)
+		for i := range hot {
+			hot[i] = newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+			mgr.RegisterNode(hot[i])
+		}
+		cold := make([]*testNodeHandle, 20)
+		for i := range cold {
+			cold[i] = newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+			mgr.RegisterNode(cold[i])
+		}
+		// The hot nodes are used repeatedly between the scans of the cold nodes
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 3; j++ {
+				for _, n := range hot {
+					assert.Nil(t, mgr.Pin(n).Close())
+				}
+			}
+			for _, n := range cold[i*10 : (i+1)*10] {
+				assert.Nil(t, mgr.Pin(n).Close())
+			}
+		}
+		for _, n := range hot {
+			assert.Equal(t, policy != EvictPolicyFIFO, n.IsLoaded(), policy)
+		}
+		stats := mgr.Stats()
+		assert.Equal(t, uint64(32), stats.Hits+stats.Misses, policy)
+		assert.Equal(t, stats.Misses-4, stats.Evictions, policy)
+		t.Log(mgr.String())
+	}
+}
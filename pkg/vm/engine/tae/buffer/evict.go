@@ -15,6 +15,7 @@
 package buffer
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
@@ -33,6 +34,29 @@ type IEvictHolder interface {
 	Dequeue() *EvictNode
 }
 
+const (
+	EvictPolicyFIFO = "fifo"
+	EvictPolicyLRUK = "lru-k"
+	EvictPolicy2Q   = "2q"
+)
+
+var (
+	ErrUnknownEvictPolicy = errors.New("buffer: unknown evict policy")
+)
+
+// NewEvictHolder creates the holder of the policy. An empty policy is fifo
+func NewEvictHolder(policy string) (IEvictHolder, error) {
+	switch policy {
+	case "", EvictPolicyFIFO:
+		return NewSimpleEvictHolder(), nil
+	case EvictPolicyLRUK:
+		return NewLRUKEvictHolder(), nil
+	case EvictPolicy2Q:
+		return NewTwoQEvictHolder(), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownEvictPolicy, policy)
+}
+
 type SimpleEvictHolder struct {
 	Queue *sq.EsQueue
 	sync.Mutex
@@ -0,0 +1,165 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"container/heap"
+	"container/list"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+)
+
+const (
+	DefaultLRUK                = 2
+	DefaultLRUKHistoryCapacity = 4096
+)
+
+type LRUKEvictHolderCtx struct {
+	K int
+	// HistoryCapacity is the max number of the evicted nodes whose access
+	// history is retained
+	HistoryCapacity int
+}
+
+type lrukEntry struct {
+	handle base.IEvictHandle
+	node   *EvictNode
+	// the last K access times, the latest first
+	history []uint64
+	// the position in the heap, -1 if the node is not in the holder
+	index int
+}
+
+type lrukHeap struct {
+	k       int
+	entries []*lrukEntry
+}
+
+func (h *lrukHeap) Len() int { return len(h.entries) }
+
+// Less puts the nodes with the largest backward K-distance first. The nodes
+// accessed less than K times have an infinite distance and are ordered by
+// their last access
+func (h *lrukHeap) Less(i, j int) bool {
+	ei, ej := h.entries[i], h.entries[j]
+	fewi, fewj := len(ei.history) < h.k, len(ej.history) < h.k
+	if fewi != fewj {
+		return fewi
+	}
+	if fewi {
+		return ei.history[0] < ej.history[0]
+	}
+	return ei.history[h.k-1] < ej.history[h.k-1]
+}
+
+func (h *lrukHeap) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].index = i
+	h.entries[j].index = j
+}
+
+func (h *lrukHeap) Push(x any) {
+	entry := x.(*lrukEntry)
+	entry.index = len(h.entries)
+	h.entries = append(h.entries, entry)
+}
+
+func (h *lrukHeap) Pop() any {
+	n := len(h.entries)
+	entry := h.entries[n-1]
+	h.entries[n-1] = nil
+	h.entries = h.entries[:n-1]
+	entry.index = -1
+	return entry
+}
+
+// LRUKEvictHolder evicts the node whose K-th most recent unpin is the
+// oldest. A node touched once by a scan is evicted before the nodes used
+// repeatedly
+type LRUKEvictHolder struct {
+	sync.Mutex
+	clock   uint64
+	entries map[base.IEvictHandle]*lrukEntry
+	heap    *lrukHeap
+	// the evicted nodes in the eviction order, to bound the retained history
+	evicted    *list.List
+	historyCap int
+}
+
+func NewLRUKEvictHolder(ctx ...any) IEvictHolder {
+	k, historyCap := DefaultLRUK, DefaultLRUKHistoryCapacity
+	if len(ctx) > 0 {
+		context := ctx[0].(*LRUKEvictHolderCtx)
+		if context != nil {
+			if context.K > 0 {
+				k = context.K
+			}
+			if context.HistoryCapacity > 0 {
+				historyCap = context.HistoryCapacity
+			}
+		}
+	}
+	return &LRUKEvictHolder{
+		entries:    make(map[base.IEvictHandle]*lrukEntry),
+		heap:       &lrukHeap{k: k},
+		evicted:    list.New(),
+		historyCap: historyCap,
+	}
+}
+
+func (holder *LRUKEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	holder.clock++
+	entry := holder.entries[node.Handle]
+	if entry == nil {
+		entry = &lrukEntry{
+			handle: node.Handle,
+			index:  -1,
+		}
+		holder.entries[node.Handle] = entry
+	}
+	if len(entry.history) < holder.heap.k {
+		entry.history = append(entry.history, 0)
+	}
+	copy(entry.history[1:], entry.history)
+	entry.history[0] = holder.clock
+	entry.node = node
+	if entry.index >= 0 {
+		heap.Fix(holder.heap, entry.index)
+	} else {
+		heap.Push(holder.heap, entry)
+	}
+}
+
+func (holder *LRUKEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	if holder.heap.Len() == 0 {
+		return nil
+	}
+	entry := heap.Pop(holder.heap).(*lrukEntry)
+	node := entry.node
+	entry.node = nil
+	holder.evicted.PushBack(entry.handle)
+	for holder.evicted.Len() > holder.historyCap {
+		h := holder.evicted.Remove(holder.evicted.Front()).(base.IEvictHandle)
+		if retained := holder.entries[h]; retained != nil && retained.index < 0 {
+			delete(holder.entries, h)
+		}
+	}
+	return node
+}
@@ -21,38 +21,65 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
+const (
+	DefaultPoolName = "default"
+)
+
 type nodeManager struct {
 	sync.RWMutex
 	sizeLimiter
+	name            string
 	nodes           map[common.ID]base.INode
 	evicter         IEvictHolder
 	unregistertimes int64
 	loadtimes       int64
 	evicttimes      int64
+	hits            uint64
+	misses          uint64
+	evictions       uint64
 }
 
 func NewNodeManager(maxsize uint64, evicter IEvictHolder) *nodeManager {
+	return NewNamedNodeManager(DefaultPoolName, maxsize, evicter)
+}
+
+// NewNamedNodeManager creates a node manager whose metrics are labeled with
+// the pool name
+func NewNamedNodeManager(name string, maxsize uint64, evicter IEvictHolder) *nodeManager {
 	if evicter == nil {
 		evicter = NewSimpleEvictHolder()
 	}
 	mgr := &nodeManager{
 		sizeLimiter: *newSizeLimiter(maxsize),
+		name:        name,
 		nodes:       make(map[common.ID]base.INode),
 		evicter:     evicter,
 	}
 	return mgr
 }
 
+func (mgr *nodeManager) Stats() base.NodeManagerStats {
+	return base.NodeManagerStats{
+		Hits:      atomic.LoadUint64(&mgr.hits),
+		Misses:    atomic.LoadUint64(&mgr.misses),
+		Evictions: atomic.LoadUint64(&mgr.evictions),
+	}
+}
+
 func (mgr *nodeManager) String() string {
 	mgr.RLock()
 	defer mgr.RUnlock()
 	loaded := 0
-	s := fmt.Sprintf("<nodeManager>[%s][Nodes:%d,LoadTimes:%d,EvictTimes:%d,UnregisterTimes:%d]:", mgr.sizeLimiter.String(), len(mgr.nodes),
-		atomic.LoadInt64(&mgr.loadtimes), atomic.LoadInt64(&mgr.evicttimes), atomic.LoadInt64(&mgr.unregistertimes))
+	stats := mgr.Stats()
+	s := fmt.Sprintf("<nodeManager:%s>[%s][Nodes:%d,LoadTimes:%d,EvictTimes:%d,UnregisterTimes:%d,Hits:%d,Misses:%d,Evictions:%d]:",
+		mgr.name, mgr.sizeLimiter.String(), len(mgr.nodes),
+		atomic.LoadInt64(&mgr.loadtimes), atomic.LoadInt64(&mgr.evicttimes), atomic.LoadInt64(&mgr.unregistertimes),
+		stats.Hits, stats.Misses, stats.Evictions)
 	for _, node := range mgr.nodes {
 		id := node.GetID()
 		node.RLock()
@@ -119,6 +146,8 @@ func (mgr *nodeManager) MakeRoom(size uint64) bool {
 			}
 			evicted.Handle.Unload()
 			evicted.Handle.Unlock()
+			atomic.AddUint64(&mgr.evictions, 1)
+			metric.BufferEvictions.WithLabelValues(mgr.name).Inc()
 		}
 		ok = mgr.sizeLimiter.ApplyQuota(size)
 	}
@@ -155,6 +184,8 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	if node.IsLoaded() {
 		node.Ref()
 		node.RUnlock()
+		atomic.AddUint64(&mgr.hits, 1)
+		metric.BufferPins.WithLabelValues(mgr.name, metric.PinHit).Inc()
 		return node.MakeHandle()
 	}
 	node.RUnlock()
@@ -163,8 +194,12 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	defer node.Unlock()
 	if node.IsLoaded() {
 		node.Ref()
+		atomic.AddUint64(&mgr.hits, 1)
+		metric.BufferPins.WithLabelValues(mgr.name, metric.PinHit).Inc()
 		return node.MakeHandle()
 	}
+	atomic.AddUint64(&mgr.misses, 1)
+	metric.BufferPins.WithLabelValues(mgr.name, metric.PinMiss).Inc()
 	ok := mgr.MakeRoom(node.Size())
 	if !ok {
 		return nil
@@ -0,0 +1,123 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+)
+
+const (
+	DefaultTwoQInRatio     = 0.25
+	DefaultTwoQOutCapacity = 4096
+)
+
+type TwoQEvictHolderCtx struct {
+	// InRatio is the max share of the nodes in the first-in queue
+	InRatio float64
+	// OutCapacity is the max number of the evicted nodes remembered
+	OutCapacity int
+}
+
+type twoQEntry struct {
+	node *EvictNode
+	elem *list.Element
+	hot  bool
+}
+
+// TwoQEvictHolder implements the 2Q policy. The nodes unpinned for the first
+// time go to the first-in queue and are evicted from it in fifo order. The
+// nodes unpinned again after their eviction go to the lru queue, so a scan
+// of the cold data does not flush out the hot nodes
+type TwoQEvictHolder struct {
+	sync.Mutex
+	in      *list.List
+	hot     *list.List
+	out     *list.List
+	entries map[base.IEvictHandle]*twoQEntry
+	ghosts  map[base.IEvictHandle]*list.Element
+	inRatio float64
+	outCap  int
+}
+
+func NewTwoQEvictHolder(ctx ...any) IEvictHolder {
+	inRatio, outCap := DefaultTwoQInRatio, DefaultTwoQOutCapacity
+	if len(ctx) > 0 {
+		context := ctx[0].(*TwoQEvictHolderCtx)
+		if context != nil {
+			if context.InRatio > 0 {
+				inRatio = context.InRatio
+			}
+			if context.OutCapacity > 0 {
+				outCap = context.OutCapacity
+			}
+		}
+	}
+	return &TwoQEvictHolder{
+		in:      list.New(),
+		hot:     list.New(),
+		out:     list.New(),
+		entries: make(map[base.IEvictHandle]*twoQEntry),
+		ghosts:  make(map[base.IEvictHandle]*list.Element),
+		inRatio: inRatio,
+		outCap:  outCap,
+	}
+}
+
+func (holder *TwoQEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	if entry := holder.entries[node.Handle]; entry != nil {
+		entry.node = node
+		entry.elem.Value = node
+		if entry.hot {
+			holder.hot.MoveToBack(entry.elem)
+		}
+		return
+	}
+	entry := &twoQEntry{node: node}
+	if ghost, ok := holder.ghosts[node.Handle]; ok {
+		holder.out.Remove(ghost)
+		delete(holder.ghosts, node.Handle)
+		entry.hot = true
+		entry.elem = holder.hot.PushBack(node)
+	} else {
+		entry.elem = holder.in.PushBack(node)
+	}
+	holder.entries[node.Handle] = entry
+}
+
+func (holder *TwoQEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	queue := holder.hot
+	inCnt := holder.in.Len()
+	if inCnt > 0 && (holder.hot.Len() == 0 || float64(inCnt) > holder.inRatio*float64(inCnt+holder.hot.Len())) {
+		queue = holder.in
+	}
+	if queue.Len() == 0 {
+		return nil
+	}
+	node := queue.Remove(queue.Front()).(*EvictNode)
+	delete(holder.entries, node.Handle)
+	holder.ghosts[node.Handle] = holder.out.PushBack(node.Handle)
+	for holder.out.Len() > holder.outCap {
+		h := holder.out.Remove(holder.out.Front()).(base.IEvictHandle)
+		delete(holder.ghosts, h)
+	}
+	return node
+}
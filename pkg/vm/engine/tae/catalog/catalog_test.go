@@ -16,11 +16,14 @@ package catalog
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -465,3 +468,57 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestSchemaFormat(t *testing.T) {
+	schema := MockSchemaAll(3)
+	schema.BlockMaxRows = 1000
+	schema.PrimaryKey = 1
+	schema.ColDefs[2].CompressAlgo = compress.Zstd
+	schema.Relkind = SystemViewRel
+	schema.Createsql = "create view v as select 1"
+	schema.CompactionPolicy = CompactionTimeBased
+	schema.ClusterBy = []uint16{2, 1}
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+
+	readed := NewEmptySchema("")
+	n, err := readed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	assert.Equal(t, schema.BlockMaxRows, readed.BlockMaxRows)
+	assert.Equal(t, schema.PrimaryKey, readed.PrimaryKey)
+	assert.Equal(t, compress.Zstd, int(readed.ColDefs[2].CompressAlgo))
+	assert.Equal(t, schema.Relkind, readed.Relkind)
+	assert.Equal(t, schema.Createsql, readed.Createsql)
+	assert.Equal(t, schema.CompactionPolicy, readed.CompactionPolicy)
+	assert.Equal(t, schema.ClusterBy, readed.ClusterBy)
+
+	// The layout before the format version
+	var w bytes.Buffer
+	_ = binary.Write(&w, binary.BigEndian, schema.BlockMaxRows)
+	_ = binary.Write(&w, binary.BigEndian, schema.PrimaryKey)
+	_ = binary.Write(&w, binary.BigEndian, schema.SegmentMaxBlocks)
+	_, _ = common.WriteString(schema.Name, &w)
+	_, _ = common.WriteString(schema.Comment, &w)
+	_ = binary.Write(&w, binary.BigEndian, uint16(len(schema.ColDefs)))
+	for _, colDef := range schema.ColDefs {
+		_, _ = w.Write(encoding.EncodeType(colDef.Type))
+		_, _ = common.WriteString(colDef.Name, &w)
+		_, _ = common.WriteString(colDef.Comment, &w)
+		_ = binary.Write(&w, binary.BigEndian, colDef.NullAbility)
+		_ = binary.Write(&w, binary.BigEndian, colDef.Hidden)
+		_ = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement)
+	}
+	size := w.Len()
+	readed = new(Schema)
+	n, err = readed.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(size), n)
+	assert.Equal(t, schema.BlockMaxRows, readed.BlockMaxRows)
+	assert.Equal(t, schema.PrimaryKey, readed.PrimaryKey)
+	assert.Equal(t, len(schema.ColDefs), len(readed.ColDefs))
+	assert.Equal(t, compress.Lz4, int(readed.ColDefs[2].CompressAlgo))
+	assert.Equal(t, SystemOrdinaryRel, readed.Relkind)
+	assert.Equal(t, "", readed.CompactionPolicy)
+	assert.False(t, readed.IsClustered())
+}
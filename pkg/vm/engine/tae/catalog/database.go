@@ -191,6 +191,13 @@ func (e *DBEntry) DropTableEntry(name string, txnCtx txnif.AsyncTxn) (deleted *T
 }
 
 func (e *DBEntry) CreateTableEntry(schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) (created *TableEntry, err error) {
+	if !IsValidCompactionPolicy(schema.CompactionPolicy) {
+		err = fmt.Errorf("%w: unknown compaction policy %s", ErrValidation, schema.CompactionPolicy)
+		return
+	}
+	if err = schema.checkClusterBy(); err != nil {
+		return
+	}
 	e.Lock()
 	created = NewTableEntry(e, schema, txnCtx, dataFactory)
 	err = e.AddEntryLocked(created)
@@ -23,6 +23,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -53,6 +54,46 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+// The schemas are marshaled with a format version since the column
+// compression was added. The schemas of the older layout start with
+// BlockMaxRows instead of schemaFormatMagic
+const (
+	schemaFormatMagic uint32 = 0xFFFFFFFF
+
+	// SchemaFormatV1 adds the compress algorithm of the columns
+	SchemaFormatV1 uint16 = 1
+	// SchemaFormatV2 adds the relkind and the create sql of views
+	SchemaFormatV2 uint16 = 2
+	// SchemaFormatV3 adds the compaction policy
+	SchemaFormatV3 uint16 = 3
+	// SchemaFormatV4 adds the cluster by columns
+	SchemaFormatV4 uint16 = 4
+
+	SchemaFormatVersion = SchemaFormatV4
+)
+
+// Compaction policies a table can pick for merging its segments, an empty
+// policy means CompactionSizeTiered
+const (
+	// CompactionSizeTiered merges a segment once it is full and remerges a
+	// sealed segment once its delete ratio is high enough
+	CompactionSizeTiered = "size-tiered"
+	// CompactionTimeBased merges a segment once it has been mergeable for
+	// a while, so bursts of writes are not rewritten back to back
+	CompactionTimeBased = "time"
+	// CompactionWriteAmp only rewrites a sealed segment when the reclaimed
+	// deletes are worth the live rows copied
+	CompactionWriteAmp = "write-amp"
+)
+
+func IsValidCompactionPolicy(policy string) bool {
+	switch policy {
+	case "", CompactionSizeTiered, CompactionTimeBased, CompactionWriteAmp:
+		return true
+	}
+	return false
+}
+
 type ColDef struct {
 	Name          string
 	Idx           int
@@ -61,6 +102,8 @@ type ColDef struct {
 	NullAbility   int8
 	AutoIncrement int8
 	Comment       string
+	// the compress algorithm of the column blocks
+	CompressAlgo uint8
 }
 
 type Schema struct {
@@ -71,6 +114,12 @@ type Schema struct {
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
 	Comment          string         `json:"comment"`
+	Relkind          string         `json:"relkind"`
+	Createsql        string         `json:"createsql"`
+	CompactionPolicy string         `json:"compaction"`
+	// ClusterBy lists the columns the sealed blocks are sorted on. Empty
+	// means the blocks are sorted on the primary key only
+	ClusterBy []uint16 `json:"clusterby"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -78,13 +127,27 @@ func NewEmptySchema(name string) *Schema {
 		Name:      name,
 		ColDefs:   make([]*ColDef, 0),
 		NameIndex: make(map[string]int),
+		Relkind:   SystemOrdinaryRel,
 	}
 }
 
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
+	version := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
 	}
+	if s.BlockMaxRows == schemaFormatMagic {
+		if err = binary.Read(r, binary.BigEndian, &version); err != nil {
+			return
+		}
+		if version > SchemaFormatVersion {
+			return n, fmt.Errorf("unknown schema format version %d", version)
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 4 + 2
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.PrimaryKey); err != nil {
 		return
 	}
@@ -95,15 +158,48 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n += sn + 4 + 4 + 2
 	if s.Comment, sn, err = common.ReadString(r); err != nil {
 		return
 	}
 	n += sn
+	if version < SchemaFormatV2 {
+		s.Relkind = SystemOrdinaryRel
+	} else {
+		if s.Relkind, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if s.Createsql, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+	}
+	if version >= SchemaFormatV3 {
+		if s.CompactionPolicy, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+	}
+	keyCnt := uint16(0)
+	if version >= SchemaFormatV4 {
+		if err = binary.Read(r, binary.BigEndian, &keyCnt); err != nil {
+			return
+		}
+		n += 2
+	}
+	if keyCnt > 0 {
+		s.ClusterBy = make([]uint16, keyCnt)
+		if err = binary.Read(r, binary.BigEndian, s.ClusterBy); err != nil {
+			return
+		}
+		n += 2 * int64(keyCnt)
+	}
 	colCnt := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
 	}
+	n += 2
 	colBuf := make([]byte, encoding.TypeSize)
 	for i := uint16(0); i < colCnt; i++ {
 		if _, err = r.Read(colBuf); err != nil {
@@ -132,6 +228,14 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if version < SchemaFormatV1 {
+			colDef.CompressAlgo = compress.Lz4
+		} else {
+			if err = binary.Read(r, binary.BigEndian, &colDef.CompressAlgo); err != nil {
+				return
+			}
+			n += 1
+		}
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
 	}
@@ -140,6 +244,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaFormatMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, SchemaFormatVersion); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -155,6 +265,21 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = common.WriteString(s.Comment, &w); err != nil {
 		return
 	}
+	if _, err = common.WriteString(s.Relkind, &w); err != nil {
+		return
+	}
+	if _, err = common.WriteString(s.Createsql, &w); err != nil {
+		return
+	}
+	if _, err = common.WriteString(s.CompactionPolicy, &w); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ClusterBy))); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.ClusterBy); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ColDefs))); err != nil {
 		return
 	}
@@ -177,6 +302,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.CompressAlgo); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -184,9 +312,10 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 
 func (s *Schema) AppendCol(name string, typ types.Type) {
 	colDef := &ColDef{
-		Name: name,
-		Type: typ,
-		Idx:  len(s.ColDefs),
+		Name:         name,
+		Type:         typ,
+		Idx:          len(s.ColDefs),
+		CompressAlgo: compress.Lz4,
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
@@ -197,6 +326,42 @@ func (s *Schema) String() string {
 	return string(buf)
 }
 
+func (s *Schema) IsClustered() bool {
+	return len(s.ClusterBy) > 0
+}
+
+// SortKey returns the columns the sealed blocks of the table are sorted on
+func (s *Schema) SortKey() []int {
+	if !s.IsClustered() {
+		return []int{int(s.PrimaryKey)}
+	}
+	keys := make([]int, len(s.ClusterBy))
+	for i, idx := range s.ClusterBy {
+		keys[i] = int(idx)
+	}
+	return keys
+}
+
+// IsPKSorted tells whether the sealed blocks are ordered on the primary key,
+// so that a primary key can be looked up with a binary search
+func (s *Schema) IsPKSorted() bool {
+	return !s.IsClustered() || int32(s.ClusterBy[0]) == s.PrimaryKey
+}
+
+func (s *Schema) checkClusterBy() error {
+	seen := make(map[uint16]bool)
+	for _, idx := range s.ClusterBy {
+		if int(idx) >= len(s.ColDefs) {
+			return fmt.Errorf("%w: cluster by column %d out of range", ErrValidation, idx)
+		}
+		if seen[idx] {
+			return fmt.Errorf("%w: duplicate cluster by column %s", ErrValidation, s.ColDefs[idx].Name)
+		}
+		seen[idx] = true
+	}
+	return nil
+}
+
 func (s *Schema) IsPartOfPK(idx int) bool {
 	return int32(idx) == s.PrimaryKey
 }
@@ -0,0 +1,36 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"hash/crc32"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("tae: checksum mismatch")
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Checksum returns the CRC32C of buf
+func Checksum(buf []byte) uint32 {
+	return crc32.Checksum(buf, crc32cTable)
+}
+
+// ChecksumUpdate returns the CRC32C of the data checksummed by crc followed by buf
+func ChecksumUpdate(crc uint32, buf []byte) uint32 {
+	return crc32.Update(crc, crc32cTable, buf)
+}
@@ -109,6 +109,14 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
+	case types.T_time:
+		if a.(types.Time) > b.(types.Time) {
+			return 1
+		} else if a.(types.Time) < b.(types.Time) {
+			return -1
+		} else {
+			return 0
+		}
 	case types.T_datetime:
 		if a.(types.Datetime) > b.(types.Datetime) {
 			return 1
@@ -117,7 +125,7 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		res := bytes.Compare(a.([]byte), b.([]byte))
 		if res > 0 {
 			return 1
@@ -14,7 +14,11 @@
 
 package common
 
-import "io"
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
 
 type FileType uint8
 
@@ -92,12 +96,13 @@ func (i *baseFileInfo) CompressAlgo() int { return 0 }
 type compressedFileInfo struct {
 	size  int64
 	osize int64
+	algo  int
 }
 
 func (i *compressedFileInfo) Name() string      { return "" }
 func (i *compressedFileInfo) Size() int64       { return i.size }
 func (i *compressedFileInfo) OriginSize() int64 { return i.osize }
-func (i *compressedFileInfo) CompressAlgo() int { return 1 }
+func (i *compressedFileInfo) CompressAlgo() int { return i.algo }
 
 // baseMemFile is an abstraction of some pure in-memory resources.
 // It belongs to IVFile family.
@@ -147,8 +152,13 @@ func (f *mockCompressedFile) GetFileType() FileType {
 }
 
 func MockCompressedFile(size int64, osize int64) IVFile {
+	return MockCompressedFileWithAlgo(size, osize, compress.Lz4)
+}
+
+func MockCompressedFileWithAlgo(size int64, osize int64, algo int) IVFile {
 	return &mockCompressedFile{stat: compressedFileInfo{
 		size:  size,
 		osize: osize,
+		algo:  algo,
 	}}
 }
@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import "math/bits"
+
+// bitWidth returns the number of bits needed by v
+func bitWidth(v uint64) int {
+	return bits.Len64(v)
+}
+
+// packedSize returns the size of n values packed with width
+func packedSize(n, width int) int {
+	return (n*width + 7) / 8
+}
+
+// pack appends the low width bits of the values to buf
+func pack(buf []byte, vals []uint64, width int) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, packedSize(len(vals), width))...)
+	if width == 0 {
+		return buf
+	}
+	out := buf[start:]
+	pos := 0
+	for _, v := range vals {
+		for left := width; left > 0; {
+			idx, off := pos/8, pos%8
+			n := 8 - off
+			if n > left {
+				n = left
+			}
+			out[idx] |= byte(v&(1<<n-1)) << off
+			v >>= n
+			left -= n
+			pos += n
+		}
+	}
+	return buf
+}
+
+// unpack reads n values packed with width from buf
+func unpack(buf []byte, n, width int) ([]uint64, error) {
+	if len(buf) < packedSize(n, width) {
+		return nil, ErrInvalidData
+	}
+	vals := make([]uint64, n)
+	if width == 0 {
+		return vals, nil
+	}
+	pos := 0
+	for i := range vals {
+		var v uint64
+		for got := 0; got < width; {
+			idx, off := pos/8, pos%8
+			m := 8 - off
+			if m > width-got {
+				m = width - got
+			}
+			v |= uint64(buf[idx]>>off&(1<<m-1)) << got
+			got += m
+			pos += m
+		}
+		vals[i] = v
+	}
+	return vals, nil
+}
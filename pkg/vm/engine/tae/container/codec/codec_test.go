@@ -0,0 +1,167 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func newVec(t *testing.T, typ types.T, vals any) *gvec.Vector {
+	vec := gvec.New(types.Type{Oid: typ, Size: int32(typ.FixedLength()), Width: 100})
+	assert.Nil(t, gvec.Append(vec, vals))
+	return vec
+}
+
+func checkEncode(t *testing.T, vec *gvec.Vector, expected Kind) []byte {
+	plain, err := vec.Show()
+	assert.Nil(t, err)
+	buf, kind, err := Encode(vec)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, kind)
+	if kind == Plain {
+		assert.False(t, IsEncoded(buf))
+		assert.Equal(t, plain, buf)
+		return buf
+	}
+	assert.True(t, IsEncoded(buf))
+	assert.Less(t, len(buf), len(plain))
+	decoded, err := Decode(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, plain, decoded)
+	res, err := DecodeVector(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, vec.String(), res.String())
+	return buf
+}
+
+func TestBitPack(t *testing.T) {
+	for width := 0; width <= 64; width++ {
+		vals := make([]uint64, 100)
+		for i := range vals {
+			vals[i] = uint64(i*7919) & (1<<width - 1)
+		}
+		buf := pack([]byte{0xff}, vals, width)
+		assert.Equal(t, 1+packedSize(len(vals), width), len(buf))
+		res, err := unpack(buf[1:], len(vals), width)
+		assert.Nil(t, err)
+		assert.Equal(t, vals, res)
+	}
+	_, err := unpack(nil, 1, 1)
+	assert.Equal(t, ErrInvalidData, err)
+}
+
+func TestEncodeInts(t *testing.T) {
+	rows := 1000
+	runs := make([]int32, rows)
+	small := make([]uint64, rows)
+	narrow := make([]int64, rows)
+	sorted := make([]int64, rows)
+	random := make([]int64, rows)
+	for i := 0; i < rows; i++ {
+		runs[i] = int32(i/100*1000000 + 1000000000)
+		small[i] = uint64(i % 13)
+		narrow[i] = math.MinInt64/2 + int64(i*7919%rows)
+		sorted[i] = -int64(rows)*1000 + int64(i*1000+i%3)
+		random[i] = int64(uint64(i) * 0x9E3779B97F4A7C15)
+	}
+	checkEncode(t, newVec(t, types.T_int32, runs), RLE)
+	checkEncode(t, newVec(t, types.T_uint64, small), BitPack)
+	checkEncode(t, newVec(t, types.T_int64, narrow), FOR)
+	checkEncode(t, newVec(t, types.T_int64, sorted), Delta)
+	checkEncode(t, newVec(t, types.T_int64, random), Plain)
+	checkEncode(t, newVec(t, types.T_float64, make([]float64, rows)), Plain)
+
+	ts := make([]types.Timestamp, rows)
+	for i := range ts {
+		ts[i] = types.Timestamp(1656000000000000 + int64(i)*1000000)
+	}
+	checkEncode(t, newVec(t, types.T_timestamp, ts), Delta)
+
+	// the nulls are kept
+	tiny := make([]int8, rows)
+	for i := range tiny {
+		tiny[i] = int8(i/100*25 - 100)
+	}
+	vec := newVec(t, types.T_int8, tiny)
+	nulls.Add(vec.Nsp, 1, 3, 999)
+	buf := checkEncode(t, vec, RLE)
+	res, err := DecodeVector(buf)
+	assert.Nil(t, err)
+	assert.True(t, nulls.Contains(res.Nsp, 999))
+	assert.False(t, nulls.Contains(res.Nsp, 2))
+
+	checkEncode(t, newVec(t, types.T_int8, []int8{}), Plain)
+}
+
+func TestEncodeDict(t *testing.T) {
+	rows := 1000
+	vals := make([][]byte, rows)
+	for i := range vals {
+		vals[i] = []byte(fmt.Sprintf("city-%d", (i*31)%17))
+	}
+	vec := newVec(t, types.T_varchar, vals)
+	nulls.Add(vec.Nsp, 0)
+	buf := checkEncode(t, vec, Dict)
+
+	col, err := Open(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, Dict, col.Kind())
+	assert.Equal(t, rows, col.Rows())
+	assert.Equal(t, 17, len(col.Dict()))
+	for i := 1; i < len(col.Dict()); i++ {
+		assert.Less(t, string(col.Dict()[i-1]), string(col.Dict()[i]))
+	}
+
+	// row 0 is null, it is not returned
+	rs, err := col.FilterEq([]byte("city-0"))
+	assert.Nil(t, err)
+	for i, v := range vals {
+		assert.Equal(t, i > 0 && string(v) == "city-0", rs.Contains(uint32(i)))
+	}
+	rs, err = col.FilterEq([]byte("city-17"))
+	assert.Nil(t, err)
+	assert.True(t, rs.IsEmpty())
+
+	unique := make([][]byte, rows)
+	for i := range unique {
+		unique[i] = []byte(fmt.Sprintf("key-%d", i))
+	}
+	checkEncode(t, newVec(t, types.T_varchar, unique), Plain)
+
+	buf = checkEncode(t, newVec(t, types.T_uint16, make([]uint16, rows)), BitPack)
+	col, err = Open(buf)
+	assert.Nil(t, err)
+	_, err = col.FilterEq([]byte("a"))
+	assert.Equal(t, ErrNotDict, err)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	vec := newVec(t, types.T_char, [][]byte{[]byte("a"), []byte("a"), []byte("a"), []byte("a"),
+		[]byte("a"), []byte("a"), []byte("a"), []byte("a"), []byte("a"), []byte("a")})
+	buf, kind, err := Encode(vec)
+	assert.Nil(t, err)
+	assert.Equal(t, Dict, kind)
+	for i := prefixSize; i < len(buf); i++ {
+		_, err = Decode(buf[:i])
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// Column is an encoded column block, it is read without decoding its values
+type Column struct {
+	kind   Kind
+	rows   int
+	header *showData
+	body   []byte
+
+	// the dictionary and the codes of a Dict column
+	dict  [][]byte
+	codes []uint64
+}
+
+// Open parses the prefix of an encoded column block
+func Open(data []byte) (*Column, error) {
+	if !IsEncoded(data) {
+		return nil, ErrInvalidData
+	}
+	c := &Column{
+		kind: Kind(data[1]),
+		rows: int(encoding.DecodeUint32(data[2:])),
+	}
+	size := int(encoding.DecodeUint32(data[6:]))
+	if len(data) < prefixSize+size {
+		return nil, ErrInvalidData
+	}
+	header, err := parseShow(data[prefixSize : prefixSize+size])
+	if err != nil {
+		return nil, err
+	}
+	c.header = header
+	c.body = data[prefixSize+size:]
+	if c.kind == Dict {
+		if err = c.readDict(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *Column) Kind() Kind       { return c.kind }
+func (c *Column) Rows() int        { return c.rows }
+func (c *Column) Type() types.Type { return c.header.typ }
+
+func (c *Column) Nulls() (*nulls.Nulls, error) {
+	nsp := &nulls.Nulls{}
+	err := nsp.Read(c.header.nulls)
+	return nsp, err
+}
+
+// Dict returns the sorted dictionary of a Dict column
+func (c *Column) Dict() [][]byte { return c.dict }
+
+func (c *Column) readDict() error {
+	body := c.body
+	if len(body) < 4 {
+		return ErrInvalidData
+	}
+	cnt := int(encoding.DecodeUint32(body))
+	body = body[4:]
+	if len(body) < 4*cnt {
+		return ErrInvalidData
+	}
+	lengths := encoding.DecodeUint32Slice(body[:4*cnt])
+	body = body[4*cnt:]
+	c.dict = make([][]byte, cnt)
+	for i, l := range lengths {
+		if len(body) < int(l) {
+			return ErrInvalidData
+		}
+		c.dict[i] = body[:l]
+		body = body[l:]
+	}
+	if len(body) < 1 {
+		return ErrInvalidData
+	}
+	codes, err := unpack(body[1:], c.rows, int(body[0]))
+	if err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if code >= uint64(cnt) {
+			return ErrInvalidData
+		}
+	}
+	c.codes = codes
+	return nil
+}
+
+// Lookup returns the code of v in the dictionary, ok is false if v is not
+// in the dictionary
+func (c *Column) Lookup(v []byte) (code uint64, ok bool) {
+	i := sort.Search(len(c.dict), func(i int) bool {
+		return bytes.Compare(c.dict[i], v) >= 0
+	})
+	if i < len(c.dict) && bytes.Equal(c.dict[i], v) {
+		return uint64(i), true
+	}
+	return 0, false
+}
+
+// FilterEq returns the rows of a Dict column equal to v, the values are
+// compared on their codes
+func (c *Column) FilterEq(v []byte) (*roaring.Bitmap, error) {
+	if c.kind != Dict {
+		return nil, ErrNotDict
+	}
+	rows := roaring.NewBitmap()
+	code, ok := c.Lookup(v)
+	if !ok {
+		return rows, nil
+	}
+	nsp, err := c.Nulls()
+	if err != nil {
+		return nil, err
+	}
+	for i, cur := range c.codes {
+		if cur == code && !nulls.Contains(nsp, uint64(i)) {
+			rows.Add(uint32(i))
+		}
+	}
+	return rows, nil
+}
+
+// Decode returns the column block in the show format
+func (c *Column) Decode() ([]byte, error) {
+	buf := make([]byte, 0, len(c.header.header)+c.rows*8)
+	buf = append(buf, c.header.header...)
+	if c.kind == Dict {
+		lengths := make([]uint32, c.rows)
+		for i, code := range c.codes {
+			lengths[i] = uint32(len(c.dict[code]))
+		}
+		buf = append(buf, encoding.EncodeInt32(int32(c.rows))...)
+		if c.rows == 0 {
+			return buf, nil
+		}
+		buf = append(buf, encoding.EncodeUint32Slice(lengths)...)
+		for _, code := range c.codes {
+			buf = append(buf, c.dict[code]...)
+		}
+		return buf, nil
+	}
+	size, signed := fixedSize(c.header.typ.Oid)
+	if size == 0 {
+		return nil, ErrInvalidData
+	}
+	vals, err := c.decodeFixed(size, signed)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vals {
+		buf = appendValue(buf, v, size)
+	}
+	return buf, nil
+}
+
+func (c *Column) decodeFixed(size int, signed bool) (vals []uint64, err error) {
+	body := c.body
+	switch c.kind {
+	case RLE:
+		if len(body) < 4 {
+			return nil, ErrInvalidData
+		}
+		runs := int(encoding.DecodeUint32(body))
+		body = body[4:]
+		if len(body) < runs*(size+4) {
+			return nil, ErrInvalidData
+		}
+		lengths := encoding.DecodeUint32Slice(body[runs*size : runs*(size+4)])
+		vals = make([]uint64, 0, c.rows)
+		for i, l := range lengths {
+			v := readValue(body[i*size:], size)
+			for j := uint32(0); j < l; j++ {
+				vals = append(vals, v)
+			}
+		}
+		if len(vals) != c.rows {
+			return nil, ErrInvalidData
+		}
+		return vals, nil
+	case BitPack:
+		if len(body) < 1 {
+			return nil, ErrInvalidData
+		}
+		return unpack(body[1:], c.rows, int(body[0]))
+	case FOR, Delta:
+		if len(body) < 9 {
+			return nil, ErrInvalidData
+		}
+		base := encoding.DecodeUint64(body)
+		width := int(body[8])
+		n := c.rows
+		if c.kind == Delta && n > 0 {
+			n--
+		}
+		if vals, err = unpack(body[9:], n, width); err != nil {
+			return
+		}
+		if c.kind == Delta && c.rows > 0 {
+			keys := make([]uint64, c.rows)
+			keys[0] = base
+			for i, d := range vals {
+				keys[i+1] = keys[i] + d
+			}
+			vals = keys
+		} else {
+			for i := range vals {
+				vals[i] += base
+			}
+		}
+		for i, k := range vals {
+			vals[i] = fromKey(k, size, signed)
+		}
+		return vals, nil
+	}
+	return nil, ErrInvalidData
+}
+
+// Decode returns an encoded column block in the show format
+func Decode(data []byte) ([]byte, error) {
+	c, err := Open(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decode()
+}
+
+// DecodeVector returns the vector of a column block, which is encoded or in
+// the show format
+func DecodeVector(data []byte) (vec *gvec.Vector, err error) {
+	if IsEncoded(data) {
+		if data, err = Decode(data); err != nil {
+			return
+		}
+	}
+	vec = gvec.New(encoding.DecodeType(data[:encoding.TypeSize]))
+	err = vec.Read(data)
+	return
+}
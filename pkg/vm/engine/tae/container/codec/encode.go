@@ -0,0 +1,237 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// showData is a vector in the show format split into the header and the values
+type showData struct {
+	typ    types.Type
+	header []byte
+	nulls  []byte
+	values []byte
+}
+
+func parseShow(data []byte) (*showData, error) {
+	if len(data) < encoding.TypeSize+4 {
+		return nil, ErrInvalidData
+	}
+	sd := &showData{typ: encoding.DecodeType(data[:encoding.TypeSize])}
+	size := int(encoding.DecodeUint32(data[encoding.TypeSize:]))
+	end := encoding.TypeSize + 4 + size
+	if len(data) < end {
+		return nil, ErrInvalidData
+	}
+	sd.header = data[:end]
+	sd.nulls = data[encoding.TypeSize+4 : end]
+	sd.values = data[end:]
+	return sd, nil
+}
+
+// parseStrings splits the values of a string vector in the show format
+func parseStrings(values []byte) (lengths []uint32, data []byte, err error) {
+	if len(values) < 4 {
+		return nil, nil, ErrInvalidData
+	}
+	cnt := int(encoding.DecodeInt32(values))
+	if cnt == 0 {
+		return nil, nil, nil
+	}
+	if len(values) < 4+4*cnt {
+		return nil, nil, ErrInvalidData
+	}
+	lengths = encoding.DecodeUint32Slice(values[4 : 4+4*cnt])
+	return lengths, values[4+4*cnt:], nil
+}
+
+func readValues(data []byte, size int) []uint64 {
+	vals := make([]uint64, len(data)/size)
+	for i := range vals {
+		vals[i] = readValue(data[i*size:], size)
+	}
+	return vals
+}
+
+func readValue(data []byte, size int) uint64 {
+	var v uint64
+	for i := size - 1; i >= 0; i-- {
+		v = v<<8 | uint64(data[i])
+	}
+	return v
+}
+
+func appendValue(buf []byte, v uint64, size int) []byte {
+	for i := 0; i < size; i++ {
+		buf = append(buf, byte(v))
+		v >>= 8
+	}
+	return buf
+}
+
+// toKey maps a value to a key keeping the order of the values of the type
+func toKey(v uint64, size int, signed bool) uint64 {
+	if !signed {
+		return v
+	}
+	shift := 64 - 8*size
+	return uint64(int64(v<<shift)>>shift) ^ 1<<63
+}
+
+func fromKey(k uint64, size int, signed bool) uint64 {
+	if signed {
+		k ^= 1 << 63
+	}
+	if size < 8 {
+		k &= 1<<(8*size) - 1
+	}
+	return k
+}
+
+// Encode encodes the vector with the encoding chosen from the statistics of
+// its values. The show format of the vector is returned if no encoding is
+// better than the plain values.
+func Encode(vec *gvec.Vector) ([]byte, Kind, error) {
+	data, err := vec.Show()
+	if err != nil {
+		return nil, Plain, err
+	}
+	return EncodeShow(data)
+}
+
+// EncodeShow encodes a vector in the show format
+func EncodeShow(data []byte) ([]byte, Kind, error) {
+	sd, err := parseShow(data)
+	if err != nil {
+		return nil, Plain, err
+	}
+	if size, signed := fixedSize(sd.typ.Oid); size > 0 {
+		vals := readValues(sd.values, size)
+		keys := make([]uint64, len(vals))
+		for i, v := range vals {
+			keys[i] = toKey(v, size, signed)
+		}
+		stats := fixedStats(vals, keys, size)
+		kind := stats.Choose()
+		if kind == Plain {
+			return data, Plain, nil
+		}
+		buf := appendPrefix(kind, sd, stats.Rows, stats.EncodedSize(kind))
+		switch kind {
+		case RLE:
+			buf = encodeRLE(buf, vals, stats.Runs, size)
+		case BitPack:
+			buf = append(buf, byte(bitWidth(stats.MaxValue)))
+			buf = pack(buf, vals, bitWidth(stats.MaxValue))
+		case FOR:
+			width := bitWidth(stats.MaxKey - stats.MinKey)
+			buf = append(buf, encoding.EncodeUint64(stats.MinKey)...)
+			buf = append(buf, byte(width))
+			for i := range keys {
+				keys[i] -= stats.MinKey
+			}
+			buf = pack(buf, keys, width)
+		case Delta:
+			width := bitWidth(stats.MaxDelta)
+			buf = append(buf, encoding.EncodeUint64(keys[0])...)
+			buf = append(buf, byte(width))
+			deltas := make([]uint64, len(keys)-1)
+			for i := range deltas {
+				deltas[i] = keys[i+1] - keys[i]
+			}
+			buf = pack(buf, deltas, width)
+		}
+		return buf, kind, nil
+	}
+	if !isDictType(sd.typ.Oid) {
+		return data, Plain, nil
+	}
+	lengths, values, err := parseStrings(sd.values)
+	if err != nil {
+		return nil, Plain, err
+	}
+	stats := stringStats(lengths, values)
+	if stats.Choose() != Dict {
+		return data, Plain, nil
+	}
+	buf := appendPrefix(Dict, sd, stats.Rows, stats.EncodedSize(Dict))
+	return encodeDict(buf, lengths, values, stats.Distinct), Dict, nil
+}
+
+func appendPrefix(kind Kind, sd *showData, rows, bodySize int) []byte {
+	buf := make([]byte, 0, prefixSize+len(sd.header)+bodySize)
+	buf = append(buf, Marker, byte(kind))
+	buf = append(buf, encoding.EncodeUint32(uint32(rows))...)
+	buf = append(buf, encoding.EncodeUint32(uint32(len(sd.header)))...)
+	return append(buf, sd.header...)
+}
+
+func encodeRLE(buf []byte, vals []uint64, runs, size int) []byte {
+	lengths := make([]uint32, 0, runs)
+	buf = append(buf, encoding.EncodeUint32(uint32(runs))...)
+	for i, v := range vals {
+		if i > 0 && v == vals[i-1] {
+			lengths[len(lengths)-1]++
+			continue
+		}
+		buf = appendValue(buf, v, size)
+		lengths = append(lengths, 1)
+	}
+	return append(buf, encoding.EncodeUint32Slice(lengths)...)
+}
+
+// encodeDict encodes the strings with a sorted dictionary, so the order of
+// the codes is the order of the values
+func encodeDict(buf []byte, lengths []uint32, data []byte, distinct int) []byte {
+	vals := make([][]byte, len(lengths))
+	dict := make([][]byte, 0, distinct)
+	seen := make(map[string]struct{}, distinct)
+	off := 0
+	for i, l := range lengths {
+		vals[i] = data[off : off+int(l)]
+		off += int(l)
+		if _, ok := seen[string(vals[i])]; !ok {
+			seen[string(vals[i])] = struct{}{}
+			dict = append(dict, vals[i])
+		}
+	}
+	sort.Slice(dict, func(i, j int) bool {
+		return bytes.Compare(dict[i], dict[j]) < 0
+	})
+	codeOf := make(map[string]uint64, len(dict))
+	dictLengths := make([]uint32, len(dict))
+	buf = append(buf, encoding.EncodeUint32(uint32(len(dict)))...)
+	for i, v := range dict {
+		codeOf[string(v)] = uint64(i)
+		dictLengths[i] = uint32(len(v))
+	}
+	buf = append(buf, encoding.EncodeUint32Slice(dictLengths)...)
+	for _, v := range dict {
+		buf = append(buf, v...)
+	}
+	codes := make([]uint64, len(vals))
+	for i, v := range vals {
+		codes[i] = codeOf[string(v)]
+	}
+	width := bitWidth(uint64(len(dict) - 1))
+	buf = append(buf, byte(width))
+	return pack(buf, codes, width)
+}
@@ -0,0 +1,141 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+// Stats are the statistics of a column block, the encoding of the block is
+// chosen from them
+type Stats struct {
+	Rows int
+	// size of the values in the plain format
+	PlainSize int
+	// size of a value of the integer like types, 0 for the strings
+	ValueSize int
+
+	// the integer like types
+	Runs     int
+	MaxValue uint64
+	MinKey   uint64
+	MaxKey   uint64
+	Sorted   bool
+	MaxDelta uint64
+
+	// the strings
+	Distinct int
+	DictSize int
+}
+
+func fixedStats(vals, keys []uint64, size int) *Stats {
+	s := &Stats{
+		Rows:      len(vals),
+		PlainSize: len(vals) * size,
+		ValueSize: size,
+		Sorted:    true,
+	}
+	if len(vals) == 0 {
+		return s
+	}
+	s.Runs = 1
+	s.MinKey, s.MaxKey = keys[0], keys[0]
+	for i, v := range vals {
+		if v > s.MaxValue {
+			s.MaxValue = v
+		}
+		k := keys[i]
+		if k < s.MinKey {
+			s.MinKey = k
+		}
+		if k > s.MaxKey {
+			s.MaxKey = k
+		}
+		if i == 0 {
+			continue
+		}
+		if v != vals[i-1] {
+			s.Runs++
+		}
+		if k < keys[i-1] {
+			s.Sorted = false
+		} else if d := k - keys[i-1]; d > s.MaxDelta {
+			s.MaxDelta = d
+		}
+	}
+	return s
+}
+
+func stringStats(lengths []uint32, data []byte) *Stats {
+	s := &Stats{
+		Rows:      len(lengths),
+		PlainSize: 4*len(lengths) + len(data),
+	}
+	distinct := make(map[string]struct{})
+	off := 0
+	for _, l := range lengths {
+		v := data[off : off+int(l)]
+		off += int(l)
+		if _, ok := distinct[string(v)]; ok {
+			continue
+		}
+		distinct[string(v)] = struct{}{}
+		s.DictSize += len(v)
+	}
+	s.Distinct = len(distinct)
+	return s
+}
+
+// EncodedSize returns the size of the body of the kind, -1 if the kind
+// does not apply to the block
+func (s *Stats) EncodedSize(kind Kind) int {
+	if s.Rows == 0 {
+		return -1
+	}
+	switch kind {
+	case Plain:
+		return s.PlainSize
+	case Dict:
+		if s.ValueSize != 0 {
+			return -1
+		}
+		return 4 + 4*s.Distinct + s.DictSize + 1 + packedSize(s.Rows, bitWidth(uint64(s.Distinct-1)))
+	}
+	if s.ValueSize == 0 {
+		return -1
+	}
+	switch kind {
+	case RLE:
+		return 4 + s.Runs*(s.ValueSize+4)
+	case BitPack:
+		return 1 + packedSize(s.Rows, bitWidth(s.MaxValue))
+	case FOR:
+		return 9 + packedSize(s.Rows, bitWidth(s.MaxKey-s.MinKey))
+	case Delta:
+		if !s.Sorted {
+			return -1
+		}
+		return 9 + packedSize(s.Rows-1, bitWidth(s.MaxDelta))
+	}
+	return -1
+}
+
+// Choose returns the kind with the smallest encoded size, Plain if no
+// encoding saves more than its prefix
+func (s *Stats) Choose() Kind {
+	kind, size := Plain, s.PlainSize-prefixSize
+	for k := Dict; k <= Delta; k++ {
+		if n := s.EncodedSize(k); n >= 0 && n < size {
+			kind, size = k, n
+		}
+	}
+	return kind
+}
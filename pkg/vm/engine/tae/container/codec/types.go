@@ -0,0 +1,112 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codec implements the lightweight encodings of the column blocks.
+//
+// An encoded column block starts with Marker, which is never the first byte
+// of a vector in the show format, followed by the kind of the encoding:
+//
+//	marker(1) | kind(1) | rows(4) | headerLen(4) | header | body
+//
+// The header is the type and the nulls of the vector copied from its show
+// format, the body depends on the kind:
+//
+//	Dict:    dictCnt(4) | lengths(4*dictCnt) | values | width(1) | codes
+//	RLE:     runCnt(4) | values(size*runCnt) | runLengths(4*runCnt)
+//	BitPack: width(1) | values
+//	FOR:     base(8) | width(1) | values - base
+//	Delta:   first(8) | width(1) | deltas
+//
+// Codes, values and deltas are bit-packed with the given width. FOR and Delta
+// work on the keys of the values, which keep the order of the signed values.
+package codec
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+type Kind uint8
+
+const (
+	Plain Kind = iota
+	Dict
+	RLE
+	BitPack
+	FOR
+	Delta
+)
+
+const (
+	Marker = byte(0xFE)
+
+	prefixSize = 10
+)
+
+var (
+	ErrInvalidData = errors.New("tae: invalid encoded column")
+	ErrNotDict     = errors.New("tae: column is not dictionary encoded")
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Plain:
+		return "Plain"
+	case Dict:
+		return "Dict"
+	case RLE:
+		return "RLE"
+	case BitPack:
+		return "BitPack"
+	case FOR:
+		return "FOR"
+	case Delta:
+		return "Delta"
+	}
+	return "Unknown"
+}
+
+// IsEncoded returns true if data is an encoded column block
+func IsEncoded(data []byte) bool {
+	return len(data) >= prefixSize && data[0] == Marker
+}
+
+// fixedSize returns the size of the values of the integer like types, 0 if
+// the type is not integer like
+func fixedSize(t types.T) (size int, signed bool) {
+	switch t {
+	case types.T_int8:
+		return 1, true
+	case types.T_int16:
+		return 2, true
+	case types.T_int32, types.T_date:
+		return 4, true
+	case types.T_int64, types.T_time, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		return 8, true
+	case types.T_uint8:
+		return 1, false
+	case types.T_uint16:
+		return 2, false
+	case types.T_uint32:
+		return 4, false
+	case types.T_uint64:
+		return 8, false
+	}
+	return 0, false
+}
+
+func isDictType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
@@ -17,6 +17,7 @@ package compute
 import (
 	"bytes"
 	"math"
+	"sort"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/RoaringBitmap/roaring/roaring64"
@@ -68,10 +69,13 @@ func AppendValue(vec *gvec.Vector, v any) {
 	case types.T_date:
 		vvals := vec.Col.([]types.Date)
 		vec.Col = append(vvals, v.(types.Date))
+	case types.T_time:
+		vvals := vec.Col.([]types.Time)
+		vec.Col = append(vvals, v.(types.Time))
 	case types.T_datetime:
 		vvals := vec.Col.([]types.Datetime)
 		vec.Col = append(vvals, v.(types.Datetime))
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vvals := vec.Col.(*types.Bytes)
 		offset := len(vvals.Data)
 		length := len(v.([]byte))
@@ -122,10 +126,13 @@ func GetValue(col *gvec.Vector, row uint32) any {
 	case types.T_date:
 		data := vals.([]types.Date)
 		return data[row]
+	case types.T_time:
+		data := vals.([]types.Time)
+		return data[row]
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		return data[row]
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data := vals.(*types.Bytes)
 		s := data.Offsets[row]
 		e := data.Lengths[row]
@@ -187,11 +194,15 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]types.Date)
 		data[row] = val.(types.Date)
 		col.Col = data
+	case types.T_time:
+		data := vals.([]types.Time)
+		data[row] = val.(types.Time)
+		col.Col = data
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		data[row] = val.(types.Datetime)
 		col.Col = data
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
 		// e := data.Lengths[row]
@@ -253,11 +264,15 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]types.Date)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
+	case types.T_time:
+		data := vals.([]types.Time)
+		data = append(data[:row], data[row+1:]...)
+		col.Col = data
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
 		// e := data.Lengths[row]
@@ -369,7 +384,7 @@ func ApplyDeleteToVector(vec *gvec.Vector, deletes *roaring.Bitmap) *gvec.Vector
 	deleted := 0
 	switch vec.Typ.Oid {
 	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
-		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64, types.T_date, types.T_time, types.T_datetime:
 		vec.Col = common.InplaceDeleteRows(vec.Col, deletesIterator)
 		deletesIterator = deletes.Iterator()
 		for deletesIterator.HasNext() {
@@ -399,7 +414,7 @@ func ApplyDeleteToVector(vec *gvec.Vector, deletes *roaring.Bitmap) *gvec.Vector
 				nsp.Np.Add(n - uint64(deleted))
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data := col.(*types.Bytes)
 		pre := -1
 		for deletesIterator.HasNext() {
@@ -463,7 +478,7 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 	col := vec.Col
 	switch vec.Typ.Oid {
 	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
-		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64, types.T_date, types.T_time, types.T_datetime:
 		for iterator.HasNext() {
 			row := iterator.Next()
 			SetFixSizeTypeValue(vec, row, vals[row])
@@ -473,7 +488,7 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 				}
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data := col.(*types.Bytes)
 		pre := -1
 		for iterator.HasNext() {
@@ -900,6 +915,27 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 			}
 		}
 		return
+	case types.T_time:
+		column := data.Col.([]types.Time)
+		val := v.(types.Time)
+		start, end := 0, len(column)-1
+		var mid int
+		for start <= end {
+			mid = (start + end) / 2
+			if column[mid] > val {
+				end = mid - 1
+			} else if column[mid] < val {
+				start = mid + 1
+			} else {
+				if deletes != nil && deletes.Contains(uint32(mid)) {
+					return
+				}
+				offset = uint32(mid)
+				exist = true
+				return
+			}
+		}
+		return
 	case types.T_datetime:
 		column := data.Col.([]types.Datetime)
 		val := v.(types.Datetime)
@@ -921,7 +957,7 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 			}
 		}
 		return
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		column := data.Col.(*types.Bytes)
 		val := v.([]byte)
 		start, end := 0, len(column.Offsets)-1
@@ -947,3 +983,31 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		panic("unsupported type")
 	}
 }
+
+// SortedOrder returns the offsets of the rows of a column that is not
+// sorted on its values, in the order of the values
+func SortedOrder(data *gvec.Vector) []uint32 {
+	order := make([]uint32, gvec.Length(data))
+	for i := range order {
+		order[i] = uint32(i)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return common.CompareGeneric(GetValue(data, order[i]), GetValue(data, order[j]), data.Typ) < 0
+	})
+	return order
+}
+
+// SearchRowExists is CheckRowExists for a column that is not sorted on its
+// values, it searches the rows in the order returned by SortedOrder
+func SearchRowExists(data *gvec.Vector, order []uint32, v any, deletes *roaring.Bitmap) (offset uint32, exist bool) {
+	i := sort.Search(len(order), func(i int) bool {
+		return common.CompareGeneric(GetValue(data, order[i]), v, data.Typ) >= 0
+	})
+	if i == len(order) || common.CompareGeneric(GetValue(data, order[i]), v, data.Typ) != 0 {
+		return
+	}
+	if deletes != nil && deletes.Contains(order[i]) {
+		return
+	}
+	return order[i], true
+}
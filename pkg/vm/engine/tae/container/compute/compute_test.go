@@ -15,12 +15,14 @@
 package compute
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/stretchr/testify/assert"
 )
@@ -132,3 +134,69 @@ func TestCheckRowExists(t *testing.T) {
 	_, exist = CheckRowExists(vec, int32(55), dels)
 	require.False(t, exist)
 }
+
+func TestSearchRowExists(t *testing.T) {
+	typ := types.Type{
+		Oid:   types.T_int32,
+		Size:  4,
+		Width: 32,
+	}
+	vec := MockVec(typ, 100, 0)
+	col := vec.Col.([]int32)
+	for i := range col {
+		col[i] = int32((i * 37) % 100)
+	}
+	order := SortedOrder(vec)
+	for i := 1; i < len(order); i++ {
+		require.Less(t, col[order[i-1]], col[order[i]])
+	}
+	offset, exist := SearchRowExists(vec, order, int32(55), nil)
+	require.True(t, exist)
+	require.Equal(t, int32(55), col[offset])
+	_, exist = SearchRowExists(vec, order, int32(-1), nil)
+	require.False(t, exist)
+	_, exist = SearchRowExists(vec, order, int32(100), nil)
+	require.False(t, exist)
+
+	dels := roaring.NewBitmap()
+	dels.Add(offset)
+	_, exist = SearchRowExists(vec, order, int32(55), dels)
+	require.False(t, exist)
+}
+
+func TestSplitLob(t *testing.T) {
+	typ := types.Type{Oid: types.T_text, Size: 24}
+	vec := gvec.New(typ)
+	large := bytes.Repeat([]byte("x"), LobInlineThreshold+1)
+	vals := [][]byte{[]byte("a"), large, []byte("b"), append(large, 'y')}
+	assert.Nil(t, gvec.Append(vec, vals))
+
+	inline, lob, err := SplitLob(vec)
+	assert.Nil(t, err)
+	assert.NotNil(t, lob)
+	col := inline.Col.(*types.Bytes)
+	assert.Equal(t, []byte("a"), col.Get(0))
+	assert.Equal(t, lobRefSize, len(col.Get(1)))
+	assert.Equal(t, lobRefSize, len(col.Get(3)))
+
+	assert.Nil(t, MergeLob(inline, lob))
+	col = inline.Col.(*types.Bytes)
+	for i, v := range vals {
+		assert.Equal(t, v, col.Get(int64(i)))
+	}
+
+	short := gvec.New(typ)
+	assert.Nil(t, gvec.Append(short, [][]byte{[]byte("a"), []byte("b")}))
+	inline, lob, err = SplitLob(short)
+	assert.Nil(t, err)
+	assert.Nil(t, lob)
+	assert.Equal(t, short, inline)
+
+	typ.Oid = types.T_varchar
+	vec2 := gvec.New(typ)
+	assert.Nil(t, gvec.Append(vec2, vals))
+	inline, lob, err = SplitLob(vec2)
+	assert.Nil(t, err)
+	assert.Nil(t, lob)
+	assert.Equal(t, vec2, inline)
+}
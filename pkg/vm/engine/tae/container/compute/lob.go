@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// LobInlineThreshold is the max length of a TEXT/BLOB value kept inline
+// in the column data. Longer values are moved to the lob file of the column
+// and replaced by an 8 bytes reference (offset, length).
+var LobInlineThreshold = 4096
+
+var ErrInvalidLob = errors.New("tae: invalid lob data")
+
+const lobRefSize = 8
+
+// SplitLob moves the values longer than LobInlineThreshold out of vec.
+// It returns the vector to be stored inline and the lob buffer:
+// | bitmap size (4) | bitmap of out of line rows | values |
+// For non lob types or when no value is out of line, the vector itself and
+// a nil buffer are returned, so no lob file is written
+func SplitLob(vec *vector.Vector) (inline *vector.Vector, lob []byte, err error) {
+	if !vec.Typ.Oid.IsLob() {
+		return vec, nil, nil
+	}
+	col := vec.Col.(*types.Bytes)
+	rows := roaring.New()
+	var values bytes.Buffer
+	inlineCol := &types.Bytes{
+		Offsets: make([]uint32, len(col.Offsets)),
+		Lengths: make([]uint32, len(col.Lengths)),
+	}
+	for i := range col.Offsets {
+		v := col.Get(int64(i))
+		if len(v) > LobInlineThreshold {
+			rows.Add(uint32(i))
+			ref := make([]byte, 0, lobRefSize)
+			ref = append(ref, encoding.EncodeUint32(uint32(values.Len()))...)
+			ref = append(ref, encoding.EncodeUint32(uint32(len(v)))...)
+			values.Write(v)
+			v = ref
+		}
+		inlineCol.Offsets[i] = uint32(len(inlineCol.Data))
+		inlineCol.Lengths[i] = uint32(len(v))
+		inlineCol.Data = append(inlineCol.Data, v...)
+	}
+	if rows.IsEmpty() {
+		return vec, nil, nil
+	}
+	var w bytes.Buffer
+	bm, err := rows.ToBytes()
+	if err != nil {
+		return
+	}
+	w.Write(encoding.EncodeUint32(uint32(len(bm))))
+	w.Write(bm)
+	w.Write(values.Bytes())
+	lob = w.Bytes()
+	inline = vector.New(vec.Typ)
+	inline.Col = inlineCol
+	inline.Nsp = vec.Nsp
+	return
+}
+
+// MergeLob is the reverse of SplitLob, it replaces the references in vec
+// with the values in the lob buffer
+func MergeLob(vec *vector.Vector, lob []byte) error {
+	if len(lob) == 0 || !vec.Typ.Oid.IsLob() {
+		return nil
+	}
+	if len(lob) < 4 {
+		return ErrInvalidLob
+	}
+	size := int(encoding.DecodeUint32(lob[:4]))
+	if len(lob) < 4+size {
+		return ErrInvalidLob
+	}
+	rows := roaring.New()
+	if err := rows.UnmarshalBinary(lob[4 : 4+size]); err != nil {
+		return err
+	}
+	if rows.IsEmpty() {
+		return nil
+	}
+	values := lob[4+size:]
+	col := vec.Col.(*types.Bytes)
+	merged := &types.Bytes{
+		Offsets: make([]uint32, len(col.Offsets)),
+		Lengths: make([]uint32, len(col.Lengths)),
+	}
+	for i := range col.Offsets {
+		v := col.Get(int64(i))
+		if rows.Contains(uint32(i)) {
+			if len(v) != lobRefSize {
+				return ErrInvalidLob
+			}
+			offset := encoding.DecodeUint32(v[:4])
+			length := encoding.DecodeUint32(v[4:])
+			if int(offset+length) > len(values) {
+				return ErrInvalidLob
+			}
+			v = values[offset : offset+length]
+		}
+		merged.Offsets[i] = uint32(len(merged.Data))
+		merged.Lengths[i] = uint32(len(v))
+		merged.Data = append(merged.Data, v...)
+	}
+	vec.Col = merged
+	return nil
+}
@@ -55,9 +55,11 @@ func DecodeKey(key []byte, typ types.Type) any {
 		return encoding.DecodeFloat64(key)
 	case types.T_date:
 		return encoding.DecodeDate(key)
+	case types.T_time:
+		return encoding.DecodeTime(key)
 	case types.T_datetime:
 		return encoding.DecodeDatetime(key)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return key
 	default:
 		panic("unsupported type")
@@ -132,13 +134,19 @@ func EncodeKey(key any, typ types.Type) ([]byte, error) {
 		} else {
 			panic("unsupported type")
 		}
+	case types.T_time:
+		if v, ok := key.(types.Time); ok {
+			return encoding.EncodeTime(v), nil
+		} else {
+			panic("unsupported type")
+		}
 	case types.T_datetime:
 		if v, ok := key.(types.Datetime); ok {
 			return encoding.EncodeDatetime(v), nil
 		} else {
 			panic("unsupported type")
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		if v, ok := key.([]byte); ok {
 			return v, nil
 		} else {
@@ -331,6 +339,22 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)[offset:]
+		if keyselects == nil {
+			for i, v := range vs {
+				if err := task(v, uint32(i)); err != nil {
+					return err
+				}
+			}
+		} else {
+			for _, idx := range idxes {
+				v := vs[idx]
+				if err := task(v, idx); err != nil {
+					return err
+				}
+			}
+		}
 	case types.T_datetime:
 		vs := vec.Col.([]types.Datetime)[offset:]
 		if keyselects == nil {
@@ -347,7 +371,7 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vs := vec.Col.(*types.Bytes)
 		if keyselects == nil {
 			for i := range vs.Offsets[offset:] {
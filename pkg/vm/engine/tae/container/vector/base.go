@@ -139,7 +139,7 @@ func MockVector(t types.Type, rows uint64) IVector {
 			vals = append(vals, float64(i%5000))
 		}
 		vec.Append(len(vals), vals)
-	case types.T_varchar, types.T_char:
+	case types.T_varchar, types.T_char, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		vec = NewStrVector(t, rows)
 		vals := make([][]byte, 0, rows)
 		prefix := "str"
@@ -148,6 +148,13 @@ func MockVector(t types.Type, rows uint64) IVector {
 			vals = append(vals, []byte(s))
 		}
 		vec.Append(len(vals), vals)
+	case types.T_time:
+		vec = NewStdVector(t, rows)
+		vals := make([]types.Time, 0, rows)
+		for i := uint64(1); i <= rows; i++ {
+			vals = append(vals, types.FromTimeClock(false, i%800, 1, 1, 1))
+		}
+		vec.Append(len(vals), vals)
 	case types.T_datetime:
 		vec = NewStdVector(t, rows)
 		vals := make([]types.Datetime, 0, rows)
@@ -184,6 +184,10 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeDate(val.(types.Date))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
+	case types.T_time:
+		data := encoding.EncodeTime(val.(types.Time))
+		copy(v.Data[start:start+int(v.Type.Size)], data)
+		return nil
 	case types.T_datetime:
 		data := encoding.EncodeDatetime(val.(types.Datetime))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
@@ -229,6 +233,8 @@ func (v *StdVector) GetValue(idx int) (any, error) {
 	// case types.T_decimal:
 	case types.T_date:
 		return encoding.DecodeDate(data), nil
+	case types.T_time:
+		return encoding.DecodeTime(data), nil
 	case types.T_datetime:
 		return encoding.DecodeDatetime(data), nil
 	default:
@@ -285,6 +291,8 @@ func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
 		data = encoding.EncodeFloat64Slice(vals.([]float64)[offset : offset+n])
 	case types.T_date:
 		data = encoding.EncodeDateSlice(vals.([]types.Date)[offset : offset+n])
+	case types.T_time:
+		data = encoding.EncodeTimeSlice(vals.([]types.Time)[offset : offset+n])
 	case types.T_datetime:
 		data = encoding.EncodeDatetimeSlice(vals.([]types.Datetime)[offset : offset+n])
 	default:
@@ -589,6 +597,12 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
+	case types.T_time:
+		col := make([]types.Time, length)
+		curCol := encoding.DecodeTimeSlice(v.Data)
+		copy(col, curCol[:length])
+		vec.Col = col
+		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 	case types.T_datetime:
 		col := make([]types.Datetime, length)
 		curCol := encoding.DecodeDatetimeSlice(v.Data)
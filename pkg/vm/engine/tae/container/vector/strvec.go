@@ -182,7 +182,7 @@ func (v *StrVector) Append(n int, vals any) error {
 func (v *StrVector) appendWithOffset(offset, n int, vals any) error {
 	var data [][]byte
 	switch v.Type.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data = vals.([][]byte)[offset : offset+n]
 	default:
 		return ErrVecTypeNotSupport
@@ -387,7 +387,7 @@ func (v *StrVector) CopyToVector() (*gvec.Vector, error) {
 	vec := gvec.New(v.Type)
 	vec.Data = v.Data.Data
 	switch v.Type.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		col := vec.Col.(*types.Bytes)
 		col.Data = make([]byte, len(v.Data.Data))
 		col.Lengths = make([]uint32, len(v.Data.Lengths))
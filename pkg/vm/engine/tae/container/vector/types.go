@@ -32,6 +32,8 @@ var (
 	ErrVecWriteRo        = errors.New("write on readonly vector")
 	ErrVecInvalidOffset  = errors.New("invalid offset error")
 	ErrVecTypeNotSupport = errors.New("type not supported yet")
+
+	ErrVecCompressNotSupport = errors.New("compress algorithm not supported")
 )
 
 type IVectorWriter interface {
@@ -88,7 +90,7 @@ type StrVector struct {
 
 func NewVector(t types.Type, capacity uint64) IVector {
 	switch t.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return NewStrVector(t, capacity)
 	default:
 		return NewStdVector(t, capacity)
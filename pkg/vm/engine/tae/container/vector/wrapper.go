@@ -26,8 +26,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/pierrec/lz4"
 )
 
 type VectorWrapper struct {
@@ -136,7 +136,7 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return nil, ErrVecInvalidOffset
 	}
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		val := v.Col.(*types.Bytes)
 		return val.Data[val.Offsets[idx] : val.Offsets[idx]+val.Lengths[idx]], nil
 	case types.T_int8:
@@ -163,6 +163,8 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return v.Col.([]float64)[idx], nil
 	case types.T_date:
 		return v.Col.([]types.Date)[idx], nil
+	case types.T_time:
+		return v.Col.([]types.Time)[idx], nil
 	case types.T_datetime:
 		return v.Col.([]types.Datetime)[idx], nil
 	case types.T_sel:
@@ -216,10 +218,10 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
-		nb := lz4.CompressBlockBound(len(buf))
-		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+	case compress.Lz4, compress.Zstd, compress.Snappy:
+		algo := stat.CompressAlgo()
+		tmp := make([]byte, compress.CompressBound(len(buf), algo))
+		tmp, err = compress.Compress(buf, tmp, algo)
 		if err != nil {
 			return 0, err
 		}
@@ -229,7 +231,7 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 		}
 		return int64(nw), nil
 	default:
-		panic("invalid compress algorithm")
+		return 0, ErrVecCompressNotSupport
 	}
 }
 
@@ -259,12 +261,18 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
+		if codec.IsEncoded(data) {
+			if data, err = codec.Decode(data); err != nil {
+				common.GPool.Free(vec.MNode)
+				return n, err
+			}
+		}
 		t := encoding.DecodeType(data[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
 		err = vec.Vector.Read(data)
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -274,12 +282,18 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], stat.CompressAlgo())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
 		data := vec.MNode.Buf[:originSize]
+		if codec.IsEncoded(data) {
+			if data, err = codec.Decode(data); err != nil {
+				common.GPool.Free(vec.MNode)
+				return n, err
+			}
+		}
 		t := encoding.DecodeType(data[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
@@ -289,7 +303,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 		}
 		return int64(nr), err
 	default:
-		panic("not supported")
+		return n, ErrVecCompressNotSupport
 	}
 }
 
@@ -308,12 +322,17 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
+		if codec.IsEncoded(buf) {
+			if buf, err = codec.Decode(buf); err != nil {
+				return n, err
+			}
+		}
 		err = vec.Vector.Read(buf)
 		if err != nil {
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		var tmpBuf []byte
@@ -341,20 +360,25 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, stat.CompressAlgo())
 		if err != nil {
 			return n, err
 		}
 		if len(buf) != int(originSize) {
 			panic(fmt.Sprintf("invalid decompressed size: %d, %d is expected", len(buf), originSize))
 		}
+		if codec.IsEncoded(buf) {
+			if buf, err = codec.Decode(buf); err != nil {
+				return n, err
+			}
+		}
 		t := encoding.DecodeType(buf[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
 		err = vec.Vector.Read(buf)
 		return int64(nr), err
 	default:
-		panic("not supported")
+		return n, ErrVecCompressNotSupport
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -161,3 +162,44 @@ func TestWrapper(t *testing.T) {
 	assert.Equal(t, nw, nr)
 	assert.Nil(t, f_.Close())
 }
+
+func TestWrapperCompress(t *testing.T) {
+	colTypes := mock.MockColTypes(14)
+	for _, colType := range []int{2, 12} {
+		v, err := MockVector(colTypes[colType], 10000).CopyToVector()
+		assert.Nil(t, err)
+		data, err := v.Show()
+		assert.Nil(t, err)
+		osz := int64(len(data))
+		for _, algo := range []int{compress.Lz4, compress.Zstd, compress.Snappy} {
+			w := NewVectorWrapper(v)
+			w.File = common.MockCompressedFileWithAlgo(int64(compress.CompressBound(int(osz), algo)), osz, algo)
+			var buf bytes.Buffer
+			nw, err := w.WriteTo(&buf)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(buf.Len()), nw)
+
+			file := common.MockCompressedFileWithAlgo(nw, osz, algo)
+			rw := VectorWrapperConstructor(file, false, func(node base.IMemoryNode) {})
+			nr, err := rw.ReadFrom(bytes.NewReader(buf.Bytes()))
+			assert.Nil(t, err)
+			assert.Equal(t, nw, nr)
+			assert.Equal(t, vector.Length(v), rw.(*VectorWrapper).Length())
+
+			rw = VectorWrapperConstructor(file, false, func(node base.IMemoryNode) {})
+			nr, err = rw.(*VectorWrapper).ReadWithBuffer(bytes.NewReader(buf.Bytes()), new(bytes.Buffer), new(bytes.Buffer))
+			assert.Nil(t, err)
+			assert.Equal(t, nw, nr)
+			assert.Equal(t, vector.Length(v), rw.(*VectorWrapper).Length())
+		}
+
+		w := NewVectorWrapper(v)
+		w.File = common.MockCompressedFileWithAlgo(osz, osz, 100)
+		_, err = w.WriteTo(new(bytes.Buffer))
+		assert.Equal(t, ErrVecCompressNotSupport, err)
+		_, err = w.ReadFrom(bytes.NewReader(data))
+		assert.Equal(t, ErrVecCompressNotSupport, err)
+		_, err = w.ReadWithBuffer(bytes.NewReader(data), new(bytes.Buffer), new(bytes.Buffer))
+		assert.Equal(t, ErrVecCompressNotSupport, err)
+	}
+}
@@ -211,6 +211,11 @@ func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (er
 	return
 }
 
+// LoadLob always returns nil, values are kept inline in memory
+func (bf *blockFile) LoadLob(colIdx int) ([]byte, error) {
+	return nil, nil
+}
+
 func (bf *blockFile) WriteBatch(bat *gbat.Batch, ts uint64) (err error) {
 	if err = bf.WriteTS(ts); err != nil {
 		return
@@ -49,6 +49,8 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	return
 }
 
+func (cb *columnBlock) SetCompressAlgo(algo int) {}
+
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
 	_, err = cb.data.Write(buf)
 	return
@@ -25,8 +25,10 @@ import (
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -207,10 +209,9 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 		if _, err = f.Read(buf); err != nil {
 			return
 		}
-		vec := vector.NewVector(colTypes[i], uint64(maxRow))
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
+		if algo := colBlk.data.stat.CompressAlgo(); algo != compress.None {
 			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
+			decompress, err = compress.Decompress(buf, decompress, algo)
 			if err != nil {
 				return nil, err
 			}
@@ -218,15 +219,11 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
 					len(decompress), colBlk.data.stat.OriginSize())))
 			}
-			if err = vec.Unmarshal(decompress); err != nil {
-				return
-			}
-		} else {
-			if err = vec.Unmarshal(buf); err != nil {
-				return
-			}
+			buf = decompress
+		}
+		if vecs[i], err = bf.loadIVector(i, colTypes[i], maxRow, buf); err != nil {
+			return
 		}
-		vecs[i] = vec
 		attrs[i] = i
 	}
 	bat, err = batch.NewBatch(attrs, vecs)
@@ -247,9 +244,9 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		vec := gvec.New(colTypes[i])
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
+		if algo := colBlk.data.stat.CompressAlgo(); algo != compress.None {
 			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
+			decompress, err = compress.Decompress(buf, decompress, algo)
 			if err != nil {
 				return nil, err
 			}
@@ -257,11 +254,22 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
 					len(decompress), colBlk.data.stat.OriginSize())))
 			}
-			if err = vec.Read(decompress); err != nil {
+			buf = decompress
+		}
+		if codec.IsEncoded(buf) {
+			if buf, err = codec.Decode(buf); err != nil {
 				return
 			}
-		} else {
-			if err = vec.Read(buf); err != nil {
+		}
+		if err = vec.Read(buf); err != nil {
+			return
+		}
+		if colTypes[i].Oid.IsLob() {
+			var lob []byte
+			if lob, err = bf.LoadLob(i); err != nil {
+				return
+			}
+			if err = compute.MergeLob(vec, lob); err != nil {
 				return
 			}
 		}
@@ -270,21 +278,125 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 	return
 }
 
+// loadIVector returns the vector of the column block data buf
+func (bf *blockFile) loadIVector(colIdx int, colType types.Type, maxRow uint32, buf []byte) (vector.IVector, error) {
+	vec := vector.NewVector(colType, uint64(maxRow))
+	if len(buf) == 0 {
+		return vec, nil
+	}
+	if isMarshaled(buf) {
+		if err := vec.Unmarshal(buf); err != nil {
+			return nil, err
+		}
+		if colType.Oid.IsLob() {
+			return bf.mergeLob(colIdx, vec)
+		}
+		return vec, nil
+	}
+	gv, err := codec.DecodeVector(buf)
+	if err != nil {
+		return nil, err
+	}
+	if colType.Oid.IsLob() {
+		lob, err := bf.LoadLob(colIdx)
+		if err != nil {
+			return nil, err
+		}
+		if lob != nil {
+			if err = compute.MergeLob(gv, lob); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if gvec.Length(gv) > 0 {
+		if _, err = vec.AppendVector(gv, 0); err != nil {
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// isMarshaled checks the column block data is a vector marshaled by the
+// IVector, as the blocks written by WriteIBatch before the encodings are.
+// The marshaled vector starts with its size, the show format and the
+// encoded blocks start with the type.
+func isMarshaled(buf []byte) bool {
+	return len(buf) >= 8 && encoding.DecodeUint64(buf[:8]) == uint64(len(buf))
+}
+
 func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (err error) {
 	cb, err := bf.OpenColumn(colIdx)
 	if err != nil {
 		return err
 	}
 	defer cb.Close()
-	err = cb.WriteTS(ts)
-	buf, err := vec.Show()
+	if err = cb.WriteTS(ts); err != nil {
+		return err
+	}
+	return writeColumnData(cb, vec)
+}
+
+// writeColumnData writes the values of the column block, with the encoding
+// chosen from the values and the large values split out to the lob file.
+// All the writers of the column blocks share it
+func writeColumnData(cb file.ColumnBlock, vec *gvec.Vector) error {
+	vec, lob, err := compute.SplitLob(vec)
+	if err != nil {
+		return err
+	}
+	buf, _, err := codec.Encode(vec)
 	if err != nil {
 		return err
 	}
-	err = cb.WriteData(buf)
+	if err = cb.WriteData(buf); err != nil {
+		return err
+	}
+	if lob != nil {
+		return cb.(*columnBlock).WriteLob(lob)
+	}
+	return nil
+}
+
+// LoadLob returns the out of line values of the column, nil if there are none
+func (bf *blockFile) LoadLob(colIdx int) (lob []byte, err error) {
+	f := bf.columns[colIdx].OpenLobFile()
+	if f == nil {
+		return
+	}
+	defer f.Unref()
+	stat := f.Stat()
+	buf := make([]byte, stat.Size())
+	if _, err = f.Read(buf); err != nil {
+		return
+	}
+	if algo := stat.CompressAlgo(); algo != compress.None {
+		lob = make([]byte, stat.OriginSize())
+		lob, err = compress.Decompress(buf, lob, algo)
+		return
+	}
+	lob = buf
 	return
 }
 
+func (bf *blockFile) mergeLob(colIdx int, vec vector.IVector) (vector.IVector, error) {
+	lob, err := bf.LoadLob(colIdx)
+	if err != nil || lob == nil {
+		return vec, err
+	}
+	gv, err := vec.CopyToVector()
+	if err != nil {
+		return nil, err
+	}
+	if err = compute.MergeLob(gv, lob); err != nil {
+		return nil, err
+	}
+	merged := vector.NewVector(gv.Typ, uint64(vec.Capacity()))
+	if _, err = merged.AppendVector(gv, 0); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
 func (bf *blockFile) WriteBatch(bat *gbat.Batch, ts uint64) (err error) {
 	if err = bf.WriteTS(ts); err != nil {
 		return
@@ -349,11 +461,12 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 			}
 		}
 		w.Reset()
-		buf, err := vec.Marshal()
+		// the vectors of an unloaded block are not readonly, copy their view
+		gv, err := vec.GetLatestView().CopyToVector()
 		if err != nil {
 			return err
 		}
-		if err = cb.WriteData(buf); err != nil {
+		if err = writeColumnData(cb, gv); err != nil {
 			return err
 		}
 	}
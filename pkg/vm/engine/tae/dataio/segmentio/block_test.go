@@ -16,13 +16,22 @@ package segmentio
 
 import (
 	"bytes"
+	"fmt"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 
 	"github.com/RoaringBitmap/roaring"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/codec"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
@@ -84,3 +93,257 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockLob(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+
+	colTypes := []types.Type{
+		{Oid: types.T_int32, Size: 4, Width: 32},
+		{Oid: types.T_blob, Size: 24},
+	}
+	large := bytes.Repeat([]byte{0xff}, compute.LobInlineThreshold*2)
+	vals := [][]byte{[]byte("small"), large, nil}
+	bat := gbat.New(true, []string{"a", "b"})
+	bat.Vecs[0] = gvec.New(colTypes[0])
+	assert.Nil(t, gvec.Append(bat.Vecs[0], []int32{1, 2, 3}))
+	bat.Vecs[1] = gvec.New(colTypes[1])
+	assert.Nil(t, gvec.Append(bat.Vecs[1], vals))
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	colBlk, err := block.OpenColumn(1)
+	assert.Nil(t, err)
+	dataFile, err := colBlk.OpenDataFile()
+	assert.Nil(t, err)
+	assert.Less(t, dataFile.Stat().OriginSize(), int64(len(large)))
+	dataFile.Unref()
+	colBlk.Close()
+
+	lob, err := block.LoadLob(0)
+	assert.Nil(t, err)
+	assert.Nil(t, lob)
+
+	loaded, err := block.LoadBatch(bat.Attrs, colTypes)
+	assert.Nil(t, err)
+	col := loaded.Vecs[1].Col.(*types.Bytes)
+	for i, v := range vals {
+		assert.Equal(t, len(v), len(col.Get(int64(i))))
+	}
+	assert.Equal(t, large, col.Get(1))
+
+	block.Unref()
+}
+
+func TestBlockNoLob(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	block := newBlock(common.NextGlobalSeqNum(), seg, 1, nil)
+
+	colTypes := []types.Type{{Oid: types.T_text, Size: 24}}
+	bat := gbat.New(true, []string{"a"})
+	bat.Vecs[0] = gvec.New(colTypes[0])
+	assert.Nil(t, gvec.Append(bat.Vecs[0], [][]byte{[]byte("a"), []byte("bb"), []byte("ccc")}))
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	// short strings are all inline, so no lob file is created
+	for fname := range seg.(*segmentFile).GetSegmentFile().GetNodes() {
+		assert.False(t, strings.HasSuffix(fname, ".lob"), fname)
+	}
+	lob, err := block.LoadLob(0)
+	assert.Nil(t, err)
+	assert.Nil(t, lob)
+
+	loaded, err := block.LoadBatch(bat.Attrs, colTypes)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bb"), loaded.Vecs[0].Col.(*types.Bytes).Get(1))
+
+	block.Unref()
+}
+
+func TestBlockEncoding(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+
+	colTypes := []types.Type{
+		{Oid: types.T_int64, Size: 8, Width: 64},
+		{Oid: types.T_varchar, Size: 24, Width: 100},
+	}
+	rows := 1000
+	keys := make([]int64, rows)
+	vals := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		keys[i] = int64(i * 10)
+		vals[i] = []byte(fmt.Sprintf("status-%d", i%4))
+	}
+	bat := gbat.New(true, []string{"a", "b"})
+	bat.Vecs[0] = gvec.New(colTypes[0])
+	assert.Nil(t, gvec.Append(bat.Vecs[0], keys))
+	bat.Vecs[1] = gvec.New(colTypes[1])
+	assert.Nil(t, gvec.Append(bat.Vecs[1], vals))
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	for i, kind := range []codec.Kind{codec.Delta, codec.Dict} {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		dataFile, err := colBlk.OpenDataFile()
+		assert.Nil(t, err)
+		buf := make([]byte, dataFile.Stat().Size())
+		_, err = dataFile.Read(buf)
+		assert.Nil(t, err)
+		data := make([]byte, dataFile.Stat().OriginSize())
+		data, err = compress.Decompress(buf, data, compress.Lz4)
+		assert.Nil(t, err)
+		col, err := codec.Open(data)
+		assert.Nil(t, err)
+		assert.Equal(t, kind, col.Kind())
+
+		wrapper := vector.NewEmptyWrapper(colTypes[i])
+		wrapper.File = dataFile
+		_, err = wrapper.ReadFrom(dataFile)
+		assert.Nil(t, err)
+		assert.Equal(t, bat.Vecs[i].String(), wrapper.Vector.String())
+		common.GPool.Free(wrapper.MNode)
+		dataFile.Unref()
+		colBlk.Close()
+	}
+
+	loaded, err := block.LoadBatch(bat.Attrs, colTypes)
+	assert.Nil(t, err)
+	assert.Equal(t, keys, loaded.Vecs[0].Col)
+	for i, v := range vals {
+		assert.Equal(t, v, loaded.Vecs[1].Col.(*types.Bytes).Get(int64(i)))
+	}
+
+	block.Unref()
+}
+
+func TestBlockIBatchEncoding(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+
+	colTypes := []types.Type{
+		{Oid: types.T_int64, Size: 8, Width: 64},
+		{Oid: types.T_varchar, Size: 24, Width: 100},
+	}
+	rows := 1000
+	keys := make([]int64, rows)
+	vals := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		keys[i] = int64(i * 10)
+		vals[i] = []byte(fmt.Sprintf("status-%d", i%4))
+	}
+	gvs := []*gvec.Vector{gvec.New(colTypes[0]), gvec.New(colTypes[1])}
+	assert.Nil(t, gvec.Append(gvs[0], keys))
+	assert.Nil(t, gvec.Append(gvs[1], vals))
+	// the vectors of an appendable block are not full, so not readonly
+	vecs := make([]vector.IVector, len(gvs))
+	for i, gv := range gvs {
+		vecs[i] = vector.NewVector(colTypes[i], uint64(rows*2))
+		_, err := vecs[i].AppendVector(gv, 0)
+		assert.Nil(t, err)
+	}
+	bat, err := batch.NewBatch([]int{0, 1}, vecs)
+	assert.Nil(t, err)
+	assert.Nil(t, block.WriteIBatch(bat, common.NextGlobalSeqNum(), nil, nil, nil))
+
+	for i, kind := range []codec.Kind{codec.Delta, codec.Dict} {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		dataFile, err := colBlk.OpenDataFile()
+		assert.Nil(t, err)
+		buf := make([]byte, dataFile.Stat().Size())
+		_, err = dataFile.Read(buf)
+		assert.Nil(t, err)
+		data := make([]byte, dataFile.Stat().OriginSize())
+		data, err = compress.Decompress(buf, data, compress.Lz4)
+		assert.Nil(t, err)
+		col, err := codec.Open(data)
+		assert.Nil(t, err)
+		assert.Equal(t, kind, col.Kind())
+		dataFile.Unref()
+		colBlk.Close()
+	}
+
+	loaded, err := block.LoadIBatch(colTypes, uint32(rows*2))
+	assert.Nil(t, err)
+	for i, gv := range gvs {
+		vec, err := loaded.GetVectorByAttr(i)
+		assert.Nil(t, err)
+		assert.Equal(t, rows, vec.Length())
+		copied, err := vec.GetLatestView().CopyToVector()
+		assert.Nil(t, err)
+		assert.Equal(t, gv.String(), copied.String())
+	}
+	block.Unref()
+
+	// the blocks written before the encodings carry the marshaled vectors
+	seg = SegmentFileIOFactory(path.Join(dir, "legacy"), common.NextGlobalSeqNum())
+	block = newBlock(common.NextGlobalSeqNum(), seg, 1, nil)
+	colBlk, err := block.OpenColumn(0)
+	assert.Nil(t, err)
+	buf, err := vecs[0].Marshal()
+	assert.Nil(t, err)
+	assert.Nil(t, colBlk.WriteData(buf))
+	colBlk.Close()
+
+	loaded, err = block.LoadIBatch(colTypes[:1], uint32(rows*2))
+	assert.Nil(t, err)
+	vec, err := loaded.GetVectorByAttr(0)
+	assert.Nil(t, err)
+	copied, err := vec.GetLatestView().CopyToVector()
+	assert.Nil(t, err)
+	assert.Equal(t, keys, copied.Col)
+	block.Unref()
+}
+
+func TestBlockCompressAlgo(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	algos := []int{compress.None, compress.Lz4, compress.Zstd, compress.Snappy}
+	block := newBlock(common.NextGlobalSeqNum(), seg, len(algos), nil)
+
+	typ := types.Type{Oid: types.T_float64, Size: 8, Width: 64}
+	colTypes := make([]types.Type, len(algos))
+	bat := gbat.New(true, []string{"a", "b", "c", "d"})
+	for i, algo := range algos {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		colBlk.SetCompressAlgo(algo)
+		colBlk.Close()
+		colTypes[i] = typ
+		bat.Vecs[i] = compute.MockVec(typ, 1000, i)
+	}
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	for i, algo := range algos {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		stat := colBlk.GetDataFileStat()
+		assert.Equal(t, algo, stat.CompressAlgo())
+		if algo == compress.None {
+			assert.Equal(t, stat.OriginSize(), stat.Size())
+		}
+		colBlk.Close()
+	}
+
+	loaded, err := block.LoadBatch(bat.Attrs, colTypes)
+	assert.Nil(t, err)
+	for i := range algos {
+		assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+	}
+
+	block.Unref()
+}
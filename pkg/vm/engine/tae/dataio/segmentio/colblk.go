@@ -16,6 +16,7 @@ package segmentio
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -29,7 +30,11 @@ type columnBlock struct {
 	indexes []*indexFile
 	updates *updatesFile
 	data    *dataFile
+	lob     *dataFile
+	lobTs   uint64
 	col     int
+	// the compress algorithm of the data written later
+	algo uint8
 }
 
 func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
@@ -37,6 +42,7 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -59,6 +65,7 @@ func openColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -77,8 +84,9 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	if cb.data.file != nil {
 		cb.data.mutex.Lock()
 		defer cb.data.mutex.Unlock()
-		cb.data.file = append(cb.data.file,
-			cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.blk", cb.col, cb.block.id, ts)))
+		dataFile := cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.blk", cb.col, cb.block.id, ts))
+		dataFile.SetAlgo(cb.algo)
+		cb.data.file = append(cb.data.file, dataFile)
 	}
 	if cb.updates.file != nil {
 		cb.updates.mutex.Lock()
@@ -89,11 +97,34 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	return
 }
 
+func (cb *columnBlock) SetCompressAlgo(algo int) {
+	cb.data.mutex.Lock()
+	defer cb.data.mutex.Unlock()
+	cb.algo = uint8(algo)
+}
+
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
 	_, err = cb.data.Write(buf)
 	return
 }
 
+// WriteLob writes the out of line values of a TEXT/BLOB column. The lob
+// file is only created for the columns having lob data
+func (cb *columnBlock) WriteLob(buf []byte) (err error) {
+	cb.data.mutex.Lock()
+	if cb.lob == nil {
+		cb.lob = newData(cb)
+	}
+	lobFile := cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.lob", cb.col, cb.block.id, cb.ts))
+	lobFile.SetAlgo(cb.algo)
+	cb.lob.file = append(cb.lob.file, lobFile)
+	cb.lobTs = cb.ts
+	lob := cb.lob
+	cb.data.mutex.Unlock()
+	_, err = lob.Write(buf)
+	return
+}
+
 func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
 	_, err = cb.updates.Write(buf)
 	return
@@ -151,6 +182,18 @@ func (cb *columnBlock) OpenUpdateFile() (vfile common.IRWFile, err error) {
 	return
 }
 
+// OpenLobFile returns nil if the column has no lob data
+func (cb *columnBlock) OpenLobFile() (vfile common.IRWFile) {
+	cb.data.mutex.RLock()
+	defer cb.data.mutex.RUnlock()
+	if cb.lob == nil {
+		return nil
+	}
+	cb.lob.Ref()
+	vfile = cb.lob
+	return
+}
+
 func (cb *columnBlock) OpenDataFile() (vfile common.IRWFile, err error) {
 	cb.data.Ref()
 	vfile = cb.data
@@ -176,6 +219,12 @@ func (cb *columnBlock) Destroy() {
 	cb.data.mutex.Lock()
 	files := cb.data.file
 	cb.data.file = nil
+	if cb.lob != nil {
+		cb.lob.mutex.Lock()
+		files = append(files, cb.lob.file...)
+		cb.lob.file = nil
+		cb.lob.mutex.Unlock()
+	}
 	cb.data.mutex.Unlock()
 	if files != nil {
 		for _, file := range files {
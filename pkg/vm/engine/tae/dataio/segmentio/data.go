@@ -114,7 +114,7 @@ func (df *dataFile) Read(buf []byte) (n int, err error) {
 	file := df.file[len(df.file)-1]
 	df.mutex.RUnlock()
 	n, err = file.Read(buf)
-	return n, nil
+	return
 }
 
 func (df *dataFile) upgradeFile() {
@@ -136,6 +136,17 @@ func (sf *segmentFile) Replay(colCnt int, indexCnt map[int]int, cache *bytes.Buf
 				bf.columns[col].ts = ts
 				bf.columns[col].updates.file[0] = file
 			}
+		case "lob":
+			cb := bf.columns[col]
+			if cb.lob == nil {
+				cb.lob = newData(cb)
+				cb.lob.file = make([]*segment.BlockFile, 1)
+			}
+			if cb.lob.file[0] == nil || cb.lobTs < ts {
+				sf.replayInfo(cb.lob.stat, file)
+				cb.lobTs = ts
+				cb.lob.file[0] = file
+			}
 		case "del":
 			sf.replayInfo(bf.deletes.stat, file)
 			if ts == 0 {
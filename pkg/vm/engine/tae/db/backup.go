@@ -0,0 +1,345 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+const (
+	BackupManifestName = "BACKUP_MANIFEST"
+)
+
+var (
+	ErrBackupExisted       = errors.New("tae backup: backup already existed")
+	ErrBackupNotFound      = errors.New("tae backup: backup not found")
+	ErrRestoreDirNotEmpty  = errors.New("tae restore: target dir is not empty")
+	ErrRestoreTSOutOfRange = errors.New("tae restore: timestamp out of range")
+)
+
+// BackupManifest describes a backup. A restored db keeps the manifest of
+// its backup in the db dir
+type BackupManifest struct {
+	// TS is the commit timestamp of the barrier txn of the backup. All the
+	// txns committed before it are in the backup
+	TS uint64 `json:"ts"`
+	// CheckpointTS is the max checkpointed timestamp at the backup. It is
+	// the earliest timestamp the backup can be restored to
+	CheckpointTS uint64   `json:"checkpoint-ts"`
+	Files        []string `json:"files"`
+	// Dropped are the commit timestamps dropped by the restores. The txns
+	// in them are skipped by the replay
+	Dropped []*common.ClosedInterval `json:"dropped,omitempty"`
+}
+
+func (m *BackupManifest) IsDropped(ts uint64) bool {
+	for _, interval := range m.Dropped {
+		if ts >= interval.Start && ts <= interval.End {
+			return true
+		}
+	}
+	return false
+}
+
+func LoadBackupManifest(dir string) (m *BackupManifest, err error) {
+	buf, err := os.ReadFile(filepath.Join(dir, BackupManifestName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return
+	}
+	m = new(BackupManifest)
+	err = json.Unmarshal(buf, m)
+	return
+}
+
+func (m *BackupManifest) Save(dir string) (err error) {
+	buf, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	name := filepath.Join(dir, BackupManifestName)
+	f, err := os.Create(name + ".tmp")
+	if err != nil {
+		return
+	}
+	if _, err = f.Write(buf); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+// backupGate holds off the jobs writing the files of the db during a backup.
+// The backup first drains the checkpoints, the compactions and the gc, the
+// io they wait on still goes through. Once they are drained, the io is held
+// off as well until the files are copied
+type backupGate struct {
+	sync.Mutex
+	cond     *sync.Cond
+	pins     int
+	draining bool
+	copying  bool
+}
+
+func (g *backupGate) wait() {
+	if g.cond == nil {
+		g.cond = sync.NewCond(&g.Mutex)
+	}
+	g.cond.Wait()
+}
+
+func (g *backupGate) broadcast() {
+	if g.cond != nil {
+		g.cond.Broadcast()
+	}
+}
+
+// pin holds off the backup until unpin. The io is only held off once the
+// other jobs are drained, they may wait on it
+func (g *backupGate) pin(io bool) {
+	g.Lock()
+	defer g.Unlock()
+	for g.copying || (g.draining && !io) {
+		g.wait()
+	}
+	g.pins++
+}
+
+func (g *backupGate) unpin() {
+	g.Lock()
+	defer g.Unlock()
+	g.pins--
+	if g.pins == 0 {
+		g.broadcast()
+	}
+}
+
+// pause waits for the pinned jobs to finish and holds off the new ones
+func (g *backupGate) pause() {
+	g.Lock()
+	defer g.Unlock()
+	for g.draining {
+		g.wait()
+	}
+	g.draining = true
+	for g.pins > 0 {
+		g.wait()
+	}
+	g.copying = true
+}
+
+func (g *backupGate) resume() {
+	g.Lock()
+	defer g.Unlock()
+	g.draining = false
+	g.copying = false
+	g.broadcast()
+}
+
+// Backup copies an online snapshot of the db into dir. Txns go on while the
+// files are copied, only the checkpoints, the compactions, the gc and the io
+// writing the files are held off until the copy is done
+func (db *DB) Backup(dir string) (m *BackupManifest, err error) {
+	if err = os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return
+	}
+	if _, err = os.Stat(filepath.Join(dir, BackupManifestName)); err == nil {
+		return nil, ErrBackupExisted
+	}
+
+	// The jobs are held off before the barrier, so that nothing committed
+	// after it is checkpointed or compacted into the copied files
+	db.backupGate.pause()
+	defer db.backupGate.resume()
+
+	// All the txns committed before the barrier are synced to the wal once
+	// the barrier is committed
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	txn.GetStore().IncreateWriteCnt()
+	if err = txn.Commit(); err != nil {
+		return
+	}
+
+	m = &BackupManifest{
+		TS:           txn.GetCommitTS(),
+		CheckpointTS: db.maxCheckpointTS(),
+	}
+	if db.Manifest != nil {
+		m.Dropped = append(m.Dropped, db.Manifest.Dropped...)
+	}
+	// The copied wal may hold the txns committed after the barrier. The
+	// backup opened by Open replays up to the barrier
+	m.Dropped = append(m.Dropped, &common.ClosedInterval{Start: m.TS + 1, End: math.MaxUint64})
+	if err = db.Catalog.GetStore().Backup(dir); err != nil {
+		return
+	}
+	if err = db.Wal.Backup(dir); err != nil {
+		return
+	}
+	entries, err := os.ReadDir(db.Dir)
+	if err != nil {
+		return
+	}
+	lockName := filepath.Base(common.MakeLockFileName(db.Dir, LockName))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == BackupManifestName || name == lockName {
+			continue
+		}
+		if !strings.HasSuffix(name, ".rot") {
+			if err = copyFile(filepath.Join(db.Dir, name), filepath.Join(dir, name)); err != nil {
+				return
+			}
+		}
+	}
+	if entries, err = os.ReadDir(dir); err != nil {
+		return
+	}
+	for _, entry := range entries {
+		m.Files = append(m.Files, entry.Name())
+	}
+	err = m.Save(dir)
+	return
+}
+
+func (db *DB) maxCheckpointTS() uint64 {
+	ts := db.Catalog.GetCheckpointed().MaxTS
+	processor := new(catalog.LoopProcessor)
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		if data := entry.GetBlockData(); data != nil {
+			if blkTs := data.GetMaxCheckpointTS(); blkTs > ts {
+				ts = blkTs
+			}
+		}
+		return nil
+	}
+	if err := db.Catalog.RecurLoop(processor); err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// Restore copies the backup in backupDir into dir and opens it. The txns
+// committed after ts are dropped. Zero ts restores to the timestamp of the
+// backup
+func Restore(backupDir, dir string, ts uint64, opts *options.Options) (db *DB, err error) {
+	m, err := LoadBackupManifest(backupDir)
+	if err != nil {
+		return
+	}
+	if m == nil {
+		return nil, ErrBackupNotFound
+	}
+	if ts == 0 {
+		ts = m.TS
+	}
+	if ts > m.TS || ts < m.CheckpointTS {
+		return nil, fmt.Errorf("%w: %d not in [%d, %d]", ErrRestoreTSOutOfRange, ts, m.CheckpointTS, m.TS)
+	}
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return nil, ErrRestoreDirNotEmpty
+	}
+	if err = os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return
+	}
+	for _, name := range m.Files {
+		if err = copyFile(filepath.Join(backupDir, name), filepath.Join(dir, name)); err != nil {
+			return
+		}
+	}
+	// The end is fixed on the first replay
+	m.Dropped[len(m.Dropped)-1].Start = ts + 1
+	if err = m.Save(dir); err != nil {
+		return
+	}
+	return Open(dir, opts)
+}
+
+// onReplayed fixes the open dropped range of a restore to the max dropped
+// timestamp, so that the txns committed after the restore are replayed
+func (db *DB) onReplayed(maxDropped uint64) {
+	if db.Manifest == nil || len(db.Manifest.Dropped) == 0 {
+		return
+	}
+	last := db.Manifest.Dropped[len(db.Manifest.Dropped)-1]
+	if last.End != math.MaxUint64 {
+		return
+	}
+	if maxDropped < last.Start {
+		db.Manifest.Dropped = db.Manifest.Dropped[:len(db.Manifest.Dropped)-1]
+	} else {
+		last.End = maxDropped
+	}
+	if err := db.Manifest.Save(db.Dir); err != nil {
+		panic(err)
+	}
+}
+
+// pinnedFn holds off the backup while fn of a task writing the files runs
+func (db *DB) pinnedFn(taskType tasks.TaskType, fn func() error) func() error {
+	switch taskType {
+	case tasks.CheckpointTask, tasks.GCTask, tasks.DataCompactionTask, tasks.IOTask:
+	default:
+		return fn
+	}
+	return func() error {
+		db.backupGate.pin(taskType == tasks.IOTask)
+		defer db.backupGate.unpin()
+		return fn()
+	}
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if _, err = io.Copy(out, in); err != nil {
+		return
+	}
+	return out.Sync()
+}
@@ -0,0 +1,228 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 300, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+
+	backupDir := tae.Dir + "-backup"
+	restoreDir := tae.Dir + "-restore"
+	restoreDir2 := tae.Dir + "-restore2"
+	for _, dir := range []string{backupDir, restoreDir, restoreDir2} {
+		assert.Nil(t, os.RemoveAll(dir))
+		defer os.RemoveAll(dir)
+	}
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	appendBat := func(tae *DB, i int) uint64 {
+		txn, _ := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bats[i]))
+		assert.Nil(t, txn.Commit())
+		return txn.GetCommitTS()
+	}
+	checkRows := func(tae *DB, expected int) {
+		txn, _ := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		rows := 0
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			rows += it.GetBlock().Rows()
+			it.Next()
+		}
+		assert.Equal(t, expected, rows)
+		assert.Nil(t, txn.Commit())
+	}
+
+	ts := appendBat(tae, 0)
+	m, err := tae.Backup(backupDir)
+	assert.Nil(t, err)
+	assert.True(t, m.TS > ts)
+	assert.True(t, m.CheckpointTS <= ts)
+	_, err = tae.Backup(backupDir)
+	assert.Equal(t, ErrBackupExisted, err)
+	appendBat(tae, 1)
+
+	_, err = Restore(backupDir, restoreDir, m.TS+1, nil)
+	assert.True(t, errors.Is(err, ErrRestoreTSOutOfRange))
+
+	tae2, err := Restore(backupDir, restoreDir, 0, nil)
+	assert.Nil(t, err)
+	checkRows(tae2, 100)
+	assert.Nil(t, tae2.Close())
+
+	// Restore to the point before the append
+	tae3, err := Restore(backupDir, restoreDir2, ts-1, nil)
+	assert.Nil(t, err)
+	checkRows(tae3, 0)
+	// The txns after the restore survive the reopen
+	newTs := appendBat(tae3, 2)
+	assert.True(t, newTs > m.TS)
+	assert.Nil(t, tae3.Close())
+
+	tae3, err = Open(restoreDir2, nil)
+	assert.Nil(t, err)
+	defer tae3.Close()
+	checkRows(tae3, 100)
+	assert.Equal(t, 1, len(tae3.Manifest.Dropped))
+	assert.True(t, tae3.Manifest.IsDropped(ts))
+	assert.False(t, tae3.Manifest.IsDropped(newTs))
+}
+
+func TestBackupWithCheckpoint(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 1000, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 100)
+
+	backupDir := tae.Dir + "-backup"
+	restoreDir := tae.Dir + "-restore"
+	for _, dir := range []string{backupDir, restoreDir} {
+		assert.Nil(t, os.RemoveAll(dir))
+		defer os.RemoveAll(dir)
+	}
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	// Appends and checkpoints go on during the backup
+	var wg sync.WaitGroup
+	var stopped int32
+	var commits []uint64
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < len(bats) && atomic.LoadInt32(&stopped) == 0; i++ {
+			txn, _ := tae.StartTxn(nil)
+			db, _ := txn.GetDatabase("db")
+			rel, _ := db.GetRelationByName(schema.Name)
+			assert.Nil(t, rel.Append(bats[i]))
+			assert.Nil(t, txn.Commit())
+			commits = append(commits, txn.GetCommitTS())
+			task, err := tae.Scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.CheckpointTask, nil, tae.Catalog.CheckpointClosure(tae.Scheduler.GetSafeTS()))
+			assert.Nil(t, err)
+			assert.Nil(t, task.WaitDone())
+		}
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	m, err := tae.Backup(backupDir)
+	assert.Nil(t, err)
+	atomic.StoreInt32(&stopped, 1)
+	wg.Wait()
+	assert.True(t, m.CheckpointTS <= m.TS)
+
+	expected := 0
+	for i, ts := range commits {
+		if ts < m.TS {
+			expected += gvec.Length(bats[i].Vecs[0])
+		}
+	}
+	tae2, err := Restore(backupDir, restoreDir, 0, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+	txn, _ = tae2.StartTxn(nil)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	rel, err := db.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	rows := 0
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		rows += it.GetBlock().Rows()
+		it.Next()
+	}
+	assert.Equal(t, expected, rows)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestBackupGate(t *testing.T) {
+	var gate backupGate
+	var paused, pinned int32
+	// A running compaction
+	gate.pin(false)
+	go func() {
+		gate.pause()
+		atomic.StoreInt32(&paused, 1)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&paused))
+
+	// The io the compaction waits on goes through while draining
+	gate.pin(true)
+	gate.unpin()
+	// A new compaction is held off
+	go func() {
+		gate.pin(false)
+		atomic.StoreInt32(&pinned, 1)
+		gate.unpin()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&pinned))
+
+	gate.unpin()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&paused) == 1 }, time.Second, time.Millisecond)
+	// The io is held off during the copy
+	go func() {
+		gate.pin(true)
+		atomic.StoreInt32(&pinned, 2)
+		gate.unpin()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&pinned))
+
+	gate.resume()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&pinned) != 0 }, time.Second, time.Millisecond)
+}
@@ -0,0 +1,422 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+var (
+	// ErrChangeRowGone is returned if the block of an updated or a deleted
+	// row is gc'ed before the change is polled
+	ErrChangeRowGone = errors.New("tae: cdc row is gone")
+	// ErrChangeLSNTruncated is returned if the wal is compacted past the lsn
+	// a subscription reads, e.g. it is subscribed from a truncated lsn
+	ErrChangeLSNTruncated = errors.New("tae: cdc lsn truncated")
+)
+
+type ChangeOp string
+
+const (
+	ChangeInsert ChangeOp = "c"
+	ChangeUpdate ChangeOp = "u"
+	ChangeDelete ChangeOp = "d"
+)
+
+// RowID is the physical address of a row. A compaction moves the rows of a
+// block to the new block without events, so the rows are matched by the
+// primary key of the events
+type RowID struct {
+	SegmentID uint64
+	BlockID   uint64
+	Offset    uint32
+}
+
+func (id RowID) String() string {
+	return fmt.Sprintf("%d-%d-%d", id.SegmentID, id.BlockID, id.Offset)
+}
+
+// ChangeEvent is a row-level change of a committed txn
+type ChangeEvent struct {
+	LSN       uint64
+	CommitTS  uint64
+	DBName    string
+	TableName string
+	TableID   uint64
+	Op        ChangeOp
+	Row       RowID
+	// Key is the primary key of the row
+	Key map[string]any
+	// Values are all the columns of an inserted row or the updated columns
+	// of an updated row. They are nil for a deleted row
+	Values map[string]any
+	// Before is the image of a deleted row. The wal has no before image, it
+	// is read from the block of the row as of the commit before the change
+	Before map[string]any
+}
+
+// ChangeSubscription reads the row-level changes of the committed txns from
+// the wal in the commit order. The wal is not compacted while an open
+// subscription has not read the checkpointed lsns, and the dropped blocks are
+// not gc'ed until it has read the drop
+type ChangeSubscription struct {
+	db     *DB
+	next   uint64
+	filter func(dbName, tableName string) bool
+}
+
+// changeSubscriptions are the open subscriptions of a db, the next lsn of
+// the subscriptions is guarded by it
+type changeSubscriptions struct {
+	sync.Mutex
+	subs map[*ChangeSubscription]struct{}
+}
+
+// minLSN returns the lowest lsn the open subscriptions resume from
+func (s *changeSubscriptions) minLSN() (lsn uint64, ok bool) {
+	s.Lock()
+	defer s.Unlock()
+	for sub := range s.subs {
+		if !ok || sub.next < lsn {
+			lsn, ok = sub.next, true
+		}
+	}
+	return
+}
+
+// retains returns true if an open subscription has not read the lsn yet
+func (s *changeSubscriptions) retains(lsn uint64) bool {
+	next, ok := s.minLSN()
+	return ok && next <= lsn
+}
+
+// retainedWal holds back the compaction of the wal while a subscription
+// still reads the checkpointed entries
+type retainedWal struct {
+	wal.Driver
+	subs *changeSubscriptions
+}
+
+func (w *retainedWal) Compact() error {
+	if w.subs.retains(w.GetCheckpointed()) {
+		logutil.Infof("CDC: wal compaction held back by the subscriptions before lsn %d", w.GetCheckpointed())
+		return nil
+	}
+	return w.Driver.Compact()
+}
+
+// SubscribeChanges subscribes to the changes of the txns logged from the
+// lsn from on. The changes of the tables rejected by filter are skipped.
+// The subscription must be closed to release the wal it holds back
+func (db *DB) SubscribeChanges(from uint64, filter func(dbName, tableName string) bool) *ChangeSubscription {
+	if from == 0 {
+		from = 1
+	}
+	sub := &ChangeSubscription{
+		db:     db,
+		next:   from,
+		filter: filter,
+	}
+	db.subscriptions.Lock()
+	defer db.subscriptions.Unlock()
+	if db.subscriptions.subs == nil {
+		db.subscriptions.subs = make(map[*ChangeSubscription]struct{})
+	}
+	db.subscriptions.subs[sub] = struct{}{}
+	return sub
+}
+
+// NextLSN is the lsn the subscription resumes from
+func (sub *ChangeSubscription) NextLSN() uint64 {
+	sub.db.subscriptions.Lock()
+	defer sub.db.subscriptions.Unlock()
+	return sub.next
+}
+
+func (sub *ChangeSubscription) setNext(next uint64) {
+	sub.db.subscriptions.Lock()
+	defer sub.db.subscriptions.Unlock()
+	sub.next = next
+}
+
+// Close releases the wal held back by the subscription
+func (sub *ChangeSubscription) Close() {
+	sub.db.subscriptions.Lock()
+	defer sub.db.subscriptions.Unlock()
+	delete(sub.db.subscriptions.subs, sub)
+}
+
+// Poll returns the changes of the txns synced to the wal since the last poll
+func (sub *ChangeSubscription) Poll() (events []*ChangeEvent, err error) {
+	events, next, err := sub.poll()
+	sub.setNext(next)
+	return
+}
+
+// poll returns the changes from the next lsn on, and the lsn after the
+// changes. The next lsn is left to the caller to advance
+func (sub *ChangeSubscription) poll() (events []*ChangeEvent, next uint64, err error) {
+	synced := sub.db.Wal.GetSynced()
+	for next = sub.NextLSN(); next <= synced; next++ {
+		var logEntry wal.LogEntry
+		if logEntry, err = sub.db.Wal.LoadEntry(wal.GroupC, next); err != nil {
+			if next <= sub.db.Wal.GetCheckpointed() {
+				err = fmt.Errorf("%w: %d: %v", ErrChangeLSNTruncated, next, err)
+			}
+			return
+		}
+		r := bytes.NewBuffer(logEntry.GetPayload())
+		txnCmd, _, err := txnbase.BuildCommandFrom(r)
+		if err != nil {
+			return events, next, err
+		}
+		cmd, ok := txnCmd.(*txnbase.ComposedCmd)
+		if !ok {
+			continue
+		}
+		if events, err = sub.decodeTxn(events, next, cmd); err != nil {
+			return events, next, err
+		}
+	}
+	return
+}
+
+func (sub *ChangeSubscription) decodeTxn(events []*ChangeEvent, lsn uint64, cmd *txnbase.ComposedCmd) ([]*ChangeEvent, error) {
+	ts, _ := cmd.GetCommitTS()
+	if sub.db.Manifest != nil && sub.db.Manifest.IsDropped(ts) {
+		return events, nil
+	}
+	// The updates of the columns of a row are in separate cmds
+	updated := make(map[RowID]*ChangeEvent)
+	for _, txnCmd := range cmd.Cmds {
+		switch subCmd := txnCmd.(type) {
+		case *txnimpl.AppendCmd:
+			data, deletes, err := sub.db.loadAppendData(subCmd)
+			if err != nil {
+				return events, err
+			}
+			for _, info := range subCmd.Infos {
+				tb := sub.getTable(info.GetDBID(), info.GetDest().TableID)
+				if tb == nil {
+					continue
+				}
+				start := info.GetSrcOff()
+				end := start + info.GetSrcLen() - 1
+				bat, err := sub.db.window(tableAttrs(tb), data, deletes, start, end)
+				if err != nil {
+					return events, err
+				}
+				id := info.GetDest()
+				for i := uint32(0); i < info.GetDestLen(); i++ {
+					event := newChangeEvent(lsn, ts, tb, ChangeInsert, id.SegmentID, id.BlockID, info.GetDestOff()+i)
+					event.Key = make(map[string]any)
+					event.Values = make(map[string]any)
+					schema := tb.GetSchema()
+					for j, def := range schema.ColDefs {
+						var v any
+						vec := bat.Vecs[j]
+						if !nulls.Contains(vec.Nsp, uint64(i)) {
+							v = changeValue(def.Type, compute.GetValue(vec, i))
+						}
+						if schema.IsPartOfPK(j) {
+							event.Key[def.Name] = v
+						}
+						if def.Hidden == 0 {
+							event.Values[def.Name] = v
+						}
+					}
+					events = append(events, event)
+				}
+			}
+		case *updates.UpdateCmd:
+			switch subCmd.GetType() {
+			case txnbase.CmdUpdate:
+				node := subCmd.GetUpdateNode()
+				id := node.GetID()
+				tb := sub.getTable(subCmd.GetDBID(), id.TableID)
+				if tb == nil {
+					continue
+				}
+				img, err := loadBlockImage(tb, ts, id.SegmentID, id.BlockID, false)
+				if err != nil {
+					return events, err
+				}
+				def := tb.GetSchema().ColDefs[id.Idx]
+				vals := node.GetValues()
+				it := node.GetMask().Iterator()
+				for it.HasNext() {
+					row := it.Next()
+					rowID := RowID{SegmentID: id.SegmentID, BlockID: id.BlockID, Offset: row}
+					event := updated[rowID]
+					if event == nil || event.TableID != id.TableID {
+						event = newChangeEvent(lsn, ts, tb, ChangeUpdate, id.SegmentID, id.BlockID, row)
+						event.Key, _ = img.row(row)
+						event.Values = make(map[string]any)
+						updated[rowID] = event
+						events = append(events, event)
+					}
+					event.Values[def.Name] = changeValue(def.Type, vals[row])
+				}
+			case txnbase.CmdDelete:
+				node := subCmd.GetDeleteNode()
+				id := node.GetID()
+				tb := sub.getTable(subCmd.GetDBID(), id.TableID)
+				if tb == nil {
+					continue
+				}
+				var err error
+				if events, err = appendDeleteEvents(events, lsn, ts, tb, id.SegmentID, id.BlockID, node.GetDeleteMaskLocked()); err != nil {
+					return events, err
+				}
+			}
+		}
+	}
+	return events, nil
+}
+
+// getTable returns nil if the table is filtered out or no longer in the
+// catalog
+func (sub *ChangeSubscription) getTable(dbID, tableID uint64) *catalog.TableEntry {
+	database, err := sub.db.Catalog.GetDatabaseByID(dbID)
+	if err != nil {
+		logutil.Warnf("CDC: database %d not found: %v", dbID, err)
+		return nil
+	}
+	tb, err := database.GetTableEntryByID(tableID)
+	if err != nil {
+		logutil.Warnf("CDC: table %d not found: %v", tableID, err)
+		return nil
+	}
+	if sub.filter != nil && !sub.filter(database.GetName(), tb.GetSchema().Name) {
+		return nil
+	}
+	return tb
+}
+
+func newChangeEvent(lsn, ts uint64, tb *catalog.TableEntry, op ChangeOp, segmentID, blockID uint64, row uint32) *ChangeEvent {
+	return &ChangeEvent{
+		LSN:       lsn,
+		CommitTS:  ts,
+		DBName:    tb.GetDB().GetName(),
+		TableName: tb.GetSchema().Name,
+		TableID:   tb.GetID(),
+		Op:        op,
+		Row:       RowID{SegmentID: segmentID, BlockID: blockID, Offset: row},
+	}
+}
+
+func appendDeleteEvents(events []*ChangeEvent, lsn, ts uint64, tb *catalog.TableEntry, segmentID, blockID uint64, mask *roaring.Bitmap) ([]*ChangeEvent, error) {
+	img, err := loadBlockImage(tb, ts, segmentID, blockID, true)
+	if err != nil {
+		return events, err
+	}
+	it := mask.Iterator()
+	for it.HasNext() {
+		event := newChangeEvent(lsn, ts, tb, ChangeDelete, segmentID, blockID, it.Next())
+		event.Key, event.Before = img.row(event.Row.Offset)
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// blockImage is the columns of a block as of the commit before a change,
+// the deletes of the change are not applied to it
+type blockImage struct {
+	schema *catalog.Schema
+	vecs   map[int]*movec.Vector
+}
+
+// loadBlockImage loads the primary key of a block, and all the visible
+// columns if all is true
+func loadBlockImage(tb *catalog.TableEntry, ts, segmentID, blockID uint64, all bool) (img *blockImage, err error) {
+	seg, err := tb.GetSegmentByID(segmentID)
+	var blk *catalog.BlockEntry
+	if err == nil {
+		blk, err = seg.GetBlockEntryByID(blockID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %d-%d of table %d", ErrChangeRowGone, segmentID, blockID, tb.GetID())
+	}
+	txn := txnbase.NewTxn(nil, nil, 0, ts-1, nil)
+	img = &blockImage{
+		schema: tb.GetSchema(),
+		vecs:   make(map[int]*movec.Vector),
+	}
+	for i, def := range img.schema.ColDefs {
+		if !img.schema.IsPartOfPK(i) && (!all || def.Hidden != 0) {
+			continue
+		}
+		view, err := blk.GetBlockData().GetColumnDataById(txn, i, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if view == nil {
+			return nil, fmt.Errorf("%w: block %d-%d of table %d", ErrChangeRowGone, segmentID, blockID, tb.GetID())
+		}
+		img.vecs[i] = view.AppliedVec
+	}
+	return
+}
+
+// row returns the primary key of a row, and the visible columns loaded
+func (img *blockImage) row(row uint32) (key, values map[string]any) {
+	key = make(map[string]any)
+	values = make(map[string]any)
+	for i, vec := range img.vecs {
+		def := img.schema.ColDefs[i]
+		var v any
+		if !nulls.Contains(vec.Nsp, uint64(row)) {
+			v = changeValue(def.Type, compute.GetValue(vec, row))
+		}
+		if img.schema.IsPartOfPK(i) {
+			key[def.Name] = v
+		}
+		if def.Hidden == 0 {
+			values[def.Name] = v
+		}
+	}
+	return
+}
+
+func tableAttrs(tb *catalog.TableEntry) []string {
+	attrs := make([]string, len(tb.GetSchema().ColDefs))
+	for i := range attrs {
+		attrs[i] = tb.GetSchema().ColDefs[i].Name
+	}
+	return attrs
+}
+
+// changeValue makes the strings readable in the json of the events
+func changeValue(typ types.Type, v any) any {
+	if buf, ok := v.([]byte); ok && (typ.Oid == types.T_char || typ.Oid == types.T_varchar || typ.Oid == types.T_json) {
+		return string(buf)
+	}
+	return v
+}
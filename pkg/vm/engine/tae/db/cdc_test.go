@@ -0,0 +1,231 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSubscription(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+	appendTS := txn.GetCommitTS()
+
+	sub := tae.SubscribeChanges(0, nil)
+	events, err := sub.Poll()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(events))
+	for i, event := range events {
+		assert.Equal(t, ChangeInsert, event.Op)
+		assert.Equal(t, appendTS, event.CommitTS)
+		assert.Equal(t, "db", event.DBName)
+		assert.Equal(t, schema.Name, event.TableName)
+		assert.Equal(t, uint32(i), event.Row.Offset)
+		assert.Equal(t, compute.GetValue(bat.Vecs[1], uint32(i)), event.Values[schema.ColDefs[1].Name])
+		assert.Equal(t, compute.GetValue(bat.Vecs[1], uint32(i)), event.Key[schema.ColDefs[1].Name])
+	}
+	resumeLSN := sub.NextLSN()
+
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[1], 3))
+	id, row, err := rel.GetByFilter(filter)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(id, row, uint16(0), int32(33)))
+	assert.Nil(t, rel.RangeDelete(id, row+1, row+1))
+	assert.Nil(t, txn.Commit())
+
+	events, err = sub.Poll()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, ChangeUpdate, events[0].Op)
+	assert.Equal(t, row, events[0].Row.Offset)
+	assert.Equal(t, int32(33), events[0].Values[schema.ColDefs[0].Name])
+	assert.Equal(t, compute.GetValue(bat.Vecs[1], 3), events[0].Key[schema.ColDefs[1].Name])
+	assert.Equal(t, ChangeDelete, events[1].Op)
+	assert.Equal(t, row+1, events[1].Row.Offset)
+	assert.Equal(t, compute.GetValue(bat.Vecs[1], 4), events[1].Key[schema.ColDefs[1].Name])
+	assert.Equal(t, compute.GetValue(bat.Vecs[0], 4), events[1].Before[schema.ColDefs[0].Name])
+	assert.Equal(t, compute.GetValue(bat.Vecs[1], 4), events[1].Before[schema.ColDefs[1].Name])
+
+	// Resume from the lsn of the update and write the changes to files
+	dir := filepath.Join(tae.Dir, "cdc")
+	sink, err := NewJSONFileSink(dir)
+	assert.Nil(t, err)
+	sub.Close()
+	sub = tae.SubscribeChanges(resumeLSN, func(dbName, tableName string) bool {
+		return tableName == schema.Name
+	})
+	defer sub.Close()
+	n, err := sub.PumpTo(sink)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	assert.Nil(t, sink.Close())
+
+	f, err := os.Open(filepath.Join(dir, "db."+schema.Name+".json"))
+	assert.Nil(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	ops := make([]string, 0)
+	for scanner.Scan() {
+		payload := make(map[string]any)
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &payload))
+		ops = append(ops, payload["op"].(string))
+		before := payload["before"].(map[string]any)
+		assert.NotNil(t, before[schema.ColDefs[1].Name])
+	}
+	assert.Equal(t, []string{"u", "d"}, ops)
+}
+
+type mockCompactWal struct {
+	wal.Driver
+	synced       uint64
+	checkpointed uint64
+	compacted    int
+}
+
+func (w *mockCompactWal) GetSynced() uint64       { return w.synced }
+func (w *mockCompactWal) GetCheckpointed() uint64 { return w.checkpointed }
+func (w *mockCompactWal) Compact() error          { w.compacted++; return nil }
+func (w *mockCompactWal) LoadEntry(uint32, uint64) (wal.LogEntry, error) {
+	return nil, errors.New("lsn not existed")
+}
+
+func TestChangeSubscriptionRetainsWal(t *testing.T) {
+	driver := &mockCompactWal{synced: 10, checkpointed: 5}
+	tae := &DB{}
+	tae.Wal = &retainedWal{Driver: driver, subs: &tae.subscriptions}
+
+	sub := tae.SubscribeChanges(3, nil)
+	assert.Nil(t, tae.Wal.Compact())
+	assert.Equal(t, 0, driver.compacted)
+
+	// the subscription has read the checkpointed lsns
+	sub.setNext(6)
+	assert.Nil(t, tae.Wal.Compact())
+	assert.Equal(t, 1, driver.compacted)
+
+	sub2 := tae.SubscribeChanges(5, nil)
+	assert.Nil(t, tae.Wal.Compact())
+	assert.Equal(t, 1, driver.compacted)
+	sub2.Close()
+	assert.Nil(t, tae.Wal.Compact())
+	assert.Equal(t, 2, driver.compacted)
+	sub.Close()
+
+	// the lsns compacted before the subscription are reported
+	sub = tae.SubscribeChanges(2, nil)
+	defer sub.Close()
+	_, err := sub.Poll()
+	assert.ErrorIs(t, err, ErrChangeLSNTruncated)
+	assert.Equal(t, uint64(2), sub.NextLSN())
+}
+
+func TestChangeSubscriptionAfterMerge(t *testing.T) {
+	opts := new(options.Options)
+	opts.CheckpointCfg = new(options.CheckpointCfg)
+	opts.CheckpointCfg.ScannerInterval = 3
+	opts.CheckpointCfg.ExecutionLevels = 2
+	opts.CheckpointCfg.ExecutionInterval = 1
+	opts.CheckpointCfg.CatalogCkpInterval = 2
+	opts.CheckpointCfg.CatalogUnCkpLimit = 1
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 15, int(schema.PrimaryKey), nil)
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	sub := tae.SubscribeChanges(0, nil)
+	defer sub.Close()
+	events, err := sub.Poll()
+	assert.Nil(t, err)
+	assert.Equal(t, 15, len(events))
+
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[1], 3)))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(id, row, uint16(0), int32(33)))
+	assert.Nil(t, rel.RangeDelete(id, row+1, row+1))
+	assert.Nil(t, txn.Commit())
+
+	// The blocks of the changes are merged and dropped before the poll
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	blks := make([]*catalog.BlockEntry, 0)
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+		it.Next()
+	}
+	factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), tae.Scheduler)
+	task, err := factory(nil, txn)
+	assert.Nil(t, err)
+	assert.Nil(t, task.OnExec())
+	assert.Nil(t, txn.Commit())
+	testutils.WaitExpect(2000, func() bool {
+		return tae.Wal.GetPenddingCnt() == 0
+	})
+	time.Sleep(50 * time.Millisecond)
+
+	events, err = sub.Poll()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, ChangeUpdate, events[0].Op)
+	assert.Equal(t, int32(33), events[0].Values[schema.ColDefs[0].Name])
+	assert.Equal(t, compute.GetValue(bat.Vecs[1], 3), events[0].Key[schema.ColDefs[1].Name])
+	assert.Equal(t, ChangeDelete, events[1].Op)
+	assert.Equal(t, compute.GetValue(bat.Vecs[1], 4), events[1].Key[schema.ColDefs[1].Name])
+	assert.Equal(t, compute.GetValue(bat.Vecs[0], 4), events[1].Before[schema.ColDefs[0].Name])
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	ChangeRowIDColumn = "__row_id"
+)
+
+// ChangeSink consumes the change events polled from a subscription
+type ChangeSink interface {
+	Write(events []*ChangeEvent) error
+	Close() error
+}
+
+type debeziumSource struct {
+	DB       string `json:"db"`
+	Table    string `json:"table"`
+	LSN      uint64 `json:"lsn"`
+	CommitTS uint64 `json:"commit_ts"`
+}
+
+type debeziumPayload struct {
+	Before map[string]any  `json:"before"`
+	After  map[string]any  `json:"after"`
+	Source *debeziumSource `json:"source"`
+	Op     ChangeOp        `json:"op"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+// JSONFileSink appends the events of each table as debezium-style json
+// lines to the file <db>.<table>.json in the dir
+type JSONFileSink struct {
+	dir   string
+	files map[string]*os.File
+}
+
+func NewJSONFileSink(dir string) (*JSONFileSink, error) {
+	if err := os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	return &JSONFileSink{
+		dir:   dir,
+		files: make(map[string]*os.File),
+	}, nil
+}
+
+func (sink *JSONFileSink) Write(events []*ChangeEvent) (err error) {
+	written := make(map[string]*os.File)
+	now := time.Now().UnixMilli()
+	for _, event := range events {
+		var f *os.File
+		if f, err = sink.getFile(event); err != nil {
+			return
+		}
+		written[f.Name()] = f
+		payload := &debeziumPayload{
+			Source: &debeziumSource{
+				DB:       event.DBName,
+				Table:    event.TableName,
+				LSN:      event.LSN,
+				CommitTS: event.CommitTS,
+			},
+			Op:   event.Op,
+			TsMs: now,
+		}
+		rowID := event.Row.String()
+		if event.Op != ChangeInsert {
+			payload.Before = make(map[string]any, len(event.Before)+len(event.Key)+1)
+			for name, v := range event.Before {
+				payload.Before[name] = v
+			}
+			for name, v := range event.Key {
+				payload.Before[name] = v
+			}
+			payload.Before[ChangeRowIDColumn] = rowID
+		}
+		if event.Op != ChangeDelete {
+			payload.After = make(map[string]any, len(event.Values)+len(event.Key)+1)
+			for name, v := range event.Values {
+				payload.After[name] = v
+			}
+			for name, v := range event.Key {
+				payload.After[name] = v
+			}
+			payload.After[ChangeRowIDColumn] = rowID
+		}
+		var buf []byte
+		if buf, err = json.Marshal(payload); err != nil {
+			return
+		}
+		if _, err = f.Write(append(buf, '\n')); err != nil {
+			return
+		}
+	}
+	for _, f := range written {
+		if err = f.Sync(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (sink *JSONFileSink) getFile(event *ChangeEvent) (f *os.File, err error) {
+	name := event.DBName + "." + event.TableName + ".json"
+	if f = sink.files[name]; f != nil {
+		return
+	}
+	if f, err = os.OpenFile(filepath.Join(sink.dir, name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, os.FileMode(0644)); err != nil {
+		return
+	}
+	sink.files[name] = f
+	return
+}
+
+func (sink *JSONFileSink) Close() (err error) {
+	for name, f := range sink.files {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		delete(sink.files, name)
+	}
+	return
+}
+
+// PumpTo polls the changes and writes them to the sink. The subscription
+// does not advance if the sink fails
+func (sub *ChangeSubscription) PumpTo(sink ChangeSink) (n int, err error) {
+	events, next, err := sub.poll()
+	if err == nil {
+		err = sink.Write(events)
+	}
+	if err != nil {
+		return
+	}
+	sub.setNext(next)
+	return len(events), nil
+}
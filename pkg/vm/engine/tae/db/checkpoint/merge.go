@@ -0,0 +1,144 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+const (
+	DefaultMergeInterval    = time.Minute
+	DefaultMergeDeleteRatio = 0.2
+	DefaultMaxWriteAmp      = 1.0
+)
+
+type MergeCfg struct {
+	// Interval is how long the time based policy waits on a mergeable segment
+	Interval time.Duration
+	// DeleteRatio is the ratio of deleted rows from which the size tiered
+	// policy remerges a sealed segment
+	DeleteRatio float64
+	// MaxWriteAmp is the max live rows copied per deleted row reclaimed
+	// the write amplification aware policy accepts
+	MaxWriteAmp float64
+}
+
+// SegmentStats summarizes the mergeable blocks of a segment
+type SegmentStats struct {
+	// Appendable segments only merge once full, the other ones are sealed
+	// and only remerge to reclaim deletes
+	Appendable bool
+	Blocks     int
+	MaxBlocks  int
+	Rows       int
+	Deletes    int
+	// Pending is how long the segment has been a merge candidate
+	Pending time.Duration
+}
+
+func (stats *SegmentStats) Full() bool {
+	return stats.Appendable && stats.Blocks >= stats.MaxBlocks
+}
+
+func (stats *SegmentStats) DeleteRatio() float64 {
+	if stats.Rows == 0 {
+		return 0
+	}
+	return float64(stats.Deletes) / float64(stats.Rows)
+}
+
+// IsCandidate returns true if some policy may merge the segment: it is full
+// or it is sealed with deletes to reclaim. A segment without live rows is
+// never a candidate
+func (stats *SegmentStats) IsCandidate() bool {
+	if stats.Blocks == 0 || stats.Rows <= stats.Deletes {
+		return false
+	}
+	if stats.Appendable {
+		return stats.Full()
+	}
+	return stats.Deletes > 0
+}
+
+type MergePolicy interface {
+	ShouldMerge(stats *SegmentStats) bool
+}
+
+// NewMergePolicy returns the merge policy named by a table schema, an unknown
+// name falls back to the size tiered one
+func NewMergePolicy(name string, cfg *MergeCfg) MergePolicy {
+	if cfg == nil {
+		cfg = new(MergeCfg)
+	}
+	switch name {
+	case catalog.CompactionTimeBased:
+		interval := cfg.Interval
+		if interval <= 0 {
+			interval = DefaultMergeInterval
+		}
+		return &timeMergePolicy{interval: interval}
+	case catalog.CompactionWriteAmp:
+		maxAmp := cfg.MaxWriteAmp
+		if maxAmp <= 0 {
+			maxAmp = DefaultMaxWriteAmp
+		}
+		return &writeAmpMergePolicy{maxAmp: maxAmp}
+	}
+	ratio := cfg.DeleteRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = DefaultMergeDeleteRatio
+	}
+	return &sizeTieredMergePolicy{deleteRatio: ratio}
+}
+
+type sizeTieredMergePolicy struct {
+	deleteRatio float64
+}
+
+func (policy *sizeTieredMergePolicy) ShouldMerge(stats *SegmentStats) bool {
+	if !stats.IsCandidate() {
+		return false
+	}
+	if stats.Appendable {
+		return true
+	}
+	return stats.DeleteRatio() >= policy.deleteRatio
+}
+
+type timeMergePolicy struct {
+	interval time.Duration
+}
+
+func (policy *timeMergePolicy) ShouldMerge(stats *SegmentStats) bool {
+	return stats.IsCandidate() && stats.Pending >= policy.interval
+}
+
+type writeAmpMergePolicy struct {
+	maxAmp float64
+}
+
+func (policy *writeAmpMergePolicy) ShouldMerge(stats *SegmentStats) bool {
+	if !stats.IsCandidate() {
+		return false
+	}
+	// A full appendable segment has to be merged once to be sorted
+	if stats.Appendable {
+		return true
+	}
+	live := stats.Rows - stats.Deletes
+	return float64(live)/float64(stats.Deletes) <= policy.maxAmp
+}
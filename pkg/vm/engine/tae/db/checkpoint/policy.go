@@ -22,9 +22,18 @@ func init() {
 	DefaultLeveledPolicy = newSimpleLeveledPolicy(nil)
 }
 
+const (
+	DefaultMinScore = 30
+	DefaultMaxScore = 100
+)
+
 type PolicyCfg struct {
 	Interval int64 // ms
 	Levels   int
+	// Units scored below MinScore stay in level 0, scores from MinScore to
+	// MaxScore are spread over the other levels
+	MinScore int
+	MaxScore int
 }
 
 type LeveledPolicy interface {
@@ -35,6 +44,7 @@ type LeveledPolicy interface {
 
 type simpleLeveledPolicy struct {
 	levels   int
+	minScore int
 	step     float64
 	interval int64
 }
@@ -48,9 +58,14 @@ func newSimpleLeveledPolicy(cfg *PolicyCfg) *simpleLeveledPolicy {
 	if cfg.Levels <= 1 {
 		panic("too small levels")
 	}
-	step := float64(70) / (float64(cfg.Levels) - 1)
+	minScore, maxScore := cfg.MinScore, cfg.MaxScore
+	if minScore <= 0 || maxScore <= minScore {
+		minScore, maxScore = DefaultMinScore, DefaultMaxScore
+	}
+	step := float64(maxScore-minScore) / (float64(cfg.Levels) - 1)
 	return &simpleLeveledPolicy{
 		levels:   cfg.Levels,
+		minScore: minScore,
 		step:     step,
 		interval: cfg.Interval,
 	}
@@ -62,10 +77,10 @@ func (policy *simpleLeveledPolicy) ScanInterval(level int) time.Duration {
 
 func (policy *simpleLeveledPolicy) TotalLevels() int { return policy.levels }
 func (policy *simpleLeveledPolicy) DecideLevel(score int) int {
-	if score < 30 {
+	if score < policy.minScore {
 		return 0
 	}
-	level := int((float64(score)-30)/policy.step) + 1
+	level := int((float64(score)-float64(policy.minScore))/policy.step) + 1
 	if level > policy.levels-1 {
 		level = policy.levels - 1
 	}
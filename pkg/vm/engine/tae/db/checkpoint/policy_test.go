@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeveledPolicy(t *testing.T) {
+	policy := newSimpleLeveledPolicy(&PolicyCfg{Levels: 8, Interval: 10})
+	assert.Equal(t, 0, policy.DecideLevel(DefaultMinScore-1))
+	assert.Equal(t, 1, policy.DecideLevel(DefaultMinScore))
+	assert.Equal(t, 7, policy.DecideLevel(DefaultMaxScore))
+
+	policy = newSimpleLeveledPolicy(&PolicyCfg{Levels: 8, Interval: 10, MinScore: 10, MaxScore: 80})
+	assert.Equal(t, 0, policy.DecideLevel(9))
+	assert.Equal(t, 1, policy.DecideLevel(10))
+	assert.Equal(t, 4, policy.DecideLevel(40))
+	assert.Equal(t, 7, policy.DecideLevel(200))
+}
+
+func TestMergePolicy(t *testing.T) {
+	cfg := &MergeCfg{Interval: time.Second}
+	sizeTiered := NewMergePolicy("", cfg)
+	timeBased := NewMergePolicy(catalog.CompactionTimeBased, cfg)
+	writeAmp := NewMergePolicy(catalog.CompactionWriteAmp, cfg)
+
+	// An appendable segment merges once full
+	filling := &SegmentStats{Appendable: true, Blocks: 3, MaxBlocks: 4, Rows: 30}
+	full := &SegmentStats{Appendable: true, Blocks: 4, MaxBlocks: 4, Rows: 40}
+	for _, policy := range []MergePolicy{sizeTiered, timeBased, writeAmp} {
+		assert.False(t, policy.ShouldMerge(filling))
+	}
+	assert.True(t, sizeTiered.ShouldMerge(full))
+	assert.True(t, writeAmp.ShouldMerge(full))
+	assert.False(t, timeBased.ShouldMerge(full))
+	full.Pending = time.Second
+	assert.True(t, timeBased.ShouldMerge(full))
+
+	// A sealed segment remerges to reclaim its deletes
+	sealed := &SegmentStats{Blocks: 4, MaxBlocks: 4, Rows: 100, Deletes: 10}
+	assert.False(t, sizeTiered.ShouldMerge(sealed))
+	assert.False(t, writeAmp.ShouldMerge(sealed))
+	sealed.Pending = time.Second
+	assert.True(t, timeBased.ShouldMerge(sealed))
+	sealed.Deletes = 20
+	assert.True(t, sizeTiered.ShouldMerge(sealed))
+	assert.False(t, writeAmp.ShouldMerge(sealed))
+	sealed.Deletes = 50
+	assert.True(t, writeAmp.ShouldMerge(sealed))
+
+	// Nothing to merge without live rows
+	sealed.Deletes = 100
+	for _, policy := range []MergePolicy{sizeTiered, timeBased, writeAmp} {
+		assert.False(t, policy.ShouldMerge(sealed))
+	}
+}
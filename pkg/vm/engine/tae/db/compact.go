@@ -0,0 +1,117 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// Compact runs the compaction jobs of the blocks of a table with pending
+// changes and then merges the segments of the table, without waiting for the
+// scanner nor the merge policy of the table. The blocks and segments already
+// scheduled by the scanner are skipped
+func (db *DB) Compact(dbName, tableName string) (err error) {
+	table, err := db.getTableEntry(dbName, tableName)
+	if err != nil {
+		return
+	}
+
+	// 1. Compact the blocks first, a full appendable segment can only be
+	// merged once all its blocks are compacted
+	blks := make([]data.CheckpointUnit, 0)
+	processor := new(catalog.LoopProcessor)
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		entry.RLock()
+		active := catalog.ActiveWithNoTxnFilter(entry.BaseEntry)
+		entry.RUnlock()
+		if active && entry.GetBlockData().EstimateScore() > 0 {
+			blks = append(blks, entry.GetBlockData())
+		}
+		return nil
+	}
+	if err = table.RecurLoop(processor); err != nil {
+		return
+	}
+	if err = db.runCompactionUnits(blks); err != nil {
+		return
+	}
+
+	// 2. Merge all the merge candidates
+	segs := make([]data.CheckpointUnit, 0)
+	processor = new(catalog.LoopProcessor)
+	processor.SegmentFn = func(entry *catalog.SegmentEntry) error {
+		if CollectSegmentStats(entry).IsCandidate() {
+			segs = append(segs, entry.GetSegmentData())
+		}
+		return nil
+	}
+	if err = table.RecurLoop(processor); err != nil {
+		return
+	}
+	return db.runCompactionUnits(segs)
+}
+
+func (db *DB) getTableEntry(dbName, tableName string) (table *catalog.TableEntry, err error) {
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err2 := txn.Rollback(); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		return
+	}
+	table = rel.GetMeta().(*catalog.TableEntry)
+	return
+}
+
+func (db *DB) runCompactionUnits(units []data.CheckpointUnit) (err error) {
+	scheduled := make([]tasks.Task, 0, len(units))
+	for _, unit := range units {
+		factory, taskType, scopes, err := unit.BuildCompactionTaskFactory()
+		if err != nil {
+			return err
+		}
+		if factory == nil {
+			continue
+		}
+		task, err := db.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+		if err == tasks.ErrScheduleScopeConflict {
+			logutil.Infof("[Compact] | Skipped | Scopes=%s", common.IDArraryString(scopes))
+			continue
+		} else if err != nil {
+			return err
+		}
+		scheduled = append(scheduled, task)
+	}
+	for _, task := range scheduled {
+		if err2 := task.WaitDone(); err2 != nil && err == nil {
+			err = err2
+		}
+	}
+	return
+}
@@ -0,0 +1,216 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/stretchr/testify/assert"
+)
+
+// sealedSegmentStats returns the stats of the active sealed segments
+func sealedSegmentStats(t *testing.T, tae *DB, name string) (stats []*checkpoint.SegmentStats) {
+	table, err := tae.getTableEntry("db", name)
+	assert.Nil(t, err)
+	it := table.MakeSegmentIt(true)
+	for it.Valid() {
+		seg := it.Get().GetPayload().(*catalog.SegmentEntry)
+		if s := CollectSegmentStats(seg); !s.Appendable && s.Blocks > 0 {
+			stats = append(stats, s)
+		}
+		it.Next()
+	}
+	return
+}
+
+func TestCompactTable(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	schema.CompactionPolicy = catalog.CompactionTimeBased
+
+	bat := compute.MockBatch(schema.Types(), 25, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	_, err := tae.getTableEntry("db", "xxx")
+	assert.Error(t, err)
+
+	// The full appendable segment is compacted and merged right away
+	assert.Nil(t, tae.Compact("db", schema.Name))
+	stats := sealedSegmentStats(t, tae, schema.Name)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, 20, stats[0].Rows)
+	assert.Equal(t, 0, stats[0].Deletes)
+
+	// Nothing left to merge
+	assert.Nil(t, tae.Compact("db", schema.Name))
+	assert.Equal(t, 1, len(sealedSegmentStats(t, tae, schema.Name)))
+
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock()
+			if !blk.IsAppendableBlock() {
+				assert.Nil(t, blk.RangeDelete(0, 4))
+				break
+			}
+			it.Next()
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	stats = sealedSegmentStats(t, tae, schema.Name)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, 5, stats[0].Deletes)
+
+	// The sealed segment is remerged to reclaim its deletes
+	assert.Nil(t, tae.Compact("db", schema.Name))
+	stats = sealedSegmentStats(t, tae, schema.Name)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, 15, stats[0].Rows)
+	assert.Equal(t, 0, stats[0].Deletes)
+}
+
+func TestCompactionPolicyValidation(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.CompactionPolicy = "leveled"
+	txn, _ := tae.StartTxn(nil)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	_, err = database.CreateRelation(schema)
+	assert.True(t, errors.Is(err, catalog.ErrValidation))
+	assert.Nil(t, txn.Rollback())
+}
+
+func TestClusteredTable(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	schema.ClusterBy = []uint16{4, 3}
+	assert.False(t, schema.IsPKSorted())
+
+	bat := compute.MockBatch(schema.Types(), 25, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	assert.Nil(t, tae.Compact("db", schema.Name))
+	stats := sealedSegmentStats(t, tae, schema.Name)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, 20, stats[0].Rows)
+
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	// The rows of the merged segment are ordered on the cluster key
+	it := rel.MakeBlockIt()
+	var prev []any
+	for it.Valid() {
+		blk := it.GetBlock()
+		if !blk.IsAppendableBlock() {
+			view0, err := blk.GetColumnDataById(4, nil, nil)
+			assert.Nil(t, err)
+			view1, err := blk.GetColumnDataById(3, nil, nil)
+			assert.Nil(t, err)
+			for row := 0; row < blk.Rows(); row++ {
+				curr := []any{
+					compute.GetValue(view0.AppliedVec, uint32(row)),
+					compute.GetValue(view1.AppliedVec, uint32(row)),
+				}
+				if prev != nil {
+					ret := common.CompareGeneric(prev[0], curr[0], view0.AppliedVec.Typ)
+					if ret == 0 {
+						ret = common.CompareGeneric(prev[1], curr[1], view1.AppliedVec.Typ)
+					}
+					assert.True(t, ret < 0)
+				}
+				prev = curr
+			}
+			// The cluster key columns have zone maps
+			data := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+			max := compute.GetValue(view0.AppliedVec, uint32(blk.Rows()-1)).(uint8)
+			assert.True(t, data.MayContainsInColumn(4, max))
+			assert.False(t, data.MayContainsInColumn(4, max+1))
+		}
+		it.Next()
+	}
+	// Primary key lookups do not rely on the key being sorted
+	for row := 0; row < 25; row++ {
+		filter := &handle.Filter{
+			Op:  handle.FilterEq,
+			Val: compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(row)),
+		}
+		id, offset, err := rel.GetByFilter(filter)
+		assert.Nil(t, err)
+		val, err := rel.GetValue(id, offset, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, compute.GetValue(bat.Vecs[4], uint32(row)), val)
+	}
+	assert.Error(t, rel.Append(bat))
+	assert.Nil(t, txn.Rollback())
+	tae.Close()
+
+	// The cluster key survives a replay
+	tae2, err := Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+	table, err := tae2.getTableEntry("db", schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, schema.ClusterBy, table.GetSchema().ClusterBy)
+}
+
+func TestClusterByValidation(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	txn, _ := tae.StartTxn(nil)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	schema := catalog.MockSchema(2)
+	schema.ClusterBy = []uint16{2}
+	_, err = database.CreateRelation(schema)
+	assert.True(t, errors.Is(err, catalog.ErrValidation))
+	schema = catalog.MockSchema(2)
+	schema.ClusterBy = []uint16{1, 1}
+	_, err = database.CreateRelation(schema)
+	assert.True(t, errors.Is(err, catalog.ErrValidation))
+	assert.Nil(t, txn.Rollback())
+}
@@ -56,6 +56,14 @@ type DB struct {
 
 	DBLocker io.Closer
 
+	// Manifest is the manifest of the backup the db restored from
+	Manifest *BackupManifest
+	// backupGate holds off the background jobs and the io that rewrite or
+	// remove the files while a backup copies them
+	backupGate backupGate
+	// subscriptions are the open change subscriptions
+	subscriptions changeSubscriptions
+
 	Closed *atomic.Value
 }
 
@@ -92,9 +100,14 @@ func (db *DB) Replay(dataFactory *tables.DataFactory) {
 	replayer := newReplayer(dataFactory, db)
 	replayer.OnTimeStamp(maxTs)
 	replayer.Replay()
+	db.onReplayed(replayer.maxDropped)
 
 	// TODO: init txn id
-	err := db.TxnMgr.Init(0, replayer.GetMaxTS())
+	maxTs = replayer.GetMaxTS()
+	if replayer.maxDropped > maxTs {
+		maxTs = replayer.maxDropped
+	}
+	err := db.TxnMgr.Init(0, maxTs)
 	if err != nil {
 		panic(err)
 	}
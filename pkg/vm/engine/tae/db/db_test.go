@@ -26,6 +26,7 @@ import (
 
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
@@ -2268,3 +2269,26 @@ func TestChaos1(t *testing.T) {
 	t.Log(view.String())
 	assert.Equal(t, uint64(deleteCnt), view.DeleteMask.GetCardinality())
 }
+
+func TestEvictPolicyOption(t *testing.T) {
+	opts := new(options.Options)
+	opts.CacheCfg = &options.CacheCfg{EvictPolicy: "mru"}
+	_, err := Open(testutils.InitTestEnv(ModuleName, t), opts)
+	assert.ErrorIs(t, err, buffer.ErrUnknownEvictPolicy)
+
+	opts.CacheCfg = &options.CacheCfg{EvictPolicy: buffer.EvictPolicy2Q}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	assert.Equal(t, uint64(options.DefaultIndexCacheSize), tae.Opts.CacheCfg.IndexCapacity)
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)))
+	assert.Nil(t, txn.Commit())
+	assert.True(t, tae.MTBufMgr.Stats().Misses > 0)
+}
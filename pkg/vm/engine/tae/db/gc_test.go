@@ -84,15 +84,19 @@ func TestGCBlock1(t *testing.T) {
 	err = meta.GetSegment().RemoveEntry(meta)
 	assert.Nil(t, err)
 	blkData := meta.GetBlockData()
-	assert.Equal(t, 3, tae.MTBufMgr.Count())
+	assert.Equal(t, 1, tae.MTBufMgr.Count())
+	assert.Equal(t, 2, tae.IndexBufMgr.Count())
 	err = blkData.Destroy()
 	assert.Nil(t, err)
-	assert.Equal(t, 2, tae.MTBufMgr.Count())
+	assert.Equal(t, 0, tae.MTBufMgr.Count())
+	assert.Equal(t, 2, tae.IndexBufMgr.Count())
 
 	err = task.GetNewBlock().GetMeta().(*catalog.BlockEntry).GetBlockData().Destroy()
 	assert.Nil(t, err)
 	assert.Equal(t, 0, tae.MTBufMgr.Count())
+	assert.Equal(t, 0, tae.IndexBufMgr.Count())
 	t.Log(tae.MTBufMgr.String())
+	t.Log(tae.IndexBufMgr.String())
 }
 
 func TestAutoGC1(t *testing.T) {
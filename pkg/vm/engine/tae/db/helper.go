@@ -17,6 +17,7 @@ package db
 import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 )
 
 func MakeSegmentScopes(entries ...*catalog.SegmentEntry) (scopes []common.ID) {
@@ -32,3 +33,29 @@ func MakeBlockScopes(entries ...*catalog.BlockEntry) (scopes []common.ID) {
 	}
 	return
 }
+
+// CollectSegmentStats counts the committed non-appendable blocks of an active
+// segment with their rows and deletes
+func CollectSegmentStats(entry *catalog.SegmentEntry) *checkpoint.SegmentStats {
+	stats := &checkpoint.SegmentStats{
+		Appendable: entry.IsAppendable(),
+		MaxBlocks:  int(entry.GetTable().GetSchema().SegmentMaxBlocks),
+	}
+	entry.RLock()
+	active := catalog.ActiveWithNoTxnFilter(entry.BaseEntry)
+	entry.RUnlock()
+	if !active {
+		return stats
+	}
+	filter := catalog.NewComposedFilter()
+	filter.AddBlockFilter(catalog.NonAppendableBlkFilter)
+	filter.AddCommitFilter(catalog.ActiveWithNoTxnFilter)
+	blks := entry.CollectBlockEntries(filter.FilteCommit, filter.FilteBlock)
+	for _, blk := range blks {
+		data := blk.GetBlockData()
+		stats.Blocks++
+		stats.Rows += data.Rows(nil, true)
+		stats.Deletes += int(data.GetDeleteCnt())
+	}
+	return stats
+}
@@ -34,6 +34,10 @@ import (
 const (
 	WALDir     = "wal"
 	CATALOGDir = "catalog"
+
+	IndexPool  = "index"
+	InsertPool = "insert"
+	TxnPool    = "txn"
 )
 
 func Open(dirname string, opts *options.Options) (db *DB, err error) {
@@ -49,9 +53,16 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	opts = opts.FillDefaults(dirname)
 
-	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, nil)
-	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, nil)
-	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, nil)
+	// Each cache is a separate pool with its own evict holder
+	var evicters [3]buffer.IEvictHolder
+	for i := range evicters {
+		if evicters[i], err = buffer.NewEvictHolder(opts.CacheCfg.EvictPolicy); err != nil {
+			return
+		}
+	}
+	indexBufMgr := buffer.NewNamedNodeManager(IndexPool, opts.CacheCfg.IndexCapacity, evicters[0])
+	mutBufMgr := buffer.NewNamedNodeManager(InsertPool, opts.CacheCfg.InsertCapacity, evicters[1])
+	txnBufMgr := buffer.NewNamedNodeManager(TxnPool, opts.CacheCfg.TxnCapacity, evicters[2])
 
 	db = &DB{
 		Dir:         dirname,
@@ -62,9 +73,16 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		Closed:      new(atomic.Value),
 	}
 
-	db.Wal = wal.NewDriver(dirname, WALDir, nil)
+	if db.Manifest, err = LoadBackupManifest(dirname); err != nil {
+		return
+	}
+
+	db.Wal = &retainedWal{
+		Driver: wal.NewDriver(dirname, WALDir, nil),
+		subs:   &db.subscriptions,
+	}
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
-	dataFactory := tables.NewDataFactory(segmentio.SegmentFileIOFactory, mutBufMgr, db.Scheduler, db.Dir)
+	dataFactory := tables.NewDataFactory(segmentio.SegmentFileIOFactory, mutBufMgr, indexBufMgr, db.Scheduler, db.Dir)
 	//dataOpenFactory := tables.NewDataFactory(segmentio.SegmentFileIOOpenFactory, mutBufMgr, db.Scheduler, db.Dir)
 	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, nil, db.Scheduler, dataFactory); err != nil {
 		return
@@ -86,11 +104,18 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	policyCfg := new(checkpoint.PolicyCfg)
 	policyCfg.Levels = int(opts.CheckpointCfg.ExecutionLevels)
 	policyCfg.Interval = opts.CheckpointCfg.ExecutionInterval
+	policyCfg.MinScore = opts.CheckpointCfg.MinScore
+	policyCfg.MaxScore = opts.CheckpointCfg.MaxScore
 	db.CKPDriver = checkpoint.NewDriver(db.Scheduler, policyCfg)
 
 	// Init timed scanner
 	scanner := NewDBScanner(db, nil)
-	calibrationOp := newCalibrationOp(db)
+	mergeCfg := &checkpoint.MergeCfg{
+		Interval:    time.Duration(opts.CheckpointCfg.MergeInterval) * time.Millisecond,
+		DeleteRatio: opts.CheckpointCfg.MergeDeleteRatio,
+		MaxWriteAmp: opts.CheckpointCfg.MergeMaxWriteAmp,
+	}
+	calibrationOp := newCalibrationOp(db, mergeCfg)
 	catalogMonotor := newCatalogStatsMonitor(db, opts.CheckpointCfg.CatalogUnCkpLimit, time.Duration(opts.CheckpointCfg.CatalogCkpInterval))
 	scanner.RegisterOp(calibrationOp)
 	scanner.RegisterOp(catalogMonotor)
@@ -21,6 +21,7 @@ type Replayer struct {
 	DataFactory *tables.DataFactory
 	db          *DB
 	maxTs       uint64
+	maxDropped  uint64
 }
 
 func newReplayer(dataFactory *tables.DataFactory, db *DB) *Replayer {
@@ -47,6 +48,18 @@ func (replayer *Replayer) OnReplayEntry(group uint32, commitId uint64, payload [
 	if err != nil {
 		panic(err)
 	}
+	if cmd, ok := txnCmd.(*txnbase.ComposedCmd); ok {
+		if ts, ok := cmd.GetCommitTS(); ok {
+			// Skip the txns dropped by a restore
+			if replayer.db.Manifest != nil && replayer.db.Manifest.IsDropped(ts) {
+				if ts > replayer.maxDropped {
+					replayer.maxDropped = ts
+				}
+				return
+			}
+			replayer.OnTimeStamp(ts)
+		}
+	}
 	replayer.OnReplayCmd(txnCmd, idxCtx)
 	if err != nil {
 		panic(err)
@@ -92,9 +105,9 @@ func (replayer *Replayer) OnReplayCmd(txncmd txnif.TxnCmd, idxCtx *wal.Index) {
 	}
 }
 
-func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) {
-	var data batch.IBatch
-	var deletes *roaring.Bitmap
+// loadAppendData returns the appended batch of the cmd and the rows
+// deleted from it in the same txn
+func (db *DB) loadAppendData(cmd *txnimpl.AppendCmd) (data batch.IBatch, deletes *roaring.Bitmap, err error) {
 	for _, subTxnCmd := range cmd.Cmds {
 		switch subCmd := subTxnCmd.(type) {
 		case *txnbase.BatchCmd:
@@ -102,18 +115,26 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) {
 		case *txnbase.DeleteBitmapCmd:
 			deletes = subCmd.Bitmap
 		case *txnbase.PointerCmd:
-			batEntry, err := db.Wal.LoadEntry(subCmd.Group, subCmd.Lsn)
-			if err != nil {
-				panic(err)
+			var batEntry wal.LogEntry
+			if batEntry, err = db.Wal.LoadEntry(subCmd.Group, subCmd.Lsn); err != nil {
+				return
 			}
 			r := bytes.NewBuffer(batEntry.GetPayload())
-			txnCmd, _, err := txnbase.BuildCommandFrom(r)
-			if err != nil {
-				panic(err)
+			var txnCmd txnif.TxnCmd
+			if txnCmd, _, err = txnbase.BuildCommandFrom(r); err != nil {
+				return
 			}
 			data = txnCmd.(*txnbase.BatchCmd).Bat
 		}
 	}
+	return
+}
+
+func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) {
+	data, deletes, err := db.loadAppendData(cmd)
+	if err != nil {
+		panic(err)
+	}
 
 	for _, info := range cmd.Infos {
 		database, err := db.Catalog.GetDatabaseByID(info.GetDBID())
@@ -18,8 +18,10 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
@@ -32,41 +34,78 @@ type ScannerOp interface {
 
 type calibrationOp struct {
 	*catalog.LoopProcessor
-	db              *DB
-	blkCntOfSegment int
+	db       *DB
+	mergeCfg *checkpoint.MergeCfg
+	policies map[string]checkpoint.MergePolicy
+	// pending records when a segment became a merge candidate
+	pending map[uint64]time.Time
+	scanned map[uint64]bool
 }
 
-func newCalibrationOp(db *DB) *calibrationOp {
+func newCalibrationOp(db *DB, mergeCfg *checkpoint.MergeCfg) *calibrationOp {
 	processor := &calibrationOp{
 		db:            db,
 		LoopProcessor: new(catalog.LoopProcessor),
+		mergeCfg:      mergeCfg,
+		policies:      make(map[string]checkpoint.MergePolicy),
+		pending:       make(map[uint64]time.Time),
 	}
 	processor.BlockFn = processor.onBlock
-	processor.SegmentFn = processor.onSegment
 	processor.PostSegmentFn = processor.onPostSegment
 	return processor
 }
 
-func (processor *calibrationOp) PreExecute() error  { return nil }
-func (processor *calibrationOp) PostExecute() error { return nil }
+func (processor *calibrationOp) PreExecute() error {
+	processor.scanned = make(map[uint64]bool)
+	return nil
+}
 
-func (processor *calibrationOp) onSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	processor.blkCntOfSegment = 0
-	return
+func (processor *calibrationOp) PostExecute() error {
+	// Forget the segments merged or dropped since the last scan
+	for id := range processor.pending {
+		if !processor.scanned[id] {
+			delete(processor.pending, id)
+		}
+	}
+	return nil
+}
+
+func (processor *calibrationOp) getMergePolicy(name string) checkpoint.MergePolicy {
+	policy, ok := processor.policies[name]
+	if !ok {
+		policy = checkpoint.NewMergePolicy(name, processor.mergeCfg)
+		processor.policies[name] = policy
+	}
+	return policy
 }
 
 func (processor *calibrationOp) onPostSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	if processor.blkCntOfSegment >= int(segmentEntry.GetTable().GetSchema().SegmentMaxBlocks) {
-		segmentData := segmentEntry.GetSegmentData()
-		taskFactory, taskType, scopes, err := segmentData.BuildCompactionTaskFactory()
-		if err != nil || taskFactory == nil {
-			logutil.Warnf("%s: %v", segmentData.MutationInfo(), err)
-		} else {
-			_, err = processor.db.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, taskFactory)
-			logutil.Infof("[Mergeblocks] | %s | Scheduled | State=%v | Scopes=%s", segmentEntry.String(), err, common.IDArraryString(scopes))
-		}
+	stats := CollectSegmentStats(segmentEntry)
+	if !stats.IsCandidate() {
+		return
+	}
+	id := segmentEntry.GetID()
+	processor.scanned[id] = true
+	since, ok := processor.pending[id]
+	if !ok {
+		since = time.Now()
+		processor.pending[id] = since
+	}
+	stats.Pending = time.Since(since)
+	policy := processor.getMergePolicy(segmentEntry.GetTable().GetSchema().CompactionPolicy)
+	if !policy.ShouldMerge(stats) {
+		return
+	}
+	segmentData := segmentEntry.GetSegmentData()
+	taskFactory, taskType, scopes, err := segmentData.BuildCompactionTaskFactory()
+	if err != nil || taskFactory == nil {
+		logutil.Warnf("%s: %v", segmentData.MutationInfo(), err)
+		err = nil
+		return
 	}
-	processor.blkCntOfSegment = 0
+	_, err = processor.db.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, taskFactory)
+	logutil.Infof("[Mergeblocks] | %s | Scheduled | State=%v | Scopes=%s", segmentEntry.String(), err, common.IDArraryString(scopes))
+	err = nil
 	return
 }
 
@@ -82,9 +121,6 @@ func (processor *calibrationOp) onBlock(blockEntry *catalog.BlockEntry) (err err
 		blockEntry.RUnlock()
 		return nil
 	}
-	if blockEntry.GetSegment().IsAppendable() && catalog.ActiveWithNoTxnFilter(blockEntry.BaseEntry) && catalog.NonAppendableBlkFilter(blockEntry) {
-		processor.blkCntOfSegment++
-	}
 	blockEntry.RUnlock()
 
 	data := blockEntry.GetBlockData()
@@ -132,6 +168,7 @@ func newCatalogStatsMonitor(db *DB, cntLimit int64, intervalLimit time.Duration)
 }
 
 func (monitor *catalogStatsMonitor) PreExecute() error {
+	metric.CheckpointLag.Set(float64(monitor.db.Wal.GetPenddingCnt()))
 	monitor.unCheckpointedCnt = 0
 	monitor.minTs = monitor.db.Catalog.GetCheckpointed().MaxTS + 1
 	monitor.maxTs = monitor.db.Scheduler.GetSafeTS()
@@ -165,8 +202,10 @@ func (monitor *catalogStatsMonitor) onBlock(entry *catalog.BlockEntry) (err erro
 	entry.RLock()
 	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.maxTs) {
 		logIndex := entry.GetLogIndex()
+		// The blocks are kept until the change subscriptions read the drop,
+		// the changes before it load their keys and before images from them
 		if logIndex != nil {
-			gcNeeded = checkpointed >= logIndex.LSN
+			gcNeeded = checkpointed >= logIndex.LSN && !monitor.db.subscriptions.retains(logIndex.LSN)
 		}
 	}
 	entry.RUnlock()
@@ -195,7 +234,7 @@ func (monitor *catalogStatsMonitor) onSegment(entry *catalog.SegmentEntry) (err
 	if entry.IsDroppedCommitted() {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
-			gcNeeded = checkpointed >= logIndex.LSN
+			gcNeeded = checkpointed >= logIndex.LSN && !monitor.db.subscriptions.retains(logIndex.LSN)
 		}
 	}
 	entry.RUnlock()
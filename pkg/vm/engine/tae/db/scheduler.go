@@ -164,7 +164,7 @@ func (s *taskScheduler) ScheduleMultiScopedTxnTask(ctx *tasks.Context, taskType
 }
 
 func (s *taskScheduler) ScheduleMultiScopedFn(ctx *tasks.Context, taskType tasks.TaskType, scopes []common.ID, fn tasks.FuncT) (task tasks.Task, err error) {
-	task = tasks.NewMultiScopedFnTask(ctx, taskType, scopes, fn)
+	task = tasks.NewMultiScopedFnTask(ctx, taskType, scopes, s.db.pinnedFn(taskType, fn))
 	err = s.Schedule(task)
 	return
 }
@@ -191,13 +191,13 @@ func (s *taskScheduler) GetCheckpointedLSN() uint64 {
 }
 
 func (s *taskScheduler) ScheduleFn(ctx *tasks.Context, taskType tasks.TaskType, fn func() error) (task tasks.Task, err error) {
-	task = tasks.NewFnTask(ctx, taskType, fn)
+	task = tasks.NewFnTask(ctx, taskType, s.db.pinnedFn(taskType, fn))
 	err = s.Schedule(task)
 	return
 }
 
 func (s *taskScheduler) ScheduleScopedFn(ctx *tasks.Context, taskType tasks.TaskType, scope *common.ID, fn func() error) (task tasks.Task, err error) {
-	task = tasks.NewScopedFnTask(ctx, taskType, scope, fn)
+	task = tasks.NewScopedFnTask(ctx, taskType, scope, s.db.pinnedFn(taskType, fn))
 	err = s.Schedule(task)
 	return
 }
@@ -45,6 +45,8 @@ func (task *ScheduledTxnTask) Scope() *common.ID {
 }
 
 func (task *ScheduledTxnTask) Execute() (err error) {
+	task.db.backupGate.pin(false)
+	defer task.db.backupGate.unpin()
 	txn, err := task.db.StartTxn(nil)
 	if err != nil {
 		return
@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fsck checks the files of a TAE data directory offline.
+package fsck
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+)
+
+const (
+	walSuffix     = ".rot"
+	segmentSuffix = ".seg"
+)
+
+type Problem struct {
+	File string
+	// the offset of the invalid data, -1 if the problem is not at an offset
+	Offset int64
+	Err    error
+}
+
+func (p Problem) String() string {
+	if p.Offset < 0 {
+		return fmt.Sprintf("%s: %v", p.File, p.Err)
+	}
+	return fmt.Sprintf("%s@%d: %v", p.File, p.Offset, p.Err)
+}
+
+type Report struct {
+	WalFiles     int
+	SegmentFiles int
+	Problems     []Problem
+	// the files truncated at their first invalid entry
+	Truncated []string
+}
+
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *Report) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%d wal files, %d segment files, %d problems\n",
+		r.WalFiles, r.SegmentFiles, len(r.Problems)))
+	for _, p := range r.Problems {
+		buf.WriteString(fmt.Sprintf("  %s\n", p))
+	}
+	for _, name := range r.Truncated {
+		buf.WriteString(fmt.Sprintf("  truncated %s\n", name))
+	}
+	return buf.String()
+}
+
+type versionFile struct {
+	name    string
+	version int
+}
+
+// Check walks the data directory dir, it validates the superblocks, inodes
+// and extents of the segment files and the entries and the LSN chain of the
+// log files. If truncate is set, the log files are truncated at their first
+// invalid entry.
+func Check(dir string, truncate bool) (*Report, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{}
+	wals := make(map[string][]versionFile)
+	segments := make([]string, 0)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name := filepath.Join(dir, f.Name())
+		switch {
+		case strings.HasSuffix(f.Name(), walSuffix):
+			prefix, version, err := store.ParseVersionFile(name)
+			if err != nil {
+				r.addProblem(name, -1, err)
+				continue
+			}
+			wals[prefix] = append(wals[prefix], versionFile{name: name, version: version})
+		case strings.HasSuffix(f.Name(), segmentSuffix):
+			segments = append(segments, name)
+		}
+	}
+	prefixes := make([]string, 0, len(wals))
+	for prefix := range wals {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		r.checkWal(wals[prefix], truncate)
+	}
+	if len(segments) > 0 {
+		cache := make([]byte, segment.LOG_SIZE)
+		for _, name := range segments {
+			r.checkSegment(name, cache)
+		}
+	}
+	return r, nil
+}
+
+func (r *Report) addProblem(name string, offset int64, err error) {
+	r.Problems = append(r.Problems, Problem{File: name, Offset: offset, Err: err})
+}
+
+// checkWal checks the version files of a log store, the versions and the
+// LSNs of each group have to be increasing.
+func (r *Report) checkWal(files []versionFile, truncate bool) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].version < files[j].version
+	})
+	lsns := make(map[uint32]uint64)
+	for i, f := range files {
+		r.WalFiles++
+		if i > 0 && f.version != files[i-1].version+1 {
+			r.addProblem(f.name, -1, fmt.Errorf("version %d is missing", files[i-1].version+1))
+		}
+		valid, err := store.VerifyVersionFile(f.name, func(info *entry.Info) {
+			if lsn, ok := lsns[info.Group]; ok && info.GroupLSN <= lsn {
+				r.addProblem(f.name, -1, fmt.Errorf("lsn %d of group %d is not after %d", info.GroupLSN, info.Group, lsn))
+			}
+			lsns[info.Group] = info.GroupLSN
+		})
+		if err == nil {
+			continue
+		}
+		r.addProblem(f.name, valid, err)
+		if truncate {
+			if err = os.Truncate(f.name, valid); err != nil {
+				r.addProblem(f.name, -1, err)
+				continue
+			}
+			r.Truncated = append(r.Truncated, f.name)
+		}
+	}
+}
+
+func (r *Report) checkSegment(name string, cache []byte) {
+	r.SegmentFiles++
+	seg := &segment.Segment{}
+	if err := seg.Open(name); err != nil {
+		r.addProblem(name, -1, err)
+		return
+	}
+	defer seg.Close()
+	if err := seg.Replay(bytes.NewBuffer(cache)); err != nil {
+		r.addProblem(name, -1, err)
+		return
+	}
+	for _, err := range seg.Verify() {
+		r.addProblem(name, -1, err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsck
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ModuleName = "FSCK"
+)
+
+func corrupt(t *testing.T, name string, offset int64) {
+	f, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+	assert.Nil(t, err)
+	defer f.Close()
+	if offset < 0 {
+		stat, err := f.Stat()
+		assert.Nil(t, err)
+		offset += stat.Size()
+	}
+	buf := make([]byte, 1)
+	_, err = f.ReadAt(buf, offset)
+	assert.Nil(t, err)
+	buf[0] ^= 0xff
+	_, err = f.WriteAt(buf, offset)
+	assert.Nil(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+
+	s, err := store.NewBaseStore(dir, "wal", nil)
+	assert.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: entry.GTCustomizedStart})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("payload-%d", i))))
+		_, err = s.AppendEntry(entry.GTCustomizedStart, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	assert.Nil(t, s.Close())
+
+	segName := path.Join(dir, "1.seg")
+	seg := segment.Segment{}
+	assert.Nil(t, seg.Init(segName))
+	seg.Mount()
+	file := seg.NewBlockFile("1_1.blk")
+	assert.Nil(t, seg.Append(file, []byte("hello tae")))
+	assert.Nil(t, seg.Close())
+
+	r, err := Check(dir, false)
+	assert.Nil(t, err)
+	assert.True(t, r.OK(), r.String())
+	assert.Equal(t, 1, r.WalFiles)
+	assert.Equal(t, 1, r.SegmentFiles)
+
+	// the corrupted tail of the log is reported, then truncated
+	walName := store.MakeVersionFile(dir, "wal", 0)
+	corrupt(t, walName, -1)
+	r, err = Check(dir, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(r.Problems))
+	assert.ErrorIs(t, r.Problems[0].Err, common.ErrChecksumMismatch)
+	r, err = Check(dir, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{walName}, r.Truncated)
+	r, err = Check(dir, false)
+	assert.Nil(t, err)
+	assert.True(t, r.OK(), r.String())
+
+	// the corrupted extent of the segment is reported
+	corrupt(t, segName, int64((*file.GetExtents())[0].Offset()))
+	r, err = Check(dir, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(r.Problems))
+	assert.Equal(t, segName, r.Problems[0].File)
+	assert.ErrorIs(t, r.Problems[0].Err, common.ErrChecksumMismatch)
+	t.Log(r.String())
+}
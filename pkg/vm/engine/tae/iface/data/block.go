@@ -61,17 +61,21 @@ type Block interface {
 	GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
 	GetMeta() any
 	GetBufMgr() base.INodeManager
+	GetIndexBufMgr() base.INodeManager
 
 	MakeAppender() (BlockAppender, error)
 	RangeDelete(txn txnif.AsyncTxn, start, end uint32) (txnif.DeleteNode, error)
 	Update(txn txnif.AsyncTxn, row uint32, colIdx uint16, v any) (txnif.UpdateNode, error)
 
 	GetTotalChanges() int
+	GetDeleteCnt() uint32
 	CollectChangesInRange(startTs, endTs uint64) (*model.BlockView, error)
 	CollectAppendLogIndexes(startTs, endTs uint64) ([]*wal.Index, error)
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector, rowmask *roaring.Bitmap) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	// MayContainsInColumn checks the key against the zone map of the column
+	MayContainsInColumn(colIdx uint16, key any) bool
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (any, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
@@ -50,12 +50,16 @@ type Block interface {
 	WriteBatch(bat *gbat.Batch, ts uint64) error
 	LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat.Batch, err error)
 	WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) error
+	// LoadLob returns the out of line TEXT/BLOB values of a column
+	LoadLob(colIdx int) ([]byte, error)
 
 	Destroy() error
 }
 
 type ColumnBlock interface {
 	io.Closer
+	// SetCompressAlgo sets the compress algorithm of the data written later
+	SetCompressAlgo(algo int)
 	WriteTS(ts uint64) error
 	WriteData(buf []byte) error
 	WriteIndex(idx int, buf []byte) error
@@ -265,6 +265,15 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
+	case types.T_time:
+		if _, err = w.Write(encoding.EncodeTime(zm.min.(types.Time))); err != nil {
+			return
+		}
+		if _, err = w.Write(encoding.EncodeTime(zm.max.(types.Time))); err != nil {
+			return
+		}
+		buf = w.Bytes()
+		return
 	case types.T_datetime:
 		if _, err = w.Write(encoding.EncodeDatetime(zm.min.(types.Datetime))); err != nil {
 			return
@@ -274,7 +283,7 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		minv := zm.min.([]byte)
 		maxv := zm.max.([]byte)
 		if _, err = w.Write(encoding.EncodeInt16(int16(len(minv)))); err != nil {
@@ -362,13 +371,19 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		zm.max = encoding.DecodeDate(buf[:4])
 		buf = buf[4:]
 		return nil
+	case types.T_time:
+		zm.min = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		zm.max = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		return nil
 	case types.T_datetime:
 		zm.min = encoding.DecodeDatetime(buf[:8])
 		buf = buf[8:]
 		zm.max = encoding.DecodeDatetime(buf[:8])
 		buf = buf[8:]
 		return nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
 		minBuf := make([]byte, int(lenminv))
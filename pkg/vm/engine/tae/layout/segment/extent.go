@@ -31,6 +31,8 @@ type Extent struct {
 	offset uint32
 	length uint32
 	data   entry
+	// CRC32C of the data, 0 if the extent is rewritten by an update
+	checksum uint32
 }
 
 func (ex *Extent) End() uint32 {
@@ -45,6 +47,10 @@ func (ex *Extent) Length() uint32 {
 	return ex.length
 }
 
+func (ex *Extent) Checksum() uint32 {
+	return ex.checksum
+}
+
 func (ex *Extent) GetData() *entry {
 	return &ex.data
 }
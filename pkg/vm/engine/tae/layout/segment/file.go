@@ -17,7 +17,9 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"io"
 )
 
@@ -53,6 +55,13 @@ func (b *BlockFile) GetAlgo() uint8 {
 	return b.snode.algo
 }
 
+// SetAlgo sets the compress algorithm of the data appended later
+func (b *BlockFile) SetAlgo(algo uint8) {
+	b.snode.mutex.Lock()
+	defer b.snode.mutex.Unlock()
+	b.snode.algo = algo
+}
+
 func (b *BlockFile) GetName() string {
 	return b.name
 }
@@ -65,10 +74,11 @@ func (b *BlockFile) Append(offset uint64, data []byte, originSize uint32) (err e
 	}
 	b.snode.mutex.Lock()
 	b.snode.extents = append(b.snode.extents, Extent{
-		typ:    APPEND,
-		offset: uint32(offset),
-		length: cbufLen,
-		data:   entry{offset: 0, length: uint32(len(data))},
+		typ:      APPEND,
+		offset:   uint32(offset),
+		length:   cbufLen,
+		data:     entry{offset: 0, length: uint32(len(data))},
+		checksum: common.Checksum(data),
 	})
 	b.snode.size += uint64(len(data))
 	b.snode.originSize += uint64(originSize)
@@ -115,6 +125,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 		remaining = ext.length - fOffset - length
 	}
 	oldOff := b.snode.extents[num].offset
+	b.snode.extents[num].checksum = 0
 	if fOffset == 0 && ext.length-fOffset-length == 0 {
 		b.snode.extents[num].typ = UPDATE
 		b.snode.extents[num].offset = offset
@@ -168,6 +179,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 			})
 			e.offset += xLen
 			e.length -= xLen
+			e.checksum = 0
 		} else {
 			free = append(free, Extent{
 				offset: e.offset,
@@ -237,6 +249,9 @@ func (b *BlockFile) Read(data []byte) (n int, err error) {
 		if err != nil && dataLen != ext.GetData().GetLength() {
 			return int(dataLen), err
 		}
+		if ext.checksum != 0 && common.Checksum(buf) != ext.checksum {
+			return n, fmt.Errorf("%w: %s extent at offset %d", common.ErrChecksumMismatch, b.name, ext.offset)
+		}
 		n += int(dataLen)
 		boff += ext.GetData().GetLength()
 		roff += ext.Length()
@@ -244,6 +259,26 @@ func (b *BlockFile) Read(data []byte) (n int, err error) {
 	return n, nil
 }
 
+// Verify reads the data of the extents and checks it against their checksums
+func (b *BlockFile) Verify() error {
+	b.snode.mutex.RLock()
+	extents := b.snode.extents
+	b.snode.mutex.RUnlock()
+	for _, ext := range extents {
+		if ext.checksum == 0 {
+			continue
+		}
+		buf := make([]byte, ext.data.length)
+		if _, err := b.segment.segFile.ReadAt(buf, int64(ext.offset+ext.data.offset)); err != nil && err != io.EOF {
+			return err
+		}
+		if common.Checksum(buf) != ext.checksum {
+			return fmt.Errorf("%w: extent at offset %d", common.ErrChecksumMismatch, ext.offset)
+		}
+	}
+	return nil
+}
+
 func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, error) {
 	remain := uint32(b.snode.size) - offset - length
 	num := 0
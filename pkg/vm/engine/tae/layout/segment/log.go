@@ -17,7 +17,9 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"unsafe"
 )
 
@@ -31,6 +33,8 @@ type Log struct {
 func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error) {
 	var nameLen uint32
 	var extentLen uint64
+	var checksum uint32
+	buf := cache.Bytes()
 	n = 0
 	if err = binary.Read(cache, binary.BigEndian, &file.snode.magic); err != nil {
 		return
@@ -47,6 +51,10 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 		return
 	}
 	n += int(unsafe.Sizeof(nameLen))
+	if int(nameLen) > cache.Len() {
+		// the length is corrupted
+		return n, common.ErrChecksumMismatch
+	}
 	name := make([]byte, nameLen)
 	if err = binary.Read(cache, binary.BigEndian, name); err != nil {
 		return
@@ -77,6 +85,9 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 		return
 	}
 	n += int(unsafe.Sizeof(extentLen))
+	if extentLen > uint64(cache.Len()) {
+		return n, common.ErrChecksumMismatch
+	}
 	file.snode.extents = make([]Extent, extentLen)
 	for i := 0; i < int(extentLen); i++ {
 		if err = binary.Read(cache, binary.BigEndian, &file.snode.extents[i].typ); err != nil {
@@ -99,7 +110,24 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 			return
 		}
 		n += int(unsafe.Sizeof(file.snode.extents[i].data.length))
+		if !l.logFile.segment.super.HasChecksum() {
+			continue
+		}
+		if err = binary.Read(cache, binary.BigEndian, &file.snode.extents[i].checksum); err != nil {
+			return
+		}
+		n += int(unsafe.Sizeof(file.snode.extents[i].checksum))
 	}
+	if !l.logFile.segment.super.HasChecksum() {
+		return
+	}
+	if err = binary.Read(cache, binary.BigEndian, &checksum); err != nil {
+		return
+	}
+	if checksum != common.Checksum(buf[:n]) {
+		err = common.ErrChecksumMismatch
+	}
+	n += int(unsafe.Sizeof(checksum))
 	return
 }
 
@@ -117,14 +145,28 @@ func (l *Log) Replay(cache *bytes.Buffer) error {
 	l.logFile.name = "logfile"
 	l.logFile.segment.nodes[l.logFile.name] = l.logFile
 	magicLen := uint32(unsafe.Sizeof(l.logFile.snode.magic))
+	size := cache.Len()
 	for {
 		file := &BlockFile{
 			snode:   &Inode{},
 			segment: l.logFile.segment,
 		}
+		buf := cache.Bytes()
+		offset := uint64(LOG_START + size - len(buf))
 		n, err = l.readInode(cache, file)
 		if err != nil {
-			return err
+			if !errors.Is(err, common.ErrChecksumMismatch) {
+				return err
+			}
+			// the inode can't be trusted to skip itself, inodes start at
+			// the boundaries of blocks
+			logutil.Warnf("inode at offset %d of %v is corrupted", offset, l.logFile.segment.name)
+			l.logFile.segment.corrupted = append(l.logFile.segment.corrupted, offset)
+			if len(buf) <= int(l.logFile.segment.super.blockSize) {
+				break
+			}
+			cache = bytes.NewBuffer(buf[l.logFile.segment.super.blockSize:])
+			continue
 		}
 		if n == 0 {
 			if int(l.logFile.segment.super.blockSize-magicLen) == cache.Len() {
@@ -222,6 +264,17 @@ func (l *Log) Append(file *BlockFile) error {
 		if err = binary.Write(&ibuffer, binary.BigEndian, ext.data.length); err != nil {
 			return err
 		}
+		if !segment.super.HasChecksum() {
+			continue
+		}
+		if err = binary.Write(&ibuffer, binary.BigEndian, ext.checksum); err != nil {
+			return err
+		}
+	}
+	if segment.super.HasChecksum() {
+		if err = binary.Write(&ibuffer, binary.BigEndian, common.Checksum(ibuffer.Bytes())); err != nil {
+			return err
+		}
 	}
 	ibufLen := (segment.super.blockSize - (uint32(ibuffer.Len()) % segment.super.blockSize)) + uint32(ibuffer.Len())
 	offset, allocated := l.allocator.Allocate(uint64(ibufLen))
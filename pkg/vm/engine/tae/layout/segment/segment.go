@@ -17,9 +17,10 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"github.com/pierrec/lz4"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"os"
 	"sync"
 )
@@ -33,6 +34,17 @@ const DATA_SIZE = SIZE - DATA_START
 const LOG_SIZE = DATA_START - LOG_START
 const MAGIC = 0xFFFFFFFF
 
+// The versions of the segment format. The legacy segments carry no
+// checksums, the checksummed ones have the checksums of the super block,
+// the inodes and the extents.
+const VERSION_LEGACY = 1
+const VERSION_CHECKSUM = 2
+
+// version u64, algo u8, blockSize u32, colCnt u32, checksum u32
+// the checksum is only present from VERSION_CHECKSUM
+const SUPER_BLOCK_SIZE = 21
+const SUPER_BLOCK_CHECKSUM = SUPER_BLOCK_SIZE - 4
+
 type SuperBlock struct {
 	version   uint64
 	blockSize uint32
@@ -41,6 +53,11 @@ type SuperBlock struct {
 	state     StateType
 }
 
+// HasChecksum returns false for a segment written in the legacy format
+func (sb *SuperBlock) HasChecksum() bool {
+	return sb.version >= VERSION_CHECKSUM
+}
+
 type Segment struct {
 	mutex     sync.Mutex
 	segFile   *os.File
@@ -50,6 +67,8 @@ type Segment struct {
 	log       *Log
 	allocator Allocator
 	name      string
+	// the offsets of the inodes skipped on replay for checksum mismatch
+	corrupted []uint64
 }
 
 func (s *Segment) Init(name string) error {
@@ -58,7 +77,7 @@ func (s *Segment) Init(name string) error {
 		sbuffer bytes.Buffer
 	)
 	s.super = SuperBlock{
-		version:   1,
+		version:   VERSION_CHECKSUM,
 		blockSize: BLOCK_SIZE,
 	}
 	log := &Inode{
@@ -94,6 +113,11 @@ func (s *Segment) Init(name string) error {
 	if err = binary.Write(&sbuffer, binary.BigEndian, s.super.colCnt); err != nil {
 		return err
 	}
+	if s.super.HasChecksum() {
+		if err = binary.Write(&sbuffer, binary.BigEndian, common.Checksum(sbuffer.Bytes())); err != nil {
+			return err
+		}
+	}
 
 	cbufLen := (s.super.blockSize - (uint32(sbuffer.Len()) % s.super.blockSize)) + uint32(sbuffer.Len())
 
@@ -116,9 +140,14 @@ func (s *Segment) Open(name string) (err error) {
 	if err != nil {
 		return err
 	}
+	s.name = name
 	return nil
 }
 
+func (s *Segment) Close() error {
+	return s.segFile.Close()
+}
+
 func (s *Segment) Mount() {
 	s.lastInode = 1
 	var seq uint64
@@ -159,7 +188,7 @@ func (s *Segment) Destroy() {
 
 func (s *Segment) Replay(cache *bytes.Buffer) error {
 	s.super = SuperBlock{
-		version:   1,
+		version:   VERSION_LEGACY,
 		blockSize: BLOCK_SIZE,
 	}
 	log := &Inode{
@@ -169,6 +198,9 @@ func (s *Segment) Replay(cache *bytes.Buffer) error {
 		state: RESIDENT,
 	}
 	s.super.lognode = log
+	if err := s.readSuperBlock(); err != nil {
+		return err
+	}
 	s.Mount()
 	err := s.log.Replay(cache)
 	if err != nil {
@@ -177,6 +209,28 @@ func (s *Segment) Replay(cache *bytes.Buffer) error {
 	return nil
 }
 
+func (s *Segment) readSuperBlock() error {
+	buf := make([]byte, SUPER_BLOCK_SIZE)
+	if _, err := s.segFile.ReadAt(buf, 0); err != nil {
+		return err
+	}
+	version := binary.BigEndian.Uint64(buf)
+	switch version {
+	case VERSION_LEGACY:
+	case VERSION_CHECKSUM:
+		if binary.BigEndian.Uint32(buf[SUPER_BLOCK_CHECKSUM:]) != common.Checksum(buf[:SUPER_BLOCK_CHECKSUM]) {
+			return fmt.Errorf("%w: superblock of %s", common.ErrChecksumMismatch, s.segFile.Name())
+		}
+	default:
+		// the version itself is corrupted
+		return fmt.Errorf("%w: unknown version %d in the superblock of %s", common.ErrChecksumMismatch, version, s.segFile.Name())
+	}
+	s.super.version = version
+	s.super.blockSize = binary.BigEndian.Uint32(buf[9:])
+	s.super.colCnt = binary.BigEndian.Uint32(buf[13:])
+	return nil
+}
+
 func (s *Segment) NewBlockFile(fname string) *BlockFile {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -206,10 +260,9 @@ func (s *Segment) NewBlockFile(fname string) *BlockFile {
 
 func (s *Segment) Append(fd *BlockFile, pl []byte) (err error) {
 	buf := pl
-	if fd.snode.algo == compress.Lz4 {
-		colSize := len(pl)
-		buf = make([]byte, lz4.CompressBlockBound(colSize))
-		if buf, err = compress.Compress(pl, buf, compress.Lz4); err != nil {
+	if algo := int(fd.GetAlgo()); algo != compress.None {
+		buf = make([]byte, compress.CompressBound(len(pl), algo))
+		if buf, err = compress.Compress(pl, buf, algo); err != nil {
 			return err
 		}
 	}
@@ -281,6 +334,25 @@ func (s *Segment) GetName() string {
 	return s.name
 }
 
+// Verify checks the files replayed from the segment against the checksums
+// of their extents. It returns the errors of the inodes skipped on replay
+// and of the extents whose data is corrupted.
+func (s *Segment) Verify() []error {
+	errs := make([]error, 0)
+	for _, offset := range s.corrupted {
+		errs = append(errs, fmt.Errorf("%w: inode at offset %d", common.ErrChecksumMismatch, offset))
+	}
+	for name, file := range s.GetNodes() {
+		if file == s.log.logFile {
+			continue
+		}
+		if err := file.Verify(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errs
+}
+
 func (s *Segment) GetNodes() map[string]*BlockFile {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -24,6 +24,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -356,3 +357,103 @@ func TestSegment_Init(t *testing.T) {
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 514)))
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 515)))*/
 }
+
+func TestSegment_Checksum(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "init.seg")
+	seg := Segment{}
+	err := seg.Init(name)
+	assert.Nil(t, err)
+	seg.Mount()
+	files := make([]*BlockFile, 3)
+	for i := range files {
+		files[i] = seg.NewBlockFile(fmt.Sprintf("test_%d.blk", i))
+		err = seg.Append(files[i], mockData(8192))
+		assert.Nil(t, err)
+	}
+	corrupt := func(offset int64) {
+		buf := make([]byte, 1)
+		_, err := seg.segFile.ReadAt(buf, offset)
+		assert.Nil(t, err)
+		buf[0] ^= 0xff
+		_, err = seg.segFile.WriteAt(buf, offset)
+		assert.Nil(t, err)
+	}
+	replay := func() (*Segment, error) {
+		segfile, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+		assert.Nil(t, err)
+		seg1 := &Segment{
+			name:    name,
+			segFile: segfile,
+		}
+		cache := bytes.NewBuffer(make([]byte, LOG_SIZE))
+		return seg1, seg1.Replay(cache)
+	}
+
+	seg1, err := replay()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(seg1.Verify()))
+
+	// corrupted extent
+	corrupt(int64((*files[1].GetExtents())[0].Offset()))
+	seg1, err = replay()
+	assert.Nil(t, err)
+	errs := seg1.Verify()
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorIs(t, errs[0], common.ErrChecksumMismatch)
+	buf := make([]byte, seg1.nodes["test_1.blk"].GetFileSize())
+	_, err = seg1.nodes["test_1.blk"].Read(buf)
+	assert.ErrorIs(t, err, common.ErrChecksumMismatch)
+
+	// corrupted inode
+	corrupt(int64(LOG_START + files[2].snode.logExtents.offset + 16))
+	seg1, err = replay()
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{uint64(LOG_START + files[2].snode.logExtents.offset)}, seg1.corrupted)
+	assert.Nil(t, seg1.nodes["test_2.blk"])
+	assert.Equal(t, 2, len(seg1.Verify()))
+
+	// corrupted superblock
+	corrupt(0)
+	_, err = replay()
+	assert.ErrorIs(t, err, common.ErrChecksumMismatch)
+}
+
+func TestSegment_ReplayLegacy(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "legacy.seg")
+	seg := Segment{}
+	err := seg.Init(name)
+	assert.Nil(t, err)
+	// rewrite the super block and the inodes in the layout without checksums
+	var sbuffer bytes.Buffer
+	for _, v := range []any{uint64(VERSION_LEGACY), uint8(compress.Lz4), uint32(BLOCK_SIZE), uint32(0), uint32(0)} {
+		assert.Nil(t, binary.Write(&sbuffer, binary.BigEndian, v))
+	}
+	_, err = seg.segFile.WriteAt(sbuffer.Bytes(), 0)
+	assert.Nil(t, err)
+	seg.super.version = VERSION_LEGACY
+	seg.Mount()
+	data := mockData(8192)
+	file := seg.NewBlockFile("test_0.blk")
+	file.snode.algo = compress.None
+	err = seg.Append(file, data)
+	assert.Nil(t, err)
+
+	segfile, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+	assert.Nil(t, err)
+	seg1 := &Segment{
+		name:    name,
+		segFile: segfile,
+	}
+	err = seg1.Replay(bytes.NewBuffer(make([]byte, LOG_SIZE)))
+	assert.Nil(t, err)
+	assert.False(t, seg1.super.HasChecksum())
+	assert.Equal(t, 0, len(seg1.Verify()))
+	file1 := seg1.nodes["test_0.blk"]
+	assert.NotNil(t, file1)
+	buf := make([]byte, file1.GetFileSize())
+	_, err = file1.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, data, buf)
+}
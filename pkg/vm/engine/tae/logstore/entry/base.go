@@ -401,7 +401,10 @@ func (b *Base) ReadFrom(r io.Reader) (int64, error) {
 	if err != nil {
 		return int64(n2), err
 	}
-	return int64(n1 + n2), nil
+	if n1+n2 != b.TotalSizeExpectMeta() {
+		return int64(n1 + n2), nil
+	}
+	return int64(n1 + n2), b.Verify()
 }
 
 func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
@@ -409,7 +412,7 @@ func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
 		b.node = common.GPool.Alloc(uint64(b.GetPayloadSize()))
 		b.payload = b.node.Buf[:b.GetPayloadSize()]
 	}
-	offset += len(b.GetMetaBuf())
+	offset += b.MetaSize()
 	infoBuf := make([]byte, b.GetInfoSize())
 	n1, err := r.ReadAt(infoBuf, int64(offset))
 	if err != nil {
@@ -421,10 +424,11 @@ func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
 	if err != nil {
 		return n2, err
 	}
-	return n1 + n2, nil
+	return n1 + n2, b.Verify()
 }
 
 func (b *Base) WriteTo(w io.Writer) (int64, error) {
+	b.SetChecksum(b.checksum())
 	n1, err := b.descriptor.WriteTo(w)
 	if err != nil {
 		return n1, err
@@ -439,3 +443,21 @@ func (b *Base) WriteTo(w io.Writer) (int64, error) {
 	}
 	return n1 + int64(n2) + int64(n3), err
 }
+
+func (b *Base) checksum() uint32 {
+	crc := common.Checksum(b.descBuf[:ChecksumOffset])
+	crc = common.ChecksumUpdate(crc, b.GetInfoBuf())
+	return common.ChecksumUpdate(crc, b.payload)
+}
+
+// Verify checks the info and the payload read from a file against the
+// checksum in the descriptor, an entry in the legacy layout has none to check.
+func (b *Base) Verify() error {
+	if !b.HasChecksum() {
+		return nil
+	}
+	if b.checksum() != b.GetChecksum() {
+		return common.ErrChecksumMismatch
+	}
+	return nil
+}
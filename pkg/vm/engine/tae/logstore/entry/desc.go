@@ -23,10 +23,19 @@ import (
 const (
 	PayloadSizeOffset = int(unsafe.Sizeof(ETInvalid))
 	InfoSizeOffset    = int(unsafe.Sizeof(ETInvalid) + unsafe.Sizeof(uint32(0)))
-	DescriptorSize    = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	ChecksumOffset    = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	DescriptorSize    = int(unsafe.Sizeof(ETInvalid) + 3*unsafe.Sizeof(uint32(0)))
+
+	// LegacyDescriptorSize is the size of the descriptors written without a checksum
+	LegacyDescriptorSize = ChecksumOffset
 )
 
-//type u16, payloadsize u32, infosize u32
+// ChecksumFlag is set in the type of the descriptors followed by a checksum,
+// the descriptors without it are read in the legacy layout
+const ChecksumFlag Type = 0x8000
+
+//type u16, payloadsize u32, infosize u32, checksum u32
+//the checksum is only present when the type has ChecksumFlag set
 type descriptor struct {
 	descBuf []byte
 }
@@ -46,7 +55,7 @@ func (desc *descriptor) IsCheckpoint() bool {
 }
 
 func (desc *descriptor) SetType(t Type) {
-	binary.BigEndian.PutUint16(desc.descBuf, t)
+	binary.BigEndian.PutUint16(desc.descBuf, t|ChecksumFlag)
 }
 
 func (desc *descriptor) SetPayloadSize(size int) {
@@ -57,18 +66,35 @@ func (desc *descriptor) SetInfoSize(size int) {
 	binary.BigEndian.PutUint32(desc.descBuf[InfoSizeOffset:], uint32(size))
 }
 
+func (desc *descriptor) SetChecksum(checksum uint32) {
+	binary.BigEndian.PutUint32(desc.descBuf[ChecksumOffset:], checksum)
+}
+
 func (desc *descriptor) reset() {
 	desc.SetType(ETInvalid)
 	desc.SetPayloadSize(0)
 	desc.SetInfoSize(0)
+	desc.SetChecksum(0)
 }
 
 func (desc *descriptor) GetMetaBuf() []byte {
-	return desc.descBuf
+	return desc.descBuf[:desc.MetaSize()]
 }
 
 func (desc *descriptor) GetType() Type {
-	return binary.BigEndian.Uint16(desc.descBuf)
+	return binary.BigEndian.Uint16(desc.descBuf) &^ ChecksumFlag
+}
+
+// HasChecksum returns false for a descriptor read in the legacy layout
+func (desc *descriptor) HasChecksum() bool {
+	return binary.BigEndian.Uint16(desc.descBuf)&ChecksumFlag != 0
+}
+
+func (desc *descriptor) MetaSize() int {
+	if desc.HasChecksum() {
+		return DescriptorSize
+	}
+	return LegacyDescriptorSize
 }
 
 func (desc *descriptor) GetPayloadSize() int {
@@ -79,8 +105,13 @@ func (desc *descriptor) GetInfoSize() int {
 	return int(binary.BigEndian.Uint32(desc.descBuf[InfoSizeOffset:]))
 }
 
+// GetChecksum returns the CRC32C of the descriptor fields before it, the info and the payload
+func (desc *descriptor) GetChecksum() uint32 {
+	return binary.BigEndian.Uint32(desc.descBuf[ChecksumOffset:])
+}
+
 func (desc *descriptor) TotalSize() int {
-	return DescriptorSize + desc.GetPayloadSize() + desc.GetInfoSize()
+	return desc.MetaSize() + desc.GetPayloadSize() + desc.GetInfoSize()
 }
 
 func (desc *descriptor) TotalSizeExpectMeta() int {
@@ -88,10 +119,28 @@ func (desc *descriptor) TotalSizeExpectMeta() int {
 }
 
 func (desc *descriptor) WriteTo(w io.Writer) (int64, error) {
-	n, err := w.Write(desc.descBuf)
+	n, err := w.Write(desc.GetMetaBuf())
 	return int64(n), err
 }
 
+// ReadMeta reads the legacy part of the descriptor first, and the checksum
+// after it only if the type says it is there
 func (desc *descriptor) ReadMeta(r io.Reader) (int, error) {
-	return r.Read(desc.descBuf)
+	desc.SetChecksum(0)
+	n, err := r.Read(desc.descBuf[:LegacyDescriptorSize])
+	if err != nil || !desc.HasChecksum() {
+		return n, err
+	}
+	n2, err := r.Read(desc.descBuf[LegacyDescriptorSize:])
+	return n + n2, err
+}
+
+func (desc *descriptor) ReadMetaAt(r io.ReaderAt, offset int64) (int, error) {
+	desc.SetChecksum(0)
+	n, err := r.ReadAt(desc.descBuf[:LegacyDescriptorSize], offset)
+	if err != nil || !desc.HasChecksum() {
+		return n, err
+	}
+	n2, err := r.ReadAt(desc.descBuf[LegacyDescriptorSize:], offset+int64(n))
+	return n + n2, err
 }
@@ -48,8 +48,14 @@ type Desc interface {
 	SetPayloadSize(int)
 	GetInfoSize() int
 	SetInfoSize(int)
+	GetChecksum() uint32
+	SetChecksum(uint32)
 	TotalSize() int
+	MetaSize() int
+	HasChecksum() bool
 	GetMetaBuf() []byte
+	ReadMeta(io.Reader) (int, error)
+	ReadMetaAt(io.ReaderAt, int64) (int, error)
 	IsFlush() bool
 	IsCheckpoint() bool
 }
@@ -66,6 +72,7 @@ type Entry interface {
 	UnmarshalFromNode(*common.MemNode, bool) error
 	ReadFrom(io.Reader) (int64, error)
 	WriteTo(io.Writer) (int64, error)
+	Verify() error
 
 	WaitDone() error
 	DoneWithErr(error)
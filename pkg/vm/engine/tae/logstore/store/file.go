@@ -170,7 +170,7 @@ func (rf *rotateFile) Replay(r *replayer, o ReplayObserver) error {
 	for _, vf := range rf.uncommitted {
 		err = vf.Replay(r, vf)
 		if err != nil {
-			return nil
+			return err
 		}
 	}
 	return nil
@@ -315,6 +315,30 @@ func (rf *rotateFile) Sync() error {
 	return lastFile.Sync()
 }
 
+// Backup copies the synced part of every version file into the dir.
+// The bytes before the synced position are never rewritten, so the copy
+// is a consistent prefix of the log.
+func (rf *rotateFile) Backup(dir string) error {
+	rf.RLock()
+	files := make([]*vFile, 0)
+	for _, id := range rf.history.EntryIds() {
+		files = append(files, rf.history.GetEntry(id).(*vFile))
+	}
+	files = append(files, rf.uncommitted...)
+	sizes := make([]int64, len(files))
+	for i, vf := range files {
+		sizes[i] = vf.SyncedSize()
+	}
+	rf.RUnlock()
+
+	for i, vf := range files {
+		if err := vf.CopyTo(filepath.Join(dir, filepath.Base(vf.Name())), sizes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (rf *rotateFile) Load(ver int, groupId uint32, lsn uint64) (entry.Entry, error) {
 	vf, err := rf.GetEntryByVersion(ver)
 	if err != nil {
@@ -290,25 +290,42 @@ func (r *replayer) replayHandler(v VFile, o ReplayObserver) error {
 	entry := entry.GetBase()
 	defer entry.Free()
 
-	metaBuf := entry.GetMetaBuf()
-	_, err := vfile.Read(metaBuf)
+	_, err := entry.ReadMeta(vfile)
 	if err != nil {
 		if !errors.Is(err, io.EOF) {
 			return err
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
+		err2 := vfile.truncate(r.state.pos)
 		if err2 != nil {
 			panic(err2)
 		}
 		return err
 	}
 
+	// the entry is torn by a crash if it runs past the end of the file
+	if r.state.pos+entry.TotalSize() > current.pos {
+		err2 := vfile.truncate(r.state.pos)
+		if err2 != nil {
+			panic(err2)
+		}
+		return io.EOF
+	}
+
 	n, err := entry.ReadFrom(vfile)
 	if err != nil {
+		// only the last entry of a file may be corrupted by a torn write,
+		// a corrupted entry before it is reported
+		if errors.Is(err, common.ErrChecksumMismatch) {
+			if r.state.pos+entry.TotalSize() != current.pos {
+				return fmt.Errorf("%w: %s at offset %d", err, vfile.Name(), r.state.pos)
+			}
+			logutil.Warnf("truncate corrupted tail of %s at offset %d", vfile.Name(), r.state.pos)
+			err = io.EOF
+		}
 		if !errors.Is(err, io.EOF) {
 			return err
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
+		err2 := vfile.truncate(r.state.pos)
 		if err2 != nil {
 			panic(err2)
 		}
@@ -316,7 +333,7 @@ func (r *replayer) replayHandler(v VFile, o ReplayObserver) error {
 	}
 	if int(n) != entry.TotalSizeExpectMeta() {
 		if current.pos == r.state.pos+int(n) {
-			err2 := vfile.Truncate(int64(current.pos))
+			err2 := vfile.truncate(current.pos)
 			if err2 != nil {
 				return err
 			}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 )
@@ -317,9 +318,11 @@ func (bs *baseStore) onCommits(batches []*batch) {
 
 func (bs *baseStore) onSyncs(batches []*batch) {
 	var err error
+	start := time.Now()
 	if err = bs.file.Sync(); err != nil {
 		panic(err)
 	}
+	metric.ObserveSince(metric.WalSyncDuration, start)
 	bats := make([]*batch, len(batches))
 	copy(bats, batches)
 	bs.commitQueue <- bats
@@ -366,6 +369,7 @@ func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
 			logutil.Infof("flush queue takes %dms", e.Duration().Milliseconds())
 			e.StartTime()
 		}
+		start := time.Now()
 		appender := bs.file.GetAppender()
 		e, err := bs.PrepareEntry(e)
 		if err != nil {
@@ -384,6 +388,7 @@ func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
 		if err = appender.Commit(); err != nil {
 			panic(err)
 		}
+		metric.ObserveSince(metric.WalAppendDuration, start)
 		if e.IsPrintTime() {
 			logutil.Infof("onEntries2 takes %dms", e.Duration().Milliseconds())
 			e.StartTime()
@@ -447,6 +452,10 @@ func (bs *baseStore) TryCompact() error {
 	return bs.file.GetHistory().TryTruncate()
 }
 
+func (bs *baseStore) Backup(dir string) error {
+	return bs.file.Backup(dir)
+}
+
 func (bs *baseStore) TryTruncate(size int64) error {
 	return bs.file.TryTruncate(size)
 }
@@ -17,6 +17,7 @@ package store
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"os"
@@ -702,3 +703,120 @@ func TestLoad(t *testing.T) {
 
 	s.Close()
 }
+
+func TestReplayChecksum(t *testing.T) {
+	dir := "/tmp/logstore/testchecksum"
+	name := "mock"
+	os.RemoveAll(dir)
+	s, err := NewBaseStore(dir, name, nil)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("payload-%d", i))))
+		_, err = s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	assert.Nil(t, s.Close())
+
+	replay := func() (int, error) {
+		s, err := NewBaseStore(dir, name, nil)
+		assert.Nil(t, err)
+		defer s.Close()
+		cnt := 0
+		err = s.Replay(func(uint32, uint64, []byte, uint16, any) { cnt++ })
+		return cnt, err
+	}
+	corrupt := func(offset int64) {
+		f, err := os.OpenFile(MakeVersionFile(dir, name, 0), os.O_RDWR, os.ModePerm)
+		assert.Nil(t, err)
+		defer f.Close()
+		if offset < 0 {
+			stat, err := f.Stat()
+			assert.Nil(t, err)
+			offset += stat.Size()
+		}
+		buf := make([]byte, 1)
+		_, err = f.ReadAt(buf, offset)
+		assert.Nil(t, err)
+		buf[0] ^= 0xff
+		_, err = f.WriteAt(buf, offset)
+		assert.Nil(t, err)
+	}
+
+	cnt, err := replay()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, cnt)
+
+	// the corrupted tail is truncated
+	corrupt(-1)
+	cnt, err = replay()
+	assert.Nil(t, err)
+	assert.Equal(t, 9, cnt)
+	cnt, err = replay()
+	assert.Nil(t, err)
+	assert.Equal(t, 9, cnt)
+
+	// the corrupted entries before the tail are reported
+	corrupt(int64(entry.DescriptorSize))
+	_, err = replay()
+	assert.ErrorIs(t, err, common.ErrChecksumMismatch)
+}
+
+func TestReplayLegacyDescriptor(t *testing.T) {
+	dir := "/tmp/logstore/testlegacydesc"
+	name := "mock"
+	os.RemoveAll(dir)
+	s, err := NewBaseStore(dir, name, nil)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("payload-%d", i))))
+		_, err = s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	assert.Nil(t, s.Close())
+
+	// rewrite the entries in the layout without the checksum
+	fname := MakeVersionFile(dir, name, 0)
+	buf, err := os.ReadFile(fname)
+	assert.Nil(t, err)
+	var legacy bytes.Buffer
+	for pos := 0; pos < len(buf); {
+		desc := buf[pos : pos+entry.DescriptorSize]
+		size := int(binary.BigEndian.Uint32(desc[entry.PayloadSizeOffset:])) +
+			int(binary.BigEndian.Uint32(desc[entry.InfoSizeOffset:]))
+		binary.BigEndian.PutUint16(desc, binary.BigEndian.Uint16(desc)&^entry.ChecksumFlag)
+		legacy.Write(desc[:entry.LegacyDescriptorSize])
+		legacy.Write(buf[pos+entry.DescriptorSize : pos+entry.DescriptorSize+size])
+		pos += entry.DescriptorSize + size
+	}
+	assert.Nil(t, os.WriteFile(fname, legacy.Bytes(), os.ModePerm))
+
+	infos := 0
+	size, err := VerifyVersionFile(fname, func(*entry.Info) { infos++ })
+	assert.Nil(t, err)
+	assert.Equal(t, int64(legacy.Len()), size)
+	assert.Equal(t, 10, infos)
+
+	s, err = NewBaseStore(dir, name, nil)
+	assert.Nil(t, err)
+	defer s.Close()
+	payloads := make([]string, 0)
+	err = s.Replay(func(_ uint32, _ uint64, payload []byte, typ uint16, _ any) {
+		assert.Equal(t, entry.ETCustomizedStart, typ)
+		payloads = append(payloads, string(payload))
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(payloads))
+	assert.Equal(t, "payload-9", payloads[9])
+}
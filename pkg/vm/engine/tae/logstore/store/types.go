@@ -109,6 +109,7 @@ type File interface {
 	GetHistory() History
 	TryTruncate(int64) error
 	Load(ver int, groupId uint32, lsn uint64) (entry.Entry, error)
+	Backup(dir string) error
 }
 
 type Store interface {
@@ -123,4 +124,6 @@ type Store interface {
 	TryCompact() error
 	TryTruncate(int64) error
 	Load(groupId uint32, lsn uint64) (entry.Entry, error)
+	// Backup copies the synced log files into the dir
+	Backup(dir string) error
 }
@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+var (
+	ErrTornEntry = errors.New("tae: torn log entry")
+)
+
+// VerifyVersionFile walks the entries of a version file and checks them
+// against their checksums, fn is called with the info of each valid entry.
+// It returns the size of the valid prefix of the file, and the error of
+// the first invalid entry.
+func VerifyVersionFile(name string, fn func(*entry.Info)) (int64, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := int(stat.Size())
+	pos := 0
+	for pos < size {
+		n, err := verifyEntry(f, pos, size, fn)
+		if err != nil {
+			return int64(pos), err
+		}
+		pos += n
+	}
+	return int64(pos), nil
+}
+
+// verifyEntry checks the entry at pos, and returns its size
+func verifyEntry(f *os.File, pos, size int, fn func(*entry.Info)) (int, error) {
+	e := entry.GetBase()
+	defer e.Free()
+	if pos+entry.LegacyDescriptorSize > size {
+		return 0, ErrTornEntry
+	}
+	if _, err := e.ReadMetaAt(f, int64(pos)); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, ErrTornEntry
+		}
+		return 0, err
+	}
+	if pos+e.TotalSize() > size {
+		return 0, ErrTornEntry
+	}
+	if _, err := e.ReadAt(f, pos); err != nil {
+		return 0, err
+	}
+	if fn != nil && e.GetInfoSize() > 0 {
+		fn(entry.Unmarshal(e.GetInfoBuf()))
+	}
+	return e.TotalSize(), nil
+}
+
+// ParseVersionFile returns the name of the store and the version of a version file
+func ParseVersionFile(name string) (string, int, error) {
+	for i := len(name) - len(suffix) - 1; i > 0; i-- {
+		if name[i] != '-' {
+			continue
+		}
+		version, err := ParseVersion(name, name[:i], suffix)
+		if err != nil {
+			return "", 0, err
+		}
+		return name[:i], version, nil
+	}
+	return "", 0, errors.New("parse version error")
+}
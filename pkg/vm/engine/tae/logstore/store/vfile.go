@@ -278,6 +278,41 @@ func (vf *vFile) Write(b []byte) (n int, err error) {
 	return
 }
 
+// truncate drops the tail of the file which is torn or corrupted
+func (vf *vFile) truncate(size int) error {
+	vf.Lock()
+	defer vf.Unlock()
+	if err := vf.File.Truncate(int64(size)); err != nil {
+		return err
+	}
+	vf.size = size
+	vf.syncpos = size
+	return nil
+}
+
+func (vf *vFile) SyncedSize() int64 {
+	vf.RLock()
+	defer vf.RUnlock()
+	return int64(vf.syncpos)
+}
+
+// CopyTo copies the first size bytes of the file into the file named name
+func (vf *vFile) CopyTo(name string, size int64) (err error) {
+	dst, err := os.Create(name)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := dst.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if _, err = io.Copy(dst, io.NewSectionReader(vf.File, 0, size)); err != nil {
+		return
+	}
+	return dst.Sync()
+}
+
 func (vf *vFile) SizeLocked() int {
 	vf.RLock()
 	defer vf.RUnlock()
@@ -342,8 +377,7 @@ func (vf *vFile) Load(groupId uint32, lsn uint64) (entry.Entry, error) {
 		return nil, err
 	}
 	entry := entry.GetBase()
-	metaBuf := entry.GetMetaBuf()
-	_, err = vf.ReadAt(metaBuf, int64(offset))
+	_, err = entry.ReadMetaAt(vf, int64(offset))
 	// fmt.Printf("%p|read meta [%v,%v]\n", vf, offset, offset+n)
 	if err != nil {
 		return nil, err
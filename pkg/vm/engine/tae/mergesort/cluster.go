@@ -0,0 +1,140 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+// compareRow compares two rows on the sort key columns, nulls first
+func compareRow(lkeys []*vector.Vector, lrow uint32, rkeys []*vector.Vector, rrow uint32) int {
+	for i := range lkeys {
+		lnull := nulls.Contains(lkeys[i].Nsp, uint64(lrow))
+		rnull := nulls.Contains(rkeys[i].Nsp, uint64(rrow))
+		if lnull || rnull {
+			if lnull && rnull {
+				continue
+			}
+			if lnull {
+				return -1
+			}
+			return 1
+		}
+		lv := compute.GetValue(lkeys[i], lrow)
+		rv := compute.GetValue(rkeys[i], rrow)
+		if ret := common.CompareGeneric(lv, rv, lkeys[i].Typ); ret != 0 {
+			return ret
+		}
+	}
+	return 0
+}
+
+// SortBlockColumnsByKeys sorts the columns of a block on the composite key
+// made of keys, in order. Rows with equal keys keep their original order
+func SortBlockColumnsByKeys(cols []*vector.Vector, keys []int) error {
+	keyCols := make([]*vector.Vector, len(keys))
+	for i, key := range keys {
+		keyCols[i] = cols[key]
+	}
+	sortedIdx := make([]uint32, vector.Length(cols[keys[0]]))
+	for i := range sortedIdx {
+		sortedIdx[i] = uint32(i)
+	}
+	sort.SliceStable(sortedIdx, func(i, j int) bool {
+		return compareRow(keyCols, sortedIdx[i], keyCols, sortedIdx[j]) < 0
+	})
+	for i := range cols {
+		shuffleColumn(cols[i], sortedIdx)
+	}
+	return nil
+}
+
+// MergeByKeys merges blocks already sorted on the composite key. keyCols[i]
+// holds the blocks of the i-th key column. It returns the source block of
+// each merged row, to be used with ShuffleColumn on every column, and the
+// mapping from the global offset of each source row to its merged offset
+func MergeByKeys(keyCols [][]*vector.Vector, fromLayout []uint32) (src []uint32, mapping []uint32) {
+	total := uint32(0)
+	offset := make([]uint32, len(fromLayout))
+	for i, rows := range fromLayout {
+		offset[i] = total
+		total += rows
+	}
+	h := &rowHeap{
+		keys:    make([][]*vector.Vector, len(fromLayout)),
+		cursors: make([]rowCursor, 0, len(fromLayout)),
+	}
+	for i, rows := range fromLayout {
+		h.keys[i] = make([]*vector.Vector, len(keyCols))
+		for j := range keyCols {
+			h.keys[i][j] = keyCols[j][i]
+		}
+		if rows > 0 {
+			h.cursors = append(h.cursors, rowCursor{blk: uint32(i)})
+		}
+	}
+	heap.Init(h)
+	src = make([]uint32, total)
+	mapping = make([]uint32, total)
+	// The rows of a block are consumed in their sorted order, which is what
+	// ShuffleColumn expects when it consumes each source sequentially
+	for k := uint32(0); k < total; k++ {
+		top := &h.cursors[0]
+		src[k] = top.blk
+		mapping[offset[top.blk]+top.row] = k
+		if top.row++; top.row < fromLayout[top.blk] {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return
+}
+
+// rowCursor is the next row of a block to merge
+type rowCursor struct {
+	blk uint32
+	row uint32
+}
+
+// rowHeap orders the cursors of the blocks on the composite key, the equal
+// keys are taken from the blocks in their order
+type rowHeap struct {
+	keys    [][]*vector.Vector
+	cursors []rowCursor
+}
+
+func (h *rowHeap) Len() int      { return len(h.cursors) }
+func (h *rowHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+func (h *rowHeap) Less(i, j int) bool {
+	l, r := h.cursors[i], h.cursors[j]
+	if ret := compareRow(h.keys[l.blk], l.row, h.keys[r.blk], r.row); ret != 0 {
+		return ret < 0
+	}
+	return l.blk < r.blk
+}
+func (h *rowHeap) Push(x any) { h.cursors = append(h.cursors, x.(rowCursor)) }
+func (h *rowHeap) Pop() any {
+	n := len(h.cursors) - 1
+	x := h.cursors[n]
+	h.cursors = h.cursors[:n]
+	return x
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/times"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint16s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint64s"
@@ -58,9 +59,11 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 		float64s.Sort(cols[pk], sortedIdx)
 	case types.T_date:
 		dates.Sort(cols[pk], sortedIdx)
+	case types.T_time:
+		times.Sort(cols[pk], sortedIdx)
 	case types.T_datetime:
 		datetimes.Sort(cols[pk], sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		varchar.Sort(cols[pk], sortedIdx)
 	}
 
@@ -68,39 +71,45 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 		if i == pk {
 			continue
 		}
-		switch cols[i].Typ.Oid {
-		case types.T_int8:
-			int8s.Shuffle(cols[i], sortedIdx)
-		case types.T_int16:
-			int16s.Shuffle(cols[i], sortedIdx)
-		case types.T_int32:
-			int32s.Shuffle(cols[i], sortedIdx)
-		case types.T_int64:
-			int64s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint8:
-			uint8s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint16:
-			uint16s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint32:
-			uint32s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint64:
-			uint64s.Shuffle(cols[i], sortedIdx)
-		case types.T_float32:
-			float32s.Shuffle(cols[i], sortedIdx)
-		case types.T_float64:
-			float64s.Shuffle(cols[i], sortedIdx)
-		case types.T_date:
-			dates.Shuffle(cols[i], sortedIdx)
-		case types.T_datetime:
-			datetimes.Shuffle(cols[i], sortedIdx)
-		case types.T_char, types.T_json, types.T_varchar:
-			varchar.Shuffle(cols[i], sortedIdx)
-		}
+		shuffleColumn(cols[i], sortedIdx)
 	}
 
 	return nil
 }
 
+func shuffleColumn(col *vector.Vector, sortedIdx []uint32) {
+	switch col.Typ.Oid {
+	case types.T_int8:
+		int8s.Shuffle(col, sortedIdx)
+	case types.T_int16:
+		int16s.Shuffle(col, sortedIdx)
+	case types.T_int32:
+		int32s.Shuffle(col, sortedIdx)
+	case types.T_int64:
+		int64s.Shuffle(col, sortedIdx)
+	case types.T_uint8:
+		uint8s.Shuffle(col, sortedIdx)
+	case types.T_uint16:
+		uint16s.Shuffle(col, sortedIdx)
+	case types.T_uint32:
+		uint32s.Shuffle(col, sortedIdx)
+	case types.T_uint64:
+		uint64s.Shuffle(col, sortedIdx)
+	case types.T_float32:
+		float32s.Shuffle(col, sortedIdx)
+	case types.T_float64:
+		float64s.Shuffle(col, sortedIdx)
+	case types.T_date:
+		dates.Shuffle(col, sortedIdx)
+	case types.T_time:
+		times.Shuffle(col, sortedIdx)
+	case types.T_datetime:
+		datetimes.Shuffle(col, sortedIdx)
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+		varchar.Shuffle(col, sortedIdx)
+	}
+}
+
 func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, mapping []uint32) {
 	switch column[0].Typ.Oid {
 	case types.T_int8:
@@ -125,9 +134,11 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout,
 		ret, mapping = float64s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_date:
 		ret, mapping = dates.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.T_time:
+		ret, mapping = times.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_datetime:
 		ret, mapping = datetimes.Merge(column, sortedIdx, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout)
 	}
 	return
@@ -157,9 +168,11 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 		ret = float64s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_date:
 		ret = dates.Multiplex(column, sortedIdx, fromLayout, toLayout)
+	case types.T_time:
+		ret = times.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_datetime:
 		ret = datetimes.Multiplex(column, sortedIdx, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		ret = varchar.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	}
 	return
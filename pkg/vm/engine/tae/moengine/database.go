@@ -15,6 +15,9 @@
 package moengine
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -57,10 +60,49 @@ func (db *txnDatabase) Create(_ uint64, name string, defs []engine.TableDef, _ e
 	schema := TableInfoToSchema(&info)
 	schema.BlockMaxRows = 40000
 	schema.SegmentMaxBlocks = 20
+	for _, def := range defs {
+		switch d := def.(type) {
+		case *engine.ViewDef:
+			schema.Relkind = catalog.SystemViewRel
+			schema.Createsql = d.View
+		case *engine.PropertiesDef:
+			for _, property := range d.Properties {
+				switch property.Key {
+				case CompactionPolicyProperty:
+					schema.CompactionPolicy = property.Value
+				case engine.ClusterByProperty:
+					if err = setClusterBy(schema, property.Value); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
 	_, err = db.handle.CreateRelation(schema)
 	return err
 }
 
+// setClusterBy resolves the comma separated cluster by columns of the schema
+func setClusterBy(schema *catalog.Schema, columns string) error {
+	for _, name := range strings.Split(columns, ",") {
+		idx := schema.GetColIdx(strings.TrimSpace(name))
+		if idx < 0 {
+			return fmt.Errorf("%w: unknown cluster by column %s", catalog.ErrValidation, name)
+		}
+		schema.ClusterBy = append(schema.ClusterBy, uint16(idx))
+	}
+	return nil
+}
+
+// clusterByColumns returns the names of the cluster by columns of the schema
+func clusterByColumns(schema *catalog.Schema) []string {
+	names := make([]string, len(schema.ClusterBy))
+	for i, idx := range schema.ClusterBy {
+		names[i] = schema.ColDefs[idx].Name
+	}
+	return names
+}
+
 func (db *txnDatabase) Delete(_ uint64, name string, _ engine.Snapshot) error {
 	_, err := db.handle.DropRelationByName(name)
 	return err
@@ -18,12 +18,15 @@ import (
 	"runtime"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
-	_ engine.Engine = (*txnEngine)(nil)
+	_ engine.Engine    = (*txnEngine)(nil)
+	_ engine.Backuper  = (*txnEngine)(nil)
+	_ engine.Compactor = (*txnEngine)(nil)
 )
 
 func NewEngine(impl *db.DB) *txnEngine {
@@ -32,6 +35,15 @@ func NewEngine(impl *db.DB) *txnEngine {
 	}
 }
 
+func (e *txnEngine) Backup(dir string) (err error) {
+	_, err = e.impl.Backup(dir)
+	return
+}
+
+func (e *txnEngine) Compact(dbName, tblName string) error {
+	return e.impl.Compact(dbName, tblName)
+}
+
 func (e *txnEngine) Delete(_ uint64, name string, ctx engine.Snapshot) (err error) {
 	var txn txnif.AsyncTxn
 	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
@@ -64,6 +76,13 @@ func (e *txnEngine) Database(name string, ctx engine.Snapshot) (db engine.Databa
 	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
 		panic(err)
 	}
+	// the tables of information_schema are generated from mo_catalog on read
+	if name == infoschema.Name {
+		if _, err = txn.GetDatabase(name); err != nil {
+			return nil, err
+		}
+		return infoschema.New(e, ctx), nil
+	}
 	h, err := txn.GetDatabase(name)
 	if err != nil {
 		return nil, err
@@ -5,9 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/adaptor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
@@ -15,6 +19,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -75,3 +82,160 @@ func TestEngine(t *testing.T) {
 	}
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+func TestInformationSchema(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, infoschema.Name, 0, txn.GetCtx()))
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int32, Size: 4}, Primary: true}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type{Oid: types.T_varchar, Size: 24}}},
+	}
+	assert.Nil(t, dbase.Create(0, "t", defs, txn.GetCtx()))
+
+	is, err := e.Database(infoschema.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Contains(t, is.Relations(txn.GetCtx()), "columns")
+	assert.NotNil(t, is.Create(0, "t", defs, txn.GetCtx()))
+
+	read := func(name string, attrs ...string) [][]string {
+		rel, err := is.Relation(name, txn.GetCtx())
+		assert.Nil(t, err)
+		bat, err := rel.NewReader(1, nil, nil, txn.GetCtx())[0].Read(make([]uint64, len(attrs)), attrs)
+		assert.Nil(t, err)
+		var rows [][]string
+		for i := range bat.Zs {
+			row := make([]string, len(attrs))
+			for j, vec := range bat.Vecs {
+				switch col := vec.Col.(type) {
+				case *types.Bytes:
+					row[j] = string(col.Get(int64(i)))
+				case []int64:
+					row[j] = fmt.Sprint(col[i])
+				}
+			}
+			rows = append(rows, row)
+		}
+		return rows
+	}
+	assert.Contains(t, read("schemata", "schema_name"), []string{"db"})
+	assert.Contains(t, read("tables", "table_schema", "table_name", "table_type"), []string{"db", "t", "BASE TABLE"})
+	assert.Contains(t, read("tables", "table_schema", "table_name", "table_type"), []string{infoschema.Name, "views", "SYSTEM VIEW"})
+	cols := read("columns", "table_schema", "table_name", "column_name", "ordinal_position", "is_nullable", "column_type", "column_key")
+	assert.Contains(t, cols, []string{"db", "t", "a", "1", "NO", "int", "PRI"})
+	assert.Contains(t, cols, []string{"db", "t", "b", "2", "YES", "varchar", ""})
+	assert.Contains(t, read("statistics", "table_schema", "table_name", "index_name", "column_name"), []string{"db", "t", "PRIMARY", "a"})
+	assert.Contains(t, read("key_column_usage", "table_name", "column_name", "ordinal_position"), []string{"t", "a", "1"})
+	assert.Contains(t, read("collations", "collation_name", "id"), []string{"utf8mb4_bin", "46"})
+	assert.Contains(t, read("character_sets", "character_set_name", "maxlen"), []string{"utf8mb4", "4"})
+	assert.Empty(t, read("views", "table_name"))
+
+	viewDefs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type{Oid: types.T_varchar, Size: 24}}},
+		&engine.ViewDef{View: "create view v as select b from t where a > 1"},
+	}
+	assert.Nil(t, dbase.Create(0, "v", viewDefs, txn.GetCtx()))
+	rel, err := dbase.Relation("v", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Contains(t, rel.TableDefs(txn.GetCtx()), viewDefs[1])
+	assert.Contains(t, read("tables", "table_schema", "table_name", "table_type"), []string{"db", "v", "VIEW"})
+	assert.Contains(t, read("columns", "table_name", "column_name", "column_key"), []string{"v", "b", ""})
+	assert.Equal(t, [][]string{{"db", "v", "select b from t where a > 1"}}, read("views", "table_schema", "table_name", "view_definition"))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestClusterByProperty(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	clusterBy := &engine.PropertiesDef{Properties: []engine.Property{{
+		Key:   engine.ClusterByProperty,
+		Value: fmt.Sprintf("%s,%s", mockTbl.Columns[2].Name, mockTbl.Columns[0].Name),
+	}}}
+	assert.Nil(t, dbase.Create(0, "t1", append(defs, clusterBy), txn.GetCtx()))
+	rel, err := dbase.Relation("t1", txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	assert.Equal(t, []uint16{2, 0}, schema.ClusterBy)
+
+	clusterBy.Properties[0].Value = "xxx"
+	assert.Error(t, dbase.Create(0, "t2", append(defs, clusterBy), txn.GetCtx()))
+	assert.Nil(t, txn.Rollback())
+}
+
+func TestSortedReader(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	clusterBy := &engine.PropertiesDef{Properties: []engine.Property{{
+		Key:   engine.ClusterByProperty,
+		Value: "b",
+	}}}
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int32, Size: 4}, Primary: true}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type{Oid: types.T_int32, Size: 4}}},
+		clusterBy,
+	}
+	assert.Nil(t, dbase.Create(0, "t", defs, txn.GetCtx()))
+	rel, err := dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Contains(t, rel.TableDefs(txn.GetCtx()), clusterBy)
+
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	for i := int32(0); i < 20; i++ {
+		assert.Nil(t, vector.Append(bat.Vecs[0], []int32{i}))
+		assert.Nil(t, vector.Append(bat.Vecs[1], []int32{(i * 7) % 20}))
+	}
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	readers := rel.(engine.SortedRelation).NewSortedReader(2, mheap.New(guest.New(1<<30, host.New(1<<30))), txn.GetCtx())
+	rows := 0
+	for _, reader := range readers {
+		for {
+			// the key column is read even if it is not asked for
+			bat, err := reader.Read([]uint64{1}, []string{"a"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			assert.Equal(t, 1, len(bat.Vecs))
+			as := bat.Vecs[0].Col.([]int32)
+			for i, a := range as {
+				if i > 0 {
+					assert.Less(t, (as[i-1]*7)%20, (a*7)%20)
+				}
+			}
+			rows += len(as)
+		}
+	}
+	assert.Equal(t, 20, rows)
+	assert.Nil(t, txn.Commit())
+}
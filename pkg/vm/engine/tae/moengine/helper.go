@@ -33,6 +33,7 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 		col := aoe.ColumnInfo{
 			Name: colDef.Name,
 			Type: colDef.Type,
+			Alg:  int(colDef.CompressAlgo),
 		}
 		if idx == int(schema.PrimaryKey) {
 			col.PrimaryKey = true
@@ -85,9 +86,10 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 	schema := catalog.NewEmptySchema(info.Name)
 	for idx, colInfo := range info.Columns {
 		newInfo := &catalog.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:         colInfo.Name,
+			Idx:          idx,
+			Type:         colInfo.Type,
+			CompressAlgo: uint8(colInfo.Alg),
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = int32(idx)
@@ -16,8 +16,12 @@ package moengine
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
 
+	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -53,7 +57,85 @@ func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error
 	r.it.Next()
 	r.it.Unlock()
 	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	if len(r.keys) == 0 {
+		return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	}
+	return r.readSorted(block, refCount, attrs)
+}
+
+// readSorted reads a block with its rows sorted on the keys of the reader.
+// The keys missing from attrs are read as well, and dropped once sorted
+func (r *txnReader) readSorted(block *txnBlock, refCount []uint64, attrs []string) (*batch.Batch, error) {
+	cols, cs := attrs, refCount
+	keys := make([]int, len(r.keys))
+	for i, key := range r.keys {
+		keys[i] = -1
+		for j, attr := range attrs {
+			if attr == key {
+				keys[i] = j
+				break
+			}
+		}
+		if keys[i] < 0 {
+			keys[i] = len(cols)
+			cols = append(cols[:len(cols):len(cols)], key)
+			cs = append(cs[:len(cs):len(cs)], 0)
+		}
+	}
+	bat, err := block.Read(cs, cols, r.compressed, r.decompressed)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.sortByKeys(bat, keys); err != nil {
+		return nil, err
+	}
+	bat.Attrs, bat.Vecs = bat.Attrs[:len(attrs)], bat.Vecs[:len(attrs)]
+	return bat, nil
+}
+
+// sortByKeys sorts the rows of the batch on the key columns, unless they are
+// sorted already as the sealed blocks are. The values are ordered the way
+// the operators merging sorted batches compare them
+func (r *txnReader) sortByKeys(bat *batch.Batch, keys []int) error {
+	cmps := make([]compare.Compare, len(keys))
+	for i, key := range keys {
+		if cmps[i] = compare.New(bat.Vecs[key].Typ.Oid, false); cmps[i] == nil {
+			return fmt.Errorf("cannot sort on the column %s of type %s", bat.Attrs[key], bat.Vecs[key].Typ)
+		}
+		cmps[i].Set(0, bat.Vecs[key])
+	}
+	compareRows := func(i, j int64) int {
+		for _, cmp := range cmps {
+			if ret := cmp.Compare(0, 0, i, j); ret != 0 {
+				return ret
+			}
+		}
+		return 0
+	}
+	n := int64(vector.Length(bat.Vecs[keys[0]]))
+	sorted := true
+	for i := int64(1); i < n; i++ {
+		if compareRows(i-1, i) > 0 {
+			sorted = false
+			break
+		}
+	}
+	if sorted {
+		return nil
+	}
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	sort.SliceStable(sels, func(i, j int) bool {
+		return compareRows(sels[i], sels[j]) < 0
+	})
+	for _, vec := range bat.Vecs {
+		if err := vector.Shuffle(vec, sels, r.mp); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
@@ -15,16 +15,21 @@
 package moengine
 
 import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ Relation              = (*txnRelation)(nil)
+	_ engine.SortedRelation = (*txnRelation)(nil)
 )
 
 func newRelation(h handle.Relation) *txnRelation {
@@ -71,6 +76,15 @@ func (rel *txnRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
 	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
 	info := SchemaToTableInfo(schema)
 	_, _, _, _, defs, _ := helper.UnTransfer(info)
+	if schema.Relkind == catalog.SystemViewRel {
+		defs = append(defs, &engine.ViewDef{View: schema.Createsql})
+	}
+	if len(schema.ClusterBy) > 0 {
+		defs = append(defs, &engine.PropertiesDef{Properties: []engine.Property{{
+			Key:   engine.ClusterByProperty,
+			Value: strings.Join(clusterByColumns(schema), ","),
+		}}})
+	}
 	return defs
 }
 
@@ -96,6 +110,7 @@ func (rel *txnRelation) Attribute() []engine.Attribute {
 	for idx, attr := range attrs {
 		attr.Name = meta.GetSchema().ColDefs[idx].Name
 		attr.Type = meta.GetSchema().ColDefs[idx].Type
+		attr.Alg = compress.T(meta.GetSchema().ColDefs[idx].CompressAlgo)
 		attrs[idx] = attr
 	}
 	return attrs
@@ -105,6 +120,14 @@ func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) err
 	return rel.handle.Append(bat)
 }
 
+func (rel *txnRelation) DeleteByPrimaryKey(v any) error {
+	id, row, err := rel.handle.GetByFilter(handle.NewEQFilter(v))
+	if err != nil {
+		return err
+	}
+	return rel.handle.RangeDelete(id, row, row)
+}
+
 func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
 	it := rel.handle.MakeBlockIt()
 	for i := 0; i < num; i++ {
@@ -113,3 +136,15 @@ func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.S
 	}
 	return
 }
+
+func (rel *txnRelation) NewSortedReader(num int, mp *mheap.Mheap, _ engine.Snapshot) (rds []engine.Reader) {
+	keys := clusterByColumns(rel.handle.GetMeta().(*catalog.TableEntry).GetSchema())
+	it := rel.handle.MakeBlockIt()
+	for i := 0; i < num; i++ {
+		reader := newReader(rel.handle, it)
+		reader.keys = keys
+		reader.mp = mp
+		rds = append(rds, reader)
+	}
+	return
+}
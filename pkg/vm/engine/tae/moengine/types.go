@@ -20,8 +20,13 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+// CompactionPolicyProperty is the table property picking the merge policy
+// of the table, e.g. properties("compaction_policy"="time")
+const CompactionPolicyProperty = "compaction_policy"
+
 type Txn interface {
 	GetCtx() []byte
 	GetID() uint64
@@ -37,6 +42,12 @@ type TxnEngine interface {
 	StartTxn(info []byte) (txn Txn, err error)
 }
 
+// Relation is the relation of tae that can delete a row by its primary key
+type Relation interface {
+	engine.Relation
+	DeleteByPrimaryKey(v any) error
+}
+
 var _ TxnEngine = &txnEngine{}
 
 type txnEngine struct {
@@ -60,4 +71,9 @@ type txnReader struct {
 	it           handle.BlockIt
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
+	// keys are the cluster by columns the rows of a batch are sorted on,
+	// empty if the batches are read as they are stored
+	keys []string
+	// mp is the mheap of the caller the sorted rows are shuffled in
+	mp *mheap.Mheap
 }
@@ -18,6 +18,8 @@ type CacheCfg struct {
 	IndexCapacity  uint64 `toml:"index-cache-size"`
 	InsertCapacity uint64 `toml:"insert-cache-size"`
 	TxnCapacity    uint64 `toml:"txn-cache-size"`
+	// EvictPolicy is the policy of all the caches: fifo, lru-k or 2q
+	EvictPolicy string `toml:"evict-policy"`
 }
 
 type StorageCfg struct {
@@ -31,6 +33,13 @@ type CheckpointCfg struct {
 	ExecutionLevels    int16 `toml:"execution-levels"`
 	CatalogUnCkpLimit  int64 `toml:"catalog-unckp-limit"`
 	CatalogCkpInterval int64 `toml:"catalog-ckp-interval"`
+	// Blocks scored in [MinScore, MaxScore] are spread over the levels
+	MinScore int `toml:"min-score"`
+	MaxScore int `toml:"max-score"`
+	// The knobs of the segment merge policies a table picks in its schema
+	MergeInterval    int64   `toml:"merge-interval"` // millisecond
+	MergeDeleteRatio float64 `toml:"merge-delete-ratio"`
+	MergeMaxWriteAmp float64 `toml:"merge-max-write-amp"`
 }
 
 type SchedulerCfg struct {
@@ -26,6 +26,16 @@ func (o *Options) FillDefaults(dirname string) *Options {
 			TxnCapacity:    DefaultTxnCacheSize,
 		}
 	}
+	// The caches are separate pools, an unset one gets the default size
+	if o.CacheCfg.IndexCapacity == 0 {
+		o.CacheCfg.IndexCapacity = DefaultIndexCacheSize
+	}
+	if o.CacheCfg.InsertCapacity == 0 {
+		o.CacheCfg.InsertCapacity = DefaultMTCacheSize
+	}
+	if o.CacheCfg.TxnCapacity == 0 {
+		o.CacheCfg.TxnCapacity = DefaultTxnCacheSize
+	}
 
 	if o.StorageCfg == nil {
 		o.StorageCfg = &StorageCfg{
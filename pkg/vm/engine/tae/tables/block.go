@@ -27,6 +27,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/indexwrapper"
@@ -35,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -47,21 +49,30 @@ import (
 type dataBlock struct {
 	*sync.RWMutex
 	common.ClosedState
-	meta      *catalog.BlockEntry
-	node      *appendableNode
-	file      file.Block
-	colFiles  map[int]common.IRWFile
-	bufMgr    base.INodeManager
-	scheduler tasks.TaskScheduler
-	index     indexwrapper.Index
-	mvcc      *updates.MVCCHandle
-	nice      uint32
-	ckpTs     uint64
-}
-
-func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
+	meta        *catalog.BlockEntry
+	node        *appendableNode
+	file        file.Block
+	colFiles    map[int]common.IRWFile
+	bufMgr      base.INodeManager
+	indexBufMgr base.INodeManager
+	scheduler   tasks.TaskScheduler
+	index       indexwrapper.Index
+	mvcc        *updates.MVCCHandle
+	nice        uint32
+	ckpTs       uint64
+	// pkOrder is the order of the rows of a sealed block on the primary key
+	// when the block is sorted on the cluster key. The primary key of a
+	// sealed block never changes, it is built on the first lookup
+	pkOrder   []uint32
+	pkOrderMu sync.Mutex
+}
+
+func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr, indexBufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
 	colCnt := len(meta.GetSchema().ColDefs)
 	indexCnt := make(map[int]int)
+	for _, key := range meta.GetSchema().ClusterBy {
+		indexCnt[int(key)] = 1
+	}
 	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
@@ -72,6 +83,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		if colBlk, err := file.OpenColumn(i); err != nil {
 			panic(err)
 		} else {
+			colBlk.SetCompressAlgo(int(meta.GetSchema().ColDefs[i].CompressAlgo))
 			colFiles[i], err = colBlk.OpenDataFile()
 			if err != nil {
 				panic(err)
@@ -81,13 +93,14 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	}
 	var node *appendableNode
 	block := &dataBlock{
-		RWMutex:   new(sync.RWMutex),
-		meta:      meta,
-		file:      file,
-		colFiles:  colFiles,
-		mvcc:      updates.NewMVCCHandle(meta),
-		scheduler: scheduler,
-		bufMgr:    bufMgr,
+		RWMutex:     new(sync.RWMutex),
+		meta:        meta,
+		file:        file,
+		colFiles:    colFiles,
+		mvcc:        updates.NewMVCCHandle(meta),
+		scheduler:   scheduler,
+		bufMgr:      bufMgr,
+		indexBufMgr: indexBufMgr,
 	}
 	if meta.IsAppendable() {
 		block.mvcc.SetDeletesListener(block.ABlkApplyDeleteToIndex)
@@ -118,6 +131,13 @@ func (blk *dataBlock) ReplayData() (err error) {
 func (blk *dataBlock) GetMeta() any                 { return blk.meta }
 func (blk *dataBlock) GetBufMgr() base.INodeManager { return blk.bufMgr }
 
+func (blk *dataBlock) GetIndexBufMgr() base.INodeManager {
+	if blk.indexBufMgr == nil {
+		return blk.bufMgr
+	}
+	return blk.indexBufMgr
+}
+
 func (blk *dataBlock) SetMaxCheckpointTS(ts uint64) {
 	atomic.StoreUint64(&blk.ckpTs, ts)
 }
@@ -288,6 +308,10 @@ func (blk *dataBlock) GetTotalChanges() int {
 	return int(blk.mvcc.GetChangeNodeCnt())
 }
 
+func (blk *dataBlock) GetDeleteCnt() uint32 {
+	return blk.mvcc.GetDeleteCnt()
+}
+
 func (blk *dataBlock) Rows(txn txnif.AsyncTxn, coarse bool) int {
 	if blk.meta.IsAppendable() {
 		rows := int(blk.node.Rows(txn, coarse))
@@ -624,9 +648,21 @@ func (blk *dataBlock) getVectorWithBuffer(colIdx int, compressed, decompressed *
 		return
 	}
 	vec = &wrapper.Vector
+	err = blk.loadLob(colIdx, vec)
 	return
 }
 
+func (blk *dataBlock) loadLob(colIdx int, vec *movec.Vector) (err error) {
+	if !vec.Typ.Oid.IsLob() {
+		return
+	}
+	lob, err := blk.file.LoadLob(colIdx)
+	if err != nil {
+		return
+	}
+	return compute.MergeLob(vec, lob)
+}
+
 func (blk *dataBlock) getVectorWrapper(colIdx int) (wrapper *vector.VectorWrapper, err error) {
 	dataFile := blk.colFiles[colIdx]
 
@@ -636,6 +672,7 @@ func (blk *dataBlock) getVectorWrapper(colIdx int) (wrapper *vector.VectorWrappe
 	if err != nil {
 		return
 	}
+	err = blk.loadLob(colIdx, &wrapper.Vector)
 
 	return
 }
@@ -691,13 +728,10 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 		return
 	}
 	err = nil
-	pkColumn, err := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
+	offset, existed, err := blk.checkPKExists(filter.Val)
 	if err != nil {
 		return
 	}
-	defer common.GPool.Free(pkColumn.MNode)
-	col := &pkColumn.Vector
-	offset, existed := compute.CheckRowExists(col, filter.Val, nil)
 	if !existed {
 		err = txnbase.ErrNotFound
 		return
@@ -715,6 +749,80 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 	return
 }
 
+// checkPKExists looks up the value in the primary key column, the strings
+// of a dictionary encoded column are compared on their codes
+func (blk *dataBlock) checkPKExists(val any) (offset uint32, existed bool, err error) {
+	colIdx := int(blk.meta.GetSchema().PrimaryKey)
+	key, ok := val.([]byte)
+	if !ok {
+		var pkColumn *vector.VectorWrapper
+		if pkColumn, err = blk.getVectorWrapper(colIdx); err != nil {
+			return
+		}
+		defer common.GPool.Free(pkColumn.MNode)
+		offset, existed = blk.findPK(&pkColumn.Vector, val, nil)
+		return
+	}
+	data, err := blk.loadColumnData(colIdx)
+	if err != nil {
+		return
+	}
+	if codec.IsEncoded(data) {
+		var col *codec.Column
+		if col, err = codec.Open(data); err != nil {
+			return
+		}
+		if col.Kind() == codec.Dict {
+			var rows *roaring.Bitmap
+			if rows, err = col.FilterEq(key); err != nil || rows.IsEmpty() {
+				return
+			}
+			return rows.Minimum(), true, nil
+		}
+	}
+	vec, err := codec.DecodeVector(data)
+	if err != nil {
+		return
+	}
+	if err = blk.loadLob(colIdx, vec); err != nil {
+		return
+	}
+	offset, existed = blk.findPK(vec, val, nil)
+	return
+}
+
+// findPK looks up the value in the primary key column of a sealed block.
+// The column is only ordered on its values when the table is not clustered
+// on other columns first, otherwise it is searched through pkOrder
+func (blk *dataBlock) findPK(pks *movec.Vector, val any, deletes *roaring.Bitmap) (offset uint32, existed bool) {
+	if blk.meta.GetSchema().IsPKSorted() {
+		return compute.CheckRowExists(pks, val, deletes)
+	}
+	blk.pkOrderMu.Lock()
+	if blk.pkOrder == nil {
+		blk.pkOrder = compute.SortedOrder(pks)
+	}
+	order := blk.pkOrder
+	blk.pkOrderMu.Unlock()
+	return compute.SearchRowExists(pks, order, val, deletes)
+}
+
+// loadColumnData returns the decompressed data of the column block
+func (blk *dataBlock) loadColumnData(colIdx int) (data []byte, err error) {
+	dataFile := blk.colFiles[colIdx]
+	stat := dataFile.Stat()
+	buf := make([]byte, stat.Size())
+	if _, err = dataFile.Read(buf); err != nil {
+		return
+	}
+	algo := stat.CompressAlgo()
+	if algo == compress.None {
+		return buf, nil
+	}
+	data = make([]byte, stat.OriginSize())
+	return compress.Decompress(buf, data, algo)
+}
+
 func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (offset uint32, err error) {
 	if filter.Op != handle.FilterEq {
 		panic("logic error")
@@ -725,6 +833,10 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(txn.GetStartTS(), filter)
 }
 
+func (blk *dataBlock) MayContainsInColumn(colIdx uint16, key any) bool {
+	return blk.index.MayContainsInColumn(colIdx, key)
+}
+
 func (blk *dataBlock) ABlkApplyDeleteToIndex(gen common.RowGen, ts uint64) (err error) {
 	var row uint32
 	err = blk.node.DoWithPin(func() (err error) {
@@ -777,7 +889,7 @@ func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *movec.Vector, rowmask
 	}
 	defer view.Free()
 	deduplicate := func(v any, _ uint32) error {
-		if _, existed := compute.CheckRowExists(view.AppliedVec, v, view.DeleteMask); existed {
+		if _, existed := blk.findPK(view.AppliedVec, v, view.DeleteMask); existed {
 			return txnbase.ErrDuplicated
 		}
 		return nil
@@ -11,6 +11,9 @@ import (
 type immutableIndex struct {
 	zmReader *ZMReader
 	bfReader *BFReader
+	// zmReaders are the zone maps of the columns, the primary key and the
+	// cluster key columns of a clustered table
+	zmReaders map[uint16]*ZMReader
 }
 
 func NewImmutableIndex() *immutableIndex {
@@ -62,14 +65,24 @@ func (index *immutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bi
 	return
 }
 
+func (index *immutableIndex) MayContainsInColumn(colIdx uint16, key any) bool {
+	reader := index.zmReaders[colIdx]
+	if reader == nil {
+		return true
+	}
+	return reader.Contains(key)
+}
+
 func (index *immutableIndex) Close() (err error) {
 	// TODO
 	return
 }
 
 func (index *immutableIndex) Destroy() (err error) {
-	if err = index.zmReader.Destroy(); err != nil {
-		return
+	for _, reader := range index.zmReaders {
+		if err = reader.Destroy(); err != nil {
+			return
+		}
 	}
 	err = index.bfReader.Destroy()
 	return
@@ -83,11 +96,12 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 	}
 	metas := idxMeta.(*IndicesMeta)
 	entry := blk.GetMeta().(*catalog.BlockEntry)
-	colFile, err := file.OpenColumn(int(entry.GetSchema().PrimaryKey))
-	if err != nil {
-		return
-	}
+	index.zmReaders = make(map[uint16]*ZMReader)
 	for _, meta := range metas.Metas {
+		colFile, err := file.OpenColumn(int(meta.ColIdx))
+		if err != nil {
+			return err
+		}
 		idxFile, err := colFile.OpenIndexFile(int(meta.InternalIdx))
 		if err != nil {
 			return err
@@ -102,14 +116,18 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 			if _, err = idxFile.Read(buf); err != nil {
 				return err
 			}
-			index.zmReader = NewZMReader(blk.GetBufMgr(), idxFile, id)
+			reader := NewZMReader(blk.GetIndexBufMgr(), idxFile, id)
+			if meta.ColIdx == uint16(entry.GetSchema().PrimaryKey) {
+				index.zmReader = reader
+			}
+			index.zmReaders[meta.ColIdx] = reader
 		case StaticFilterIndex:
 			size := idxFile.Stat().Size()
 			buf := make([]byte, size)
 			if _, err = idxFile.Read(buf); err != nil {
 				return err
 			}
-			index.bfReader = NewBFReader(blk.GetBufMgr(), idxFile, id)
+			index.bfReader = NewBFReader(blk.GetIndexBufMgr(), idxFile, id)
 		default:
 			panic("unsupported index type")
 		}
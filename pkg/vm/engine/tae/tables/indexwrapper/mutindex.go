@@ -96,6 +96,8 @@ func (idx *mutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bitmap
 	return
 }
 
+func (idx *mutableIndex) MayContainsInColumn(uint16, any) bool { return true }
+
 func (idx *mutableIndex) Destroy() error {
 	return idx.Close()
 }
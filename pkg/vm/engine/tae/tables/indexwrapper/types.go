@@ -37,6 +37,11 @@ type Index interface {
 
 	BatchDedup(keys *movec.Vector, rowmask *roaring.Bitmap) (keyselects *roaring.Bitmap, err error)
 
+	// MayContainsInColumn returns false if the key is definitely not in the
+	// column. Only the primary key and the cluster key columns of a sealed
+	// block have a zone map, it returns true for the other columns
+	MayContainsInColumn(colIdx uint16, key any) bool
+
 	// BatchUpsert batch insert the specific keys
 	// If any deduplication, it will fetch the old value first, fill the active map with new value, insert the old value into delete map
 	// If any other unknown error hanppens, return error
@@ -15,6 +15,9 @@
 package jobs
 
 import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -49,6 +52,7 @@ func NewCompactABlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.
 func (task *compactABlockTask) Scopes() []common.ID { return task.scopes }
 
 func (task *compactABlockTask) Execute() (err error) {
+	defer metric.ObserveSince(metric.JobDuration.WithLabelValues(metric.JobCompactABlock), time.Now())
 	dataBlock := task.meta.GetBlockData()
 	return dataBlock.ForceCompact()
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -76,10 +77,10 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 func (task *compactBlockTask) Scopes() []common.ID { return task.scopes }
 
 func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
-	attrs := task.meta.GetSchema().Attrs()
-	bat = batch.New(true, attrs)
+	schema := task.meta.GetSchema()
+	bat = batch.New(true, schema.Attrs())
 
-	for i := range task.meta.GetSchema().ColDefs {
+	for i := range schema.ColDefs {
 		view, err := task.compacted.GetColumnDataById(i, nil, nil)
 		if err != nil {
 			return bat, err
@@ -87,8 +88,10 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 		vec := view.ApplyDeletes()
 		bat.Vecs[i] = vec
 	}
-	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.meta.GetSchema().PrimaryKey)); err != nil {
-		return
+	if schema.IsClustered() {
+		err = mergesort.SortBlockColumnsByKeys(bat.Vecs, schema.SortKey())
+	} else {
+		err = mergesort.SortBlockColumns(bat.Vecs, int(schema.PrimaryKey))
 	}
 	return
 }
@@ -96,6 +99,7 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 func (task *compactBlockTask) GetNewBlock() handle.Block { return task.created }
 
 func (task *compactBlockTask) Execute() (err error) {
+	defer metric.ObserveSince(metric.JobDuration.WithLabelValues(metric.JobCompactBlock), time.Now())
 	now := time.Now()
 	data, err := task.PrepareData()
 	if err != nil {
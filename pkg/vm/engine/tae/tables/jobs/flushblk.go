@@ -15,7 +15,11 @@
 package jobs
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -44,8 +48,14 @@ func NewFlushBlkTask(ctx *tasks.Context, bf file.Block, ts uint64, meta *catalog
 func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
-	pkColumnData := task.data.Vecs[task.meta.GetSchema().PrimaryKey]
-	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData); err != nil {
+	defer metric.ObserveSince(metric.JobDuration.WithLabelValues(metric.JobFlushBlock), time.Now())
+	schema := task.meta.GetSchema()
+	pkColumnData := task.data.Vecs[schema.PrimaryKey]
+	keyColumns := make(map[int]*vector.Vector)
+	for _, key := range schema.ClusterBy {
+		keyColumns[int(key)] = task.data.Vecs[key]
+	}
+	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData, keyColumns); err != nil {
 		return
 	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
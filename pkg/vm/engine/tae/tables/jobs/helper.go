@@ -21,7 +21,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/indexwrapper"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// BuildAndFlushBlockIndex writes the zone map and the bloom filter of the
+// primary key, and the zone maps of the cluster key columns in keyColumns
+func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector, keyColumns map[int]*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
 	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
@@ -69,6 +71,17 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 		return err
 	}
 	metas.AddIndex(*sfMeta)
+
+	for _, key := range schema.SortKey() {
+		if key == int(schema.PrimaryKey) || keyColumns[key] == nil {
+			continue
+		}
+		var keyMeta *indexwrapper.IndexMeta
+		if keyMeta, err = buildKeyZoneMap(file, key, keyColumns[key]); err != nil {
+			return err
+		}
+		metas.AddIndex(*keyMeta)
+	}
 	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
@@ -80,3 +93,23 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 	}
 	return nil
 }
+
+func buildKeyZoneMap(file file.Block, colIdx int, columnData *vector.Vector) (meta *indexwrapper.IndexMeta, err error) {
+	column, err := file.OpenColumn(colIdx)
+	if err != nil {
+		return
+	}
+	defer column.Close()
+	zmFile, err := column.OpenIndexFile(0)
+	if err != nil {
+		return
+	}
+	zoneMapWriter := indexwrapper.NewZMWriter()
+	if err = zoneMapWriter.Init(zmFile, indexwrapper.Plain, uint16(colIdx), 0); err != nil {
+		return
+	}
+	if err = zoneMapWriter.AddValues(columnData); err != nil {
+		return
+	}
+	return zoneMapWriter.Finalize()
+}
@@ -16,10 +16,12 @@ package jobs
 
 import (
 	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -109,7 +111,20 @@ func (task *mergeBlocksTask) mergeColumn(vecs []*vector.Vector, sortedIdx *[]uin
 	return
 }
 
+func (task *mergeBlocksTask) loadColumn(idx int) (vecs []*vector.Vector, err error) {
+	var view *model.ColumnView
+	vecs = make([]*vector.Vector, 0, len(task.compacted))
+	for _, block := range task.compacted {
+		if view, err = block.GetColumnDataById(idx, nil, nil); err != nil {
+			return
+		}
+		vecs = append(vecs, view.ApplyDeletes())
+	}
+	return
+}
+
 func (task *mergeBlocksTask) Execute() (err error) {
+	defer metric.ObserveSince(metric.JobDuration.WithLabelValues(metric.JobMergeBlocks), time.Now())
 	segStr := ""
 	for _, seg := range task.mergedSegs {
 		segStr = fmt.Sprintf("%d,", seg.GetID())
@@ -168,7 +183,36 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	buf := node.Buf[:length]
 	defer common.GPool.Free(node)
 	sortedIdx := *(*[]uint32)(unsafe.Pointer(&buf))
-	vecs, mapping := task.mergeColumn(vecs, &sortedIdx, true, rows, to)
+	var mapping []uint32
+	// keyColumns are the merged cluster key columns other than the primary key
+	keyColumns := make(map[int][]*vector.Vector)
+	if schema.IsClustered() {
+		// Order the rows on the cluster key and shuffle every column,
+		// the primary key included, the same way
+		keyCols := make([][]*vector.Vector, 0, len(schema.ClusterBy))
+		for _, key := range schema.SortKey() {
+			if key == int(schema.PrimaryKey) {
+				keyCols = append(keyCols, vecs)
+				continue
+			}
+			var keyVecs []*vector.Vector
+			if keyVecs, err = task.loadColumn(key); err != nil {
+				return
+			}
+			keyCols = append(keyCols, keyVecs)
+		}
+		var src []uint32
+		src, mapping = mergesort.MergeByKeys(keyCols, rows)
+		copy(sortedIdx, src)
+		for i, key := range schema.SortKey() {
+			if key != int(schema.PrimaryKey) {
+				keyColumns[key], _ = task.mergeColumn(keyCols[i], &sortedIdx, false, rows, to)
+			}
+		}
+		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
+	} else {
+		vecs, mapping = task.mergeColumn(vecs, &sortedIdx, true, rows, to)
+	}
 	// logutil.Infof("mapping is %v", mapping)
 	// logutil.Infof("sortedIdx is %v", sortedIdx)
 	ts := task.txn.GetStartTS()
@@ -176,7 +220,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length = 0
 	var blk handle.Block
 	toAddr := make([]uint32, 0, len(vecs))
-	for _, vec := range vecs {
+	for pos, vec := range vecs {
 		toAddr = append(toAddr, uint32(length))
 		length += vector.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -193,7 +237,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
+		blkKeyColumns := make(map[int]*vector.Vector, len(keyColumns))
+		for key, keyVecs := range keyColumns {
+			blkKeyColumns[key] = keyVecs[pos]
+		}
+		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec, blkKeyColumns); err != nil {
 			return
 		}
 		if err = meta.GetBlockData().ReplayData(); err != nil {
@@ -209,15 +257,14 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if i == int(schema.PrimaryKey) {
 			continue
 		}
-		vecs = vecs[:0]
-		for _, block := range task.compacted {
-			if view, err = block.GetColumnDataById(i, nil, nil); err != nil {
+		if keyColumns[i] != nil {
+			vecs = keyColumns[i]
+		} else {
+			if vecs, err = task.loadColumn(i); err != nil {
 				return
 			}
-			vec := view.ApplyDeletes()
-			vecs = append(vecs, vec)
+			vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
 		}
-		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
 		for pos, vec := range vecs {
 			blk := task.createdBlks[pos]
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, i, vec, false)
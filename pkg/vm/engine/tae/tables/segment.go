@@ -84,28 +84,31 @@ func (segment *dataSegment) MutationInfo() string { return "" }
 func (segment *dataSegment) RunCalibration()    {}
 func (segment *dataSegment) EstimateScore() int { return 0 }
 
+// BuildCompactionTaskFactory merges the blocks of the segment into a new
+// sealed segment. An appendable segment is only merged once full, a sealed
+// segment can be remerged anytime to reclaim its deletes
 func (segment *dataSegment) BuildCompactionTaskFactory() (factory tasks.TxnTaskFactory, taskType tasks.TaskType, scopes []common.ID, err error) {
-	if segment.meta.IsAppendable() {
-		segment.meta.RLock()
-		dropped := segment.meta.IsDroppedCommitted()
-		inTxn := segment.meta.HasActiveTxn()
-		segment.meta.RUnlock()
-		if dropped || inTxn {
-			return
-		}
-		filter := catalog.NewComposedFilter()
-		filter.AddBlockFilter(catalog.NonAppendableBlkFilter)
-		filter.AddCommitFilter(catalog.ActiveWithNoTxnFilter)
-		blks := segment.meta.CollectBlockEntries(filter.FilteCommit, filter.FilteBlock)
-		if len(blks) < int(segment.meta.GetTable().GetSchema().SegmentMaxBlocks) {
-			return
-		}
-		for _, blk := range blks {
-			scopes = append(scopes, *blk.AsCommonID())
-		}
-		factory = jobs.CompactSegmentTaskFactory(blks, segment.scheduler)
-		taskType = tasks.DataCompactionTask
+	segment.meta.RLock()
+	dropped := segment.meta.IsDroppedCommitted()
+	inTxn := segment.meta.HasActiveTxn()
+	segment.meta.RUnlock()
+	if dropped || inTxn {
 		return
 	}
+	filter := catalog.NewComposedFilter()
+	filter.AddBlockFilter(catalog.NonAppendableBlkFilter)
+	filter.AddCommitFilter(catalog.ActiveWithNoTxnFilter)
+	blks := segment.meta.CollectBlockEntries(filter.FilteCommit, filter.FilteBlock)
+	if len(blks) == 0 {
+		return
+	}
+	if segment.meta.IsAppendable() && len(blks) < int(segment.meta.GetTable().GetSchema().SegmentMaxBlocks) {
+		return
+	}
+	for _, blk := range blks {
+		scopes = append(scopes, *blk.AsCommonID())
+	}
+	factory = jobs.CompactSegmentTaskFactory(blks, segment.scheduler)
+	taskType = tasks.DataCompactionTask
 	return
 }
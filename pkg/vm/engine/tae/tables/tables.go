@@ -25,17 +25,20 @@ import (
 type DataFactory struct {
 	fileFactory  file.SegmentFileFactory
 	appendBufMgr base.INodeManager
+	indexBufMgr  base.INodeManager
 	scheduler    tasks.TaskScheduler
 	dir          string
 }
 
 func NewDataFactory(fileFactory file.SegmentFileFactory,
 	appendBufMgr base.INodeManager,
+	indexBufMgr base.INodeManager,
 	scheduler tasks.TaskScheduler,
 	dir string) *DataFactory {
 	return &DataFactory{
 		fileFactory:  fileFactory,
 		appendBufMgr: appendBufMgr,
+		indexBufMgr:  indexBufMgr,
 		scheduler:    scheduler,
 		dir:          dir,
 	}
@@ -55,6 +58,6 @@ func (factory *DataFactory) MakeSegmentFactory() catalog.SegmentDataFactory {
 
 func (factory *DataFactory) MakeBlockFactory(segFile file.Segment) catalog.BlockDataFactory {
 	return func(meta *catalog.BlockEntry) data.Block {
-		return newBlock(meta, segFile, factory.appendBufMgr, factory.scheduler)
+		return newBlock(meta, segFile, factory.appendBufMgr, factory.indexBufMgr, factory.scheduler)
 	}
 }
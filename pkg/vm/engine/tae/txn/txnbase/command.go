@@ -37,6 +37,10 @@ const (
 	CmdCustomized
 )
 
+// CmdTxnCommit is numbered apart from the builtin commands, the customized
+// commands are numbered from CmdCustomized in the existing logs
+const CmdTxnCommit int16 = 0x0300
+
 func init() {
 	txnif.RegisterCmdFactory(CmdPointer, func(int16) txnif.TxnCmd {
 		return new(PointerCmd)
@@ -50,6 +54,9 @@ func init() {
 	txnif.RegisterCmdFactory(CmdComposed, func(int16) txnif.TxnCmd {
 		return new(ComposedCmd)
 	})
+	txnif.RegisterCmdFactory(CmdTxnCommit, func(int16) txnif.TxnCmd {
+		return new(TxnCommitCmd)
+	})
 }
 
 type CustomizedCmd interface {
@@ -58,7 +65,7 @@ type CustomizedCmd interface {
 
 func IsCustomizedCmd(cmd txnif.TxnCmd) bool {
 	ctype := cmd.GetType()
-	return ctype >= CmdCustomized
+	return ctype >= CmdCustomized && ctype != CmdTxnCommit
 }
 
 type BaseCmd struct{}
@@ -85,6 +92,13 @@ type ComposedCmd struct {
 	CmdSize uint32
 }
 
+// TxnCommitCmd records the commit timestamp of the txn. It is the last
+// command of the txn record, so the replay can stop at a timestamp.
+type TxnCommitCmd struct {
+	BaseCmd
+	CommitTS uint64
+}
+
 type BaseCustomizedCmd struct {
 	ID   uint32
 	Impl txnif.TxnCmd
@@ -110,6 +124,12 @@ func NewBatchCmd(bat batch.IBatch, colTypes []types.Type) *BatchCmd {
 	}
 }
 
+func NewTxnCommitCmd(commitTs uint64) *TxnCommitCmd {
+	return &TxnCommitCmd{
+		CommitTS: commitTs,
+	}
+}
+
 func NewComposedCmd() *ComposedCmd {
 	return &ComposedCmd{
 		Cmds: make([]txnif.TxnCmd, 0),
@@ -169,6 +189,48 @@ func (e *PointerCmd) Unmarshal(buf []byte) error {
 	return err
 }
 
+func (e *TxnCommitCmd) GetType() int16 {
+	return CmdTxnCommit
+}
+
+func (e *TxnCommitCmd) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, e.GetType()); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, e.CommitTS); err != nil {
+		return
+	}
+	n = 10
+	return
+}
+
+func (e *TxnCommitCmd) Marshal() (buf []byte, err error) {
+	var bbuf bytes.Buffer
+	if _, err = e.WriteTo(&bbuf); err != nil {
+		return
+	}
+	buf = bbuf.Bytes()
+	return
+}
+
+func (e *TxnCommitCmd) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &e.CommitTS); err != nil {
+		return
+	}
+	n = 8
+	return
+}
+
+func (e *TxnCommitCmd) Unmarshal(buf []byte) error {
+	bbuf := bytes.NewBuffer(buf)
+	_, err := e.ReadFrom(bbuf)
+	return err
+}
+
+func (e *TxnCommitCmd) String() string {
+	return fmt.Sprintf("TxnCommitCmd: CommitTS=%d", e.CommitTS)
+}
+
 func (e *DeleteBitmapCmd) GetType() int16 {
 	return CmdDeleteBitmap
 }
@@ -339,6 +401,18 @@ func (cc *ComposedCmd) String() string {
 	return cc.ToString("")
 }
 
+// GetCommitTS returns the commit timestamp recorded in the composed command.
+// It returns false for the record written without it.
+func (cc *ComposedCmd) GetCommitTS() (ts uint64, ok bool) {
+	if len(cc.Cmds) == 0 {
+		return
+	}
+	if cmd, isCommit := cc.Cmds[len(cc.Cmds)-1].(*TxnCommitCmd); isCommit {
+		return cmd.CommitTS, true
+	}
+	return
+}
+
 func BuildCommandFrom(r io.Reader) (cmd txnif.TxnCmd, n int64, err error) {
 	var cmdType int16
 	if err = binary.Read(r, binary.BigEndian, &cmdType); err != nil {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/sm"
@@ -202,11 +203,13 @@ func (mgr *TxnManager) onCommit(items ...any) {
 				mgr.OnException(err)
 				logutil.Warnf("ApplyCommit %s: %v", op.Txn.Repr(), err)
 			}
+			metric.TxnDone.WithLabelValues(metric.TxnCommit).Inc()
 		case OpRollback:
 			if err = op.Txn.ApplyRollback(); err != nil {
 				mgr.OnException(err)
 				logutil.Warnf("ApplyRollback %s: %v", op.Txn.Repr(), err)
 			}
+			metric.TxnDone.WithLabelValues(metric.TxnRollback).Inc()
 		}
 		// Here only wait the txn to be done. The err returned can be access via op.Txn.GetError()
 		_ = op.Txn.WaitDone(err)
@@ -51,7 +51,7 @@ func NewSimpleTableIndex() *simpleTableIndex {
 func (idx *simpleTableIndex) KeyToVector(kType types.Type) *gvec.Vector {
 	vec := gvec.New(kType)
 	switch kType.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		for k := range idx.tree {
 			compute.AppendValue(vec, []byte(k.(string)))
 		}
@@ -350,6 +350,25 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
+	case types.T_time:
+		data := vals.([]types.Time)
+		if dedupCol {
+			set := make(map[types.Time]bool)
+			for _, v := range data[start : start+count] {
+				if _, ok := set[v]; ok {
+					return txnbase.ErrDuplicated
+				}
+				set[v] = true
+			}
+			break
+		}
+		for _, v := range data[start : start+count] {
+			if _, ok := idx.tree[v]; ok {
+				return txnbase.ErrDuplicated
+			}
+			idx.tree[v] = row
+			row++
+		}
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		if dedupCol {
@@ -369,7 +388,7 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data := vals.(*types.Bytes)
 		if dedupCol {
 			set := make(map[string]bool)
@@ -488,6 +507,13 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return txnbase.ErrDuplicated
 			}
 		}
+	case types.T_time:
+		data := vals.([]types.Time)
+		for _, v := range data {
+			if _, ok := idx.tree[v]; ok {
+				return txnbase.ErrDuplicated
+			}
+		}
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		for _, v := range data {
@@ -495,7 +521,7 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return txnbase.ErrDuplicated
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		data := vals.(*types.Bytes)
 		// bytes := make([]string, 0, len(data.Lengths))
 		for i, s := range data.Offsets {
@@ -407,6 +407,7 @@ func (store *txnStore) PreApplyCommit() (err error) {
 	if err = store.CollectCmd(); err != nil {
 		return
 	}
+	store.cmdMgr.AddInternalCmd(txnbase.NewTxnCommitCmd(store.txn.GetCommitTS()))
 
 	logEntry, err := store.cmdMgr.ApplyTxnRecord()
 	if err != nil {
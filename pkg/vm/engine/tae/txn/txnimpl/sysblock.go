@@ -157,6 +157,9 @@ func (blk *txnSysBlock) isPrimaryKey(schema *catalog.Schema, colIdx int) bool {
 	case catalog.SystemTable_DB_Name:
 		return attrName == catalog.SystemDBAttr_Name
 	}
+	if schema.Relkind == catalog.SystemViewRel {
+		return false
+	}
 	return int(schema.PrimaryKey) == colIdx
 }
 
@@ -238,9 +241,9 @@ func (blk *txnSysBlock) getRelTableData(colIdx int) (view *model.ColumnView, err
 		case catalog.SystemRelAttr_Persistence:
 			compute.AppendValue(colData, []byte(catalog.SystemPersistRel))
 		case catalog.SystemRelAttr_Kind:
-			compute.AppendValue(colData, []byte(catalog.SystemOrdinaryRel))
+			compute.AppendValue(colData, []byte(table.GetSchema().Relkind))
 		case catalog.SystemRelAttr_CreateSQL:
-			compute.AppendValue(colData, []byte("todosql"))
+			compute.AppendValue(colData, []byte(table.GetSchema().Createsql))
 		default:
 			panic("unexpected")
 		}
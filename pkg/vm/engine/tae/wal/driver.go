@@ -95,6 +95,10 @@ func (driver *walDriver) Checkpoint(indexes []*Index) (e LogEntry, err error) {
 	return
 }
 
+func (driver *walDriver) Backup(dir string) error {
+	return driver.impl.Backup(dir)
+}
+
 func (driver *walDriver) Compact() error {
 	return driver.impl.TryCompact()
 }
@@ -107,6 +111,10 @@ func (driver *walDriver) GetCurrSeqNum() uint64 {
 	return driver.impl.GetCurrSeqNum(GroupC)
 }
 
+func (driver *walDriver) GetSynced() uint64 {
+	return driver.impl.GetSynced(GroupC)
+}
+
 func (driver *walDriver) LoadEntry(groupId uint32, lsn uint64) (LogEntry, error) {
 	return driver.impl.Load(groupId, lsn)
 }
@@ -47,8 +47,11 @@ type Driver interface {
 	AppendEntry(uint32, LogEntry) (uint64, error)
 	LoadEntry(groupId uint32, lsn uint64) (LogEntry, error)
 	GetCurrSeqNum() uint64
+	// GetSynced returns the max lsn synced to the log
+	GetSynced() uint64
 	GetPenddingCnt() uint64
 	Compact() error
+	Backup(dir string) error
 	Replay(handle store.ApplyHandle) (err error)
 	Close() error
 }
@@ -39,6 +39,10 @@ type ComputationHandler interface {
 
 	GetTable(dbId uint64, name string) (*descriptor.RelationDesc, error)
 
+	CreateIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc) error
+
+	DropIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexName string) error
+
 	Read(readCtx interface{}) (*batch.Batch, error)
 
 	Write(writeCtx interface{}, bat *batch.Batch) error
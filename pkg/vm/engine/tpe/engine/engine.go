@@ -48,6 +48,11 @@ func NewTpeEngine(tc *TpeConfig) (*TpeEngine, error) {
 		if err != nil {
 			return nil, err
 		}
+	} else if tc.KvType == tuplecodec.KV_PEBBLE {
+		kv, err = tuplecodec.NewPebbleKV(tc.PBKV, uint64(kvLimit))
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		return nil, errorInvalidKVType
 	}
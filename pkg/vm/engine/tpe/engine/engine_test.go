@@ -19,6 +19,9 @@ import (
 	"reflect"
 	"testing"
 
+	cpebble "github.com/cockroachdb/pebble"
+	"github.com/matrixorigin/matrixcube/storage/kv/pebble"
+	"github.com/matrixorigin/matrixcube/vfs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"github.com/smartystreets/goconvey/convey"
 )
@@ -94,7 +97,7 @@ func TestTpeEngine_Create(t *testing.T) {
 func TestTpeCubeKVEngine_Create(t *testing.T) {
 	convey.Convey("create series database", t, func() {
 		tpe, err := NewTpeEngine(&TpeConfig{
-			KvType:                    tuplecodec.KV_CUBE + 1,
+			KvType:                    tuplecodec.KV_PEBBLE + 1,
 			SerialType:                tuplecodec.ST_JSON,
 			ValueLayoutSerializerType: "default",
 			KVLimit:                   10000})
@@ -135,6 +138,48 @@ func TestTpeCubeKVEngine_Create(t *testing.T) {
 	})
 }
 
+func TestTpePebbleKVEngine_Create(t *testing.T) {
+	convey.Convey("create database on the local pebble", t, func() {
+		tpe, err := NewTpeEngine(&TpeConfig{
+			KvType:                    tuplecodec.KV_PEBBLE,
+			SerialType:                tuplecodec.ST_JSON,
+			ValueLayoutSerializerType: "default",
+			KVLimit:                   10000})
+		convey.So(tpe, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		dir := t.TempDir()
+		open := func() (*pebble.Storage, *TpeEngine) {
+			pbkv, err := pebble.NewStorage(dir, nil, &cpebble.Options{
+				FS: vfs.NewPebbleFS(vfs.Default),
+			})
+			convey.So(err, convey.ShouldBeNil)
+			tpe, err := NewTpeEngine(&TpeConfig{
+				KvType:                    tuplecodec.KV_PEBBLE,
+				SerialType:                tuplecodec.ST_JSON,
+				ValueLayoutSerializerType: "default",
+				KVLimit:                   10000,
+				PBKV:                      pbkv})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(tpe.Open(), convey.ShouldBeNil)
+			return pbkv, tpe
+		}
+
+		pbkv, tpe := open()
+		err = tpe.Create(0, "testdb", 0, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pbkv.Close(), convey.ShouldBeNil)
+
+		//the database survives the restart
+		pbkv, tpe = open()
+		defer pbkv.Close()
+		convey.So(tpe.Databases(nil), convey.ShouldContain, "testdb")
+
+		nodes := tpe.Node("", nil)
+		convey.So(nodes.Mcpu, convey.ShouldEqual, 1)
+	})
+}
+
 func Test_TpeRemoveDeletedTable(t *testing.T) {
 	convey.Convey("Remove Deletedtable", t, func() {
 		tpe, err := NewTpeEngine(&TpeConfig{
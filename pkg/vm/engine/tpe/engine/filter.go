@@ -0,0 +1,244 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/descriptor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
+)
+
+// reversedOp swaps the operands of the comparison
+var reversedOp = map[int]int{
+	overload.EQ: overload.EQ,
+	overload.LT: overload.GT,
+	overload.LE: overload.GE,
+	overload.GT: overload.LT,
+	overload.GE: overload.LE,
+}
+
+// findAttribute finds the attribute in the relation by the name
+// which may be qualified by the table name.
+func findAttribute(tableDesc *descriptor.RelationDesc, name string) *descriptor.AttributeDesc {
+	for i := range tableDesc.Attributes {
+		if tableDesc.Attributes[i].Name == name {
+			return &tableDesc.Attributes[i]
+		}
+	}
+	if pos := strings.LastIndexByte(name, '.'); pos >= 0 {
+		return findAttribute(tableDesc, name[pos+1:])
+	}
+	return nil
+}
+
+// constantOfValue gets the constant in the value extend
+func constantOfValue(v *vector.Vector) (interface{}, bool) {
+	if v == nil || vector.Length(v) != 1 || nulls.Any(v.Nsp) {
+		return nil, false
+	}
+	switch v.Typ.Oid {
+	case types.T_int8:
+		return int64(v.Col.([]int8)[0]), true
+	case types.T_int16:
+		return int64(v.Col.([]int16)[0]), true
+	case types.T_int32:
+		return int64(v.Col.([]int32)[0]), true
+	case types.T_int64:
+		return v.Col.([]int64)[0], true
+	case types.T_uint8:
+		return uint64(v.Col.([]uint8)[0]), true
+	case types.T_uint16:
+		return uint64(v.Col.([]uint16)[0]), true
+	case types.T_uint32:
+		return uint64(v.Col.([]uint32)[0]), true
+	case types.T_uint64:
+		return v.Col.([]uint64)[0], true
+	case types.T_float32:
+		return float64(v.Col.([]float32)[0]), true
+	case types.T_float64:
+		return v.Col.([]float64)[0], true
+	case types.T_char, types.T_varchar:
+		return string(v.Col.(*types.Bytes).Get(0)), true
+	case types.T_date:
+		return v.Col.([]types.Date)[0], true
+	case types.T_datetime:
+		return v.Col.([]types.Datetime)[0], true
+	}
+	return nil, false
+}
+
+// boundOfAttribute converts the constant into the bound which is encoded
+// in the same way as the value of the attribute in the key.
+// The integers of any width share one encoding.
+func boundOfAttribute(attr *descriptor.AttributeDesc, c interface{}) (interface{}, bool) {
+	switch attr.TypesType.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		switch v := c.(type) {
+		case int64:
+			return v, true
+		case uint64:
+			return v, true
+		}
+	case types.T_float32, types.T_float64:
+		switch v := c.(type) {
+		case int64:
+			return float64(v), true
+		case uint64:
+			return float64(v), true
+		case float64:
+			return v, true
+		}
+	case types.T_char, types.T_varchar:
+		if v, ok := c.(string); ok {
+			return v, true
+		}
+	case types.T_date:
+		if v, ok := c.(types.Date); ok {
+			return v, true
+		}
+	case types.T_datetime:
+		if v, ok := c.(types.Datetime); ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// rangeOfComparison converts the comparison between the attribute and the constant into the range
+func rangeOfComparison(op int, attr *descriptor.AttributeDesc, c interface{}) *tuplecodec.ScanRange {
+	v, ok := boundOfAttribute(attr, c)
+	if !ok {
+		return nil
+	}
+	if f, ok := v.(float64); ok {
+		if math.IsNaN(f) {
+			return nil
+		}
+		//the float32 in the key can not be compared with the constant exactly
+		if attr.TypesType.Oid == types.T_float32 && float64(float32(f)) != f {
+			return nil
+		}
+	}
+	switch op {
+	case overload.EQ:
+		return &tuplecodec.ScanRange{Low: v, LowInclusive: true, High: v, HighInclusive: true}
+	case overload.LT:
+		return &tuplecodec.ScanRange{High: v}
+	case overload.LE:
+		return &tuplecodec.ScanRange{High: v, HighInclusive: true}
+	case overload.GT:
+		return &tuplecodec.ScanRange{Low: v}
+	case overload.GE:
+		return &tuplecodec.ScanRange{Low: v, LowInclusive: true}
+	}
+	return nil
+}
+
+// collectRanges collects the ranges on the attributes from the comparisons
+// between the attribute and the constant in the conjunction of the filter.
+func collectRanges(tableDesc *descriptor.RelationDesc, e extend.Extend, ranges map[uint32]*tuplecodec.ScanRange) {
+	switch t := e.(type) {
+	case *extend.ParenExtend:
+		collectRanges(tableDesc, t.E, ranges)
+	case *extend.BinaryExtend:
+		if t.Op == overload.And {
+			collectRanges(tableDesc, t.Left, ranges)
+			collectRanges(tableDesc, t.Right, ranges)
+			return
+		}
+		op, ok := reversedOp[t.Op]
+		if !ok {
+			return
+		}
+		left, right := t.Left, t.Right
+		if _, ok := left.(*extend.ValueExtend); ok {
+			left, right = right, left
+		} else {
+			op = t.Op
+		}
+		attr, ok := left.(*extend.Attribute)
+		if !ok {
+			return
+		}
+		value, ok := right.(*extend.ValueExtend)
+		if !ok {
+			return
+		}
+		attrDesc := findAttribute(tableDesc, attr.Name)
+		if attrDesc == nil {
+			return
+		}
+		c, ok := constantOfValue(value.V)
+		if !ok {
+			return
+		}
+		r := rangeOfComparison(op, attrDesc, c)
+		if r == nil {
+			return
+		}
+		if old, exist := ranges[attrDesc.ID]; exist {
+			r = old.Intersect(r)
+		}
+		ranges[attrDesc.ID] = r
+	}
+}
+
+// chooseScanRange chooses the index and the range on its first attribute
+// for the filter. The primary index is preferred. Then the secondary index
+// with the equality on the first attribute is better than others.
+// The index is nil when the primary index is chosen.
+// The range is nil when there is no range on any index.
+// The filter is still evaluated on the tuples read.
+func chooseScanRange(tableDesc *descriptor.RelationDesc, e extend.Extend, useSecondaryIndex bool) (*descriptor.IndexDesc, *tuplecodec.ScanRange) {
+	if e == nil {
+		return nil, nil
+	}
+	ranges := make(map[uint32]*tuplecodec.ScanRange)
+	collectRanges(tableDesc, e, ranges)
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+
+	if len(tableDesc.Primary_index.Attributes) != 0 {
+		if r, ok := ranges[tableDesc.Primary_index.Attributes[0].ID]; ok {
+			return nil, r
+		}
+	}
+
+	if !useSecondaryIndex {
+		return nil, nil
+	}
+	var chosenIndex *descriptor.IndexDesc
+	var chosenRange *tuplecodec.ScanRange
+	for i := range tableDesc.Indexes {
+		index := &tableDesc.Indexes[i]
+		r, ok := ranges[index.Attributes[0].ID]
+		if !ok {
+			continue
+		}
+		if chosenRange == nil || (r.IsPoint() && !chosenRange.IsPoint()) {
+			chosenIndex, chosenRange = index, r
+		}
+	}
+	return chosenIndex, chosenRange
+}
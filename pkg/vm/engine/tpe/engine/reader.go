@@ -93,6 +93,8 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 			ReadCount:           0,
 			DumpData:            tr.dumpData,
 			Opt:                 tr.opt,
+			ScanIndexDesc:       tr.scanIndexDesc,
+			ScanRange:           tr.scanRange,
 		}
 		if tr.readCtx.ParallelReader || tr.readCtx.MultiNode {
 			tr.readCtx.ParallelReaderContext = tuplecodec.ParallelReaderContext{
@@ -30,6 +30,11 @@ var (
 	errorBatchAttributeDoNotExistInTheRelation = errors.New("batch attribute do not exist in the relation")
 	errorDuplicateAttributeNameInBatch         = errors.New("duplicate attribute name in the batch")
 	errorDoNotGetValidValueForTheAttribute     = errors.New("can not get the value for the attribute")
+	errorUnsupportedTableDef                   = errors.New("unsupported table definition")
+	errorUnsupportedIndexType                  = errors.New("unsupported index type")
+	errorIndexWithoutAttribute                 = errors.New("index has no attribute")
+	errorIndexAttributeDoNotExist              = errors.New("index attribute do not exist in the relation")
+	errorDuplicateIndexName                    = errors.New("duplicate index name")
 )
 
 func (trel *TpeRelation) Rows() int64 {
@@ -134,6 +139,17 @@ func (trel *TpeRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
 		})
 	}
 
+	for _, index := range trel.desc.Indexes {
+		def := &engine.IndexTableDef{
+			Typ:  engine.ZoneMap,
+			Name: index.Name,
+		}
+		for _, attr := range index.Attributes {
+			def.ColNames = append(def.ColNames, attr.Name)
+		}
+		defs = append(defs, def)
+	}
+
 	if len(trel.desc.Comment) != 0 {
 		defs = append(defs, &engine.CommentDef{Comment: trel.desc.Comment})
 	}
@@ -210,15 +226,70 @@ func (trel *TpeRelation) Write(_ uint64, batch *batch.Batch, _ engine.Snapshot)
 	return nil
 }
 
-func (trel *TpeRelation) AddTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+//makeIndexDesc converts the definition of the secondary index into the descriptor.
+//The tuple in the secondary index is located by the attributes of the primary index.
+func makeIndexDesc(tableDesc *descriptor.RelationDesc, def *engine.IndexTableDef) (*descriptor.IndexDesc, error) {
+	//the secondary index is ordered. BSI is not the one.
+	if def.Typ == engine.BsiIndex {
+		return nil, errorUnsupportedIndexType
+	}
+	if len(def.ColNames) == 0 {
+		return nil, errorIndexWithoutAttribute
+	}
+	for _, index := range tableDesc.Indexes {
+		if index.Name == def.Name {
+			return nil, errorDuplicateIndexName
+		}
+	}
+
+	indexDesc := &descriptor.IndexDesc{
+		Name:                def.Name,
+		Is_unique:           false,
+		Impilict_attributes: tableDesc.Primary_index.Attributes,
+	}
+	for _, name := range def.ColNames {
+		var attrDesc *descriptor.AttributeDesc
+		for i := range tableDesc.Attributes {
+			if tableDesc.Attributes[i].Name == name {
+				attrDesc = &tableDesc.Attributes[i]
+				break
+			}
+		}
+		if attrDesc == nil {
+			return nil, errorIndexAttributeDoNotExist
+		}
+		indexDesc.Attributes = append(indexDesc.Attributes, descriptor.IndexDesc_Attribute{
+			Name:      attrDesc.Name,
+			Direction: 0,
+			ID:        attrDesc.ID,
+			Type:      attrDesc.Ttype,
+			TypesType: attrDesc.TypesType,
+		})
+	}
+	return indexDesc, nil
+}
+
+func (trel *TpeRelation) AddTableDef(epoch uint64, def engine.TableDef, _ engine.Snapshot) error {
+	indexDef, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return errorUnsupportedTableDef
+	}
+	indexDesc, err := makeIndexDesc(trel.desc, indexDef)
+	if err != nil {
+		return err
+	}
+	return trel.computeHandler.CreateIndex(epoch, uint64(trel.dbDesc.ID), trel.desc, indexDesc)
 }
 
-func (trel *TpeRelation) DelTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+func (trel *TpeRelation) DelTableDef(epoch uint64, def engine.TableDef, _ engine.Snapshot) error {
+	indexDef, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return errorUnsupportedTableDef
+	}
+	return trel.computeHandler.DropIndex(epoch, uint64(trel.dbDesc.ID), trel.desc, indexDef.Name)
 }
 
-func (trel *TpeRelation) parallelReader(cnt int, payload []byte) []engine.Reader {
+func (trel *TpeRelation) parallelReader(cnt int, payload []byte, scanRange *tuplecodec.ScanRange) []engine.Reader {
 	tcnt := cnt
 	if cnt <= 0 {
 		tcnt = 1
@@ -279,6 +350,7 @@ func (trel *TpeRelation) parallelReader(cnt int, payload []byte) []engine.Reader
 				isDumpReader:   false,
 				id:             i,
 				storeID:        trel.storeID,
+				scanRange:      scanRange,
 			}
 		} else {
 			tpeReaders[i] = &TpeReader{isDumpReader: true, id: i}
@@ -300,11 +372,14 @@ func (trel *TpeRelation) parallelReader(cnt int, payload []byte) []engine.Reader
 	return retReaders
 }
 
-func (trel *TpeRelation) NewReader(cnt int, _ extend.Extend, payload []byte, _ engine.Snapshot) []engine.Reader {
+func (trel *TpeRelation) NewReader(cnt int, e extend.Extend, payload []byte, _ engine.Snapshot) []engine.Reader {
 	logutil.Infof("table %s newreader cnt %d storeID %d\n", trel.desc.Name, cnt, trel.storeID)
 	logutil.Infof("table %s storeID %d payload len %d \n", trel.desc.Name, trel.storeID, len(payload))
-	if trel.computeHandler.ParallelReader() || trel.computeHandler.MultiNode() {
-		return trel.parallelReader(cnt, payload)
+	//the parallel readers scan the primary index in their shards only
+	parallel := trel.computeHandler.ParallelReader() || trel.computeHandler.MultiNode()
+	scanIndexDesc, scanRange := chooseScanRange(trel.desc, e, !parallel)
+	if parallel {
+		return trel.parallelReader(cnt, payload, scanRange)
 	}
 	var readers []engine.Reader = make([]engine.Reader, cnt)
 	tr := &TpeReader{
@@ -315,6 +390,8 @@ func (trel *TpeRelation) NewReader(cnt int, _ extend.Extend, payload []byte, _ e
 		isDumpReader:   false,
 		multiNode:      trel.computeHandler.MultiNode(),
 		storeID:        trel.storeID,
+		scanIndexDesc:  scanIndexDesc,
+		scanRange:      scanRange,
 	}
 	shardsThisNodeWillRead := &tuplecodec.CubeShards{}
 	err := json.Unmarshal(payload, shardsThisNodeWillRead)
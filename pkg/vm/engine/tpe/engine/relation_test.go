@@ -17,10 +17,15 @@ package engine
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/matrixorigin/matrixcube/pb/metapb"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 
@@ -167,3 +172,180 @@ func TestTpeRelation_Write(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func TestTpeRelation_SecondaryIndex(t *testing.T) {
+	convey.Convey("secondary index and range scan", t, func() {
+		tpe, err := NewTpeEngine(&TpeConfig{
+			KvType:                    tuplecodec.KV_MEMORY,
+			SerialType:                tuplecodec.ST_JSON,
+			ValueLayoutSerializerType: "default",
+			KVLimit:                   10000})
+		convey.So(err, convey.ShouldBeNil)
+		err = tpe.Create(0, "test", 0, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		dbDesc, err := tpe.Database("test", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		//(a,b,c)
+		//(uint64,uint64,uint64)
+		//primary key (a)
+		_, attrDefs := tuplecodec.MakeAttributes(types.T_uint64, types.T_uint64, types.T_uint64)
+
+		attrNames := []string{
+			"a", "b", "c",
+		}
+		var defs []engine.TableDef
+		for i, def := range attrDefs {
+			def.Attr.Name = attrNames[i]
+			defs = append(defs, def)
+		}
+		defs = append(defs, &engine.PrimaryIndexDef{Names: []string{"a"}})
+
+		err = dbDesc.Create(0, "A", defs, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		rel, err := dbDesc.Relation("A", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		//(i,i,i%3)
+		makeData := func(from, to int) *batch.Batch {
+			bat := tuplecodec.MakeBatch(to-from, attrNames, attrDefs)
+			for i := from; i < to; i++ {
+				bat.Vecs[0].Col.([]uint64)[i-from] = uint64(i)
+				bat.Vecs[1].Col.([]uint64)[i-from] = uint64(i)
+				bat.Vecs[2].Col.([]uint64)[i-from] = uint64(i % 3)
+			}
+			bat.Zs = nil
+			return bat
+		}
+
+		err = rel.Write(0, makeData(0, 10), nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		//build the index on the existing tuples
+		err = rel.AddTableDef(0, &engine.IndexTableDef{Typ: engine.ZoneMap, Name: "idx_c", ColNames: []string{"c"}}, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = rel.AddTableDef(0, &engine.IndexTableDef{Typ: engine.ZoneMap, Name: "idx_c", ColNames: []string{"b"}}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = rel.AddTableDef(0, &engine.IndexTableDef{Typ: engine.BsiIndex, Name: "idx_b", ColNames: []string{"b"}}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//maintain the index on the new tuples
+		err = rel.Write(0, makeData(10, 15), nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		var indexDefs []*engine.IndexTableDef
+		for _, def := range rel.TableDefs(nil) {
+			if x, ok := def.(*engine.IndexTableDef); ok {
+				indexDefs = append(indexDefs, x)
+			}
+		}
+		convey.So(len(indexDefs), convey.ShouldEqual, 1)
+		convey.So(indexDefs[0].Name, convey.ShouldEqual, "idx_c")
+		convey.So(indexDefs[0].ColNames, convey.ShouldResemble, []string{"c"})
+
+		payload := rel.Nodes(nil)[0].Data
+
+		compare := func(op int, attr string, value int64) extend.Extend {
+			v := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+			err := vector.Append(v, []int64{value})
+			convey.So(err, convey.ShouldBeNil)
+			return &extend.BinaryExtend{
+				Op:    op,
+				Left:  &extend.Attribute{Name: attr, Type: types.T_uint64},
+				Right: &extend.ValueExtend{V: v},
+			}
+		}
+
+		and := func(left, right extend.Extend) extend.Extend {
+			return &extend.BinaryExtend{Op: overload.And, Left: left, Right: right}
+		}
+
+		read := func(e extend.Extend) (*TpeReader, []uint64) {
+			readers := rel.NewReader(1, e, payload, nil)
+			convey.So(len(readers), convey.ShouldEqual, 1)
+			var as []uint64
+			for {
+				get, err := readers[0].Read([]uint64{1, 1, 1}, attrNames)
+				convey.So(err, convey.ShouldBeNil)
+				if get == nil {
+					break
+				}
+				as = append(as, get.Vecs[0].Col.([]uint64)...)
+				for i, c := range get.Vecs[2].Col.([]uint64) {
+					convey.So(c, convey.ShouldEqual, get.Vecs[0].Col.([]uint64)[i]%3)
+				}
+			}
+			sort.Slice(as, func(i, j int) bool {
+				return as[i] < as[j]
+			})
+			return readers[0].(*TpeReader), as
+		}
+
+		//range on the primary index
+		rd, as := read(and(compare(overload.GE, "a", 3), compare(overload.LT, "a", 6)))
+		convey.So(rd.scanIndexDesc, convey.ShouldBeNil)
+		convey.So(rd.scanRange, convey.ShouldNotBeNil)
+		convey.So(as, convey.ShouldResemble, []uint64{3, 4, 5})
+
+		rd, as = read(&extend.ParenExtend{E: and(compare(overload.GT, "A.a", 12), compare(overload.GT, "a", 10))})
+		convey.So(rd.scanRange, convey.ShouldNotBeNil)
+		convey.So(as, convey.ShouldResemble, []uint64{13, 14})
+
+		_, as = read(and(compare(overload.GT, "a", 5), compare(overload.LT, "a", 3)))
+		convey.So(as, convey.ShouldBeNil)
+
+		//equality on the secondary index
+		rd, as = read(compare(overload.EQ, "c", 1))
+		convey.So(rd.scanIndexDesc, convey.ShouldNotBeNil)
+		convey.So(rd.scanIndexDesc.Name, convey.ShouldEqual, "idx_c")
+		convey.So(as, convey.ShouldResemble, []uint64{1, 4, 7, 10, 13})
+
+		//range on the secondary index with the constant on the left
+		v := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		err = vector.Append(v, []int64{1})
+		convey.So(err, convey.ShouldBeNil)
+		rd, as = read(&extend.BinaryExtend{
+			Op:    overload.LE,
+			Left:  &extend.ValueExtend{V: v},
+			Right: &extend.Attribute{Name: "c", Type: types.T_uint64},
+		})
+		convey.So(rd.scanIndexDesc, convey.ShouldNotBeNil)
+		convey.So(as, convey.ShouldResemble, []uint64{1, 2, 4, 5, 7, 8, 10, 11, 13, 14})
+
+		//the batch with a duplicate primary key writes nothing
+		err = rel.Write(0, makeData(14, 16), nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, as = read(compare(overload.EQ, "a", 15))
+		convey.So(as, convey.ShouldBeNil)
+
+		//the attribute without index is scanned fully
+		rd, as = read(compare(overload.EQ, "b", 1))
+		convey.So(rd.scanRange, convey.ShouldBeNil)
+		convey.So(len(as), convey.ShouldEqual, 15)
+
+		//delete tuples and their keys in the index
+		del := makeData(1, 5)
+		del.Zs = []int64{-1, -1, -1, -1}
+		err = rel.Write(0, del, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		_, as = read(compare(overload.EQ, "c", 1))
+		convey.So(as, convey.ShouldResemble, []uint64{7, 10, 13})
+
+		//drop the index
+		err = rel.DelTableDef(0, &engine.IndexTableDef{Name: "idx_c"}, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		rd, as = read(compare(overload.EQ, "c", 1))
+		convey.So(rd.scanRange, convey.ShouldBeNil)
+		convey.So(len(as), convey.ShouldEqual, 11)
+
+		err = rel.DelTableDef(0, &engine.IndexTableDef{Name: "idx_c"}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -51,7 +51,9 @@ type TpeConfig struct {
 	TpeDedupSetBatchTrycount int
 	TpeScanTimeout           time.Duration
 	TpeScanTryCount          int
-	PBKV                     *pebble.Storage
+
+	//pebbleKV needs the local pebble storage
+	PBKV *pebble.Storage
 }
 
 type TpeEngine struct {
@@ -131,6 +133,9 @@ type TpeReader struct {
 	storeID      uint64
 	dumpData     bool
 	opt          *batch.DumpOption
+	//the index and the range for the range scan
+	scanIndexDesc *descriptor.IndexDesc
+	scanRange     *tuplecodec.ScanRange
 }
 
 func GetTpeReaderInfo(r *TpeRelation, eng *TpeEngine, opt *batch.DumpOption) *TpeReader {
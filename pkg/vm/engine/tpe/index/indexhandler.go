@@ -31,4 +31,6 @@ type IndexHandler interface {
 	DeleteFromTable(writeCtx interface{}, bat *batch.Batch) error
 
 	DeleteFromIndex(writeCtx interface{}, bat *batch.Batch) error
+
+	BuildIndex(writeCtx interface{}, indexDesc *descriptor.IndexDesc) error
 }
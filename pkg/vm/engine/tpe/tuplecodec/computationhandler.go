@@ -48,6 +48,9 @@ var (
 	errorThereAreNotNodesHoldTheTable            = errors.New("there are not nodes hold the table")
 	errorCanNotDropTheInternalDatabase           = errors.New("you can not drop the internal database")
 	errorCanNotDropTheTableInTheInternalDatabase = errors.New("you can not drop the table in the internal database")
+	errorCanNotChangeTheTableInTheInternalDatabase = errors.New("you can not change the table in the internal database")
+	errorIndexExists                             = errors.New("index has exists")
+	errorIndexDoesNotExist                       = errors.New("index does not exist")
 )
 
 var _ computation.ComputationHandler = &ComputationHandlerImpl{}
@@ -288,6 +291,89 @@ func (chi *ComputationHandlerImpl) CreateTable(epoch, dbId uint64, tableDesc *de
 	return id, nil
 }
 
+func (chi *ComputationHandlerImpl) CreateIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc) error {
+	if chi.isInternalDatabase(dbId) {
+		return errorCanNotChangeTheTableInTheInternalDatabase
+	}
+
+	//1. check database exists
+	dbDesc, err := chi.dh.LoadDatabaseDescByID(dbId)
+	if err != nil {
+		return err
+	}
+
+	//2. check index exists
+	for _, index := range tableDesc.Indexes {
+		if index.Name == indexDesc.Name {
+			return errorIndexExists
+		}
+	}
+
+	//3. save the copy of the descriptor with the new index.
+	//the descriptor of the caller is changed after it is saved.
+	newDesc := *tableDesc
+	if newDesc.Next_index_id <= PrimaryIndexID {
+		newDesc.Next_index_id = PrimaryIndexID + 1
+	}
+	indexDesc.ID = newDesc.Next_index_id
+	newDesc.Next_index_id++
+	newDesc.Indexes = make([]descriptor.IndexDesc, 0, len(tableDesc.Indexes)+1)
+	newDesc.Indexes = append(newDesc.Indexes, tableDesc.Indexes...)
+	newDesc.Indexes = append(newDesc.Indexes, *indexDesc)
+	newDesc.Max_access_epoch = epoch
+
+	err = chi.dh.StoreRelationDescByID(dbId, uint64(newDesc.ID), &newDesc)
+	if err != nil {
+		return err
+	}
+	*tableDesc = newDesc
+
+	//4. build the index for the tuples in the table
+	writeCtx := &WriteContext{
+		DbDesc:    dbDesc,
+		TableDesc: tableDesc,
+		IndexDesc: &tableDesc.Primary_index,
+	}
+	return chi.indexHandler.BuildIndex(writeCtx, indexDesc)
+}
+
+func (chi *ComputationHandlerImpl) DropIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexName string) error {
+	if chi.isInternalDatabase(dbId) {
+		return errorCanNotChangeTheTableInTheInternalDatabase
+	}
+
+	//1. find the index
+	pos := -1
+	for i, index := range tableDesc.Indexes {
+		if index.Name == indexName {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return errorIndexDoesNotExist
+	}
+	indexID := tableDesc.Indexes[pos].ID
+
+	//2. save the copy of the descriptor without the index
+	newDesc := *tableDesc
+	newDesc.Indexes = make([]descriptor.IndexDesc, 0, len(tableDesc.Indexes)-1)
+	newDesc.Indexes = append(newDesc.Indexes, tableDesc.Indexes[:pos]...)
+	newDesc.Indexes = append(newDesc.Indexes, tableDesc.Indexes[pos+1:]...)
+	newDesc.Max_access_epoch = epoch
+
+	err := chi.dh.StoreRelationDescByID(dbId, uint64(newDesc.ID), &newDesc)
+	if err != nil {
+		return err
+	}
+	*tableDesc = newDesc
+
+	//3. delete the keys of the index
+	tke := chi.tch.GetEncoder()
+	prefix, _ := tke.EncodeIndexPrefix(nil, dbId, uint64(tableDesc.ID), uint64(indexID))
+	return chi.kv.DeleteWithPrefix(prefix)
+}
+
 func (chi *ComputationHandlerImpl) isInternalDatabase(dbID uint64) bool {
 	return dbID == InternalDatabaseID
 }
@@ -426,7 +512,7 @@ func (chi *ComputationHandlerImpl) RemoveDeletedTable(epoch uint64) (int, error)
 }
 
 func (chi *ComputationHandlerImpl) GetNodesHoldTheTable(dbId uint64, desc *descriptor.RelationDesc) (engine.Nodes, interface{}, error) {
-	if chi.kv.GetKVType() == KV_MEMORY {
+	if kvType := chi.kv.GetKVType(); kvType == KV_MEMORY || kvType == KV_PEBBLE {
 		dumpShards := &CubeShards{
 			Shards: []metapb.Shard{
 				{
@@ -529,11 +615,15 @@ type WriteContext struct {
 	values   []TupleValue
 	t0       time.Duration
 	colIndex map[string]int
+
+	//keys of the secondary indexes to write or delete
+	indexKeys []TupleKey
 }
 
 func (wc *WriteContext) resetWriteCache() {
 	wc.keys = nil
 	wc.values = nil
+	wc.indexKeys = nil
 }
 
 //for parallel readers
@@ -628,6 +718,15 @@ type SingleReaderContext struct {
 	LengthOfPrefixForScanKey int
 
 	PrefixEnd []byte
+
+	//the next key for the range scan
+	ScanStartKey []byte
+
+	//the end key for the range scan
+	ScanEndKey []byte
+
+	//the length of the prefix of the secondary index in the range scan
+	LengthOfScanIndexPrefix int
 }
 
 type ReadContext struct {
@@ -656,6 +755,14 @@ type ReadContext struct {
 	DumpData bool // dumpData flag
 
 	Opt *batch.DumpOption
+
+	//the secondary index for locating the tuples.
+	//nil means the primary index.
+	ScanIndexDesc *descriptor.IndexDesc
+
+	//the range on the first attribute of the index to be scanned.
+	//nil means scanning all tuples.
+	ScanRange *ScanRange
 }
 
 func (rc *ReadContext) AddReadCount() int {
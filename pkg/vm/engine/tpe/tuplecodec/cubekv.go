@@ -766,6 +766,44 @@ func (ck *CubeKV) DedupSetBatch(keys []TupleKey, values []TupleValue) error {
 	}
 }
 
+// WriteBatch applies the batch in the cube.
+// The cube can not write the keys in several shards atomically.
+// The DedupKeys are checked before anything is written, so that
+// a duplicate key fails the batch without a partial write.
+func (ck *CubeKV) WriteBatch(b *KVBatch) error {
+	deleted, err := b.check()
+	if err != nil {
+		return err
+	}
+	var checkKeys []TupleKey
+	for _, key := range b.DedupKeys {
+		if _, ok := deleted[string(key)]; !ok {
+			checkKeys = append(checkKeys, key)
+		}
+	}
+	values, err := ck.GetBatch(checkKeys)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if value != nil {
+			return errorKeyExists
+		}
+	}
+
+	for _, key := range b.DeleteKeys {
+		if err = ck.Cube.Delete(key); err != nil {
+			return err
+		}
+	}
+	keys := append(append([]TupleKey{}, b.DedupKeys...), b.Keys...)
+	if len(keys) == 0 {
+		return nil
+	}
+	values = append(append([]TupleValue{}, b.DedupValues...), b.Values...)
+	return ck.SetBatch(keys, values)
+}
+
 func (ck *CubeKV) Delete(key TupleKey) error {
 	return ck.Cube.Delete(key)
 }
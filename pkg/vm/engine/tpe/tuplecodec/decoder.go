@@ -124,6 +124,21 @@ func (tkd *TupleKeyDecoder) DecodePrimaryIndexKey(key TupleKey, index *descripto
 	return rest, retDis, nil
 }
 
+// SkipSecondaryIndexKey skips fields of the secondary index and returns the rest
+// which is the key of the tuple in the primary index without the prefix.
+// The dbID,tableID and Index ID have been decoded.
+func (tkd *TupleKeyDecoder) SkipSecondaryIndexKey(key TupleKey, index *descriptor.IndexDesc) (TupleKey, error) {
+	rest := key
+	for _, attr := range index.Attributes {
+		rest2, _, err := tkd.od.DecodeKey(rest, attr.Type)
+		if err != nil {
+			return nil, err
+		}
+		rest = rest2
+	}
+	return rest, nil
+}
+
 // DecodePrimaryIndexValue decodes the values of the primary index and return the rest.
 // Now,it decodes all tuple.
 func (tkd *TupleKeyDecoder) DecodePrimaryIndexValue(value TupleValue, index *descriptor.IndexDesc, columnGroupID uint64, serializer ValueSerializer) (TupleValue, []*orderedcodec.DecodedItem, error) {
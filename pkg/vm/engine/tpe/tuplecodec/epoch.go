@@ -75,8 +75,8 @@ func (eh *EpochHandler) RemoveDeletedTable(epoch uint64) (int, error) {
 	for _, item := range gcItems {
 		//logutil.Infof("epoch %d saveEpoch %d dbid %d tableid %d",
 		//	epoch,item.Epoch,item.DbID,item.TableID)
-		//delete the data in the table
-		prefixDeleted, _ := tke.EncodeIndexPrefix(nil, item.DbID, item.TableID, uint64(PrimaryIndexID))
+		//delete the data in the primary index and the secondary indexes of the table
+		prefixDeleted, _ := tke.EncodeTablePrefix(nil, item.DbID, item.TableID)
 		err = eh.kv.DeleteWithPrefix(prefixDeleted)
 		if err != nil {
 			return 0, err
@@ -40,6 +40,43 @@ var _ index.IndexHandler = &IndexHandlerImpl{}
 
 type callbackPackage struct {
 	prefix TupleKey
+
+	//the secondary indexes to be maintained and their prefixes
+	secondaryIndexes  []*descriptor.IndexDesc
+	secondaryPrefixes []TupleKey
+}
+
+//makeCallbackPackage encodes the prefixes (tenantID,dbID,tableID,indexID)
+//of the primary index and the secondary indexes.
+func (ihi *IndexHandlerImpl) makeCallbackPackage(writeCtx *WriteContext, secondaryIndexes []*descriptor.IndexDesc) callbackPackage {
+	tke := ihi.tch.GetEncoder()
+	var prefix TupleKey
+	prefix, _ = tke.EncodeIndexPrefix(prefix,
+		uint64(writeCtx.DbDesc.ID),
+		uint64(writeCtx.TableDesc.ID),
+		uint64(writeCtx.IndexDesc.ID))
+
+	cp := callbackPackage{
+		prefix:           prefix,
+		secondaryIndexes: secondaryIndexes,
+	}
+	for _, index := range secondaryIndexes {
+		secondaryPrefix, _ := tke.EncodeIndexPrefix(nil,
+			uint64(writeCtx.DbDesc.ID),
+			uint64(writeCtx.TableDesc.ID),
+			uint64(index.ID))
+		cp.secondaryPrefixes = append(cp.secondaryPrefixes, secondaryPrefix)
+	}
+	return cp
+}
+
+//secondaryIndexesOfTable lists the secondary indexes of the table
+func secondaryIndexesOfTable(table *descriptor.RelationDesc) []*descriptor.IndexDesc {
+	var indexes []*descriptor.IndexDesc
+	for i := range table.Indexes {
+		indexes = append(indexes, &table.Indexes[i])
+	}
+	return indexes
 }
 
 type IndexHandlerImpl struct {
@@ -143,6 +180,21 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 		return nil, 0, errorShardScanEndKeyIsNil
 	}
 
+	//narrow the scan in the shard to the range on the primary index
+	if indexReadCtx.ScanRange != nil && indexReadCtx.ScanIndexDesc == nil {
+		startKey, endKey := indexReadCtx.ScanRange.Bounds(tke, indexReadCtx.PrefixForScanKey)
+		if TupleKey(indexReadCtx.ShardNextScanKey).Less(startKey) {
+			indexReadCtx.ShardNextScanKey = startKey
+		}
+		if endKey.Less(indexReadCtx.ShardScanEndKey) {
+			indexReadCtx.ShardScanEndKey = endKey
+		}
+		if !TupleKey(indexReadCtx.ShardNextScanKey).Less(indexReadCtx.ShardScanEndKey) {
+			indexReadCtx.CompleteInShard = true
+			return nil, 0, nil
+		}
+	}
+
 	//nextScanKey does not have the prefix of the table
 	//TODO: may be wrong,fix it
 	//if bytes.HasPrefix(indexReadCtx.ShardNextScanKey,indexReadCtx.PrefixForScanKey) {
@@ -172,6 +224,7 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 			return nil, 0, err
 		}
 
+		batchOffset := rowRead
 		rowRead += len(keys)
 		indexReadCtx.addReadCount(len(keys))
 
@@ -194,7 +247,7 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 
 			//pick wanted fields and save them in the batch
 			err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
-				0, dis, amForKey, bat, i)
+				0, dis, amForKey, bat, batchOffset+i)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -219,7 +272,7 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 
 					//fill the batch
 					err = ihi.rcc.FillBatchFromDecodedIndexValue2(indexReadCtx.IndexDesc,
-						0, vdis, amForValue, bat, i)
+						0, vdis, amForValue, bat, batchOffset+i)
 					if err != nil {
 						return nil, 0, err
 					}
@@ -232,7 +285,7 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 
 					//pick wanted fields and save them in the batch
 					err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
-						0, dis, amForValue, bat, i)
+						0, dis, amForValue, bat, batchOffset+i)
 					if err != nil {
 						return nil, 0, err
 					}
@@ -574,6 +627,15 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 			uint64(indexReadCtx.IndexDesc.ID))
 		indexReadCtx.LengthOfPrefixForScanKey = len(indexReadCtx.PrefixForScanKey)
 		indexReadCtx.PrefixEnd = SuccessorOfPrefix(indexReadCtx.PrefixForScanKey)
+
+		if indexReadCtx.ScanRange != nil {
+			ihi.initRangeScan(indexReadCtx)
+			//the range is empty
+			if !TupleKey(indexReadCtx.ScanStartKey).Less(indexReadCtx.ScanEndKey) {
+				indexReadCtx.CompleteInAllShards = true
+				return nil, 0, nil
+			}
+		}
 	}
 
 	//prepare the batch
@@ -587,11 +649,21 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 	//get keys with the prefix
 	for rowRead < int(ihi.kvLimit) {
 		needRead := int(ihi.kvLimit) - rowRead
-		keys, values, complete, nextScanKey, err := ihi.kv.GetWithPrefix(indexReadCtx.PrefixForScanKey, indexReadCtx.LengthOfPrefixForScanKey, indexReadCtx.PrefixEnd, needKeyOnly, uint64(needRead))
+		var keys []TupleKey
+		var values []TupleValue
+		var complete bool
+		var nextScanKey TupleKey
+		var err error
+		if indexReadCtx.ScanRange != nil {
+			keys, values, complete, nextScanKey, err = ihi.getRangeOfIndex(indexReadCtx, uint64(needRead))
+		} else {
+			keys, values, complete, nextScanKey, err = ihi.kv.GetWithPrefix(indexReadCtx.PrefixForScanKey, indexReadCtx.LengthOfPrefixForScanKey, indexReadCtx.PrefixEnd, needKeyOnly, uint64(needRead))
+		}
 		if err != nil {
 			return nil, 0, err
 		}
 
+		batchOffset := rowRead
 		rowRead += len(keys)
 
 		//1.decode index key
@@ -605,7 +677,7 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 
 			//pick wanted fields and save them in the batch
 			err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
-				0, dis, amForKey, bat, i)
+				0, dis, amForKey, bat, batchOffset+i)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -627,7 +699,7 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 
 					//fill the batch
 					err = ihi.rcc.FillBatchFromDecodedIndexValue2(indexReadCtx.IndexDesc,
-						0, vdis, amForValue, bat, i)
+						0, vdis, amForValue, bat, batchOffset+i)
 					if err != nil {
 						return nil, 0, err
 					}
@@ -640,7 +712,7 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 
 					//pick wanted fields and save them in the batch
 					err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
-						0, dis, amForValue, bat, i)
+						0, dis, amForValue, bat, batchOffset+i)
 					if err != nil {
 						return nil, 0, err
 					}
@@ -649,7 +721,11 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 		}
 
 		//get the next prefix
-		indexReadCtx.PrefixForScanKey = nextScanKey
+		if indexReadCtx.ScanRange != nil {
+			indexReadCtx.ScanStartKey = nextScanKey
+		} else {
+			indexReadCtx.PrefixForScanKey = nextScanKey
+		}
 		if complete {
 			indexReadCtx.CompleteInAllShards = true
 			readFinished = true
@@ -677,6 +753,68 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 	return bat, rowRead, nil
 }
 
+//initRangeScan encodes the range on the index to be scanned into keys
+func (ihi *IndexHandlerImpl) initRangeScan(readCtx *ReadContext) {
+	tke := ihi.tch.GetEncoder()
+	if readCtx.ScanIndexDesc == nil {
+		readCtx.ScanStartKey, readCtx.ScanEndKey = readCtx.ScanRange.Bounds(tke, readCtx.PrefixForScanKey)
+		return
+	}
+	prefix, _ := tke.EncodeIndexPrefix(nil, uint64(readCtx.DbDesc.ID),
+		uint64(readCtx.TableDesc.ID),
+		uint64(readCtx.ScanIndexDesc.ID))
+	readCtx.LengthOfScanIndexPrefix = len(prefix)
+	readCtx.ScanStartKey, readCtx.ScanEndKey = readCtx.ScanRange.Bounds(tke, prefix)
+}
+
+//getRangeOfIndex gets the tuples in the range of the index.
+//The tuples are located by the keys in the secondary index
+//when the secondary index is scanned.
+//It returns the keys and the values in the primary index.
+func (ihi *IndexHandlerImpl) getRangeOfIndex(readCtx *ReadContext, limit uint64) ([]TupleKey, []TupleValue, bool, TupleKey, error) {
+	keys, values, complete, nextScanKey, err := ihi.kv.GetRangeWithLimit(readCtx.ScanStartKey, readCtx.ScanEndKey, limit)
+	if err != nil {
+		return nil, nil, false, nil, err
+	}
+	if readCtx.ScanIndexDesc == nil {
+		return keys, values, complete, nextScanKey, nil
+	}
+
+	//convert the keys in the secondary index into the primary keys
+	tkd := ihi.tch.GetDecoder()
+	primaryKeys := make([]TupleKey, 0, len(keys))
+	for _, key := range keys {
+		rest, err := tkd.SkipSecondaryIndexKey(key[readCtx.LengthOfScanIndexPrefix:], readCtx.ScanIndexDesc)
+		if err != nil {
+			return nil, nil, false, nil, err
+		}
+		primaryKey := make(TupleKey, 0, readCtx.LengthOfPrefixForScanKey+len(rest))
+		primaryKey = append(primaryKey, readCtx.PrefixForScanKey[:readCtx.LengthOfPrefixForScanKey]...)
+		primaryKey = append(primaryKey, rest...)
+		primaryKeys = append(primaryKeys, primaryKey)
+	}
+	if len(primaryKeys) == 0 {
+		return nil, nil, complete, nextScanKey, nil
+	}
+
+	primaryValues, err := ihi.kv.GetBatch(primaryKeys)
+	if err != nil {
+		return nil, nil, false, nil, err
+	}
+
+	//skip the tuples that do not exist
+	keys = keys[:0]
+	values = values[:0]
+	for i, value := range primaryValues {
+		if value == nil {
+			continue
+		}
+		keys = append(keys, primaryKeys[i])
+		values = append(values, value)
+	}
+	return keys, values, complete, nextScanKey, nil
+}
+
 func (ihi *IndexHandlerImpl) WriteIntoTable(table *descriptor.RelationDesc, writeCtx interface{}, bat *batch.Batch) error {
 	return ihi.WriteIntoIndex(writeCtx, bat)
 }
@@ -805,14 +943,24 @@ func (ihi *IndexHandlerImpl) UpdateIntoIndex(writeCtx interface{}, bat *batch.Ba
 	if !ok {
 		return errorWriteContextIsInvalid
 	}
+
+	defer func() {
+		indexWriteCtx.resetWriteCache()
+	}()
+
 	oldBatchData, newBatchData := &batch.Batch{}, &batch.Batch{}
 	oldBatchData.Vecs = make([]*vector.Vector, len(bat.Vecs))
 	newBatchData.Vecs = make([]*vector.Vector, len(bat.Vecs))
 
 	row1, row2 := make([]interface{}, len(bat.Vecs)), make([]interface{}, len(bat.Vecs))
 	n := vector.Length(bat.Vecs[0])
-	var deleteKey, insertKeys []TupleKey
+	var deleteKey, insertKeys, insertIndexKeys []TupleKey
 	var insertValues []TupleValue
+
+	//1.encode prefix (tenantID,dbID,tableID,indexID)
+	indexWriteCtx.callback = ihi.makeCallbackPackage(indexWriteCtx,
+		secondaryIndexesOfTable(indexWriteCtx.TableDesc))
+
 	for j := 0; j < n/2; j++ { //row index
 		err := GetRow(indexWriteCtx, bat, row1, j)
 		if err != nil {
@@ -827,48 +975,98 @@ func (ihi *IndexHandlerImpl) UpdateIntoIndex(writeCtx interface{}, bat *batch.Ba
 		if err != nil {
 			return err
 		}
-		//1.encode prefix (tenantID,dbID,tableID,indexID)
-		tke := ihi.tch.GetEncoder()
-		var prefix TupleKey
-		prefix, _ = tke.EncodeIndexPrefix(prefix,
-			uint64(indexWriteCtx.DbDesc.ID),
-			uint64(indexWriteCtx.TableDesc.ID),
-			uint64(indexWriteCtx.IndexDesc.ID))
-
-		indexWriteCtx.callback = callbackPackage{
-			prefix: prefix,
-		}
 
 		if flag {
 			tuple := NewTupleBatchImpl(bat, row1)
+			indexKeyCnt := len(indexWriteCtx.indexKeys)
 			if err = ihi.callbackForEncodeTupleInBatch(indexWriteCtx, tuple); err != nil {
 				return err
 			}
 			deleteKey = append(deleteKey, indexWriteCtx.keys[len(indexWriteCtx.keys)-1])
+			deleteKey = append(deleteKey, indexWriteCtx.indexKeys[indexKeyCnt:]...)
 		}
 		tuple := NewTupleBatchImpl(bat, row2)
+		indexKeyCnt := len(indexWriteCtx.indexKeys)
 		err = ihi.callbackForEncodeTupleInBatch(indexWriteCtx, tuple)
 		if err != nil {
 			return err
 		}
 		insertKeys = append(insertKeys, indexWriteCtx.keys[len(indexWriteCtx.keys)-1])
 		insertValues = append(insertValues, indexWriteCtx.values[len(indexWriteCtx.values)-1])
+		insertIndexKeys = append(insertIndexKeys, indexWriteCtx.indexKeys[indexKeyCnt:]...)
 	}
-	for _, key := range deleteKey {
-		err := ihi.kv.Delete(key)
-		if err != nil {
-			return err
+	//the old tuples and their keys in the secondary indexes are replaced together
+	return ihi.kv.WriteBatch(&KVBatch{
+		DeleteKeys:  deleteKey,
+		DedupKeys:   insertKeys,
+		DedupValues: insertValues,
+		Keys:        insertIndexKeys,
+		Values:      secondaryIndexValues(len(insertIndexKeys)),
+	})
+}
+
+//encodeSecondaryIndexKey encodes the key of the tuple in the secondary index.
+//The key is the prefix of the secondary index, the attributes of the secondary index
+//and the primary key without the prefix.
+func (ihi *IndexHandlerImpl) encodeSecondaryIndexKey(writeCtx *WriteContext, prefix TupleKey, index *descriptor.IndexDesc, tuple Tuple, primaryKey TupleKey) (TupleKey, error) {
+	tke := ihi.tch.GetEncoder()
+	key := make(TupleKey, len(prefix))
+	copy(key, prefix)
+	var value interface{}
+	var err error
+	for _, attr := range index.Attributes {
+		writeState := &writeCtx.AttributeStates[attr.ID]
+		if writeState.NeedGenerated {
+			if writeState.AttrDesc.Default.Exist { //default expr
+				value = writeState.AttrDesc.Default.Value
+			} else {
+				value = writeState.ImplicitPrimaryKey
+			}
+		} else {
+			value, err = tuple.GetValue(uint32(writeState.PositionInBatch))
+			if err != nil {
+				return nil, err
+			}
 		}
+		//the null is allowed in the secondary index
+		key, _ = tke.oe.EncodeKey(key, value)
 	}
-	for i, key := range insertKeys {
-		err := ihi.kv.DedupSet(key, insertValues[i])
+	key = append(key, primaryKey[len(writeCtx.callback.prefix):]...)
+	return key, nil
+}
+
+//encodeSecondaryIndexKeys encodes the keys of the tuple in all secondary indexes
+//and appends them to the write cache.
+func (ihi *IndexHandlerImpl) encodeSecondaryIndexKeys(writeCtx *WriteContext, tuple Tuple, primaryKey TupleKey) error {
+	for i, index := range writeCtx.callback.secondaryIndexes {
+		key, err := ihi.encodeSecondaryIndexKey(writeCtx,
+			writeCtx.callback.secondaryPrefixes[i], index, tuple, primaryKey)
 		if err != nil {
 			return err
 		}
+		writeCtx.indexKeys = append(writeCtx.indexKeys, key)
 	}
 	return nil
 }
 
+//secondaryIndexValues makes the values of the keys in the secondary indexes.
+//All information is in the key. The value is empty.
+func secondaryIndexValues(n int) []TupleValue {
+	values := make([]TupleValue, n)
+	for i := range values {
+		values[i] = TupleValue{}
+	}
+	return values
+}
+
+//setSecondaryIndexKeys writes the keys of the secondary indexes.
+func (ihi *IndexHandlerImpl) setSecondaryIndexKeys(keys []TupleKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return ihi.kv.SetBatch(keys, secondaryIndexValues(len(keys)))
+}
+
 func (ihi *IndexHandlerImpl) callbackForEncodeTupleInBatch(callbackCtx interface{}, tuple Tuple) error {
 	writeCtx := callbackCtx.(*WriteContext)
 
@@ -882,6 +1080,11 @@ func (ihi *IndexHandlerImpl) callbackForEncodeTupleInBatch(callbackCtx interface
 		return err
 	}
 
+	err = ihi.encodeSecondaryIndexKeys(writeCtx, tuple, key)
+	if err != nil {
+		return err
+	}
+
 	writeCtx.keys = append(writeCtx.keys, key)
 	writeCtx.values = append(writeCtx.values, value)
 	return nil
@@ -898,16 +1101,8 @@ func (ihi *IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Bat
 	}()
 
 	//1.encode prefix (tenantID,dbID,tableID,indexID)
-	tke := ihi.tch.GetEncoder()
-	var prefix TupleKey
-	prefix, _ = tke.EncodeIndexPrefix(prefix,
-		uint64(indexWriteCtx.DbDesc.ID),
-		uint64(indexWriteCtx.TableDesc.ID),
-		uint64(indexWriteCtx.IndexDesc.ID))
-
-	indexWriteCtx.callback = callbackPackage{
-		prefix: prefix,
-	}
+	indexWriteCtx.callback = ihi.makeCallbackPackage(indexWriteCtx,
+		secondaryIndexesOfTable(indexWriteCtx.TableDesc))
 
 	//2.encode every row in the batch
 	ba := NewBatchAdapter(bat)
@@ -916,11 +1111,13 @@ func (ihi *IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Bat
 		return err
 	}
 
-	err = ihi.kv.DedupSetBatch(indexWriteCtx.keys, indexWriteCtx.values)
-	if err != nil {
-		return err
-	}
-	return nil
+	//3.write the tuples and their keys in the secondary indexes together
+	return ihi.kv.WriteBatch(&KVBatch{
+		DedupKeys:   indexWriteCtx.keys,
+		DedupValues: indexWriteCtx.values,
+		Keys:        indexWriteCtx.indexKeys,
+		Values:      secondaryIndexValues(len(indexWriteCtx.indexKeys)),
+	})
 }
 
 func (ihi *IndexHandlerImpl) DeleteFromTable(writeCtx interface{}, bat *batch.Batch) error {
@@ -936,17 +1133,14 @@ func (ihi *IndexHandlerImpl) DeleteFromIndex(writeCtx interface{}, bat *batch.Ba
 	if bat == nil {
 		return nil
 	}
-	//1.encode prefix (tenantID,dbID,tableID,indexID)
-	tke := ihi.tch.GetEncoder()
-	var prefix TupleKey
-	prefix, _ = tke.EncodeIndexPrefix(prefix,
-		uint64(indexWriteCtx.DbDesc.ID),
-		uint64(indexWriteCtx.TableDesc.ID),
-		uint64(indexWriteCtx.IndexDesc.ID))
 
-	indexWriteCtx.callback = callbackPackage{
-		prefix: prefix,
-	}
+	defer func() {
+		indexWriteCtx.resetWriteCache()
+	}()
+
+	//1.encode prefix (tenantID,dbID,tableID,indexID)
+	indexWriteCtx.callback = ihi.makeCallbackPackage(indexWriteCtx,
+		secondaryIndexesOfTable(indexWriteCtx.TableDesc))
 
 	// get every row of the delete set
 	n := vector.Length(bat.Vecs[0])
@@ -961,13 +1155,85 @@ func (ihi *IndexHandlerImpl) DeleteFromIndex(writeCtx interface{}, bat *batch.Ba
 		if err != nil {
 			return err
 		}
+		indexWriteCtx.keys = append(indexWriteCtx.keys, key)
 
-		//delete key in the kv storage
-		err = ihi.kv.Delete(key)
+		//collect keys of the secondary indexes
+		err = ihi.encodeSecondaryIndexKeys(indexWriteCtx, tuple, key)
 		if err != nil {
 			return err
 		}
+	}
+
+	//delete the tuples and their keys in the secondary indexes together
+	return ihi.kv.WriteBatch(&KVBatch{
+		DeleteKeys: append(indexWriteCtx.keys, indexWriteCtx.indexKeys...),
+	})
+}
+
+//callbackForEncodeSecondaryIndexKey encodes the keys of the tuple in the secondary indexes only
+func (ihi *IndexHandlerImpl) callbackForEncodeSecondaryIndexKey(callbackCtx interface{}, tuple Tuple) error {
+	writeCtx := callbackCtx.(*WriteContext)
+
+	key, _, err := ihi.encodePrimaryIndexKey(0, writeCtx, tuple)
+	if err != nil {
+		return err
+	}
 
+	return ihi.encodeSecondaryIndexKeys(writeCtx, tuple, key)
+}
+
+//BuildIndex scans the primary index of the table and writes
+//the keys of the secondary index for the tuples in it.
+func (ihi *IndexHandlerImpl) BuildIndex(writeCtx interface{}, indexDesc *descriptor.IndexDesc) error {
+	indexWriteCtx, ok := writeCtx.(*WriteContext)
+	if !ok {
+		return errorWriteContextIsInvalid
+	}
+
+	//read all attributes of the table
+	table := indexWriteCtx.TableDesc
+	readCtx := &ReadContext{
+		DbDesc:    indexWriteCtx.DbDesc,
+		TableDesc: table,
+		IndexDesc: &table.Primary_index,
+	}
+	for i := range table.Attributes {
+		readCtx.ReadAttributesNames = append(readCtx.ReadAttributesNames, table.Attributes[i].Name)
+		readCtx.ReadAttributeDescs = append(readCtx.ReadAttributeDescs, &table.Attributes[i])
+	}
+
+	//the tuple in the batch has all attributes in the order of the table
+	indexWriteCtx.AttributeStates = make([]AttributeStateForWrite, len(table.Attributes))
+	for i, attr := range table.Attributes {
+		indexWriteCtx.AttributeStates[i] = AttributeStateForWrite{
+			PositionInBatch: i,
+			AttrDesc:        attr,
+		}
+	}
+	indexWriteCtx.callback = ihi.makeCallbackPackage(indexWriteCtx,
+		[]*descriptor.IndexDesc{indexDesc})
+
+	for {
+		bat, _, err := ihi.ReadFromIndex(readCtx)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+
+		indexWriteCtx.colIndex = nil
+		ba := NewBatchAdapter(bat)
+		err = ba.ForEachTuple(indexWriteCtx, ihi.callbackForEncodeSecondaryIndexKey)
+		if err != nil {
+			return err
+		}
+
+		err = ihi.setSecondaryIndexKeys(indexWriteCtx.indexKeys)
+		indexWriteCtx.resetWriteCache()
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
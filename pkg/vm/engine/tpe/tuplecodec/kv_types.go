@@ -24,8 +24,52 @@ type KVType int
 const (
 	KV_MEMORY KVType = iota
 	KV_CUBE   KVType = iota + 1
+	KV_PEBBLE KVType = iota + 1
 )
 
+// KVBatch is a group of writes applied by the WriteBatch together.
+// The DeleteKeys are deleted first. Then the DedupKeys and the Keys are written.
+type KVBatch struct {
+	DeleteKeys []TupleKey
+	//DedupKeys fail the batch if one of them exists after the deletes
+	DedupKeys   []TupleKey
+	DedupValues []TupleValue
+	//Keys are overwritten
+	Keys   []TupleKey
+	Values []TupleValue
+}
+
+// check checks the counts of the keys and the values, the null keys and
+// the duplicate DedupKeys in the batch. It returns the set of the deleted keys.
+func (b *KVBatch) check() (map[string]struct{}, error) {
+	if len(b.DedupKeys) != len(b.DedupValues) || len(b.Keys) != len(b.Values) {
+		return nil, errorKeysCountNotEqualToValuesCount
+	}
+	deleted := make(map[string]struct{}, len(b.DeleteKeys))
+	for _, key := range b.DeleteKeys {
+		if key == nil {
+			return nil, errorKeyIsNull
+		}
+		deleted[string(key)] = struct{}{}
+	}
+	seen := make(map[string]struct{}, len(b.DedupKeys))
+	for _, key := range b.DedupKeys {
+		if key == nil {
+			return nil, errorKeyIsNull
+		}
+		if _, ok := seen[string(key)]; ok {
+			return nil, errorKeyExists
+		}
+		seen[string(key)] = struct{}{}
+	}
+	for _, key := range b.Keys {
+		if key == nil {
+			return nil, errorKeyIsNull
+		}
+	}
+	return deleted, nil
+}
+
 type KVHandler interface {
 	GetKVType() KVType
 
@@ -44,6 +88,10 @@ type KVHandler interface {
 	// DedupSetBatch writes the batch of keys-values. It will fail if there is one key exists
 	DedupSetBatch(keys []TupleKey, values []TupleValue) error
 
+	// WriteBatch applies the deletes and the writes in the batch together.
+	// Nothing is written if one of the DedupKeys exists.
+	WriteBatch(b *KVBatch) error
+
 	// Delete deletes the key
 	Delete(key TupleKey) error
 
@@ -160,6 +160,33 @@ func (m *MemoryKV) DedupSetBatch(keys []TupleKey, values []TupleValue) error {
 	return err
 }
 
+func (m *MemoryKV) WriteBatch(b *KVBatch) error {
+	m.rwLock.Lock()
+	defer m.rwLock.Unlock()
+	deleted, err := b.check()
+	if err != nil {
+		return err
+	}
+	for _, key := range b.DedupKeys {
+		if _, ok := deleted[string(key)]; ok {
+			continue
+		}
+		if m.container.Has(NewMemoryItem(key, nil)) {
+			return errorKeyExists
+		}
+	}
+	for _, key := range b.DeleteKeys {
+		m.container.Delete(NewMemoryItem(key, nil))
+	}
+	for i, key := range b.DedupKeys {
+		m.container.ReplaceOrInsert(NewMemoryItem(key, b.DedupValues[i]))
+	}
+	for i, key := range b.Keys {
+		m.container.ReplaceOrInsert(NewMemoryItem(key, b.Values[i]))
+	}
+	return nil
+}
+
 func (m *MemoryKV) Delete(key TupleKey) error {
 	m.rwLock.Lock()
 	defer m.rwLock.Unlock()
@@ -159,6 +159,43 @@ func TestMemoryKV_DedupSet(t *testing.T) {
 	})
 }
 
+func TestMemoryKV_WriteBatch(t *testing.T) {
+	convey.Convey("write batch", t, func() {
+		kv := NewMemoryKV()
+		err := kv.SetBatch([]TupleKey{TupleKey("a"), TupleKey("b")},
+			[]TupleValue{TupleValue("a"), TupleValue("b")})
+		convey.So(err, convey.ShouldBeNil)
+
+		//the dedup key exists, nothing is written
+		err = kv.WriteBatch(&KVBatch{
+			DeleteKeys:  []TupleKey{TupleKey("a")},
+			DedupKeys:   []TupleKey{TupleKey("b")},
+			DedupValues: []TupleValue{TupleValue("bb")},
+			Keys:        []TupleKey{TupleKey("c")},
+			Values:      []TupleValue{TupleValue("c")},
+		})
+		convey.So(err, convey.ShouldBeError)
+
+		gets, err := kv.GetBatch([]TupleKey{TupleKey("a"), TupleKey("b"), TupleKey("c")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, []TupleValue{TupleValue("a"), TupleValue("b"), nil})
+
+		//the deleted key can be written again in the batch
+		err = kv.WriteBatch(&KVBatch{
+			DeleteKeys:  []TupleKey{TupleKey("a"), TupleKey("b")},
+			DedupKeys:   []TupleKey{TupleKey("b")},
+			DedupValues: []TupleValue{TupleValue("bb")},
+			Keys:        []TupleKey{TupleKey("c")},
+			Values:      []TupleValue{TupleValue("c")},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		gets, err = kv.GetBatch([]TupleKey{TupleKey("a"), TupleKey("b"), TupleKey("c")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, []TupleValue{nil, TupleValue("bb"), TupleValue("c")})
+	})
+}
+
 func TestMemoryKV_DedupSetBatch(t *testing.T) {
 	convey.Convey("dedup set batch", t, func() {
 		kv := NewMemoryKV()
@@ -0,0 +1,326 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/matrixorigin/matrixcube/storage/kv/pebble"
+	"github.com/matrixorigin/matrixcube/util"
+)
+
+var _ KVHandler = &PebbleKV{}
+
+var (
+	errorPebbleStorageIsNull   = errors.New("the pebble storage is null")
+	errorUnsupportedInPebbleKV = errors.New("unsupported in pebble kv")
+)
+
+// PebbleKV stores the tuples in a local pebble instance.
+// It makes the single node tpe durable without the cube.
+type PebbleKV struct {
+	//rwLock serializes the check-then-write in NextID and DedupSet*
+	rwLock sync.RWMutex
+	PBKV   *pebble.Storage
+	limit  uint64
+}
+
+func NewPebbleKV(pbkv *pebble.Storage, limit uint64) (*PebbleKV, error) {
+	if pbkv == nil {
+		return nil, errorPebbleStorageIsNull
+	}
+	return &PebbleKV{
+		PBKV:  pbkv,
+		limit: limit,
+	}, nil
+}
+
+func (pk *PebbleKV) GetKVType() KVType {
+	return KV_PEBBLE
+}
+
+func (pk *PebbleKV) NextID(typ string) (uint64, error) {
+	pk.rwLock.Lock()
+	defer pk.rwLock.Unlock()
+	value, err := pk.PBKV.Get([]byte(typ))
+	if err != nil {
+		return 0, err
+	}
+	var buf [8]byte
+	var nextID uint64
+	if value != nil {
+		nextID = binary.BigEndian.Uint64(value)
+	} else {
+		//id = 3, return 2
+		nextID = UserTableIDOffset
+	}
+	binary.BigEndian.PutUint64(buf[:], nextID+1)
+	err = pk.PBKV.Set([]byte(typ), buf[:], true)
+	if err != nil {
+		return 0, err
+	}
+	return nextID, nil
+}
+
+func (pk *PebbleKV) Set(key TupleKey, value TupleValue) error {
+	if key == nil {
+		return errorKeyIsNull
+	}
+	pk.rwLock.RLock()
+	defer pk.rwLock.RUnlock()
+	return pk.PBKV.Set(key, value, true)
+}
+
+func (pk *PebbleKV) SetBatch(keys []TupleKey, values []TupleValue) error {
+	if len(keys) != len(values) {
+		return errorKeysCountNotEqualToValuesCount
+	}
+	for _, key := range keys {
+		if key == nil {
+			return errorKeyIsNull
+		}
+	}
+	pk.rwLock.RLock()
+	defer pk.rwLock.RUnlock()
+	return pk.writeBatch(keys, values)
+}
+
+// writeBatch writes the keys and the values in one atomic pebble batch.
+func (pk *PebbleKV) writeBatch(keys []TupleKey, values []TupleValue) error {
+	batch := pk.PBKV.NewWriteBatch().(util.WriteBatch)
+	defer batch.Close()
+	for i, key := range keys {
+		batch.Set(key, values[i])
+	}
+	return pk.PBKV.Write(batch, true)
+}
+
+// exists checks the key is in the storage.
+// The Get of the pebble storage can not tell the key with the empty value
+// from the absent key, so it seeks the key instead.
+func (pk *PebbleKV) exists(key TupleKey) (bool, error) {
+	k, _, err := pk.PBKV.Seek(key)
+	if err != nil {
+		return false, err
+	}
+	return k != nil && bytes.Equal(k, key), nil
+}
+
+func (pk *PebbleKV) DedupSet(key TupleKey, value TupleValue) error {
+	if key == nil {
+		return errorKeyIsNull
+	}
+	pk.rwLock.Lock()
+	defer pk.rwLock.Unlock()
+	ok, err := pk.exists(key)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errorKeyExists
+	}
+	return pk.PBKV.Set(key, value, true)
+}
+
+func (pk *PebbleKV) DedupSetBatch(keys []TupleKey, values []TupleValue) error {
+	if len(keys) != len(values) {
+		return errorKeysCountNotEqualToValuesCount
+	}
+	pk.rwLock.Lock()
+	defer pk.rwLock.Unlock()
+
+	//check nils and duplication before writing anything
+	seen := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if key == nil {
+			return errorKeyIsNull
+		}
+		if _, ok := seen[string(key)]; ok {
+			return errorKeyExists
+		}
+		seen[string(key)] = struct{}{}
+		ok, err := pk.exists(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return errorKeyExists
+		}
+	}
+	return pk.writeBatch(keys, values)
+}
+
+func (pk *PebbleKV) WriteBatch(b *KVBatch) error {
+	pk.rwLock.Lock()
+	defer pk.rwLock.Unlock()
+	deleted, err := b.check()
+	if err != nil {
+		return err
+	}
+	for _, key := range b.DedupKeys {
+		if _, ok := deleted[string(key)]; ok {
+			continue
+		}
+		ok, err := pk.exists(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return errorKeyExists
+		}
+	}
+
+	batch := pk.PBKV.NewWriteBatch().(util.WriteBatch)
+	defer batch.Close()
+	for _, key := range b.DeleteKeys {
+		batch.Delete(key)
+	}
+	for i, key := range b.DedupKeys {
+		batch.Set(key, b.DedupValues[i])
+	}
+	for i, key := range b.Keys {
+		batch.Set(key, b.Values[i])
+	}
+	return pk.PBKV.Write(batch, true)
+}
+
+func (pk *PebbleKV) Delete(key TupleKey) error {
+	if key == nil {
+		return errorKeyIsNull
+	}
+	pk.rwLock.RLock()
+	defer pk.rwLock.RUnlock()
+	return pk.PBKV.Delete(key, true)
+}
+
+func (pk *PebbleKV) DeleteWithPrefix(prefix TupleKey) error {
+	if prefix == nil {
+		return errorPrefixIsNull
+	}
+	pk.rwLock.RLock()
+	defer pk.rwLock.RUnlock()
+	return pk.PBKV.RangeDelete(prefix, SuccessorOfPrefix(prefix), true)
+}
+
+func (pk *PebbleKV) Get(key TupleKey) (TupleValue, error) {
+	if key == nil {
+		return nil, errorKeyIsNull
+	}
+	return pk.PBKV.Get(key)
+}
+
+func (pk *PebbleKV) GetBatch(keys []TupleKey) ([]TupleValue, error) {
+	for _, key := range keys {
+		if key == nil {
+			return nil, errorKeyIsNull
+		}
+	}
+
+	values := make([]TupleValue, len(keys))
+	for i, key := range keys {
+		value, err := pk.PBKV.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (pk *PebbleKV) GetRange(startKey TupleKey, endKey TupleKey) ([]TupleValue, error) {
+	var values []TupleValue
+	err := pk.PBKV.Scan(startKey, endKey, func(key, value []byte) (bool, error) {
+		values = append(values, value)
+		return true, nil
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// scanWithLimit reads at most limit keys in [startKey,endKey) that have the prefix.
+// It reads one more key to know whether the range is complete.
+func (pk *PebbleKV) scanWithLimit(startKey TupleKey, endKey TupleKey, prefix TupleKey, needKeyOnly bool, limit uint64) ([]TupleKey, []TupleValue, bool, TupleKey, error) {
+	var keys []TupleKey
+	var values []TupleValue
+	more := false
+	err := pk.PBKV.Scan(startKey, endKey, func(key, value []byte) (bool, error) {
+		if prefix != nil && !bytes.HasPrefix(key, prefix) {
+			return false, nil
+		}
+		if uint64(len(keys)) >= limit {
+			more = true
+			return false, nil
+		}
+		keys = append(keys, key)
+		if needKeyOnly {
+			values = append(values, nil)
+		} else {
+			values = append(values, value)
+		}
+		return true, nil
+	}, true)
+	if err != nil {
+		return nil, nil, false, nil, err
+	}
+
+	if more && len(keys) != 0 {
+		return keys, values, false, SuccessorOfKey(keys[len(keys)-1]), nil
+	}
+	return keys, values, true, nil, nil
+}
+
+func (pk *PebbleKV) GetRangeWithLimit(startKey TupleKey, endKey TupleKey, limit uint64) ([]TupleKey, []TupleValue, bool, TupleKey, error) {
+	return pk.scanWithLimit(startKey, endKey, nil, false, limit)
+}
+
+func (pk *PebbleKV) GetRangeWithPrefixLimit(startKey TupleKey, endKey TupleKey, prefix TupleKey, limit uint64) ([]TupleKey, []TupleValue, bool, TupleKey, error) {
+	if prefix == nil {
+		return nil, nil, false, nil, errorPrefixIsNull
+	}
+	//clip the range into the prefix
+	if startKey == nil || bytes.Compare(startKey, prefix) < 0 {
+		startKey = prefix
+	}
+	prefixEnd := SuccessorOfPrefix(prefix)
+	if endKey == nil || bytes.Compare(prefixEnd, endKey) < 0 {
+		endKey = prefixEnd
+	}
+	return pk.scanWithLimit(startKey, endKey, prefix, false, limit)
+}
+
+func (pk *PebbleKV) GetWithPrefix(prefixOrStartkey TupleKey, prefixLen int, prefixEnd []byte, needKeyOnly bool, limit uint64) ([]TupleKey, []TupleValue, bool, TupleKey, error) {
+	if prefixOrStartkey == nil {
+		return nil, nil, false, nil, errorPrefixIsNull
+	}
+
+	if prefixLen > len(prefixOrStartkey) {
+		return nil, nil, false, nil, errorPrefixLengthIsLongerThanStartKey
+	}
+	prefix := prefixOrStartkey[:prefixLen]
+	return pk.scanWithLimit(prefixOrStartkey, SuccessorOfPrefix(prefix), prefix, needKeyOnly, limit)
+}
+
+func (pk *PebbleKV) GetShardsWithRange(startKey TupleKey, endKey TupleKey) (interface{}, error) {
+	return nil, errorUnsupportedInPebbleKV
+}
+
+func (pk *PebbleKV) GetShardsWithPrefix(prefix TupleKey) (interface{}, error) {
+	return nil, errorUnsupportedInPebbleKV
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"fmt"
+	"testing"
+
+	cpebble "github.com/cockroachdb/pebble"
+	"github.com/matrixorigin/matrixcube/storage/kv/pebble"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newTestPebbleStorage(dir string) *pebble.Storage {
+	pbkv, err := pebble.NewStorage(dir, nil, &cpebble.Options{
+		FS: vfs.NewPebbleFS(vfs.Default),
+	})
+	convey.So(err, convey.ShouldBeNil)
+	return pbkv
+}
+
+func TestPebbleKV_NextID(t *testing.T) {
+	convey.Convey("next id", t, func() {
+		dir := t.TempDir()
+		pbkv := newTestPebbleStorage(dir)
+		kv, err := NewPebbleKV(pbkv, 10)
+		convey.So(err, convey.ShouldBeNil)
+
+		typ := "xxxxx"
+		for i := 0; i < 100; i++ {
+			id, err := kv.NextID(typ)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(id, convey.ShouldEqual, uint64(i)+UserTableIDOffset)
+		}
+
+		//the id survives the restart
+		convey.So(pbkv.Close(), convey.ShouldBeNil)
+		pbkv = newTestPebbleStorage(dir)
+		defer pbkv.Close()
+		kv, err = NewPebbleKV(pbkv, 10)
+		convey.So(err, convey.ShouldBeNil)
+
+		id, err := kv.NextID(typ)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(id, convey.ShouldEqual, uint64(100)+UserTableIDOffset)
+	})
+}
+
+func TestPebbleKV_DedupSetBatch(t *testing.T) {
+	convey.Convey("dedup set batch", t, func() {
+		pbkv := newTestPebbleStorage(t.TempDir())
+		defer pbkv.Close()
+		kv, err := NewPebbleKV(pbkv, 10)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = kv.DedupSet(TupleKey("d"), TupleValue{})
+		convey.So(err, convey.ShouldBeNil)
+
+		//the key with the empty value exists also
+		err = kv.DedupSet(TupleKey("d"), TupleValue("d"))
+		convey.So(err, convey.ShouldBeError)
+
+		keys := []TupleKey{TupleKey("a"), TupleKey("b"), TupleKey("c")}
+		values := []TupleValue{TupleValue("a"), TupleValue("b"), TupleValue("c")}
+
+		//the duplicate key in the batch
+		err = kv.DedupSetBatch(append(keys, TupleKey("c")), append(values, TupleValue("c")))
+		convey.So(err, convey.ShouldBeError)
+
+		//the key exists in the storage
+		err = kv.DedupSetBatch(append(keys, TupleKey("d")), append(values, TupleValue("d")))
+		convey.So(err, convey.ShouldBeError)
+
+		//nothing is written by the failed batch
+		gets, err := kv.GetBatch(keys)
+		convey.So(err, convey.ShouldBeNil)
+		for _, get := range gets {
+			convey.So(get, convey.ShouldBeNil)
+		}
+
+		err = kv.DedupSetBatch(keys, values)
+		convey.So(err, convey.ShouldBeNil)
+
+		gets, err = kv.GetBatch(keys)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, values)
+	})
+}
+
+func TestPebbleKV_WriteBatch(t *testing.T) {
+	convey.Convey("write batch", t, func() {
+		pbkv := newTestPebbleStorage(t.TempDir())
+		defer pbkv.Close()
+		kv, err := NewPebbleKV(pbkv, 10)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = kv.SetBatch([]TupleKey{TupleKey("a"), TupleKey("b")},
+			[]TupleValue{TupleValue("a"), TupleValue{}})
+		convey.So(err, convey.ShouldBeNil)
+
+		//the dedup key with the empty value exists, nothing is written
+		err = kv.WriteBatch(&KVBatch{
+			DeleteKeys:  []TupleKey{TupleKey("a")},
+			DedupKeys:   []TupleKey{TupleKey("b")},
+			DedupValues: []TupleValue{TupleValue("b")},
+			Keys:        []TupleKey{TupleKey("c")},
+			Values:      []TupleValue{TupleValue("c")},
+		})
+		convey.So(err, convey.ShouldBeError)
+
+		gets, err := kv.GetBatch([]TupleKey{TupleKey("a"), TupleKey("c")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, []TupleValue{TupleValue("a"), nil})
+
+		//the deleted key can be written again in the batch
+		err = kv.WriteBatch(&KVBatch{
+			DeleteKeys:  []TupleKey{TupleKey("a"), TupleKey("b")},
+			DedupKeys:   []TupleKey{TupleKey("b")},
+			DedupValues: []TupleValue{TupleValue("b")},
+			Keys:        []TupleKey{TupleKey("c")},
+			Values:      []TupleValue{TupleValue("c")},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		gets, err = kv.GetBatch([]TupleKey{TupleKey("a"), TupleKey("b"), TupleKey("c")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, []TupleValue{nil, TupleValue("b"), TupleValue("c")})
+	})
+}
+
+func TestPebbleKV_GetWithPrefix(t *testing.T) {
+	convey.Convey("get with prefix", t, func() {
+		pbkv := newTestPebbleStorage(t.TempDir())
+		defer pbkv.Close()
+		kv, err := NewPebbleKV(pbkv, 10)
+		convey.So(err, convey.ShouldBeNil)
+
+		prefix := "abc"
+		cnt := 25
+
+		var keys []TupleKey
+		var values []TupleValue
+		for i := 0; i < cnt; i++ {
+			keys = append(keys, TupleKey(prefix+fmt.Sprintf("%20d", i)))
+			values = append(values, TupleValue(fmt.Sprintf("v%d", i)))
+		}
+		err = kv.SetBatch(keys, values)
+		convey.So(err, convey.ShouldBeNil)
+
+		//the key out of the prefix
+		err = kv.Set(TupleKey("abd"), TupleValue("x"))
+		convey.So(err, convey.ShouldBeNil)
+
+		step := 10
+		prefixEnd := SuccessorOfPrefix([]byte(prefix))
+		last := TupleKey(prefix)
+		var readValues []TupleValue
+		for {
+			_, values, complete, nextScanKey, err := kv.GetWithPrefix(last, len(prefix), prefixEnd, false, uint64(step))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(values), convey.ShouldBeLessThanOrEqualTo, step)
+			readValues = append(readValues, values...)
+			if complete {
+				break
+			}
+			last = nextScanKey
+		}
+		convey.So(readValues, convey.ShouldResemble, values)
+
+		_, rangeValues, complete, _, err := kv.GetRangeWithLimit(keys[5], keys[8], uint64(step))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(complete, convey.ShouldBeTrue)
+		convey.So(rangeValues, convey.ShouldResemble, values[5:8])
+
+		_, rangeValues, _, _, err = kv.GetRangeWithPrefixLimit(nil, nil, TupleKey(prefix), uint64(cnt+1))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rangeValues, convey.ShouldResemble, values)
+
+		err = kv.DeleteWithPrefix(TupleKey(prefix))
+		convey.So(err, convey.ShouldBeNil)
+
+		gets, err := kv.GetRange(TupleKey("a"), TupleKey("b"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(gets, convey.ShouldResemble, []TupleValue{TupleValue("x")})
+	})
+}
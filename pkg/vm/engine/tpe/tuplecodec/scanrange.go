@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/orderedcodec"
+)
+
+// ScanRange denotes the bounds on the first attribute of an index.
+// A nil bound means the range is unbounded on that side.
+type ScanRange struct {
+	Low           interface{}
+	LowInclusive  bool
+	High          interface{}
+	HighInclusive bool
+}
+
+func compareBoundValue(a, b interface{}) int {
+	oe := orderedcodec.NewOrderedEncoder()
+	ka, _ := oe.EncodeKey(nil, a)
+	kb, _ := oe.EncodeKey(nil, b)
+	return bytes.Compare(ka, kb)
+}
+
+// Intersect narrows the range with another range on the same attribute.
+func (sr *ScanRange) Intersect(another *ScanRange) *ScanRange {
+	ret := *sr
+	if another.Low != nil {
+		if ret.Low == nil {
+			ret.Low, ret.LowInclusive = another.Low, another.LowInclusive
+		} else if c := compareBoundValue(another.Low, ret.Low); c > 0 || (c == 0 && !another.LowInclusive) {
+			ret.Low, ret.LowInclusive = another.Low, another.LowInclusive
+		}
+	}
+	if another.High != nil {
+		if ret.High == nil {
+			ret.High, ret.HighInclusive = another.High, another.HighInclusive
+		} else if c := compareBoundValue(another.High, ret.High); c < 0 || (c == 0 && !another.HighInclusive) {
+			ret.High, ret.HighInclusive = another.High, another.HighInclusive
+		}
+	}
+	return &ret
+}
+
+// IsPoint decides the range holds one value only.
+func (sr *ScanRange) IsPoint() bool {
+	return sr.Low != nil && sr.High != nil &&
+		sr.LowInclusive && sr.HighInclusive &&
+		compareBoundValue(sr.Low, sr.High) == 0
+}
+
+// Bounds encodes the range under the prefix of the index into the keys [startKey,endKey).
+// The null is out of any range.
+func (sr *ScanRange) Bounds(tke *TupleKeyEncoder, prefix TupleKey) (TupleKey, TupleKey) {
+	var startKey, endKey TupleKey
+	if sr.Low == nil {
+		startKey, _ = tke.oe.EncodeNull(append(TupleKey{}, prefix...))
+		startKey = SuccessorOfPrefix(startKey)
+	} else {
+		startKey, _ = tke.oe.EncodeKey(append(TupleKey{}, prefix...), sr.Low)
+		if !sr.LowInclusive {
+			startKey = SuccessorOfPrefix(startKey)
+		}
+	}
+	if sr.High == nil {
+		endKey = SuccessorOfPrefix(prefix)
+	} else {
+		endKey, _ = tke.oe.EncodeKey(append(TupleKey{}, prefix...), sr.High)
+		if sr.HighInclusive {
+			endKey = SuccessorOfPrefix(endKey)
+		}
+	}
+	return startKey, endKey
+}
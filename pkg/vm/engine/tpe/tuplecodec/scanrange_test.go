@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestScanRange_Intersect(t *testing.T) {
+	convey.Convey("intersect", t, func() {
+		a := &ScanRange{Low: int64(1), LowInclusive: true}
+		b := &ScanRange{Low: int64(1), High: uint64(10), HighInclusive: true}
+
+		c := a.Intersect(b)
+		convey.So(c.Low, convey.ShouldEqual, int64(1))
+		convey.So(c.LowInclusive, convey.ShouldBeFalse)
+		convey.So(c.High, convey.ShouldEqual, uint64(10))
+		convey.So(c.HighInclusive, convey.ShouldBeTrue)
+		convey.So(c.IsPoint(), convey.ShouldBeFalse)
+
+		d := c.Intersect(&ScanRange{Low: int64(-1), High: int64(10)})
+		convey.So(d.Low, convey.ShouldEqual, int64(1))
+		convey.So(d.High, convey.ShouldEqual, int64(10))
+		convey.So(d.HighInclusive, convey.ShouldBeFalse)
+
+		e := a.Intersect(&ScanRange{High: int64(1), HighInclusive: true})
+		convey.So(e.IsPoint(), convey.ShouldBeTrue)
+	})
+}
+
+func TestScanRange_Bounds(t *testing.T) {
+	convey.Convey("bounds", t, func() {
+		tch := NewTupleCodecHandler(SystemTenantID)
+		tke := tch.GetEncoder()
+		prefix, _ := tke.EncodeIndexPrefix(nil, 2, 3, 4)
+
+		var keys []TupleKey
+		for _, v := range []interface{}{nil, int64(-5), int64(0), uint64(5), uint64(7), uint64(1 << 63)} {
+			key, _ := tke.oe.EncodeKey(append(TupleKey{}, prefix...), v)
+			//with the primary key
+			key, _ = tke.oe.EncodeKey(key, "pk")
+			keys = append(keys, key)
+		}
+
+		count := func(r *ScanRange) []int {
+			var ret []int
+			start, end := r.Bounds(tke, prefix)
+			for i, key := range keys {
+				if !key.Less(start) && key.Less(end) {
+					ret = append(ret, i)
+				}
+			}
+			return ret
+		}
+
+		convey.So(count(&ScanRange{}), convey.ShouldResemble, []int{1, 2, 3, 4, 5})
+		convey.So(count(&ScanRange{Low: int64(0), LowInclusive: true}), convey.ShouldResemble, []int{2, 3, 4, 5})
+		convey.So(count(&ScanRange{Low: int64(0)}), convey.ShouldResemble, []int{3, 4, 5})
+		convey.So(count(&ScanRange{High: uint64(5)}), convey.ShouldResemble, []int{1, 2})
+		convey.So(count(&ScanRange{High: uint64(5), HighInclusive: true}), convey.ShouldResemble, []int{1, 2, 3})
+		convey.So(count(&ScanRange{Low: int64(7), LowInclusive: true, High: int64(7), HighInclusive: true}), convey.ShouldResemble, []int{4})
+		convey.So(count(&ScanRange{Low: int64(6), High: int64(7)}), convey.ShouldBeNil)
+
+		start, end := (&ScanRange{Low: int64(7), High: int64(6)}).Bounds(tke, prefix)
+		convey.So(start.Less(end), convey.ShouldBeFalse)
+	})
+}
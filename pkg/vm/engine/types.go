@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
 type Snapshot []byte
@@ -61,6 +62,10 @@ type Property struct {
 	Value string
 }
 
+// ClusterByProperty is the table property listing the comma separated
+// columns the rows of the table are kept sorted on
+const ClusterByProperty = "cluster_by"
+
 type NodeInfo struct {
 	Mcpu int
 }
@@ -122,6 +127,11 @@ type CommentDef struct {
 	Comment string
 }
 
+// ViewDef marks the relation as a view, View is the sql text of its definition
+type ViewDef struct {
+	View string
+}
+
 type TableDef interface {
 	tableDef()
 }
@@ -131,6 +141,7 @@ func (*AttributeDef) tableDef()   {}
 func (*IndexTableDef) tableDef()  {}
 func (*PartitionByDef) tableDef() {}
 func (*PropertiesDef) tableDef()  {}
+func (*ViewDef) tableDef()        {}
 
 type Relation interface {
 	Statistics
@@ -159,6 +170,15 @@ type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
 
+// SortedRelation is implemented by the relations that keep their rows sorted
+// on the columns of the ClusterByProperty
+type SortedRelation interface {
+	// NewSortedReader is like NewReader, but the rows of every batch read are
+	// sorted on the cluster by columns. The rows are sorted in the memory of
+	// the mheap of the caller
+	NewSortedReader(int, *mheap.Mheap, Snapshot) []Reader
+}
+
 type Filter interface {
 	Eq(string, interface{}) (*roaring.Bitmap, error)
 	Ne(string, interface{}) (*roaring.Bitmap, error)
@@ -205,6 +225,18 @@ type Engine interface {
 	Node(string, Snapshot) *NodeInfo
 }
 
+// Backuper is implemented by the engines that can take an online backup
+type Backuper interface {
+	// Backup copies a consistent snapshot of the engine into the dir
+	Backup(dir string) error
+}
+
+// Compactor is implemented by the engines that can compact a table on demand
+type Compactor interface {
+	// Compact runs the pending compaction and merge jobs of the table
+	Compact(dbName, tblName string) error
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
@@ -1,6 +1,8 @@
 package mheap
 
 import (
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -24,8 +26,14 @@ func Free(m *Mheap, data []byte) {
 	//m.Gm.Free(int64(cap(data)))
 }
 
+// Allocated returns the bytes allocated by all the heaps
+func Allocated() int64 {
+	return atomic.LoadInt64(&allocated)
+}
+
 func Alloc(m *Mheap, size int64) ([]byte, error) {
 	data := mempool.Alloc(m.Mp, int(size))
+	atomic.AddInt64(&allocated, int64(cap(data)))
 	/*
 		if err := m.Gm.Alloc(int64(cap(data))); err != nil {
 			return nil, err
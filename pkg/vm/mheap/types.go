@@ -15,6 +15,9 @@ type Mheap interface {
 }
 */
 
+// allocated is the bytes allocated by all the heaps
+var allocated int64
+
 type Mheap struct {
 	Gm *guest.Mmu
 	Mp *mempool.Mempool
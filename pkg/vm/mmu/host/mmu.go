@@ -29,6 +29,10 @@ func (m *Mmu) Size() int64 {
 	return atomic.LoadInt64(&m.size)
 }
 
+func (m *Mmu) Limit() int64 {
+	return m.limit
+}
+
 func (m *Mmu) Free(size int64) {
 	atomic.AddInt64(&m.size, size*-1)
 }
@@ -24,8 +24,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeorder"
@@ -36,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -56,12 +60,16 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Connector:  connector.String,
 	Projection: projection.String,
 	Complement: complement.String,
+	Semi:       semi.String,
+	Mark:       mark.String,
+	Single:     single.String,
 
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
 	MergeOrder:  mergeorder.String,
 	MergeGroup:  mergegroup.String,
 	MergeOffset: mergeoffset.String,
+	MergeJoin:   mergejoin.String,
 }
 
 var prepareFunc = [...]func(*process.Process, interface{}) error{
@@ -80,12 +88,16 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Connector:  connector.Prepare,
 	Projection: projection.Prepare,
 	Complement: complement.Prepare,
+	Semi:       semi.Prepare,
+	Mark:       mark.Prepare,
+	Single:     single.Prepare,
 
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
 	MergeOrder:  mergeorder.Prepare,
 	MergeGroup:  mergegroup.Prepare,
 	MergeOffset: mergeoffset.Prepare,
+	MergeJoin:   mergejoin.Prepare,
 }
 
 var execFunc = [...]func(*process.Process, interface{}) (bool, error){
@@ -104,10 +116,14 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	Connector:  connector.Call,
 	Projection: projection.Call,
 	Complement: complement.Call,
+	Semi:       semi.Call,
+	Mark:       mark.Call,
+	Single:     single.Call,
 
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
 	MergeOrder:  mergeorder.Call,
 	MergeGroup:  mergegroup.Call,
 	MergeOffset: mergeoffset.Call,
+	MergeJoin:   mergejoin.Call,
 }
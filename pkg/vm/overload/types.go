@@ -30,10 +30,14 @@ const (
 	Connector
 	Projection
 	Complement
+	Semi
+	Mark
+	Single
 
 	MergeTop
 	MergeLimit
 	MergeOrder
 	MergeGroup
 	MergeOffset
+	MergeJoin
 )
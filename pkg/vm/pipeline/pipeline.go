@@ -55,7 +55,10 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 			for i, in := range p.instructions {
 				if in.Op == vm.Connector {
 					arg := p.instructions[i].Arg.(*connector.Argument)
-					arg.Reg.Ch <- nil
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
 					break
 				}
 			}
@@ -68,10 +71,17 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
+		// stop reading as soon as the statement is cancelled or timed out
+		if err = proc.Ctx.Err(); err != nil {
+			return false, err
+		}
 		// read data from storage engine
 		if bat, err = r.Read(p.refCnts, p.attrs); err != nil {
 			return false, err
 		}
+		if bat != nil {
+			process.AddRowsRead(proc, int64(batch.Length(bat)))
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = vm.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -89,7 +99,10 @@ func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
 			for i, in := range p.instructions {
 				if in.Op == vm.Connector {
 					arg := p.instructions[i].Arg.(*connector.Argument)
-					arg.Reg.Ch <- nil
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
 					break
 				}
 			}
@@ -103,6 +116,9 @@ func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
+		if err = proc.Ctx.Err(); err != nil {
+			return false, err
+		}
 		proc.Reg.InputBatch = nil
 		if end, err = vm.Run(p.instructions, proc); err != nil || end {
 			return end, err
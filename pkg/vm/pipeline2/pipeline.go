@@ -86,10 +86,17 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 	}
 	refCnts := make([]uint64, len(p.attrs))
 	for {
+		// stop reading as soon as the statement is cancelled or timed out
+		if err = proc.Ctx.Err(); err != nil {
+			return false, err
+		}
 		// read data from storage engine
 		if bat, err = r.Read(refCnts, p.attrs); err != nil {
 			return false, err
 		}
+		if bat != nil {
+			process.AddRowsRead(proc, int64(batch.Length(bat)))
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = overload.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -133,6 +140,9 @@ func (p *Pipeline) ConstRun(bat *batch.Batch, proc *process.Process) (bool, erro
 	if err = overload.Prepare(p.instructions, proc); err != nil {
 		return false, err
 	}
+	if bat != nil {
+		process.AddRowsRead(proc, int64(batch.Length(bat)))
+	}
 	// processing the batch according to the instructions
 	proc.Reg.InputBatch = bat
 	end, err = overload.Run(p.instructions, proc)
@@ -184,6 +194,9 @@ func (p *Pipeline) MergeRun(proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
+		if err = proc.Ctx.Err(); err != nil {
+			return false, err
+		}
 		proc.Reg.InputBatch = nil
 		if end, err = overload.Run(p.instructions, proc); err != nil || end {
 			return end, err
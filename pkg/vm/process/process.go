@@ -15,6 +15,11 @@
 package process
 
 import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -25,7 +30,28 @@ import (
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:       m,
+		Ctx:      context.Background(),
+		TimeZone: time.Local,
+	}
+}
+
+// AddRowsRead adds n to the rows read by the statement of the process
+func AddRowsRead(proc *Process, n int64) {
+	if proc.RowsRead != nil {
+		atomic.AddInt64(proc.RowsRead, n)
+	}
+}
+
+// ReceiveBatch receives a batch from reg. It returns the error of the context
+// of the process once the context is done, so that a cancelled statement does
+// not wait on the pipelines feeding it
+func ReceiveBatch(proc *Process, reg *WaitRegister) (*batch.Batch, error) {
+	select {
+	case <-proc.Ctx.Done():
+		return nil, proc.Ctx.Err()
+	case bat := <-reg.Ch:
+		return bat, nil
 	}
 }
 
@@ -16,6 +16,7 @@ package process
 
 import (
 	"context"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -73,4 +74,16 @@ type Process struct {
 
 	// snapshot is transaction context
 	Cancel context.CancelFunc
+
+	// Ctx, bounds the whole execution of a statement, it will be done
+	// when the statement is killed or has run out of its time budget.
+	Ctx context.Context
+
+	// RowsRead, counts the rows read from the storage engine by the
+	// statement, it is shared by all the processes of the statement.
+	RowsRead *int64
+
+	// TimeZone, the time zone of the session, TIMESTAMP values are
+	// converted from and to the wall clock in it.
+	TimeZone *time.Location
 }